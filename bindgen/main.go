@@ -8,7 +8,9 @@ import (
 	"io"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/smartcontractkit/chainlink-sui/bindgen/parse"
@@ -16,26 +18,41 @@ import (
 )
 
 func main() {
-	inputFile := flag.String("input", "", "path to Move Sui contract file to parse")
-	outputFolder := flag.String("output", "", "path to output directory")
+	inputFile := flag.String("input", "", "path to Move Sui contract file to parse, with --normalized only used to find the emitted events and the parameter names")
+	normalizedFile := flag.String("normalized", "", "path to the saved JSON result of sui_getNormalizedMoveModulesByPackage, used instead of the contract file")
+	moduleName := flag.String("module", "", "module of the normalized package to generate bindings for, optional for single module packages")
+	packageName := flag.String("package", "", "named address of the normalized package, defaults to its address")
+	outputFolder := flag.String("output", "", "path to output directory, of the bindings of each module of the package without --input")
 	moveConfigPath := flag.String("moveConfig", "", "path to Move.toml file")
+	dependencies := flag.String("dependencies", "", "without --input, comma-separated list of name=output of the local dependencies of the package to generate bindings for, by their name in Move.toml")
 	uppercase := flag.String("uppercase", "", "list of words to convert to uppercase")
+	mocks := flag.Bool("mocks", false, "also generate gomock mocks of the interfaces of each module, in a mocks package next to its bindings")
 
 	flag.Parse()
 
+	source := *inputFile
+	if *normalizedFile != "" {
+		source = *normalizedFile
+	}
+	if source == "" {
+		source = *moveConfigPath
+	}
+
 	fmt.Println(fmt.Sprintf(`
 	##############################################################
 	Generating Go bindings for: %s
 	##############################################################
-	`, *inputFile))
+	`, source))
 
-	// Validate the move config path exists before using it
-	if *moveConfigPath == "" {
-		log.Fatalf("Move config path is required")
-	}
-	cleanPath := filepath.Clean(*moveConfigPath)
-	if _, err := os.Stat(cleanPath); os.IsNotExist(err) {
-		log.Fatalf("Move config file does not exist at path: %s", cleanPath)
+	// Validate the move config path exists before using it, normalized modules need none
+	if *normalizedFile == "" {
+		if *moveConfigPath == "" {
+			log.Fatalf("Move config path is required")
+		}
+		cleanPath := filepath.Clean(*moveConfigPath)
+		if _, err := os.Stat(cleanPath); os.IsNotExist(err) {
+			log.Fatalf("Move config file does not exist at path: %s", cleanPath)
+		}
 	}
 
 	if *uppercase != "" {
@@ -45,7 +62,16 @@ func main() {
 		log.Printf("Capitalizing %v words: %v", len(template.UppercaseWords), strings.Join(template.UppercaseWords, ", "))
 	}
 
-	file, err := os.Open(*inputFile)
+	// without a contract file, generate the bindings of every module of the package and its dependencies
+	if *inputFile == "" && *normalizedFile == "" {
+		if err := generatePackage(*moveConfigPath, *outputFolder, *dependencies, *mocks); err != nil {
+			log.Fatal(err)
+		}
+
+		return
+	}
+
+	file, err := os.Open(source)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -54,35 +80,191 @@ func main() {
 		log.Fatal(err)
 	}
 
-	pkg, mod, err := parse.ParseModule(fileBytes)
-	if err != nil {
-		panic(err)
-	}
-
-	funcs, err := parse.ParseFunctions(fileBytes)
-	if err != nil {
-		panic(err)
+	var module parse.Module
+	if *normalizedFile != "" {
+		module, err = parse.ParseNormalizedModule(fileBytes, *moduleName)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if *packageName != "" {
+			module.Package = *packageName
+		}
+		// the bytecode doesn't tell which structs are events, take them from the source when given
+		if *inputFile != "" {
+			sourceBytes, err := os.ReadFile(*inputFile)
+			if err != nil {
+				log.Fatal(err)
+			}
+			events := parse.ParseEvents(sourceBytes)
+			for i := range module.Structs {
+				module.Structs[i].IsEvent = events[module.Structs[i].Name]
+			}
+			// nor the names of the parameters
+			sourceFuncs, err := parse.ParseFunctions(sourceBytes)
+			if err != nil {
+				log.Fatal(err)
+			}
+			module.UseSourceNames(sourceFuncs)
+		}
+	} else {
+		module, err = parse.ParseSourceModule(fileBytes)
+		if err != nil {
+			panic(err)
+		}
 	}
 
-	structs, err := parse.ParseStructs(fileBytes)
-	if err != nil {
-		panic(err)
+	if err := generateModule(module, nil, *outputFolder, *mocks); err != nil {
+		log.Fatal(err)
 	}
+}
 
-	data, err := template.Convert(pkg, mod, structs, funcs)
+// generateModule writes the bindings of a module to outputFolder, with mocks of its interfaces if mocks is set, the
+// structs of other modules being resolved through registry, which may be nil.
+func generateModule(module parse.Module, registry *template.Registry, outputFolder string, mocks bool) error {
+	data, err := template.ConvertModule(module, registry)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 	t, err := template.Generate(data)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	outputFile := filepath.Join(*outputFolder, fmt.Sprintf("%s.go", data.Module))
+	outputFile := filepath.Join(outputFolder, fmt.Sprintf("%s.go", data.Module))
 
 	log.Printf("Writing output to %s", outputFile)
 	_ = os.MkdirAll(filepath.Dir(outputFile), os.ModePerm)
+
 	if err := os.WriteFile(outputFile, []byte(t), 0600); err != nil {
-		panic(err)
+		return err
+	}
+	if !mocks {
+		return nil
+	}
+
+	// mocks live in their own package, so that gomock is only imported by the code using them
+	importPath, err := goImportPath(outputFolder)
+	if err != nil {
+		return err
+	}
+	m, err := template.GenerateMock(data, importPath)
+	if err != nil {
+		return err
+	}
+	mockFile := filepath.Join(outputFolder, "mocks", fmt.Sprintf("mock_%s.go", data.Module))
+	log.Printf("Writing mocks to %s", mockFile)
+	_ = os.MkdirAll(filepath.Dir(mockFile), os.ModePerm)
+
+	return os.WriteFile(mockFile, []byte(m), 0600)
+}
+
+// generatePackage writes the bindings of every module of the package at moveConfigPath to outputFolder, each in a
+// directory named after the module, and does the same for the local dependencies given as name=output. The structs
+// of the package and of all its local dependencies are registered, so that modules use the Go types of the structs
+// of other modules. Structs of dependencies without bindings are referenced as bind.Object.
+func generatePackage(moveConfigPath, outputFolder, dependencies string, mocks bool) error {
+	if outputFolder == "" {
+		return fmt.Errorf("output directory is required")
+	}
+
+	outputs := map[string]string{}
+	for _, dependency := range strings.Split(dependencies, ",") {
+		if strings.TrimSpace(dependency) == "" {
+			continue
+		}
+		name, output, ok := strings.Cut(dependency, "=")
+		if !ok {
+			return fmt.Errorf("invalid dependency %q, expected name=output", dependency)
+		}
+		outputs[strings.TrimSpace(name)] = strings.TrimSpace(output)
+	}
+
+	type packageOutput struct {
+		pkg    parse.Package
+		output string
+	}
+	var generated []packageOutput
+	registry := template.NewRegistry()
+	loaded := map[string]bool{}
+
+	var register func(dir, output string) error
+	register = func(dir, output string) error {
+		pkg, err := parse.LoadPackage(dir)
+		if err != nil {
+			return err
+		}
+		if loaded[pkg.Dir] {
+			return nil
+		}
+		loaded[pkg.Dir] = true
+
+		for _, module := range pkg.Modules {
+			importPath := ""
+			if output != "" {
+				importPath, err = goImportPath(filepath.Join(output, module.Name))
+				if err != nil {
+					return err
+				}
+			}
+			registry.AddModule(module, importPath)
+		}
+		if output != "" {
+			generated = append(generated, packageOutput{pkg: pkg, output: output})
+		}
+
+		names := make([]string, 0, len(pkg.Dependencies))
+		for name := range pkg.Dependencies {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if err := register(pkg.Dependencies[name], outputs[name]); err != nil {
+				return fmt.Errorf("loading dependency %s: %w", name, err)
+			}
+		}
+
+		return nil
+	}
+	if err := register(moveConfigPath, outputFolder); err != nil {
+		return err
+	}
+
+	for _, g := range generated {
+		for _, module := range g.pkg.Modules {
+			if err := generateModule(module, registry, filepath.Join(g.output, module.Name), mocks); err != nil {
+				return fmt.Errorf("generating %s::%s: %w", module.Package, module.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// goImportPath returns the import path of the Go package at dir, from the path of the module of the closest go.mod.
+func goImportPath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for moduleDir := abs; ; moduleDir = filepath.Dir(moduleDir) {
+		goMod, err := os.ReadFile(filepath.Join(moduleDir, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(goMod), "\n") {
+				if modulePath, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+					rel, err := filepath.Rel(moduleDir, abs)
+					if err != nil {
+						return "", err
+					}
+
+					return path.Join(strings.Trim(strings.TrimSpace(modulePath), `"`), filepath.ToSlash(rel)), nil
+				}
+			}
+
+			return "", fmt.Errorf("no module path in %s", filepath.Join(moduleDir, "go.mod"))
+		}
+		if filepath.Dir(moduleDir) == moduleDir {
+			return "", fmt.Errorf("no go.mod found for %s", dir)
+		}
 	}
 }
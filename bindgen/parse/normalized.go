@@ -0,0 +1,438 @@
+// nolint
+package parse
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
+)
+
+// Module is a Move module parsed from its source or its normalized JSON.
+type Module struct {
+	Package string
+	Name    string
+	Structs []Struct
+	Enums   []Enum
+	Funcs   []Func
+	// Uses maps the names imported by the module to their path, only known when parsed from source
+	Uses map[string]string
+}
+
+type normalizedModule struct {
+	Address          string                        `json:"address"`
+	Name             string                        `json:"name"`
+	Structs          map[string]normalizedStruct   `json:"structs"`
+	Enums            map[string]normalizedEnum     `json:"enums"`
+	ExposedFunctions map[string]normalizedFunction `json:"exposedFunctions"`
+}
+
+type normalizedAbilities struct {
+	Abilities []string `json:"abilities"`
+}
+
+type normalizedTypeParameter struct {
+	Constraints normalizedAbilities `json:"constraints"`
+	IsPhantom   bool                `json:"isPhantom"`
+}
+
+type normalizedStruct struct {
+	Abilities      normalizedAbilities       `json:"abilities"`
+	TypeParameters []normalizedTypeParameter `json:"typeParameters"`
+	Fields         []struct {
+		Name string `json:"name"`
+		Type any    `json:"type"`
+	} `json:"fields"`
+}
+
+type normalizedEnum struct {
+	Abilities      normalizedAbilities       `json:"abilities"`
+	TypeParameters []normalizedTypeParameter `json:"typeParameters"`
+	Variants       map[string][]struct {
+		Name string `json:"name"`
+		Type any    `json:"type"`
+	} `json:"variants"`
+	// VariantDeclarationOrder lists the variants by BCS tag
+	VariantDeclarationOrder []string `json:"variantDeclarationOrder"`
+}
+
+type normalizedFunction struct {
+	Visibility     string                `json:"visibility"`
+	IsEntry        bool                  `json:"isEntry"`
+	TypeParameters []normalizedAbilities `json:"typeParameters"`
+	Parameters     []any                 `json:"parameters"`
+	Return         []any                 `json:"return"`
+}
+
+// ParseNormalizedModule parses a module from the JSON result of `sui_getNormalizedMoveModulesByPackage`, which
+// holds every module of a package keyed by name, or of `sui_getNormalizedMoveModule`, which holds a single module.
+// moduleName selects the module of a package and may be empty when the package has a single module.
+//
+// Unlike the source parser, the types are exact: structs of the parsed module are referenced by name as in its
+// source, other structs by their fully-qualified name. Type parameters, which have no name in the bytecode, are
+// named T0, T1, and so on, and parameters are named after their type, see paramNames; UseSourceNames takes the names
+// of the parameters from the source instead. Only the functions callable in a PTB, public or entry ones, are parsed.
+// The abilities of the structs and the constraints of the type parameters are kept, which tells objects apart
+// exactly.
+func ParseNormalizedModule(data []byte, moduleName string) (Module, error) {
+	module, err := selectNormalizedModule(data, moduleName)
+	if err != nil {
+		return Module{}, err
+	}
+
+	out := Module{Package: module.Address, Name: module.Name}
+
+	for _, name := range sortedKeys(module.Structs) {
+		s := module.Structs[name]
+		typeParams := typeParamNames(len(s.TypeParameters))
+
+		parsed := Struct{Name: name, Abilities: abilities(s.Abilities), TypeParams: normalizedTypeParams(s.TypeParameters)}
+		for _, field := range s.Fields {
+			fieldType, err := normalizedTypeString(field.Type, module, typeParams)
+			if err != nil {
+				return Module{}, fmt.Errorf("struct %s field %s: %w", name, field.Name, err)
+			}
+			parsed.Fields = append(parsed.Fields, Param{Name: field.Name, Type: fieldType})
+		}
+		out.Structs = append(out.Structs, parsed)
+	}
+
+	for _, name := range sortedKeys(module.Enums) {
+		e := module.Enums[name]
+		typeParams := typeParamNames(len(e.TypeParameters))
+		if len(e.VariantDeclarationOrder) != len(e.Variants) {
+			return Module{}, fmt.Errorf("enum %s: missing variant declaration order", name)
+		}
+
+		parsed := Enum{Name: name, Abilities: abilities(e.Abilities), TypeParams: normalizedTypeParams(e.TypeParameters)}
+		for _, variantName := range e.VariantDeclarationOrder {
+			fields, ok := e.Variants[variantName]
+			if !ok {
+				return Module{}, fmt.Errorf("enum %s: unknown variant %s", name, variantName)
+			}
+			variant := Variant{Name: variantName}
+			for _, field := range fields {
+				fieldType, err := normalizedTypeString(field.Type, module, typeParams)
+				if err != nil {
+					return Module{}, fmt.Errorf("enum %s variant %s field %s: %w", name, variantName, field.Name, err)
+				}
+				variant.Fields = append(variant.Fields, Param{Name: field.Name, Type: fieldType})
+			}
+			parsed.Variants = append(parsed.Variants, variant)
+		}
+		out.Enums = append(out.Enums, parsed)
+	}
+
+	for _, name := range sortedKeys(module.ExposedFunctions) {
+		f := module.ExposedFunctions[name]
+		if f.Visibility != "Public" && !f.IsEntry {
+			continue
+		}
+		typeParams := typeParamNames(len(f.TypeParameters))
+
+		parsed := Func{
+			IsEntry:       f.IsEntry,
+			Name:          name,
+			HasTypeParams: len(typeParams) > 0,
+			TypeParams:    typeParams,
+		}
+		for _, typeParameter := range f.TypeParameters {
+			parsed.TypeParamConstraints = append(parsed.TypeParamConstraints, abilities(typeParameter))
+		}
+		paramTypes := make([]string, 0, len(f.Parameters))
+		for i, param := range f.Parameters {
+			paramType, err := normalizedTypeString(param, module, typeParams)
+			if err != nil {
+				return Module{}, fmt.Errorf("function %s param %d: %w", name, i, err)
+			}
+			paramTypes = append(paramTypes, paramType)
+		}
+		for i, paramName := range paramNames(paramTypes) {
+			parsed.Params = append(parsed.Params, Param{Name: paramName, Type: paramTypes[i]})
+		}
+		for i, ret := range f.Return {
+			returnType, err := normalizedTypeString(ret, module, typeParams)
+			if err != nil {
+				return Module{}, fmt.Errorf("function %s return %d: %w", name, i, err)
+			}
+			parsed.ReturnTypes = append(parsed.ReturnTypes, returnType)
+		}
+		out.Funcs = append(out.Funcs, parsed)
+	}
+
+	return out, nil
+}
+
+// UseSourceNames names the parameters of the functions of a module parsed from its normalized JSON after the
+// parameters of the same functions parsed from its source, see ParseFunctions. Functions missing from the source or
+// whose number of parameters differs keep the names derived from their types.
+func (m *Module) UseSourceNames(sourceFuncs []Func) {
+	byName := make(map[string]Func, len(sourceFuncs))
+	for _, f := range sourceFuncs {
+		byName[f.Name] = f
+	}
+
+	for i, f := range m.Funcs {
+		source, ok := byName[f.Name]
+		if !ok || len(source.Params) != len(f.Params) {
+			continue
+		}
+		for j := range f.Params {
+			m.Funcs[i].Params[j].Name = source.Params[j].Name
+		}
+	}
+}
+
+func selectNormalizedModule(data []byte, moduleName string) (*normalizedModule, error) {
+	var single normalizedModule
+	if err := json.Unmarshal(data, &single); err == nil && single.Address != "" && single.Name != "" {
+		if moduleName != "" && moduleName != single.Name {
+			return nil, fmt.Errorf("normalized module is %s, not %s", single.Name, moduleName)
+		}
+
+		return &single, nil
+	}
+
+	var modules map[string]*normalizedModule
+	if err := json.Unmarshal(data, &modules); err != nil {
+		return nil, fmt.Errorf("parsing normalized modules: %w", err)
+	}
+
+	if moduleName == "" {
+		if len(modules) != 1 {
+			return nil, fmt.Errorf("package has %d modules, a module name is required: %s", len(modules), strings.Join(sortedKeys(modules), ", "))
+		}
+		for name := range modules {
+			moduleName = name
+		}
+	}
+
+	module, ok := modules[moduleName]
+	if !ok || module == nil {
+		return nil, fmt.Errorf("module %s not found in the normalized package", moduleName)
+	}
+	if module.Name == "" {
+		module.Name = moduleName
+	}
+
+	return module, nil
+}
+
+// normalizedTypeString renders a normalized type, e.g. `{"MutableReference": {"Struct": {...}}}`, as the Move
+// type the source parser would produce for it.
+func normalizedTypeString(raw any, module *normalizedModule, typeParams []string) (string, error) {
+	prefix := ""
+	if fields, ok := raw.(map[string]any); ok && len(fields) == 1 {
+		if _, ok := fields["MutableReference"]; ok {
+			prefix = "&mut "
+		} else if _, ok := fields["Reference"]; ok {
+			prefix = "&"
+		}
+	}
+
+	parsed, err := movebcs.ParseNormalizedType(raw)
+	if err != nil {
+		return "", err
+	}
+	typeString, err := moveTypeString(parsed, module, typeParams)
+	if err != nil {
+		return "", err
+	}
+
+	return prefix + typeString, nil
+}
+
+// moveTypeString renders a type the way the source parser would, i.e. with the structs of the module unqualified
+// and the type parameters named.
+func moveTypeString(t movebcs.Type, module *normalizedModule, typeParams []string) (string, error) {
+	switch t.Kind {
+	case movebcs.KindVector:
+		inner, err := moveTypeString(*t.Elem, module, typeParams)
+		if err != nil {
+			return "", err
+		}
+
+		return "vector<" + inner + ">", nil
+	case movebcs.KindTypeParameter:
+		if t.Index >= len(typeParams) {
+			return "", fmt.Errorf("unexpected type parameter %d", t.Index)
+		}
+
+		return typeParams[t.Index], nil
+	case movebcs.KindStruct:
+		return moveStructString(t.Struct, module, typeParams)
+	default:
+		return t.String(), nil
+	}
+}
+
+func moveStructString(tag *movebcs.StructTag, module *normalizedModule, typeParams []string) (string, error) {
+	typeArgs := make([]string, 0, len(tag.TypeArgs))
+	for _, typeArg := range tag.TypeArgs {
+		typeArgString, err := moveTypeString(typeArg, module, typeParams)
+		if err != nil {
+			return "", err
+		}
+		typeArgs = append(typeArgs, typeArgString)
+	}
+
+	address := movebcs.ShortAddress(tag.Address)
+	var base string
+	switch {
+	case address == movebcs.ShortAddress(module.Address) && tag.Module == module.Name:
+		base = tag.Name
+	case tag.Is("0x1", "ascii", "String"):
+		base = "ascii::String"
+	case tag.Is("0x2", "object", "UID"):
+		base = "UID"
+	case tag.Is("0x2", "tx_context", "TxContext"):
+		base = "TxContext"
+	default:
+		base = address + "::" + tag.Module + "::" + tag.Name
+	}
+
+	if len(typeArgs) == 0 {
+		return base, nil
+	}
+
+	return base + "<" + strings.Join(typeArgs, ", ") + ">", nil
+}
+
+// paramNames names parameters, which have no name in the bytecode, after their types: a parameter of a struct type
+// is named after the struct in snake case, e.g. `pool_state` for `&mut PoolState`, and `coins` for a vector of
+// coins. Other parameters are named arg0, arg1, and so on after their position. Names used more than once, and names
+// that would clash with Go keywords or the identifiers of the generated bindings, are suffixed with the position.
+func paramNames(types []string) []string {
+	names := make([]string, len(types))
+	counts := map[string]int{}
+	for i, paramType := range types {
+		names[i] = paramNameFromType(paramType)
+		if names[i] == "" {
+			names[i] = fmt.Sprintf("arg%d", i)
+		}
+		counts[names[i]]++
+	}
+
+	for i, name := range names {
+		if counts[name] > 1 || reservedParamNames[name] {
+			names[i] = fmt.Sprintf("%s%d", name, i)
+		}
+	}
+
+	return names
+}
+
+// reservedParamNames are the Go keywords and predeclared identifiers, and the identifiers of the generated bindings,
+// a parameter name must not shadow.
+var reservedParamNames = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true, "default": true, "defer": true,
+	"else": true, "fallthrough": true, "for": true, "func": true, "go": true, "goto": true, "if": true,
+	"import": true, "interface": true, "map": true, "package": true, "range": true, "return": true, "select": true,
+	"struct": true, "switch": true, "type": true, "var": true, "string": true, "bool": true, "error": true,
+	"ctx": true, "opts": true, "args": true, "type_args": true, "encoded": true, "err": true, "results": true,
+	"result": true, "c": true, "d": true, "bind": true, "fmt": true, "models": true, "context": true, "big": true,
+	"transaction": true,
+}
+
+func paramNameFromType(paramType string) string {
+	paramType = strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(paramType, "&mut "), "&"))
+
+	plural := false
+	if inner, ok := strings.CutPrefix(paramType, "vector<"); ok {
+		paramType, plural = strings.TrimSuffix(inner, ">"), true
+	}
+
+	// drop the type arguments and the path of the struct
+	paramType, _, _ = strings.Cut(paramType, "<")
+	if index := strings.LastIndex(paramType, "::"); index >= 0 {
+		paramType = paramType[index+2:]
+	}
+	// primitives and type parameters are lower case or T0, T1...
+	if paramType == "" || !unicode.IsUpper(rune(paramType[0])) || isTypeParamName(paramType) {
+		return ""
+	}
+
+	name := toSnakeCase(paramType)
+	if plural {
+		name += "s"
+	}
+
+	return name
+}
+
+func isTypeParamName(name string) bool {
+	if len(name) < 2 || name[0] != 'T' {
+		return false
+	}
+	for _, r := range name[1:] {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// toSnakeCase converts a CamelCase name to snake_case, e.g. OwnerCap to owner_cap and UID to uid.
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+	var out strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// a word starts at an upper case letter following a lower case one, or starting a lower case word
+			startsWord := i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])))
+			if startsWord {
+				out.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		out.WriteRune(r)
+	}
+
+	return out.String()
+}
+
+func normalizedTypeParams(parameters []normalizedTypeParameter) []TypeParam {
+	out := make([]TypeParam, 0, len(parameters))
+	for i, parameter := range parameters {
+		out = append(out, TypeParam{
+			Name:        fmt.Sprintf("T%d", i),
+			Constraints: abilities(parameter.Constraints),
+			IsPhantom:   parameter.IsPhantom,
+		})
+	}
+
+	return out
+}
+
+func typeParamNames(count int) []string {
+	names := make([]string, count)
+	for i := range names {
+		names[i] = fmt.Sprintf("T%d", i)
+	}
+
+	return names
+}
+
+func abilities(a normalizedAbilities) []string {
+	out := make([]string, 0, len(a.Abilities))
+	for _, ability := range a.Abilities {
+		out = append(out, strings.ToLower(ability))
+	}
+	sort.Strings(out)
+
+	return out
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,196 @@
+package parse
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNormalizedModule(t *testing.T) {
+	t.Parallel()
+
+	// the normalized modules of a package, as returned by sui_getNormalizedMoveModulesByPackage
+	packageJSON, err := os.ReadFile("testdata/normalized_package.json")
+	require.NoError(t, err)
+
+	var modules map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(packageJSON, &modules))
+	// a single module, as returned by sui_getNormalizedMoveModule
+	oracleJSON := modules["oracle"]
+
+	const address = "0x00000000000000000000000000000000000000000000000000000000000000ab"
+	pool := Module{
+		Package: address,
+		Name:    "pool",
+		Structs: []Struct{
+			{
+				Name:       "Deposited",
+				Abilities:  []string{"copy", "drop"},
+				TypeParams: []TypeParam{{Name: "T0", Constraints: []string{"drop", "store"}}},
+				Fields: []Param{
+					{Name: "amount", Type: "u64"},
+					{Name: "tag", Type: "T0"},
+					{Name: "memo", Type: "0x1::option::Option<vector<u8>>"},
+				},
+			},
+			{
+				Name:       "OwnerCap",
+				Abilities:  []string{"key", "store"},
+				TypeParams: []TypeParam{},
+				Fields:     []Param{{Name: "id", Type: "UID"}},
+			},
+			{
+				Name:       "Pool",
+				Abilities:  []string{"key"},
+				TypeParams: []TypeParam{{Name: "T0", Constraints: []string{}, IsPhantom: true}},
+				Fields: []Param{
+					{Name: "id", Type: "UID"},
+					{Name: "balance", Type: "0x2::balance::Balance<T0>"},
+					{Name: "admins", Type: "vector<address>"},
+					{Name: "label", Type: "ascii::String"},
+				},
+			},
+		},
+		Enums: []Enum{
+			{
+				Name:       "Status",
+				Abilities:  []string{"copy", "drop", "store"},
+				TypeParams: []TypeParam{},
+				Variants: []Variant{
+					{Name: "Active"},
+					{Name: "Paused", Fields: []Param{{Name: "until", Type: "u64"}}},
+				},
+			},
+		},
+		Funcs: []Func{
+			{
+				Name:                 "deposit",
+				HasTypeParams:        true,
+				TypeParams:           []string{"T0"},
+				TypeParamConstraints: [][]string{{"drop", "store"}},
+				Params: []Param{
+					{Name: "pool", Type: "&mut Pool<T0>"},
+					{Name: "coin", Type: "0x2::coin::Coin<T0>"},
+					{Name: "coins", Type: "vector<0x2::coin::Coin<T0>>"},
+					{Name: "arg3", Type: "u64"},
+					{Name: "clock", Type: "&0x2::clock::Clock"},
+					{Name: "tx_context", Type: "&mut TxContext"},
+				},
+				ReturnTypes: []string{"u64", "Deposited<T0>"},
+			},
+			{
+				Name:                 "status",
+				HasTypeParams:        true,
+				TypeParams:           []string{"T0"},
+				TypeParamConstraints: [][]string{{}},
+				Params:               []Param{{Name: "pool", Type: "&Pool<T0>"}},
+				ReturnTypes:          []string{"Status"},
+			},
+			{
+				IsEntry:    true,
+				Name:       "transfer_cap",
+				TypeParams: []string{},
+				Params: []Param{
+					{Name: "owner_cap0", Type: "OwnerCap"},
+					{Name: "owner_cap1", Type: "OwnerCap"},
+					{Name: "arg2", Type: "address"},
+					{Name: "arg3", Type: "address"},
+				},
+			},
+		},
+	}
+	oracle := Module{
+		Package: address,
+		Name:    "oracle",
+		Funcs: []Func{
+			{
+				Name:        "price",
+				TypeParams:  []string{},
+				Params:      []Param{{Name: "type_name", Type: "0x1::type_name::TypeName"}},
+				ReturnTypes: []string{"u128"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		data       []byte
+		moduleName string
+		want       Module
+		wantErr    string
+	}{
+		{name: "module of a package", data: packageJSON, moduleName: "pool", want: pool},
+		{name: "other module of a package", data: packageJSON, moduleName: "oracle", want: oracle},
+		{name: "single module", data: oracleJSON, want: oracle},
+		{name: "single module by name", data: oracleJSON, moduleName: "oracle", want: oracle},
+		{name: "module name required", data: packageJSON, wantErr: "package has 2 modules, a module name is required: oracle, pool"},
+		{name: "unknown module", data: packageJSON, moduleName: "vault", wantErr: "module vault not found"},
+		{name: "other single module", data: oracleJSON, moduleName: "pool", wantErr: "normalized module is oracle, not pool"},
+		{
+			name:    "unknown type parameter",
+			data:    []byte(`{"address": "0xab", "name": "m", "exposedFunctions": {"f": {"visibility": "Public", "parameters": [{"TypeParameter": 0}]}}}`),
+			wantErr: "function f param 0: unexpected type parameter 0",
+		},
+		{
+			name:    "missing variant order",
+			data:    []byte(`{"address": "0xab", "name": "m", "enums": {"E": {"variants": {"A": []}}}}`),
+			wantErr: "enum E: missing variant declaration order",
+		},
+		{name: "invalid JSON", data: []byte(`[]`), wantErr: "parsing normalized modules"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			module, err := ParseNormalizedModule(tt.data, tt.moduleName)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, module)
+		})
+	}
+}
+
+func TestParamNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		types []string
+		want  []string
+	}{
+		{types: []string{"&mut PoolState", "u64", "T0"}, want: []string{"pool_state", "arg1", "arg2"}},
+		{types: []string{"vector<0x2::coin::Coin<T0>>", "vector<u8>"}, want: []string{"coins", "arg1"}},
+		{types: []string{"&UID", "0x2::object::ID", "USDCoin"}, want: []string{"uid", "id", "usd_coin"}},
+		{types: []string{"Clock", "&0x2::clock::Clock"}, want: []string{"clock0", "clock1"}},
+		// clashes with the identifiers of the generated bindings
+		{types: []string{"&mut TxContext", "Context", "Type"}, want: []string{"tx_context", "context1", "type2"}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, paramNames(tt.types), "types: %v", tt.types)
+	}
+}
+
+func TestUseSourceNames(t *testing.T) {
+	t.Parallel()
+
+	module := Module{Funcs: []Func{
+		{Name: "deposit", Params: []Param{{Name: "pool", Type: "&mut Pool"}, {Name: "arg1", Type: "u64"}}},
+		{Name: "withdraw", Params: []Param{{Name: "pool", Type: "&mut Pool"}}},
+		{Name: "bytecode_only", Params: []Param{{Name: "arg0", Type: "u64"}}},
+	}}
+
+	module.UseSourceNames([]Func{
+		{Name: "deposit", Params: []Param{{Name: "self", Type: "&mut Pool"}, {Name: "amount", Type: "u64"}}},
+		// differs from the bytecode, so its names are not used
+		{Name: "withdraw", Params: []Param{{Name: "self", Type: "&mut Pool"}, {Name: "amount", Type: "u64"}}},
+	})
+
+	assert.Equal(t, []Param{{Name: "self", Type: "&mut Pool"}, {Name: "amount", Type: "u64"}}, module.Funcs[0].Params)
+	assert.Equal(t, []Param{{Name: "pool", Type: "&mut Pool"}}, module.Funcs[1].Params)
+	assert.Equal(t, []Param{{Name: "arg0", Type: "u64"}}, module.Funcs[2].Params)
+}
@@ -0,0 +1,114 @@
+// nolint
+package parse
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+)
+
+// Package is a Move package parsed from its Move.toml and the sources of its modules.
+type Package struct {
+	Name    string
+	Dir     string
+	Modules []Module
+	// Dependencies maps the local dependencies of the package, by their name in Move.toml, to their directory
+	Dependencies map[string]string
+}
+
+type moveManifest struct {
+	Package struct {
+		Name string `toml:"name"`
+	} `toml:"package"`
+	Dependencies map[string]any `toml:"dependencies"`
+}
+
+// LoadPackage parses the Move package at dir, or of the Move.toml at dir, and every module under its sources
+// directory. Only local dependencies are listed, others, such as the Sui framework, are fetched by the Move
+// toolchain and have no bindings.
+func LoadPackage(dir string) (Package, error) {
+	if filepath.Base(dir) == "Move.toml" {
+		dir = filepath.Dir(dir)
+	}
+	dir = filepath.Clean(dir)
+
+	data, err := os.ReadFile(filepath.Join(dir, "Move.toml"))
+	if err != nil {
+		return Package{}, fmt.Errorf("reading Move.toml: %w", err)
+	}
+	var manifest moveManifest
+	if err := toml.Unmarshal(data, &manifest); err != nil {
+		return Package{}, fmt.Errorf("parsing %s: %w", filepath.Join(dir, "Move.toml"), err)
+	}
+
+	pkg := Package{Name: manifest.Package.Name, Dir: dir, Dependencies: map[string]string{}}
+	for name, dependency := range manifest.Dependencies {
+		fields, ok := dependency.(map[string]any)
+		if !ok {
+			continue
+		}
+		if local, ok := fields["local"].(string); ok {
+			pkg.Dependencies[name] = filepath.Join(dir, local)
+		}
+	}
+
+	var sources []string
+	err = filepath.WalkDir(filepath.Join(dir, "sources"), func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() && strings.HasSuffix(path, ".move") {
+			sources = append(sources, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Package{}, fmt.Errorf("listing the sources of %s: %w", dir, err)
+	}
+	sort.Strings(sources)
+
+	for _, source := range sources {
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return Package{}, err
+		}
+		module, err := ParseSourceModule(data)
+		if err != nil {
+			return Package{}, fmt.Errorf("parsing %s: %w", source, err)
+		}
+		pkg.Modules = append(pkg.Modules, module)
+	}
+
+	return pkg, nil
+}
+
+// ParseSourceModule parses a module from its Move source.
+func ParseSourceModule(source []byte) (Module, error) {
+	pkg, mod, err := ParseModule(source)
+	if err != nil {
+		return Module{}, err
+	}
+
+	funcs, err := ParseFunctions(source)
+	if err != nil {
+		return Module{}, err
+	}
+
+	structs, err := ParseStructs(source)
+	if err != nil {
+		return Module{}, err
+	}
+
+	enums, err := ParseEnums(source)
+	if err != nil {
+		return Module{}, err
+	}
+
+	return Module{Package: pkg, Name: mod, Structs: structs, Enums: enums, Funcs: funcs, Uses: ParseUses(source)}, nil
+}
@@ -23,7 +23,18 @@ type Func struct {
 	Params        []Param  `json:"params"`
 	HasTypeParams bool     `json:"has_type_params"`
 	TypeParams    []string `json:"type_params"`
-	ReturnTypes   []string `json:"return_types"`
+	// TypeParamConstraints holds the abilities each type parameter is constrained by, in lower case, only known when
+	// parsed from a normalized module
+	TypeParamConstraints [][]string `json:"type_param_constraints,omitempty"`
+	ReturnTypes          []string   `json:"return_types"`
+}
+
+// TypeParam is a type parameter of a struct or enum, only known when parsed from a normalized module.
+type TypeParam struct {
+	Name string
+	// Constraints are the abilities the type parameter is constrained by, in lower case
+	Constraints []string
+	IsPhantom   bool
 }
 
 type Struct struct {
@@ -31,6 +42,24 @@ type Struct struct {
 
 	Name   string
 	Fields []Param
+	// Abilities of the struct in lower case, only known when parsed from a normalized module
+	Abilities  []string
+	TypeParams []TypeParam
+}
+
+// Enum is a Move 2024 enum, its variants in declaration order, the index of a variant being its BCS tag.
+type Enum struct {
+	Name     string
+	Variants []Variant
+	// Abilities of the enum in lower case, only known when parsed from a normalized module
+	Abilities  []string
+	TypeParams []TypeParam
+}
+
+// Variant is a variant of an enum. Positional fields are named pos0, pos1, ... as in the bytecode.
+type Variant struct {
+	Name   string
+	Fields []Param
 }
 
 func ParseModule(module []byte) (pkg string, mod string, err error) {
@@ -312,5 +341,115 @@ func ParseStructs(module []byte) ([]Struct, error) {
 		structs = append(structs, s)
 	}
 
+	events := ParseEvents(module)
+	for i := range structs {
+		if events[structs[i].Name] {
+			structs[i].IsEvent = true
+		}
+	}
+
 	return structs, nil
 }
+
+// ParseEnums parses the enums of a module, with the fields of their variants.
+func ParseEnums(module []byte) ([]Enum, error) {
+	lang := tree_sitter.NewLanguage(tree_sitter_move_on_aptos.Language())
+	n, err := tree_sitter.ParseCtx(context.Background(), module, lang)
+	if err != nil {
+		return nil, fmt.Errorf("parsing AST: %w", err)
+	}
+
+	queryEnums, err := tree_sitter.NewQuery([]byte(`
+(enum_decl
+  name: (identifier) @name
+  (enum_body) @enumBody
+)
+	`), lang)
+	if err != nil {
+		panic(err)
+	}
+
+	enumsCursor := tree_sitter.NewQueryCursor()
+	enumsCursor.Exec(queryEnums, n)
+	var enums []Enum
+	for {
+		m, ok := enumsCursor.NextMatch()
+		if !ok {
+			break
+		}
+
+		e := Enum{}
+		for _, capture := range m.Captures {
+			switch capture.Index {
+			case 0:
+				// @name
+				e.Name = capture.Node.Content(module)
+			case 1:
+				// @enumBody
+				for i := 0; i < int(capture.Node.NamedChildCount()); i++ {
+					if variant, ok := parseVariant(capture.Node.NamedChild(i), module); ok {
+						e.Variants = append(e.Variants, variant)
+					}
+				}
+			}
+		}
+		enums = append(enums, e)
+	}
+
+	return enums, nil
+}
+
+func parseVariant(node *tree_sitter.Node, module []byte) (Variant, bool) {
+	switch node.Type() {
+	case "enum_variant":
+		return Variant{Name: node.Content(module)}, true
+	case "enum_variant_struct", "enum_variant_posit":
+	default:
+		return Variant{}, false
+	}
+
+	v := Variant{Name: node.ChildByFieldName("variant").Content(module)}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		fields := node.NamedChild(i)
+		switch fields.Type() {
+		case "struct_body":
+			for j := 0; j < int(fields.NamedChildCount()); j++ {
+				field := fields.NamedChild(j)
+				if field.Type() != "field_annot" {
+					continue
+				}
+				f := Param{Name: field.ChildByFieldName("field").Content(module)}
+				for k := 0; k < int(field.NamedChildCount()); k++ {
+					if field.NamedChild(k).Type() == "type" {
+						f.Type = field.NamedChild(k).Content(module)
+					}
+				}
+				v.Fields = append(v.Fields, f)
+			}
+		case "anon_fields":
+			for j := 0; j < int(fields.NamedChildCount()); j++ {
+				if fields.NamedChild(j).Type() != "type" {
+					continue
+				}
+				v.Fields = append(v.Fields, Param{Name: fmt.Sprintf("pos%d", len(v.Fields)), Type: fields.NamedChild(j).Content(module)})
+			}
+		}
+	}
+
+	return v, true
+}
+
+// emitPattern matches the structs emitted as events, e.g. `event::emit(Minted {` or `emit(Pause {})`. Generic
+// events, e.g. `event::emit(Minted<T> {`, are not matched since their type arguments are only known at runtime.
+var emitPattern = regexp.MustCompile(`\bemit\s*\(\s*(\w+)\s*\{`)
+
+// ParseEvents returns the names of the structs a module emits as events. Sui Move has no event attribute, so they
+// are found from the calls to `event::emit`.
+func ParseEvents(module []byte) map[string]bool {
+	events := map[string]bool{}
+	for _, match := range emitPattern.FindAllSubmatch(module, -1) {
+		events[string(match[1])] = true
+	}
+
+	return events
+}
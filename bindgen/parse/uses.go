@@ -0,0 +1,55 @@
+// nolint
+package parse
+
+import (
+	"regexp"
+	"strings"
+)
+
+var usePattern = regexp.MustCompile(`(?s)\buse\s+([^;]+);`)
+
+// ParseUses returns the names a module imports with `use` declarations, mapped to the path they stand for, e.g.
+// `use ccip::client::{Self, Any2SuiMessage as Message};` maps client to ccip::client and Message to
+// ccip::client::Any2SuiMessage. Method aliases (`use fun`) are ignored.
+func ParseUses(module []byte) map[string]string {
+	uses := map[string]string{}
+	for _, match := range usePattern.FindAllSubmatch(module, -1) {
+		declaration := strings.Join(strings.Fields(string(match[1])), " ")
+		if strings.HasPrefix(declaration, "fun ") {
+			continue
+		}
+
+		prefix, members, grouped := strings.Cut(declaration, "::{")
+		if !grouped {
+			addUse(uses, "", declaration)
+			continue
+		}
+		for _, member := range strings.Split(strings.TrimSuffix(strings.TrimSpace(members), "}"), ",") {
+			addUse(uses, strings.TrimSpace(prefix), member)
+		}
+	}
+
+	return uses
+}
+
+func addUse(uses map[string]string, prefix, member string) {
+	path, alias, _ := strings.Cut(strings.TrimSpace(member), " as ")
+	path, alias = strings.TrimSpace(path), strings.TrimSpace(alias)
+	if path == "" {
+		return
+	}
+
+	switch {
+	case path == "Self":
+		path = prefix
+	case prefix != "":
+		path = prefix + "::" + path
+	}
+	if alias == "" {
+		alias = path
+		if i := strings.LastIndex(path, "::"); i != -1 {
+			alias = path[i+2:]
+		}
+	}
+	uses[alias] = path
+}
@@ -0,0 +1,102 @@
+// nolint
+package template
+
+import (
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-sui/bindgen/parse"
+)
+
+// Registry holds the structs of the modules of the packages bindings are generated for, so that a module can use the
+// Go types generated for the structs of other modules and packages instead of bind.Object.
+type Registry struct {
+	structs map[string]registeredStruct
+}
+
+type registeredStruct struct {
+	Module parse.Module
+	Struct parse.Struct
+	Enum   *parse.Enum // set for an enum, whose Struct only has its name
+	Import *tmplImport // nil when the module has no bindings
+}
+
+func NewRegistry() *Registry {
+	return &Registry{structs: map[string]registeredStruct{}}
+}
+
+// AddModule registers the structs and enums of a module, by their fully-qualified name, e.g. ccip::client::Any2SuiMessage.
+// importPath is the Go package of the bindings of the module, the structs of a module registered without one are
+// known but still referenced as bind.Object.
+func (r *Registry) AddModule(module parse.Module, importPath string) {
+	var imp *tmplImport
+	if importPath != "" {
+		imp = &tmplImport{Path: importPath, PackageName: "module_" + module.Name}
+	}
+
+	for _, s := range module.Structs {
+		r.structs[module.Package+"::"+module.Name+"::"+s.Name] = registeredStruct{Module: module, Struct: s, Import: imp}
+	}
+	for _, e := range module.Enums {
+		r.structs[module.Package+"::"+module.Name+"::"+e.Name] = registeredStruct{Module: module, Struct: parse.Struct{Name: e.Name}, Enum: &e, Import: imp}
+	}
+}
+
+func (r *Registry) lookup(qualifiedName string) (registeredStruct, bool) {
+	if r == nil {
+		return registeredStruct{}, false
+	}
+	s, ok := r.structs[qualifiedName]
+
+	return s, ok
+}
+
+// typeScope resolves the struct names used in a module: its own structs, the ones it imports with `use` and the
+// fully-qualified ones.
+type typeScope struct {
+	pkg      string
+	mod      string
+	local    map[string]parse.Struct
+	uses     map[string]string
+	registry *Registry
+}
+
+// foreignScope is the scope of the module of a registered struct, where every struct is resolved through the
+// registry, including the ones of the module itself.
+func foreignScope(s registeredStruct, registry *Registry) *typeScope {
+	return &typeScope{pkg: s.Module.Package, mod: s.Module.Name, uses: s.Module.Uses, registry: registry}
+}
+
+// qualify returns the fully-qualified name of a struct, e.g. client::Any2SuiMessage to ccip::client::Any2SuiMessage.
+func (s *typeScope) qualify(name string) string {
+	parts := strings.Split(name, "::")
+	switch len(parts) {
+	case 1:
+		if path, ok := s.uses[name]; ok {
+			return path
+		}
+
+		return s.pkg + "::" + s.mod + "::" + name
+	case 2:
+		if path, ok := s.uses[parts[0]]; ok {
+			return path + "::" + parts[1]
+		}
+
+		return s.pkg + "::" + name
+	default:
+		return name
+	}
+}
+
+// foreign returns the registered struct a name resolves to when it isn't a struct of the module itself.
+func (s *typeScope) foreign(name string) (string, registeredStruct, bool) {
+	if s == nil || s.registry == nil {
+		return "", registeredStruct{}, false
+	}
+	if _, ok := s.local[name]; ok {
+		return "", registeredStruct{}, false
+	}
+	qualified := s.qualify(name)
+	registered, ok := s.registry.lookup(qualified)
+
+	return qualified, registered, ok
+}
@@ -53,35 +53,100 @@ func MustParseFunctionInfo(info ...string) []FunctionInfo {
 //go:embed go.tmpl
 var tmpl string
 
+//go:embed mock.tmpl
+var mockTmpl string
+
 type tmplData struct {
-	Package  string
-	Module   string
-	Structs  []*tmplStruct
-	Funcs    []*tmplFunc
-	Imports  []*tmplImport
-	Artifact bind.PackageArtifact
+	Package string
+	Module  string
+	Structs []*tmplStruct
+	Enums   []*tmplStruct
+	// ForeignStructs are the structs of other modules this module decodes, either nested in its structs or returned
+	// by its functions
+	ForeignStructs []*tmplStruct
+	Funcs          []*tmplFunc
+	Imports        []*tmplImport
+	Artifact       bind.PackageArtifact
+	// Bindings is the package of the bindings of the module, imported by its mocks
+	Bindings *tmplImport
+}
+
+// AllStructs returns the structs and enums the module decodes, followed by the variants of the enums.
+func (d tmplData) AllStructs() []*tmplStruct {
+	all := append(append(slices.Clone(d.Structs), d.Enums...), d.ForeignStructs...)
+	for _, s := range all {
+		all = append(all, s.Variants...)
+	}
+
+	return all
+}
+
+// MockImports returns the imports of the types of the interface methods: parameters and typed single returns.
+func (d tmplData) MockImports() []*tmplImport {
+	var imports []*tmplImport
+	add := func(imp *tmplImport) {
+		if imp != nil && !slices.Contains(imports, imp) {
+			imports = append(imports, imp)
+		}
+	}
+	for _, f := range d.Funcs {
+		for _, param := range f.Params {
+			add(param.Type.Import)
+		}
+		if f.HasSingleReturn() && !f.HasGenericReturns() {
+			add(f.Returns[0].Import)
+		}
+	}
+	slices.SortFunc(imports, func(a, b *tmplImport) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return imports
 }
 
 func (d *tmplData) BuildStructMap() map[string]*tmplStruct {
 	structMap := make(map[string]*tmplStruct)
-	for _, s := range d.Structs {
-		structMap[s.Name] = s
+	for _, s := range d.AllStructs() {
+		structMap[s.MoveType] = s
 	}
 	return structMap
 }
 
 type tmplStruct struct {
-	Name   string
-	Fields []*tmplField
+	Name    string
+	Fields  []*tmplField
+	IsEvent bool
+
+	MoveType   string // the name of the struct for a struct of the module, its fully-qualified name otherwise
+	GoName     string // the Go type of the struct, qualified by its package for a struct of another module
+	Ident      string // the suffix of the names of the BCS struct and converter of the struct
+	DecoderKey string // the Move type the struct decoder is registered for
+	Register   bool   // whether the struct decoder is registered by this module
+	Import     *tmplImport
+
+	Variants    []*tmplStruct // the variants of an enum, nil for a struct
+	VariantName string        // the name of a variant in its enum
+	Tag         int           // the index of a variant in its enum, its BCS tag
+}
+
+func (s *tmplStruct) IsEnum() bool {
+	return s.Variants != nil
 }
 
 func (s *tmplStruct) NeedsCustomDecoder(allStructs map[string]*tmplStruct) bool {
+	// enums are Go interfaces, decoded through a BCS struct holding a pointer per variant
+	if s.IsEnum() {
+		return true
+	}
 	for _, field := range s.Fields {
 		// TODO: recursively handle address decoding
 		switch field.Type.MoveType {
 		case "address", "vector<address>", "vector<vector<address>>", "u256", "u128":
 			return true
 		}
+		if isIDType(field.Type.MoveType) {
+			return true
+		}
 
 		if nestedStruct, ok := allStructs[field.Type.MoveType]; ok {
 			if nestedStruct.NeedsCustomDecoder(allStructs) {
@@ -106,9 +171,13 @@ func GetBCSType(field *tmplField, allStructs map[string]*tmplStruct) string {
 	case "u128":
 		return "[16]byte"
 	default:
+		// object IDs are encoded as addresses
+		if isIDType(field.Type.MoveType) {
+			return "[32]byte"
+		}
 		if nestedStruct, ok := allStructs[field.Type.MoveType]; ok {
 			if nestedStruct.NeedsCustomDecoder(allStructs) {
-				return "bcs" + field.Type.MoveType
+				return "bcs" + nestedStruct.Ident
 			}
 		}
 		return field.Type.GoType
@@ -134,8 +203,9 @@ type tmplType struct {
 }
 
 type tmplField struct {
-	Name string
-	Type tmplType
+	Name     string
+	MoveName string
+	Type     tmplType
 }
 
 type tmplFunc struct {
@@ -147,6 +217,8 @@ type tmplFunc struct {
 	HasReturnValues bool
 	HasTypeParams   bool
 	TypeParams      []string
+	// TypeParamConstraints describes the constrained type parameters, e.g. "T0: drop + store, T1: key"
+	TypeParamConstraints string
 }
 
 func (f *tmplFunc) HasSingleReturn() bool {
@@ -185,42 +257,93 @@ func (f *tmplFunc) GetSingleReturnGoTypeForDevInspect() string {
 	return f.Returns[0].GoType
 }
 
+// Convert converts a module without resolving the structs of other modules, which are referenced as bind.Object.
 func Convert(pkg, mod string, structs []parse.Struct, functions []parse.Func) (tmplData, error) {
+	return ConvertModule(parse.Module{Package: pkg, Name: mod, Structs: structs, Funcs: functions}, nil)
+}
+
+// ConvertModule converts a module, the structs of other modules registered in registry, which may be nil, being
+// referenced by the Go types of their bindings.
+func ConvertModule(module parse.Module, registry *Registry) (tmplData, error) {
+	pkg, mod := module.Package, module.Name
 	data := tmplData{
 		Package: pkg,
 		Module:  mod,
 	}
 	structMap := make(map[string]parse.Struct)
 	importMap := make(map[string]*tmplImport)
-	for _, s := range structs {
+	for _, s := range module.Structs {
 		out := &tmplStruct{
-			Name:   s.Name,
-			Fields: nil,
+			Name:       s.Name,
+			Fields:     nil,
+			IsEvent:    s.IsEvent,
+			MoveType:   s.Name,
+			GoName:     s.Name,
+			Ident:      s.Name,
+			DecoderKey: pkg + "::" + mod + "::" + s.Name,
+			Register:   true,
 		}
 		structMap[s.Name] = s
 		data.Structs = append(data.Structs, out)
 	}
-	for i, s := range data.Structs {
-		parsedStruct := structMap[s.Name]
-		for _, field := range parsedStruct.Fields {
-			goType, err := createGoTypeFromMove(field.Type, structMap)
+	// enums are referenced by name like structs
+	for _, e := range module.Enums {
+		structMap[e.Name] = parse.Struct{Name: e.Name}
+	}
+	scope := &typeScope{pkg: pkg, mod: mod, local: structMap, uses: module.Uses, registry: registry}
+	foreignStructs := newForeignStructs(registry)
+	convertFields := func(name string, fields []parse.Param) []*tmplField {
+		var out []*tmplField
+		for _, field := range fields {
+			goType, err := createGoTypeFromMove(field.Type, scope)
 			if err != nil {
-				log.Printf("WARNING: Ignoring unknown type of struct %q: %v\n", s.Name, field.Type)
+				log.Printf("WARNING: Ignoring unknown type of struct %q: %v\n", name, field.Type)
 				continue
 			}
-			data.Structs[i].Fields = append(data.Structs[i].Fields, &tmplField{
-				Type: goType,
-				Name: ToUpperCamelCase(field.Name),
+			out = append(out, &tmplField{
+				Type:     goType,
+				Name:     ToUpperCamelCase(field.Name),
+				MoveName: field.Name,
 			})
 			if goType.Import != nil {
 				importMap[goType.Import.Path] = goType.Import
 			}
+			foreignStructs.add(goType, false)
+		}
+
+		return out
+	}
+	for i, s := range data.Structs {
+		data.Structs[i].Fields = convertFields(s.Name, structMap[s.Name].Fields)
+	}
+	for _, e := range module.Enums {
+		out := &tmplStruct{
+			Name:       e.Name,
+			MoveType:   e.Name,
+			GoName:     e.Name,
+			Ident:      e.Name,
+			DecoderKey: pkg + "::" + mod + "::" + e.Name,
+			Register:   true,
+			Variants:   []*tmplStruct{},
+		}
+		for tag, v := range e.Variants {
+			name := e.Name + ToUpperCamelCase(v.Name)
+			out.Variants = append(out.Variants, &tmplStruct{
+				Name:        name,
+				Fields:      convertFields(name, v.Fields),
+				MoveType:    e.Name + "::" + v.Name,
+				GoName:      name,
+				Ident:       name,
+				VariantName: v.Name,
+				Tag:         tag,
+			})
 		}
+		data.Enums = append(data.Enums, out)
 	}
 
 	var functionInfos []FunctionInfo
 
-	for _, f := range functions {
+	for _, f := range module.Funcs {
 		if f.Name == "init_module" {
 			continue
 		}
@@ -235,6 +358,13 @@ func Convert(pkg, mod string, structs []parse.Struct, functions []parse.Func) (t
 			HasTypeParams:   f.HasTypeParams,
 			TypeParams:      f.TypeParams,
 		}
+		var constrained []string
+		for i, constraints := range f.TypeParamConstraints {
+			if len(constraints) > 0 && i < len(f.TypeParams) {
+				constrained = append(constrained, f.TypeParams[i]+": "+strings.Join(constraints, " + "))
+			}
+		}
+		out.TypeParamConstraints = strings.Join(constrained, ", ")
 		functionInfo := FunctionInfo{
 			Package:    pkg,
 			Module:     mod,
@@ -254,7 +384,7 @@ func Convert(pkg, mod string, structs []parse.Struct, functions []parse.Func) (t
 			if cleanType == "TxContext" {
 				continue
 			}
-			typ, err := createGoTypeFromMove(cleanType, structMap)
+			typ, err := createGoTypeFromMove(cleanType, scope)
 			if err != nil {
 				if f.IsEntry {
 					panic(fmt.Sprintf("Function %v has unsupported parameter %v, type %v", f.Name, param.Name, param.Type))
@@ -292,8 +422,11 @@ func Convert(pkg, mod string, structs []parse.Struct, functions []parse.Func) (t
 				Type: originalType,
 			})
 		}
+		if skip {
+			continue
+		}
 		for _, returnType := range f.ReturnTypes {
-			typ, err := createGoTypeFromMove(returnType, structMap)
+			typ, err := createGoTypeFromMove(returnType, scope)
 			if err != nil {
 				log.Printf("WARNING: Function %v has an unknown return type: %v", f.Name, returnType)
 				// skip = true
@@ -301,12 +434,12 @@ func Convert(pkg, mod string, structs []parse.Struct, functions []parse.Func) (t
 				break
 			}
 			out.Returns = append(out.Returns, typ)
-			if typ.Import != nil {
+			// only a single return is typed, other structs of other modules are decoded by the decoders registered
+			// for them below
+			if typ.Import != nil && len(f.ReturnTypes) == 1 && !containsGenericTypeParam(typ.MoveType, f.TypeParams) {
 				importMap[typ.Import.Path] = typ.Import
 			}
-		}
-		if skip {
-			continue
+			foreignStructs.add(typ, true)
 		}
 		data.Funcs = append(data.Funcs, out)
 		functionInfos = append(functionInfos, functionInfo)
@@ -315,13 +448,131 @@ func Convert(pkg, mod string, structs []parse.Struct, functions []parse.Func) (t
 		return strings.Compare(a.Name, b.Name)
 	})
 
+	// structs of other modules are decoded with local copies of their BCS structs and converters when they need a
+	// custom decoder, the ones returned by functions have their decoders registered
+	data.ForeignStructs = foreignStructs.list()
+	allStructs := data.BuildStructMap()
+	data.ForeignStructs = slices.DeleteFunc(data.ForeignStructs, func(s *tmplStruct) bool {
+		return !s.Register && !s.NeedsCustomDecoder(allStructs)
+	})
+	for _, s := range data.ForeignStructs {
+		importMap[s.Import.Path] = s.Import
+	}
+	for _, s := range data.AllStructs() {
+		if s.Import == nil || !s.NeedsCustomDecoder(allStructs) {
+			continue
+		}
+		for _, field := range s.Fields {
+			if field.Type.Import != nil && GetBCSType(field, allStructs) == field.Type.GoType {
+				importMap[field.Type.Import.Path] = field.Type.Import
+			}
+		}
+	}
+
 	for _, v := range importMap {
 		data.Imports = append(data.Imports, v)
 	}
+	slices.SortFunc(data.Imports, func(a, b *tmplImport) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	// imports are named after their module, which modules of different packages may share, e.g. ownable
+	for i := 1; i < len(data.Imports); i++ {
+		for _, other := range data.Imports[:i] {
+			if other.PackageName == data.Imports[i].PackageName {
+				return tmplData{}, fmt.Errorf("%s and %s are both imported as %s", other.Path, data.Imports[i].Path, other.PackageName)
+			}
+		}
+	}
 
 	return data, nil
 }
 
+// foreignStructs collects the structs of other modules used by a module, with the structs nested in them.
+type foreignStructs struct {
+	registry *Registry
+	structs  map[string]*tmplStruct
+	order    []string
+}
+
+func newForeignStructs(registry *Registry) *foreignStructs {
+	return &foreignStructs{registry: registry, structs: map[string]*tmplStruct{}}
+}
+
+func (f *foreignStructs) add(typ tmplType, register bool) {
+	// only structs of other modules, not vectors nor options of them
+	if typ.Import == nil || !strings.HasPrefix(typ.GoType, typ.Import.PackageName+".") {
+		return
+	}
+	qualified := stripGenericType(typ.MoveType)
+	if s, ok := f.structs[qualified]; ok {
+		s.Register = s.Register || register
+		return
+	}
+	registered, ok := f.registry.lookup(qualified)
+	if !ok {
+		return
+	}
+
+	out := &tmplStruct{
+		Name:       registered.Struct.Name,
+		MoveType:   qualified,
+		GoName:     registered.Import.PackageName + "." + registered.Struct.Name,
+		Ident:      ToUpperCamelCase(registered.Module.Name) + registered.Struct.Name,
+		DecoderKey: qualified,
+		Register:   register,
+		Import:     registered.Import,
+	}
+	f.structs[qualified] = out
+	f.order = append(f.order, qualified)
+
+	scope := foreignScope(registered, f.registry)
+	convertFields := func(fields []parse.Param) []*tmplField {
+		var out []*tmplField
+		for _, field := range fields {
+			goType, err := createGoTypeFromMove(field.Type, scope)
+			if err != nil {
+				continue
+			}
+			out = append(out, &tmplField{
+				Type:     goType,
+				Name:     ToUpperCamelCase(field.Name),
+				MoveName: field.Name,
+			})
+			f.add(goType, false)
+		}
+
+		return out
+	}
+	if registered.Enum == nil {
+		out.Fields = convertFields(registered.Struct.Fields)
+		return
+	}
+
+	out.Variants = []*tmplStruct{}
+	for tag, v := range registered.Enum.Variants {
+		name := registered.Enum.Name + ToUpperCamelCase(v.Name)
+		out.Variants = append(out.Variants, &tmplStruct{
+			Name:        name,
+			Fields:      convertFields(v.Fields),
+			MoveType:    qualified + "::" + v.Name,
+			GoName:      registered.Import.PackageName + "." + name,
+			Ident:       ToUpperCamelCase(registered.Module.Name) + name,
+			VariantName: v.Name,
+			Tag:         tag,
+			Import:      registered.Import,
+		})
+	}
+}
+
+func (f *foreignStructs) list() []*tmplStruct {
+	out := make([]*tmplStruct, 0, len(f.order))
+	for _, qualified := range f.order {
+		out = append(out, f.structs[qualified])
+	}
+
+	return out
+}
+
 func getZeroValue(goType string) string {
 	switch goType {
 	case "string":
@@ -363,20 +614,39 @@ func getFullyQualifiedType(moveType string, packageName string, moduleName strin
 		return "0x1::option::Option"
 	}
 
-	// check if this is a struct defined in this module
-	if _, ok := structMap[moveType]; ok {
+	// check if this is a struct defined in this module, structs of other modules are already fully-qualified
+	if s, ok := structMap[moveType]; ok && s.Import == nil {
 		return packageName + "::" + moduleName + "::" + moveType
 	}
 	return moveType
 }
 
 func Generate(data tmplData) (string, error) {
+	return execute(tmpl, data)
+}
+
+// GenerateMock generates gomock mocks of the interfaces generated by Generate, in a mocks package importing the
+// bindings from importPath.
+func GenerateMock(data tmplData, importPath string) (string, error) {
+	data.Bindings = &tmplImport{Path: importPath, PackageName: "module_" + data.Module}
+	return execute(mockTmpl, data)
+}
+
+func execute(text string, data tmplData) (string, error) {
 	structMap := data.BuildStructMap()
 
 	funcs := template.FuncMap{
 		"toLowerCamel": ToLowerCamelCase,
 		"toUpperCamel": ToUpperCamelCase,
-		"getZeroValue": getZeroValue,
+		"getZeroValue": func(goType string) string {
+			// enums are interfaces
+			for _, s := range structMap {
+				if s.IsEnum() && s.GoName == goType {
+					return "nil"
+				}
+			}
+			return getZeroValue(goType)
+		},
 		"needsCustomDecoder": func(structName string) bool {
 			if s, ok := structMap[structName]; ok {
 				return s.NeedsCustomDecoder(structMap)
@@ -392,12 +662,19 @@ func Generate(data tmplData) (string, error) {
 			}
 			return false
 		},
+		"nestedIdent": func(moveType string) string {
+			return structMap[moveType].Ident
+		},
+		"isID": isIDType,
 		"getFullyQualifiedType": func(moveType string, packageName string, moduleName string) string {
 			return getFullyQualifiedType(moveType, packageName, moduleName, structMap)
 		},
+		"qualify": func(goType string) string {
+			return qualifyGoType(goType, data.Bindings, structMap)
+		},
 	}
 
-	tpl := template.Must(template.New("").Funcs(funcs).Parse(tmpl))
+	tpl := template.Must(template.New("").Funcs(funcs).Parse(text))
 	buffer := new(bytes.Buffer)
 	if err := tpl.Execute(buffer, data); err != nil {
 		return "", err
@@ -411,6 +688,23 @@ func Generate(data tmplData) (string, error) {
 	return string(bb), nil
 }
 
+// qualifyGoType qualifies the Go types of the structs and enums of the module, possibly in slices or pointers, by
+// the package of its bindings, for code generated outside of it.
+func qualifyGoType(goType string, bindings *tmplImport, structMap map[string]*tmplStruct) string {
+	if bindings == nil {
+		return goType
+	}
+
+	base := strings.TrimLeft(goType, "[]*")
+	for _, s := range structMap {
+		if s.Import == nil && s.GoName == base {
+			return goType[:len(goType)-len(base)] + bindings.PackageName + "." + base
+		}
+	}
+
+	return goType
+}
+
 var UppercaseWords []string
 
 // ToUpperCamelCase converts an under-score string to a camel-case string
@@ -457,8 +751,9 @@ func ToLowerCamelCase(input string) string {
 	if len(param) == 0 { // Give a default name if empty, mostly for `_` named params
 		param = "param"
 	}
-	if param == "c" {
-		param = "c_"
+	// avoid shadowing the receiver and the builtins used by the generated methods
+	if param == "c" || param == "len" {
+		param += "_"
 	}
 	return param
 }
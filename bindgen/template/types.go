@@ -2,12 +2,13 @@ package template
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/smartcontractkit/chainlink-sui/bindgen/parse"
 )
 
-func createGoTypeFromMove(s string, localStructs map[string]parse.Struct) (tmplType, error) {
+func createGoTypeFromMove(s string, scope *typeScope) (tmplType, error) {
 	switch s {
 	case "u8":
 		return tmplType{
@@ -67,14 +68,21 @@ func createGoTypeFromMove(s string, localStructs map[string]parse.Struct) (tmplT
 	default:
 		if strings.HasPrefix(s, "vector<") && strings.HasSuffix(s, ">") {
 			innerTypeName := strings.TrimSuffix(strings.TrimPrefix(s, "vector<"), ">")
-			innerType, err := createGoTypeFromMove(innerTypeName, localStructs)
+			innerType, err := createGoTypeFromMove(innerTypeName, scope)
 			if err != nil {
 				return tmplType{}, err
 			}
 
+			moveType := s
+			if innerType.Import != nil {
+				// keep the fully-qualified name of structs of other modules
+				moveType = "vector<" + innerType.MoveType + ">"
+			}
+
 			return tmplType{
 				GoType:   "[]" + innerType.GoType,
-				MoveType: s,
+				MoveType: moveType,
+				Import:   innerType.Import,
 			}, nil
 		}
 
@@ -82,7 +90,7 @@ func createGoTypeFromMove(s string, localStructs map[string]parse.Struct) (tmplT
 		for _, prefix := range optionPrefixes {
 			if strings.HasPrefix(s, prefix) && strings.HasSuffix(s, ">") {
 				innerTypeName := strings.TrimSuffix(strings.TrimPrefix(s, prefix), ">")
-				innerType, err := createGoTypeFromMove(innerTypeName, localStructs)
+				innerType, err := createGoTypeFromMove(innerTypeName, scope)
 				if err != nil {
 					return tmplType{}, err
 				}
@@ -93,13 +101,22 @@ func createGoTypeFromMove(s string, localStructs map[string]parse.Struct) (tmplT
 					Option: &tmplOption{
 						UnderlyingGoType: innerType.GoType,
 					},
+					Import: innerType.Import,
 				}, nil
 			}
 		}
 
 		baseType := stripGenericType(s)
-		if _, ok := localStructs[baseType]; ok {
-			if isSuiObjectStruct(localStructs[baseType]) {
+		// objects sent to other objects are received by ID, bind resolves them as Receiving arguments
+		if isReceivingType(baseType) {
+			return tmplType{
+				GoType:   "bind.Object",
+				MoveType: s,
+			}, nil
+		}
+
+		if local, ok := scope.local[baseType]; ok {
+			if isSuiObjectStruct(local) {
 				return tmplType{
 					GoType:   "bind.Object",
 					MoveType: s,
@@ -113,6 +130,15 @@ func createGoTypeFromMove(s string, localStructs map[string]parse.Struct) (tmplT
 			}, nil
 		}
 
+		// structs of other modules use the type of their bindings, objects are still referenced by ID
+		if qualified, foreign, ok := scope.foreign(baseType); ok && foreign.Import != nil && !isSuiObjectStruct(foreign.Struct) {
+			return tmplType{
+				GoType:   foreign.Import.PackageName + "." + foreign.Struct.Name,
+				MoveType: qualified + strings.TrimPrefix(s, baseType),
+				Import:   foreign.Import,
+			}, nil
+		}
+
 		return tmplType{
 			GoType:   "bind.Object",
 			MoveType: s,
@@ -125,6 +151,10 @@ func isSuiObjectStruct(s parse.Struct) bool {
 		return false
 	}
 
+	if s.Abilities != nil {
+		return slices.Contains(s.Abilities, "key")
+	}
+
 	for _, field := range s.Fields {
 		if field.Name == "id" && field.Type == "UID" {
 			return true
@@ -134,6 +164,27 @@ func isSuiObjectStruct(s parse.Struct) bool {
 	return false
 }
 
+// isIDType reports whether a Move type is sui::object::ID, as written in source or normalized modules.
+func isIDType(moveType string) bool {
+	switch moveType {
+	case "ID", "object::ID", "sui::object::ID", "0x2::object::ID":
+		return true
+	}
+
+	return false
+}
+
+// isReceivingType reports whether a Move type, without its type arguments, is sui::transfer::Receiving, as written
+// in source or normalized modules.
+func isReceivingType(baseType string) bool {
+	switch baseType {
+	case "Receiving", "transfer::Receiving", "sui::transfer::Receiving", "0x2::transfer::Receiving":
+		return true
+	}
+
+	return false
+}
+
 func stripGenericType(s string) string {
 	if i := strings.Index(s, "<"); i != -1 {
 		return s[:i]
@@ -7,6 +7,8 @@ import (
 type PackageArtifact struct {
 	Modules      []string `json:"modules"`
 	Dependencies []string `json:"dependencies"`
+	// Digest is the digest of the package computed by sui move build, authorized by the ticket of its upgrades
+	Digest []byte `json:"digest"`
 }
 
 func ToArtifact(artifactJSON string) (PackageArtifact, error) {
@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"math/big"
 
-	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
 )
 
 const (
@@ -97,163 +97,72 @@ func extractBCSBytes(value any) ([]byte, error) {
 
 func decodeBCSValue(data []byte, moveType string) (any, error) {
 	if decoder, ok := structDecoders[moveType]; ok {
-		return decoder(data)
+		return decodeStruct(decoder, data)
 	}
 
-	switch moveType {
-	case "bool":
-		if len(data) != 1 {
-			return nil, fmt.Errorf("invalid bool BCS data length %d", len(data))
-		}
-		// check that it's 0 or 1 as expected since sui-go-sdk has a more relaxed check
-		// https://github.com/block-vision/sui-go-sdk/blob/5434626f683dcd308be2c418d5611072fee52484/mystenbcs/decode.go#L117
-		if data[0] != 0 && data[0] != 1 {
-			return nil, fmt.Errorf("invalid bool BCS value %v", data)
-		}
-		var result bool
-		if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
-			return nil, err
-		}
-
-		return result, nil
-
-	case "u8":
-		if len(data) != U8Len {
-			return nil, fmt.Errorf("invalid u8 BCS data length %d", len(data))
-		}
-		var result uint8
-		if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
-			return nil, err
-		}
-
-		return result, nil
-
-	case "u16":
-		if len(data) != U16Len {
-			return nil, fmt.Errorf("invalid u16 BCS data length %d", len(data))
-		}
-		var result uint16
-		if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
-			return nil, err
-		}
-
-		return result, nil
-
-	case "u32":
-		if len(data) != U32Len {
-			return nil, fmt.Errorf("invalid u32 BCS data length %d", len(data))
-		}
-		var result uint32
-		if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
-			return nil, err
-		}
-
-		return result, nil
-
-	case "u64":
-		if len(data) != U64Len {
-			return nil, fmt.Errorf("invalid u64 BCS data length %d", len(data))
-		}
-		var result uint64
-		if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
-			return nil, err
-		}
-
-		return result, nil
-
-	case "u128":
-		// mystenbcs.Unmarshal doesn't support u128, u256, or address handling.
-		// https://github.com/block-vision/sui-go-sdk/blob/5434626f683dcd308be2c418d5611072fee52484/mystenbcs/decode.go#L131
-		if len(data) != U128Len {
-			return nil, fmt.Errorf("invalid u128 data length: %d", len(data))
-		}
-		var bytes [16]byte
-		copy(bytes[:], data)
-		return DecodeU128Value(bytes)
-
-	case "u256":
-		if len(data) != U256Len {
-			return nil, fmt.Errorf("invalid u256 data length: %d", len(data))
-		}
-		var bytes [32]byte
-		copy(bytes[:], data)
-		return DecodeU256Value(bytes)
-
-	case AddressType:
-		if len(data) != AddressLen {
-			return nil, fmt.Errorf("invalid address BCS data length %d", len(data))
-		}
-		var result [32]byte
-		if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
-			return nil, err
-		}
-
-		return fmt.Sprintf("0x%x", result), nil
-
-	case "vector<u8>":
-		var result []byte
-		if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
-			return nil, err
-		}
-
-		return result, nil
-
-	case "vector<address>":
-		var result [][32]byte
-		if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
-			return nil, err
-		}
-		addresses := make([]string, len(result))
-		for i, addr := range result {
-			addresses[i] = fmt.Sprintf("0x%x", addr)
-		}
+	parsed, err := movebcs.ParseType(moveType)
+	if err != nil {
+		return data, fmt.Errorf("unsupported type for automatic decoding: %s: %w", moveType, err)
+	}
+	if missing := moveTypes.Missing(parsed); len(missing) > 0 {
+		return data, fmt.Errorf("unsupported type for automatic decoding: %s: no layout registered for %s", moveType, missing[0].String())
+	}
 
-		return addresses, nil
+	decoded, err := moveTypes.Decode(data, parsed)
+	if err != nil {
+		return nil, err
+	}
 
-	case "vector<vector<address>>":
-		var result [][][32]byte
-		if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
-			return nil, err
-		}
-		addresses := make([][]string, len(result))
-		for i, a := range result {
-			subAddresses := make([]string, len(a))
-			for j, addr := range a {
-				subAddresses[j] = fmt.Sprintf("0x%x", addr)
-			}
-			addresses[i] = subAddresses
-		}
+	return typedVector(parsed, decoded), nil
+}
 
-		return addresses, nil
+// typedVector turns the []any of a decoded vector into the typed slices the generated bindings expect: []string
+// for addresses and strings, [][]byte for byte vectors and [][]string for vectors of addresses. Other values are
+// returned as is.
+func typedVector(moveType movebcs.Type, value any) any {
+	values, ok := value.([]any)
+	if !ok || moveType.Kind != movebcs.KindVector {
+		return value
+	}
 
-	case "vector<vector<u8>>":
-		var result [][]byte
-		if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
-			return nil, err
+	elem := *moveType.Elem
+	switch {
+	case elem.Kind == movebcs.KindAddress, elem.Is("0x1", "string", "String"):
+		return typedSlice[string](values)
+	case elem.Kind == movebcs.KindVector && elem.Elem.Kind == movebcs.KindU8:
+		return typedSlice[[]byte](values)
+	case elem.Kind == movebcs.KindVector && elem.Elem.Kind == movebcs.KindAddress:
+		nested := make([][]string, len(values))
+		for i, inner := range values {
+			innerValues, _ := inner.([]any)
+			nested[i] = typedSlice[string](innerValues)
 		}
 
-		return result, nil
+		return nested
+	default:
+		return value
+	}
+}
 
-	case "0x1::string::String":
-		var result string
-		if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
-			return nil, err
-		}
+func typedSlice[T any](values []any) []T {
+	typed := make([]T, len(values))
+	for i, value := range values {
+		typed[i], _ = value.(T)
+	}
 
-		return result, nil
+	return typed
+}
 
-	// TODO: handle vectors recursively
-	case "vector<0x1::string::String>":
-		var result []string
-		if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
-			return nil, err
+// decodeStruct runs a struct decoder, turning its panics into errors: mystenbcs panics when an enum tag is out of
+// the range of the variants of the enum.
+func decodeStruct(decoder StructDecoder, data []byte) (result any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result, err = nil, fmt.Errorf("malformed BCS data: %v", r)
 		}
+	}()
 
-		return result, nil
-
-	default:
-		return data, fmt.Errorf("unsupported type for automatic decoding: %s", moveType)
-	}
+	return decoder(data)
 }
 
 func reverseBytes(data []byte) []byte {
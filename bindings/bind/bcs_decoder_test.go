@@ -2,6 +2,7 @@ package bind
 
 import (
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/block-vision/sui-go-sdk/mystenbcs"
@@ -256,3 +257,85 @@ func TestSpecificDecodeFunctions(t *testing.T) {
 		assert.Equal(t, 0, result128.Cmp(testValue), "Decoded value should match original")
 	})
 }
+
+func TestMoveTypes(t *testing.T) {
+	t.Run("Option and VecMap round-trip through call args and decoding", func(t *testing.T) {
+		some := uint64(42)
+		arg, err := ConvertToCallArg("0x1::option::Option<u64>", &some)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1, 42, 0, 0, 0, 0, 0, 0, 0}, arg.Pure.Bytes)
+
+		decoded, err := decodeBCSValue(arg.Pure.Bytes, "0x1::option::Option<u64>")
+		require.NoError(t, err)
+		assert.Equal(t, uint64(42), decoded)
+
+		arg, err = ConvertToCallArg("0x1::option::Option<u64>", nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0}, arg.Pure.Bytes)
+
+		decoded, err = decodeBCSValue(arg.Pure.Bytes, "0x1::option::Option<u64>")
+		require.NoError(t, err)
+		assert.Nil(t, decoded)
+
+		arg, err = ConvertToCallArg("0x2::vec_map::VecMap<u8, bool>", map[uint8]bool{2: false, 1: true})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{2, 1, 1, 2, 0}, arg.Pure.Bytes)
+
+		arg, err = ConvertToCallArg("vector<0x1::option::Option<u8>>", []*uint8{nil})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1, 0}, arg.Pure.Bytes)
+	})
+
+	t.Run("Primitives and vectors keep the Go types of the bindings", func(t *testing.T) {
+		address := "0x" + strings.Repeat("0", 63) + "2"
+
+		arg, err := ConvertToCallArg("vector<address>", []string{"0x2"})
+		require.NoError(t, err)
+		decoded, err := decodeBCSValue(arg.Pure.Bytes, "vector<address>")
+		require.NoError(t, err)
+		assert.Equal(t, []string{address}, decoded)
+
+		arg, err = ConvertToCallArg("vector<vector<address>>", [][]string{{"0x2"}, {}})
+		require.NoError(t, err)
+		decoded, err = decodeBCSValue(arg.Pure.Bytes, "vector<vector<address>>")
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{address}, {}}, decoded)
+
+		arg, err = ConvertToCallArg("vector<0x1::string::String>", []string{"a", "b"})
+		require.NoError(t, err)
+		decoded, err = decodeBCSValue(arg.Pure.Bytes, "vector<0x1::string::String>")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, decoded)
+
+		// strings are hex for vector<u8>, with or without a 0x prefix
+		arg, err = ConvertToCallArg("vector<vector<u8>>", [][]byte{{1, 2}})
+		require.NoError(t, err)
+		decoded, err = decodeBCSValue(arg.Pure.Bytes, "vector<vector<u8>>")
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{{1, 2}}, decoded)
+		arg, err = ConvertToCallArg("vector<u8>", "0102")
+		require.NoError(t, err)
+		assert.Equal(t, []byte{2, 1, 2}, arg.Pure.Bytes)
+
+		arg, err = ConvertToCallArg("u128", "340282366920938463463374607431768211455")
+		require.NoError(t, err)
+		decoded, err = decodeBCSValue(arg.Pure.Bytes, "u128")
+		require.NoError(t, err)
+		assert.Equal(t, "340282366920938463463374607431768211455", decoded.(*big.Int).String())
+
+		_, err = ConvertToCallArg("u8", 256)
+		require.ErrorContains(t, err, "does not fit in u8")
+		_, err = decodeBCSValue([]byte{2}, "bool")
+		require.ErrorContains(t, err, "invalid bool")
+		_, err = decodeBCSValue([]byte{1, 0}, "u8")
+		require.ErrorContains(t, err, "trailing bytes")
+	})
+
+	t.Run("Unregistered structs are still unsupported", func(t *testing.T) {
+		_, err := ConvertToCallArg("0xabc::pool::Config", map[string]any{})
+		require.ErrorContains(t, err, "unsupported type for CallArg")
+
+		_, err = decodeBCSValue([]byte{1}, "0xabc::pool::Config")
+		require.ErrorContains(t, err, "unsupported type for automatic decoding")
+	})
+}
@@ -0,0 +1,19 @@
+package bindtest
+
+import (
+	bindutils "github.com/smartcontractkit/chainlink-sui/bindings/utils"
+)
+
+var _ bindutils.SuiSigner = Signer("")
+
+// Signer is a bindutils.SuiSigner of the address it holds. Its signatures are placeholders, SuiClient doesn't
+// verify them.
+type Signer string
+
+func (s Signer) Sign(_ []byte) ([]string, error) {
+	return []string{"signature"}, nil
+}
+
+func (s Signer) GetAddress() (string, error) {
+	return normalize(string(s)), nil
+}
@@ -0,0 +1,389 @@
+// Package bindtest provides an in-memory Sui client to unit test code using bindings without a Sui node.
+package bindtest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	bindutils "github.com/smartcontractkit/chainlink-sui/bindings/utils"
+)
+
+const (
+	// DefaultReferenceGasPrice is the reference gas price of a new SuiClient
+	DefaultReferenceGasPrice uint64 = 1000
+)
+
+var _ sui.ISuiAPI = (*SuiClient)(nil)
+
+// SuiClient is an in-memory sui.ISuiAPI implementing the methods used by bind: objects, packages, gas coins and events
+// are served from what is added to it, and executed transactions are recorded. Other methods of sui.ISuiAPI panic.
+//
+// Executions and dev inspects succeed without effects unless OnExecute and OnDevInspect return other responses.
+type SuiClient struct {
+	sui.ISuiAPI
+
+	// OnExecute returns the response to the execution of a transaction, decoded from its TransactionData
+	OnExecute func(tx *bind.DecodedTransaction) (models.SuiTransactionBlockResponse, error)
+	// OnDevInspect returns the response to a dev inspect, e.g. built by DevInspectResponse
+	OnDevInspect func(req models.SuiDevInspectTransactionBlockRequest) (models.SuiTransactionBlockResponse, error)
+	// GasUsed is the gas used by dry runs
+	GasUsed models.GasCostSummary
+
+	mu       sync.Mutex
+	gasPrice uint64
+	objects  map[string]models.SuiObjectData
+	packages map[string]map[string]string
+	coins    map[string][]models.CoinData
+	events   []models.SuiEventResponse
+	executed []*bind.DecodedTransaction
+}
+
+// NewSuiClient returns a SuiClient without objects at the DefaultReferenceGasPrice.
+func NewSuiClient() *SuiClient {
+	return &SuiClient{
+		GasUsed: models.GasCostSummary{
+			ComputationCost: "1000000",
+			StorageCost:     "2000000",
+			StorageRebate:   "1000000",
+		},
+		gasPrice: DefaultReferenceGasPrice,
+		objects:  map[string]models.SuiObjectData{},
+		packages: map[string]map[string]string{},
+		coins:    map[string][]models.CoinData{},
+	}
+}
+
+// SetReferenceGasPrice sets the reference gas price returned by SuiXGetReferenceGasPrice.
+func (c *SuiClient) SetReferenceGasPrice(price uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gasPrice = price
+}
+
+// AddObject serves the object from SuiGetObject, replacing any object with the same ID.
+func (c *SuiClient) AddObject(object models.SuiObjectData) {
+	objectId := normalize(object.ObjectId)
+	object.ObjectId = objectId
+	if object.Content == nil {
+		object.Content = &models.SuiParsedData{DataType: "moveObject", SuiMoveObject: models.SuiMoveObject{Type: object.Type}}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.objects[objectId] = object
+}
+
+// AddPackage serves the package from sui_getObject with its type origin table, mapping its datatypes, as
+// module::name, to the package which first defined them. The datatypes of an upgraded package keep the ID of the
+// original package, which is then the ID of the events they are emitted as.
+func (c *SuiClient) AddPackage(packageId string, typeOrigins map[string]string) {
+	origins := make(map[string]string, len(typeOrigins))
+	for datatype, originId := range typeOrigins {
+		origins[datatype] = normalize(originId)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.packages[normalize(packageId)] = origins
+}
+
+// AddOwnedObject adds an object of the type owned by the address.
+func (c *SuiClient) AddOwnedObject(objectId, objectType string, version uint64, digest, owner string) {
+	c.AddObject(models.SuiObjectData{
+		ObjectId: objectId,
+		Type:     objectType,
+		Version:  strconv.FormatUint(version, 10),
+		Digest:   digest,
+		Owner:    map[string]any{"AddressOwner": normalize(owner)},
+	})
+}
+
+// AddSharedObject adds a shared object of the type, shared at initialSharedVersion.
+func (c *SuiClient) AddSharedObject(objectId, objectType string, initialSharedVersion uint64) {
+	c.AddObject(models.SuiObjectData{
+		ObjectId: objectId,
+		Type:     objectType,
+		Version:  strconv.FormatUint(initialSharedVersion, 10),
+		Owner:    map[string]any{"Shared": map[string]any{"initial_shared_version": initialSharedVersion}},
+	})
+}
+
+// AddGasCoin adds a SUI coin owned by the address, returned by SuiXGetAllCoins and SuiGetObject.
+func (c *SuiClient) AddGasCoin(objectId string, version uint64, digest, owner string, balance uint64) {
+	c.AddOwnedObject(objectId, "0x2::coin::Coin<0x2::sui::SUI>", version, digest, owner)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	owner = normalize(owner)
+	c.coins[owner] = append(c.coins[owner], models.CoinData{
+		CoinType:     "0x2::sui::SUI",
+		CoinObjectId: normalize(objectId),
+		Version:      strconv.FormatUint(version, 10),
+		Digest:       digest,
+		Balance:      strconv.FormatUint(balance, 10),
+	})
+}
+
+// EmitEvent adds an event of the Move type with the BCS data, returned by SuiXQueryEvents and suix_queryEvents.
+func (c *SuiClient) EmitEvent(eventType string, data []byte) models.EventId {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := models.EventId{TxDigest: fmt.Sprintf("event-%d", len(c.events)), EventSeq: strconv.Itoa(len(c.events))}
+	c.events = append(c.events, models.SuiEventResponse{
+		Id:   id,
+		Type: eventType,
+		Bcs:  base64.StdEncoding.EncodeToString(data),
+	})
+
+	return id
+}
+
+// Executed returns the transactions executed so far, in order.
+func (c *SuiClient) Executed() []*bind.DecodedTransaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]*bind.DecodedTransaction(nil), c.executed...)
+}
+
+func (c *SuiClient) SuiGetObject(_ context.Context, req models.SuiGetObjectRequest) (models.SuiObjectResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	object, ok := c.objects[normalize(req.ObjectId)]
+	if !ok {
+		return models.SuiObjectResponse{Error: &models.SuiObjectResponseError{Code: "notExists", ObjectId: req.ObjectId}}, nil
+	}
+
+	return models.SuiObjectResponse{Data: &object}, nil
+}
+
+func (c *SuiClient) SuiXGetAllCoins(_ context.Context, req models.SuiXGetAllCoinsRequest) (models.PaginatedCoinsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return models.PaginatedCoinsResponse{Data: append([]models.CoinData(nil), c.coins[normalize(req.Owner)]...)}, nil
+}
+
+func (c *SuiClient) SuiXGetReferenceGasPrice(_ context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.gasPrice, nil
+}
+
+func (c *SuiClient) SuiExecuteTransactionBlock(_ context.Context, req models.SuiExecuteTransactionBlockRequest) (models.SuiTransactionBlockResponse, error) {
+	tx, err := decodeTransaction(req.TxBytes)
+	if err != nil {
+		return models.SuiTransactionBlockResponse{}, err
+	}
+
+	c.mu.Lock()
+	digest := fmt.Sprintf("tx-%d", len(c.executed))
+	c.executed = append(c.executed, tx)
+	c.mu.Unlock()
+
+	if c.OnExecute != nil {
+		return c.OnExecute(tx)
+	}
+
+	return models.SuiTransactionBlockResponse{
+		Digest: digest,
+		Effects: models.SuiEffects{
+			Status:            models.ExecutionStatus{Status: "success"},
+			TransactionDigest: digest,
+		},
+	}, nil
+}
+
+func (c *SuiClient) SuiDryRunTransactionBlock(_ context.Context, req models.SuiDryRunTransactionBlockRequest) (models.SuiTransactionBlockResponse, error) {
+	if _, err := decodeTransaction(req.TxBytes); err != nil {
+		return models.SuiTransactionBlockResponse{}, err
+	}
+
+	return models.SuiTransactionBlockResponse{
+		Effects: models.SuiEffects{
+			Status:  models.ExecutionStatus{Status: "success"},
+			GasUsed: c.GasUsed,
+		},
+	}, nil
+}
+
+func (c *SuiClient) SuiDevInspectTransactionBlock(_ context.Context, req models.SuiDevInspectTransactionBlockRequest) (models.SuiTransactionBlockResponse, error) {
+	if c.OnDevInspect != nil {
+		return c.OnDevInspect(req)
+	}
+
+	return models.SuiTransactionBlockResponse{
+		Effects: models.SuiEffects{Status: models.ExecutionStatus{Status: "success"}},
+	}, nil
+}
+
+// SuiXQueryEvents pages through the emitted events matching a MoveEventType filter, or all of them with other filters.
+func (c *SuiClient) SuiXQueryEvents(_ context.Context, req models.SuiXQueryEventsRequest) (models.PaginatedEventsResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var events []models.SuiEventResponse
+	for _, event := range c.events {
+		if filter, ok := req.SuiEventFilter.(models.EventFilterByMoveEventType); ok && filter.MoveEventType != event.Type {
+			continue
+		}
+		events = append(events, event)
+	}
+	if req.DescendingOrder {
+		if len(events) == 0 {
+			return models.PaginatedEventsResponse{}, nil
+		}
+
+		return models.PaginatedEventsResponse{Data: events[len(events)-1:]}, nil
+	}
+
+	after := -1
+	if cursor, ok := req.Cursor.(*models.EventId); ok && cursor != nil {
+		var err error
+		if after, err = strconv.Atoi(cursor.EventSeq); err != nil {
+			return models.PaginatedEventsResponse{}, fmt.Errorf("invalid cursor %s: %w", cursor.EventSeq, err)
+		}
+	}
+	var page []models.SuiEventResponse
+	for _, event := range events {
+		seq, _ := strconv.Atoi(event.Id.EventSeq)
+		if seq <= after {
+			continue
+		}
+		if req.Limit > 0 && uint64(len(page)) == req.Limit {
+			return models.PaginatedEventsResponse{Data: page, NextCursor: page[len(page)-1].Id, HasNextPage: true}, nil
+		}
+		page = append(page, event)
+	}
+	if len(page) == 0 {
+		return models.PaginatedEventsResponse{}, nil
+	}
+
+	return models.PaginatedEventsResponse{Data: page, NextCursor: page[len(page)-1].Id}, nil
+}
+
+// SuiCall serves suix_queryEvents from the emitted events, whose BCS is encoded as base64, sui_getObject from the
+// added packages and sui_executeTransactionBlock like SuiExecuteTransactionBlock. Other methods are unsupported.
+func (c *SuiClient) SuiCall(ctx context.Context, method string, params ...interface{}) (interface{}, error) {
+	const (
+		queryEventsParams        = 4
+		getObjectParams          = 2
+		executeTransactionParams = 4
+	)
+
+	var result any
+	switch {
+	case method == "suix_queryEvents" && len(params) == queryEventsParams:
+		request := models.SuiXQueryEventsRequest{SuiEventFilter: params[0], Cursor: params[1]}
+		request.Limit, _ = params[2].(uint64)
+		request.DescendingOrder, _ = params[3].(bool)
+		response, err := c.SuiXQueryEvents(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		page := bind.EventsPage{NextCursor: response.NextCursor, HasNextPage: response.HasNextPage}
+		for _, event := range response.Data {
+			page.Data = append(page.Data, bind.SuiEvent{SuiEventResponse: event, BcsEncoding: bind.BcsEncodingBase64})
+		}
+		result = page
+	case method == "sui_executeTransactionBlock" && len(params) == executeTransactionParams:
+		request := models.SuiExecuteTransactionBlockRequest{}
+		request.TxBytes, _ = params[0].(string)
+		request.Signature, _ = params[1].([]string)
+		request.Options, _ = params[2].(models.SuiTransactionBlockOptions)
+		request.RequestType, _ = params[3].(string)
+		response, err := c.SuiExecuteTransactionBlock(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		result = response
+	case method == "sui_getObject" && len(params) == getObjectParams:
+		packageId, _ := params[0].(string)
+		result = c.packageObject(normalize(packageId))
+	default:
+		return nil, fmt.Errorf("unsupported method %s", method)
+	}
+
+	response, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "result": result})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(response), nil
+}
+
+// packageObject returns the sui_getObject result of an added package, with its type origin table.
+func (c *SuiClient) packageObject(packageId string) map[string]any {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	origins, ok := c.packages[packageId]
+	if !ok {
+		return map[string]any{"error": models.SuiObjectResponseError{Code: "notExists", ObjectId: packageId}}
+	}
+
+	typeOriginTable := make([]map[string]string, 0, len(origins))
+	for datatype, originId := range origins {
+		moduleName, datatypeName, _ := strings.Cut(datatype, "::")
+		typeOriginTable = append(typeOriginTable, map[string]string{
+			"module_name":   moduleName,
+			"datatype_name": datatypeName,
+			"package":       originId,
+		})
+	}
+
+	return map[string]any{"data": map[string]any{
+		"objectId": packageId,
+		"bcs":      map[string]any{"dataType": "package", "id": packageId, "typeOriginTable": typeOriginTable},
+	}}
+}
+
+// DevInspectResponse returns a successful dev inspect response whose first command returns the BCS values.
+func DevInspectResponse(returnValues ...[]byte) models.SuiTransactionBlockResponse {
+	values := make([][]any, len(returnValues))
+	for i, value := range returnValues {
+		valueBytes := make([]int, len(value))
+		for j, b := range value {
+			valueBytes[j] = int(b)
+		}
+		// the type of the value isn't used by bind
+		values[i] = []any{valueBytes, ""}
+	}
+	results, _ := json.Marshal([]bind.DevInspectResult{{ReturnValues: values}})
+
+	return models.SuiTransactionBlockResponse{
+		Effects: models.SuiEffects{Status: models.ExecutionStatus{Status: "success"}},
+		Results: results,
+	}
+}
+
+func decodeTransaction(txBytes string) (*bind.DecodedTransaction, error) {
+	data, err := bindutils.DecodeBase64(txBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction bytes: %w", err)
+	}
+
+	return bind.DecodeTransactionData(data)
+}
+
+func normalize(address string) string {
+	normalized, err := bindutils.ConvertAddressToString(address)
+	if err != nil {
+		return address
+	}
+
+	return normalized
+}
@@ -0,0 +1,152 @@
+package bindtest
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	module_counter "github.com/smartcontractkit/chainlink-sui/bindings/generated/test/counter"
+	counter_mocks "github.com/smartcontractkit/chainlink-sui/bindings/generated/test/counter/mocks"
+)
+
+const testPackageId = "0x00000000000000000000000000000000000000000000000000000000000000a1"
+
+func testObjectId(b byte) string {
+	return fmt.Sprintf("0x%064x", b)
+}
+
+// testDigest returns a valid base58 digest, a digest being 32 bytes
+func testDigest(b byte) string {
+	digest := make([]byte, 32)
+	digest[31] = b
+
+	return base58.Encode(digest)
+}
+
+func newTestOpts(t *testing.T) (*bind.CallOpts, string) {
+	t.Helper()
+
+	address := testObjectId(0x5e)
+
+	return &bind.CallOpts{Signer: Signer(address), GasBudget: new(uint64)}, address
+}
+
+func TestSuiClientExecute(t *testing.T) {
+	t.Parallel()
+
+	client := NewSuiClient()
+	opts, sender := newTestOpts(t)
+	*opts.GasBudget = 10_000_000
+	client.AddGasCoin(testObjectId(0xc0), 4, testDigest(0xc0), sender, 1_000_000_000)
+	client.AddSharedObject(testObjectId(1), testPackageId+"::counter::Counter", 3)
+
+	counter, err := module_counter.NewCounter(testPackageId, client)
+	require.NoError(t, err)
+	tx, err := counter.Increment(context.Background(), opts, bind.Object{Id: testObjectId(1)})
+	require.NoError(t, err)
+	assert.Equal(t, "tx-0", tx.Digest)
+
+	executed := client.Executed()
+	require.Len(t, executed, 1)
+	assert.Equal(t, sender, executed[0].Sender)
+	assert.Equal(t, DefaultReferenceGasPrice, executed[0].GasPrice)
+	assert.Equal(t, uint64(10_000_000), executed[0].GasBudget)
+	assert.Equal(t, []models.SuiObjectRef{{ObjectId: testObjectId(0xc0), Version: 4, Digest: testDigest(0xc0)}}, executed[0].GasPayment)
+	assert.Equal(t, []string{fmt.Sprintf("SharedObject(%s, initial 3, mutable)", testObjectId(1))}, executed[0].Inputs)
+	assert.Equal(t, []string{fmt.Sprintf("MoveCall %s::counter::increment(Input(0))", testPackageId)}, executed[0].Commands)
+
+	client.OnExecute = func(tx *bind.DecodedTransaction) (models.SuiTransactionBlockResponse, error) {
+		return models.SuiTransactionBlockResponse{}, errors.New("rejected")
+	}
+	_, err = counter.Increment(context.Background(), opts, bind.Object{Id: testObjectId(1)})
+	require.ErrorContains(t, err, "rejected")
+
+	// objects not added to the client fail to resolve
+	_, err = counter.Increment(context.Background(), opts, bind.Object{Id: testObjectId(2)})
+	require.Error(t, err)
+	assert.Len(t, client.Executed(), 2)
+}
+
+func TestSuiClientDevInspect(t *testing.T) {
+	t.Parallel()
+
+	client := NewSuiClient()
+	opts, sender := newTestOpts(t)
+	client.AddOwnedObject(testObjectId(2), "0x2::coin::Coin<0x2::sui::SUI>", 5, testDigest(2), sender)
+	client.OnDevInspect = func(req models.SuiDevInspectTransactionBlockRequest) (models.SuiTransactionBlockResponse, error) {
+		return DevInspectResponse(binary.LittleEndian.AppendUint64(nil, 42)), nil
+	}
+
+	counter, err := module_counter.NewCounter(testPackageId, client)
+	require.NoError(t, err)
+	value, err := counter.DevInspect().GetCoinValue(context.Background(), opts, []string{"0x2::sui::SUI"}, bind.Object{Id: testObjectId(2)})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), value)
+	assert.Empty(t, client.Executed())
+}
+
+func TestSuiClientEvents(t *testing.T) {
+	t.Parallel()
+
+	client := NewSuiClient()
+	eventType := testPackageId + "::counter::CounterIncremented"
+	for value := range uint64(3) {
+		data := append(make([]byte, 31), 1)
+		client.EmitEvent(eventType, binary.LittleEndian.AppendUint64(data, value))
+		client.EmitEvent(testPackageId+"::counter::CounterDecremented", nil)
+	}
+
+	// the events of an upgraded package keep the ID of the package which first defined them
+	const upgradedPackageId = "0x00000000000000000000000000000000000000000000000000000000000000a2"
+	client.AddPackage(upgradedPackageId, map[string]string{"counter::CounterIncremented": testPackageId})
+
+	counter, err := module_counter.NewCounter(upgradedPackageId, client)
+	require.NoError(t, err)
+	page, err := counter.FilterCounterIncremented(context.Background(), nil, 2)
+	require.NoError(t, err)
+	require.Len(t, page.Events, 2)
+	assert.True(t, page.HasNextPage)
+	assert.Equal(t, testObjectId(1), page.Events[0].Data.CounterId.Id)
+	assert.Equal(t, uint64(1), page.Events[1].Data.NewValue)
+
+	page, err = counter.FilterCounterIncremented(context.Background(), page.NextCursor, 2)
+	require.NoError(t, err)
+	require.Len(t, page.Events, 1)
+	assert.False(t, page.HasNextPage)
+	assert.Equal(t, uint64(2), page.Events[0].Data.NewValue)
+
+	// packages not added to the client cannot be filtered
+	unknown, err := module_counter.NewCounter(testPackageId, client)
+	require.NoError(t, err)
+	_, err = unknown.FilterCounterIncremented(context.Background(), nil, 2)
+	require.ErrorContains(t, err, "notExists")
+}
+
+func TestMockCounter(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	counter := counter_mocks.NewMockICounter(ctrl)
+	devInspect := counter_mocks.NewMockICounterDevInspect(ctrl)
+	object := bind.Object{Id: testObjectId(1)}
+
+	counter.EXPECT().Increment(gomock.Any(), gomock.Any(), object).Return(&models.SuiTransactionBlockResponse{Digest: "tx"}, nil)
+	counter.EXPECT().DevInspect().Return(devInspect)
+	devInspect.EXPECT().GetCoinValue(gomock.Any(), gomock.Any(), []string{"0x2::sui::SUI"}, object).Return(uint64(0), errors.New("no coin"))
+
+	var c module_counter.ICounter = counter
+	tx, err := c.Increment(context.Background(), nil, object)
+	require.NoError(t, err)
+	assert.Equal(t, "tx", tx.Digest)
+	_, err = c.DevInspect().GetCoinValue(context.Background(), nil, []string{"0x2::sui::SUI"}, object)
+	require.ErrorContains(t, err, "no coin")
+}
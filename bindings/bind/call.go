@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/block-vision/sui-go-sdk/models"
 	"github.com/block-vision/sui-go-sdk/sui"
@@ -34,6 +35,10 @@ type BoundContract struct {
 	packageName string
 	moduleName  string
 	client      sui.ISuiAPI
+
+	// typeOrigins maps the datatypes of the package, as module::name, to the package which first defined them
+	typeOriginsMu sync.Mutex
+	typeOrigins   map[string]string
 }
 
 func (c *BoundContract) GetPackageID() string {
@@ -77,6 +82,9 @@ func (c *BoundContract) Call(ctx context.Context, opts *CallOpts, encoded *Encod
 	if opts == nil || opts.Signer == nil {
 		return nil, fmt.Errorf("CallOpts with Signer is required")
 	}
+	if opts.Offline != nil {
+		return nil, fmt.Errorf("%w: dev inspecting %s", ErrOfflineNetworkCall, encoded.Function)
+	}
 
 	signerAddressStr, err := opts.Signer.GetAddress()
 	if err != nil {
@@ -324,7 +332,17 @@ func parseVersionString(version string) (uint64, error) {
 // AppendPTB adds an EncodedCall to an existing PTB and returns the result argument
 func (c *BoundContract) AppendPTB(ctx context.Context, opts *CallOpts, ptb *transaction.Transaction, encoded *EncodedCall) (*transaction.Argument, error) {
 	if opts.ObjectResolver == nil {
-		opts.ObjectResolver = NewObjectResolver(c.client)
+		if opts.Offline != nil {
+			resolver, err := opts.Offline.NewObjectResolver()
+			if err != nil {
+				return nil, err
+			}
+			opts.ObjectResolver = resolver
+		} else {
+			opts.ObjectResolver = NewObjectResolver(c.client)
+		}
+	} else if opts.Offline != nil && opts.ObjectResolver.client != nil {
+		return nil, fmt.Errorf("%w: resolving the objects of %s with an RPC backed ObjectResolver", ErrOfflineNetworkCall, encoded.Function)
 	}
 
 	// resolve any UnresolvedObjects in EncodedCallArguments
@@ -395,18 +413,44 @@ func (c *BoundContract) AppendPTB(ctx context.Context, opts *CallOpts, ptb *tran
 }
 
 func ExecutePTB(ctx context.Context, opts *CallOpts, client sui.ISuiAPI, ptb *transaction.Transaction) (*models.SuiTransactionBlockResponse, error) {
+	if err := setTransactionDefaults(ctx, opts, client, ptb); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := ptb.Data.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal transaction: %w", err)
+	}
+
+	tx, err := signAndExecuteTx(ctx, opts.Signer, client, txBytes, opts.WaitForExecution)
+	// the versions of the owned objects used by the transaction change even if it fails
+	opts.ObjectResolver.UpdateFromTransaction(tx)
+	if tx == nil {
+		return nil, err
+	}
+
+	return &tx.SuiTransactionBlockResponse, err
+}
+
+// setTransactionDefaults sets the sender and the gas data of the PTB which are not set yet from the CallOpts, or
+// all of them from its OfflineContext.
+func setTransactionDefaults(ctx context.Context, opts *CallOpts, client sui.ISuiAPI, ptb *transaction.Transaction) error {
 	if opts == nil || opts.Signer == nil {
-		return nil, fmt.Errorf("CallOpts with Signer is required")
+		return fmt.Errorf("CallOpts with Signer is required")
 	}
 
 	signerAddressStr, err := opts.Signer.GetAddress()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get signer address: %w", err)
+		return fmt.Errorf("failed to get signer address: %w", err)
 	}
 
 	signerAddress, err := bindutils.ConvertAddressToString(signerAddressStr)
 	if err != nil {
-		return nil, fmt.Errorf("invalid signer address %v: %w", signerAddressStr, err)
+		return fmt.Errorf("invalid signer address %v: %w", signerAddressStr, err)
+	}
+
+	if opts.Offline != nil {
+		return opts.Offline.SetTransactionData(ptb)
 	}
 
 	if ptb.Data.V1.Sender == nil {
@@ -424,7 +468,7 @@ func ExecutePTB(ctx context.Context, opts *CallOpts, client sui.ISuiAPI, ptb *tr
 	if ptb.Data.V1.GasData.Price == nil {
 		gasPrice, gasPriceErr := client.SuiXGetReferenceGasPrice(ctx)
 		if gasPriceErr != nil {
-			return nil, fmt.Errorf("failed to get reference gas price: %w", gasPriceErr)
+			return fmt.Errorf("failed to get reference gas price: %w", gasPriceErr)
 		}
 		ptb.SetGasPrice(gasPrice)
 	}
@@ -432,7 +476,7 @@ func ExecutePTB(ctx context.Context, opts *CallOpts, client sui.ISuiAPI, ptb *tr
 	if ptb.Data.V1.GasData.Owner == nil {
 		normalizedSigner, normalizationErr := bindutils.ConvertAddressToString(signerAddressStr)
 		if normalizationErr != nil {
-			return nil, fmt.Errorf("invalid signer address for gas owner %v: %w", signerAddressStr, normalizationErr)
+			return fmt.Errorf("invalid signer address for gas owner %v: %w", signerAddressStr, normalizationErr)
 		}
 		ptb.SetGasOwner(models.SuiAddress(normalizedSigner))
 	}
@@ -445,17 +489,17 @@ func ExecutePTB(ctx context.Context, opts *CallOpts, client sui.ISuiAPI, ptb *tr
 			gasRef, err = FetchDefaultGasCoinRef(ctx, client, signerAddress)
 		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to get gas object: %w", err)
+			return fmt.Errorf("failed to get gas object: %w", err)
 		}
 
 		if gasRef != nil {
 			objIdBytes, objIdErr := bindutils.ConvertStringToAddressBytes(gasRef.ObjectId)
 			if objIdErr != nil {
-				return nil, fmt.Errorf("failed to convert gas object ID: %w", objIdErr)
+				return fmt.Errorf("failed to convert gas object ID: %w", objIdErr)
 			}
 			digestBytes, digestErr := bindutils.ConvertStringToDigestBytes(gasRef.Digest)
 			if digestErr != nil {
-				return nil, fmt.Errorf("failed to convert gas object digest: %w", digestErr)
+				return fmt.Errorf("failed to convert gas object digest: %w", digestErr)
 			}
 
 			payment := []transaction.SuiObjectRef{{
@@ -467,10 +511,5 @@ func ExecutePTB(ctx context.Context, opts *CallOpts, client sui.ISuiAPI, ptb *tr
 		}
 	}
 
-	txBytes, err := ptb.Data.Marshal()
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal transaction: %w", err)
-	}
-
-	return SignAndSendTx(ctx, opts.Signer, client, txBytes, opts.WaitForExecution)
+	return nil
 }
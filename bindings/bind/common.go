@@ -2,6 +2,7 @@ package bind
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/block-vision/sui-go-sdk/models"
@@ -24,9 +25,22 @@ type CallOpts struct {
 	WaitForExecution bool
 
 	ObjectResolver *ObjectResolver
+	// Offline builds PTBs from the objects and gas data of the context, without RPC calls
+	Offline *OfflineContext
 }
 
 func SignAndSendTx(ctx context.Context, signer bindutils.SuiSigner, client sui.ISuiAPI, txBytes []byte, waitForExecution bool) (*models.SuiTransactionBlockResponse, error) {
+	tx, err := signAndExecuteTx(ctx, signer, client, txBytes, waitForExecution)
+	if tx == nil {
+		return nil, err
+	}
+
+	return &tx.SuiTransactionBlockResponse, err
+}
+
+// signAndExecuteTx signs and executes a transaction like SignAndSendTx, and returns the response with the full
+// effects of the transaction.
+func signAndExecuteTx(ctx context.Context, signer bindutils.SuiSigner, client sui.ISuiAPI, txBytes []byte, waitForExecution bool) (*SuiTransactionBlockResponse, error) {
 	signatures, err := signer.Sign(txBytes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign tx: %w", err)
@@ -59,17 +73,73 @@ func SignAndSendTx(ctx context.Context, signer bindutils.SuiSigner, client sui.I
 		blockReq.RequestType = "WaitForLocalExecution"
 	}
 
-	tx, err := client.SuiExecuteTransactionBlock(ctx, blockReq)
+	tx, err := ExecuteTransactionBlock(ctx, client, blockReq)
 	if err != nil {
 		msg := fmt.Errorf("tx failed calling move method: %w", err)
 		return nil, msg
 	}
 
-	if err := GetFailedTxError(&tx); err != nil {
-		return &tx, err
+	if err := GetFailedTxError(&tx.SuiTransactionBlockResponse); err != nil {
+		return tx, err
 	}
 
-	return &tx, nil
+	return tx, nil
+}
+
+// SuiEffects are the effects of a transaction as returned by the node. Unlike models.SuiEffects, they keep the
+// objects the transaction wrapped, unwrapped, and unwrapped then deleted.
+type SuiEffects struct {
+	models.SuiEffects
+	Unwrapped            []models.OwnedObjectRef `json:"unwrapped,omitempty"`
+	Wrapped              []models.SuiObjectRef   `json:"wrapped,omitempty"`
+	UnwrappedThenDeleted []models.SuiObjectRef   `json:"unwrappedThenDeleted,omitempty"`
+}
+
+// SuiTransactionBlockResponse is a transaction response with its SuiEffects, whose models.SuiEffects are also the
+// effects of the embedded models.SuiTransactionBlockResponse.
+type SuiTransactionBlockResponse struct {
+	models.SuiTransactionBlockResponse
+	Effects SuiEffects `json:"effects,omitempty"`
+}
+
+func (r *SuiTransactionBlockResponse) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.SuiTransactionBlockResponse); err != nil {
+		return err
+	}
+
+	var effects struct {
+		Effects SuiEffects `json:"effects"`
+	}
+	if err := json.Unmarshal(data, &effects); err != nil {
+		return err
+	}
+	r.Effects = effects.Effects
+
+	return nil
+}
+
+// ExecuteTransactionBlock executes a signed transaction with sui_executeTransactionBlock. The SDK's
+// SuiExecuteTransactionBlock is not used as its model of effects drops the objects wrapped and unwrapped by the
+// transaction, which the object caches must not keep.
+func ExecuteTransactionBlock(ctx context.Context, api sui.ISuiAPI, req models.SuiExecuteTransactionBlockRequest) (*SuiTransactionBlockResponse, error) {
+	response, err := api.SuiCall(ctx, "sui_executeTransactionBlock", req.TxBytes, req.Signature, req.Options, req.RequestType)
+	if err != nil {
+		return nil, err
+	}
+
+	rawResponse, ok := response.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected transaction response type %T", response)
+	}
+
+	var tx struct {
+		Result SuiTransactionBlockResponse `json:"result"`
+	}
+	if err := json.Unmarshal([]byte(rawResponse), &tx); err != nil {
+		return nil, fmt.Errorf("failed to parse transaction response: %w", err)
+	}
+
+	return &tx.Result, nil
 }
 
 func DevInspectTx(ctx context.Context, signerAddress string, client sui.ISuiAPI, txBytes []byte) (*models.SuiTransactionBlockResponse, error) {
@@ -0,0 +1,83 @@
+package bind
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
+)
+
+// EnumVariant is implemented by the Go types generated for the variants of Move enums. Each enum is a Go interface
+// implemented by one struct per variant, whose fields are the fields of the variant.
+type EnumVariant interface {
+	// MoveVariant returns the index of the variant in the declaration of its enum, which is its BCS tag, and its name
+	MoveVariant() (uint32, string)
+}
+
+// EncodeEnumVariant encodes a variant of a Move enum as BCS: its tag as ULEB128 followed by its fields.
+func EncodeEnumVariant(variant EnumVariant) ([]byte, error) {
+	value := reflect.Indirect(reflect.ValueOf(variant))
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("enum variant must be a struct, got %T", variant)
+	}
+
+	tag, _ := variant.MoveVariant()
+	buffer := bytes.NewBuffer(mystenbcs.ULEB128Encode(tag))
+	// the fields are encoded one by one, encoding the variant itself would call its MarshalBCS again
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		encoded, err := encodeEnumField(field.Tag.Get("move"), value.Field(i).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode field %s of %T: %w", field.Name, variant, err)
+		}
+		buffer.Write(encoded)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// encodeEnumField encodes a field of a variant from its Move type when it only involves types known to every
+// registry, e.g. addresses given as strings or u256 as *big.Int, and as is otherwise, e.g. nested structs and enums.
+func encodeEnumField(moveType string, value any) ([]byte, error) {
+	if parsed, err := movebcs.ParseType(moveType); err == nil && len(moveTypes.Missing(parsed)) == 0 {
+		return moveTypes.Encode(parsed, value)
+	}
+
+	return mystenbcs.Marshal(value)
+}
+
+// MarshalEnumVariantJSON returns the JSON of a variant of a Move enum as the chain reader represents enums,
+// {"variant": name, "fields": {...}}. Fields are named after their json tag, the name of the Move field.
+func MarshalEnumVariantJSON(variant EnumVariant) ([]byte, error) {
+	value := reflect.Indirect(reflect.ValueOf(variant))
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("enum variant must be a struct, got %T", variant)
+	}
+
+	fields := make(map[string]any, value.NumField())
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = movebcs.ToJSON(value.Field(i).Interface())
+	}
+	_, name := variant.MoveVariant()
+
+	return json.Marshal(map[string]any{
+		"variant": name,
+		"fields":  fields,
+	})
+}
@@ -0,0 +1,380 @@
+package bind
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+	"github.com/mr-tron/base58"
+
+	bindutils "github.com/smartcontractkit/chainlink-sui/bindings/utils"
+)
+
+const (
+	// DefaultEventPageSize is the number of events queried per page, the maximum of suix_queryEvents.
+	DefaultEventPageSize = 50
+	// DefaultEventPollInterval is the interval at which watched events are polled.
+	DefaultEventPollInterval = 2 * time.Second
+
+	// BcsEncodingBase64 and BcsEncodingBase58 are the values of the bcsEncoding field of events.
+	BcsEncodingBase64 = "base64"
+	BcsEncodingBase58 = "base58"
+)
+
+// SuiEvent is an event as returned by suix_queryEvents. Unlike models.SuiEventResponse, it keeps the encoding of
+// its BCS.
+type SuiEvent struct {
+	models.SuiEventResponse
+	// BcsEncoding is the encoding of Bcs. Nodes predating the field encode it as base58.
+	BcsEncoding string `json:"bcsEncoding"`
+}
+
+// DecodeBCS decodes the BCS of the event with the encoding reported by the node.
+func (e SuiEvent) DecodeBCS() ([]byte, error) {
+	switch e.BcsEncoding {
+	case BcsEncodingBase64:
+		return base64.StdEncoding.DecodeString(e.Bcs)
+	case BcsEncodingBase58, "":
+		return base58.Decode(e.Bcs)
+	default:
+		return nil, fmt.Errorf("unknown event BCS encoding %q", e.BcsEncoding)
+	}
+}
+
+// EventsPage is a page of events returned by suix_queryEvents.
+type EventsPage struct {
+	Data        []SuiEvent     `json:"data"`
+	NextCursor  models.EventId `json:"nextCursor"`
+	HasNextPage bool           `json:"hasNextPage"`
+}
+
+// QuerySuiEvents queries a page of events with suix_queryEvents. The SDK's SuiXQueryEvents is not used as its model
+// of events drops their BCS encoding.
+func QuerySuiEvents(ctx context.Context, api sui.ISuiAPI, request models.SuiXQueryEventsRequest) (EventsPage, error) {
+	response, err := api.SuiCall(ctx, "suix_queryEvents", request.SuiEventFilter, request.Cursor, request.Limit, request.DescendingOrder)
+	if err != nil {
+		return EventsPage{}, err
+	}
+
+	rawResponse, ok := response.(string)
+	if !ok {
+		return EventsPage{}, fmt.Errorf("unexpected events response type %T", response)
+	}
+
+	var page struct {
+		Result EventsPage `json:"result"`
+	}
+	if err := json.Unmarshal([]byte(rawResponse), &page); err != nil {
+		return EventsPage{}, fmt.Errorf("failed to parse events response: %w", err)
+	}
+
+	return page.Result, nil
+}
+
+// EventDecoder decodes the BCS of an event into its Go struct.
+type EventDecoder[T any] func(data []byte) (T, error)
+
+// Event is a decoded Move event with its metadata.
+type Event[T any] struct {
+	Data        T
+	ID          models.EventId
+	Sender      string
+	TimestampMs string
+}
+
+// EventPage is a page of decoded events. NextCursor resumes the query after the last event of the page.
+type EventPage[T any] struct {
+	Events      []*Event[T]
+	NextCursor  *models.EventId
+	HasNextPage bool
+}
+
+// WatchOpts configures a watch of events.
+type WatchOpts struct {
+	// Start is the cursor to watch events after, events emitted after the watch started are delivered when nil
+	Start *models.EventId
+	// PollInterval is the interval at which events are polled, DefaultEventPollInterval when zero
+	PollInterval time.Duration
+}
+
+// EventSubscription is a running watch of events, stopped by Unsubscribe or by cancelling its context.
+type EventSubscription struct {
+	cancel context.CancelFunc
+	errs   chan error
+	done   chan struct{}
+}
+
+// Unsubscribe stops the watch and waits for it to return.
+func (s *EventSubscription) Unsubscribe() {
+	s.cancel()
+	<-s.done
+}
+
+// Err returns a channel receiving the error that stopped the watch, closed when the watch returns.
+func (s *EventSubscription) Err() <-chan error {
+	return s.errs
+}
+
+// EventType returns the Move type of an event struct of the bound module. Types keep the ID of the package version
+// which first defined them, so the package of the type is read from the type origin table of the bound package,
+// which differs from the bound package once it has been upgraded.
+func (c *BoundContract) EventType(ctx context.Context, eventName string) (string, error) {
+	origins, err := c.loadTypeOrigins(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	packageID, ok := origins[c.moduleName+"::"+eventName]
+	if !ok {
+		return "", fmt.Errorf("event %s::%s not defined by package %s", c.moduleName, eventName, c.packageID)
+	}
+
+	return fmt.Sprintf("%s::%s::%s", packageID, c.moduleName, eventName), nil
+}
+
+// loadTypeOrigins returns the type origin table of the bound package, read with sui_getObject on first use.
+func (c *BoundContract) loadTypeOrigins(ctx context.Context) (map[string]string, error) {
+	c.typeOriginsMu.Lock()
+	defer c.typeOriginsMu.Unlock()
+
+	if c.typeOrigins != nil {
+		return c.typeOrigins, nil
+	}
+
+	origins, err := GetTypeOrigins(ctx, c.client, c.packageID)
+	if err != nil {
+		return nil, err
+	}
+	c.typeOrigins = origins
+
+	return origins, nil
+}
+
+// GetTypeOrigins returns the type origin table of a package, mapping its datatypes, as module::name, to the ID of
+// the package which first defined them. The SDK's SuiGetObject is not used as its model of packages drops the table.
+func GetTypeOrigins(ctx context.Context, api sui.ISuiAPI, packageID string) (map[string]string, error) {
+	response, err := api.SuiCall(ctx, "sui_getObject", packageID, models.SuiObjectDataOptions{ShowBcs: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get package %s: %w", packageID, err)
+	}
+
+	rawResponse, ok := response.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected package response type %T", response)
+	}
+
+	var object struct {
+		Result struct {
+			Data *struct {
+				Bcs *struct {
+					DataType        string `json:"dataType"`
+					TypeOriginTable []struct {
+						ModuleName   string `json:"module_name"`
+						DatatypeName string `json:"datatype_name"`
+						// StructName is the name of datatype_name in nodes predating enums
+						StructName string `json:"struct_name"`
+						Package    string `json:"package"`
+					} `json:"typeOriginTable"`
+				} `json:"bcs"`
+			} `json:"data"`
+			Error *models.SuiObjectResponseError `json:"error"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal([]byte(rawResponse), &object); err != nil {
+		return nil, fmt.Errorf("failed to parse package response: %w", err)
+	}
+	if object.Result.Error != nil {
+		return nil, fmt.Errorf("failed to get package %s: %s", packageID, object.Result.Error.Code)
+	}
+	if object.Result.Data == nil || object.Result.Data.Bcs == nil || object.Result.Data.Bcs.DataType != "package" {
+		return nil, fmt.Errorf("object %s is not a package", packageID)
+	}
+
+	origins := make(map[string]string, len(object.Result.Data.Bcs.TypeOriginTable))
+	for _, origin := range object.Result.Data.Bcs.TypeOriginTable {
+		name := origin.DatatypeName
+		if name == "" {
+			name = origin.StructName
+		}
+		originID, err := bindutils.ConvertAddressToString(origin.Package)
+		if err != nil {
+			return nil, fmt.Errorf("invalid origin package of %s::%s: %w", origin.ModuleName, name, err)
+		}
+		origins[origin.ModuleName+"::"+name] = originID
+	}
+
+	return origins, nil
+}
+
+// QueryEvents returns a page of the events of the given struct of the bound module emitted after cursor, oldest
+// first. A nil cursor starts from the first event and a zero limit uses DefaultEventPageSize.
+func (c *BoundContract) QueryEvents(ctx context.Context, eventName string, cursor *models.EventId, limit uint64) (EventsPage, error) {
+	if limit == 0 {
+		limit = DefaultEventPageSize
+	}
+
+	eventType, err := c.EventType(ctx, eventName)
+	if err != nil {
+		return EventsPage{}, err
+	}
+
+	request := models.SuiXQueryEventsRequest{
+		SuiEventFilter: models.EventFilterByMoveEventType{MoveEventType: eventType},
+		Limit:          limit,
+	}
+	if cursor != nil {
+		request.Cursor = cursor
+	}
+
+	response, err := QuerySuiEvents(ctx, c.client, request)
+	if err != nil {
+		return EventsPage{}, fmt.Errorf("failed to query %s events: %w", eventName, err)
+	}
+
+	return response, nil
+}
+
+// FilterEvents returns a page of the events of the given struct of the bound contract emitted after cursor, decoded
+// with decode. Generated bindings expose it as Filter<Event> for each event of a module.
+func FilterEvents[T any](ctx context.Context, c *BoundContract, eventName string, cursor *models.EventId, limit uint64, decode EventDecoder[T]) (*EventPage[T], error) {
+	response, err := c.QueryEvents(ctx, eventName, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &EventPage[T]{
+		Events:      make([]*Event[T], 0, len(response.Data)),
+		HasNextPage: response.HasNextPage,
+	}
+	for _, rawEvent := range response.Data {
+		event, err := decodeEvent(rawEvent, decode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s event %s:%s: %w", eventName, rawEvent.Id.TxDigest, rawEvent.Id.EventSeq, err)
+		}
+		page.Events = append(page.Events, event)
+	}
+
+	switch {
+	case response.NextCursor.TxDigest != "":
+		nextCursor := response.NextCursor
+		page.NextCursor = &nextCursor
+	case len(page.Events) > 0:
+		page.NextCursor = &page.Events[len(page.Events)-1].ID
+	default:
+		page.NextCursor = cursor
+	}
+
+	return page, nil
+}
+
+// WatchEvents polls the events of the given struct of the bound contract and sends them, decoded with decode, to ch
+// in emission order until the subscription is stopped. Generated bindings expose it as Watch<Event> for each event
+// of a module. A failed query or decoding stops the watch, its error being sent on the Err channel.
+func WatchEvents[T any](ctx context.Context, c *BoundContract, eventName string, opts *WatchOpts, ch chan<- *Event[T], decode EventDecoder[T]) (*EventSubscription, error) {
+	if opts == nil {
+		opts = &WatchOpts{}
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = DefaultEventPollInterval
+	}
+
+	cursor := opts.Start
+	if cursor == nil {
+		latest, err := c.latestEvent(ctx, eventName)
+		if err != nil {
+			return nil, err
+		}
+		cursor = latest
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	sub := &EventSubscription{cancel: cancel, errs: make(chan error, 1), done: make(chan struct{})}
+
+	go func() {
+		defer close(sub.done)
+		defer close(sub.errs)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			// drain the available pages before waiting for the next poll
+			for {
+				page, err := FilterEvents(ctx, c, eventName, cursor, DefaultEventPageSize, decode)
+				if err != nil {
+					if !errors.Is(ctx.Err(), context.Canceled) {
+						sub.errs <- err
+					}
+
+					return
+				}
+				for _, event := range page.Events {
+					select {
+					case ch <- event:
+					case <-ctx.Done():
+						return
+					}
+				}
+				cursor = page.NextCursor
+				if !page.HasNextPage {
+					break
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return sub, nil
+}
+
+// latestEvent returns the ID of the latest event of the given struct of the bound module, nil if there is none.
+func (c *BoundContract) latestEvent(ctx context.Context, eventName string) (*models.EventId, error) {
+	eventType, err := c.EventType(ctx, eventName)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := QuerySuiEvents(ctx, c.client, models.SuiXQueryEventsRequest{
+		SuiEventFilter:  models.EventFilterByMoveEventType{MoveEventType: eventType},
+		Limit:           1,
+		DescendingOrder: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the latest %s event: %w", eventName, err)
+	}
+	if len(response.Data) == 0 {
+		return nil, nil
+	}
+
+	return &response.Data[0].Id, nil
+}
+
+func decodeEvent[T any](rawEvent SuiEvent, decode EventDecoder[T]) (*Event[T], error) {
+	data, err := rawEvent.DecodeBCS()
+	if err != nil {
+		return nil, fmt.Errorf("invalid event BCS: %w", err)
+	}
+
+	decoded, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event[T]{
+		Data:        decoded,
+		ID:          rawEvent.Id,
+		Sender:      rawEvent.Sender,
+		TimestampMs: rawEvent.TimestampMs,
+	}, nil
+}
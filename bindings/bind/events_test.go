@@ -0,0 +1,222 @@
+package bind
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/sui"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEvent struct {
+	Value uint64
+}
+
+func decodeTestEvent(data []byte) (testEvent, error) {
+	var event testEvent
+	_, err := mystenbcs.Unmarshal(data, &event)
+
+	return event, err
+}
+
+// fakeEventsAPI serves the events appended to it from suix_queryEvents, paginated by event sequence, and a package
+// whose events were first defined by originID from sui_getObject.
+type fakeEventsAPI struct {
+	sui.ISuiAPI
+	mu             sync.Mutex
+	originID       string
+	events         []SuiEvent
+	requests       []models.SuiXQueryEventsRequest
+	packageQueries int
+}
+
+// emit appends an event with its BCS in the given encoding, base58 for nodes not reporting it.
+func (f *fakeEventsAPI) emit(t *testing.T, value uint64, bcsEncoding string) {
+	t.Helper()
+	data, err := mystenbcs.Marshal(testEvent{Value: value})
+	require.NoError(t, err)
+
+	encoded := base64.StdEncoding.EncodeToString(data)
+	if bcsEncoding != BcsEncodingBase64 {
+		encoded = base58.Encode(data)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, SuiEvent{
+		SuiEventResponse: models.SuiEventResponse{
+			Id:  models.EventId{TxDigest: fmt.Sprintf("digest-%d", len(f.events)), EventSeq: fmt.Sprint(len(f.events))},
+			Bcs: encoded,
+		},
+		BcsEncoding: bcsEncoding,
+	})
+}
+
+func (f *fakeEventsAPI) SuiCall(_ context.Context, method string, params ...interface{}) (interface{}, error) {
+	if method == "sui_getObject" {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.packageQueries++
+
+		response, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "result": map[string]any{"data": map[string]any{
+			"objectId": params[0],
+			"bcs": map[string]any{"dataType": "package", "typeOriginTable": []map[string]string{
+				{"module_name": "counter", "datatype_name": "CounterIncremented", "package": f.originID},
+				// nodes predating enums name the datatype struct_name
+				{"module_name": "counter", "struct_name": "CounterDecremented", "package": f.originID},
+			}},
+		}}})
+
+		return string(response), err
+	}
+	if method != "suix_queryEvents" {
+		return nil, fmt.Errorf("unexpected method %s", method)
+	}
+	req := models.SuiXQueryEventsRequest{SuiEventFilter: params[0], Cursor: params[1]}
+	req.Limit, _ = params[2].(uint64)
+	req.DescendingOrder, _ = params[3].(bool)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, req)
+
+	page := EventsPage{}
+	switch {
+	case req.DescendingOrder:
+		if len(f.events) > 0 {
+			page.Data = f.events[len(f.events)-1:]
+		}
+	default:
+		start := 0
+		if cursor, ok := req.Cursor.(*models.EventId); ok && cursor != nil {
+			if _, err := fmt.Sscan(cursor.EventSeq, &start); err != nil {
+				return nil, err
+			}
+			start++
+		}
+		end := min(len(f.events), start+int(req.Limit))
+		if start < end {
+			page = EventsPage{Data: f.events[start:end], NextCursor: f.events[end-1].Id, HasNextPage: end < len(f.events)}
+		}
+	}
+
+	response, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "result": page})
+
+	return string(response), err
+}
+
+func TestSuiEventDecodeBCS(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{1, 2, 3}
+	for _, tt := range []struct {
+		encoding string
+		bcs      string
+	}{
+		{BcsEncodingBase64, base64.StdEncoding.EncodeToString(data)},
+		{BcsEncodingBase58, base58.Encode(data)},
+		// nodes predating the bcsEncoding field encode the BCS as base58
+		{"", base58.Encode(data)},
+	} {
+		event := SuiEvent{SuiEventResponse: models.SuiEventResponse{Bcs: tt.bcs}, BcsEncoding: tt.encoding}
+		decoded, err := event.DecodeBCS()
+		require.NoError(t, err)
+		assert.Equal(t, data, decoded)
+	}
+
+	// base58 strings which are valid base64 are not mistaken for it
+	ambiguous := []byte{7, 7, 7, 7, 7, 7}
+	_, err := base64.StdEncoding.DecodeString(base58.Encode(ambiguous))
+	require.NoError(t, err)
+	decoded, err := SuiEvent{SuiEventResponse: models.SuiEventResponse{Bcs: base58.Encode(ambiguous)}, BcsEncoding: BcsEncodingBase58}.DecodeBCS()
+	require.NoError(t, err)
+	assert.Equal(t, ambiguous, decoded)
+
+	_, err = SuiEvent{SuiEventResponse: models.SuiEventResponse{Bcs: "AA=="}, BcsEncoding: "hex"}.DecodeBCS()
+	require.ErrorContains(t, err, "unknown event BCS encoding")
+}
+
+func TestFilterEvents(t *testing.T) {
+	t.Parallel()
+
+	// the bound package is an upgrade of the package defining the event
+	api := &fakeEventsAPI{originID: "0x1234"}
+	contract, err := NewBoundContract("0x5678", "test", "counter", api)
+	require.NoError(t, err)
+
+	api.emit(t, 1, BcsEncodingBase64)
+	api.emit(t, 2, "")
+	api.emit(t, 3, BcsEncodingBase58)
+
+	page, err := FilterEvents(context.Background(), contract, "CounterIncremented", nil, 2, decodeTestEvent)
+	require.NoError(t, err)
+	require.Len(t, page.Events, 2)
+	assert.Equal(t, uint64(1), page.Events[0].Data.Value)
+	assert.Equal(t, uint64(2), page.Events[1].Data.Value)
+	assert.True(t, page.HasNextPage)
+
+	filter, ok := api.requests[0].SuiEventFilter.(models.EventFilterByMoveEventType)
+	require.True(t, ok)
+	assert.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000001234::counter::CounterIncremented", filter.MoveEventType)
+
+	page, err = FilterEvents(context.Background(), contract, "CounterIncremented", page.NextCursor, 2, decodeTestEvent)
+	require.NoError(t, err)
+	require.Len(t, page.Events, 1)
+	assert.Equal(t, uint64(3), page.Events[0].Data.Value)
+	assert.False(t, page.HasNextPage)
+
+	// an empty page keeps the cursor
+	cursor := page.NextCursor
+	page, err = FilterEvents(context.Background(), contract, "CounterIncremented", cursor, 2, decodeTestEvent)
+	require.NoError(t, err)
+	assert.Empty(t, page.Events)
+	assert.Equal(t, cursor, page.NextCursor)
+	// the type origin table is read once
+	assert.Equal(t, 1, api.packageQueries)
+
+	eventType, err := contract.EventType(context.Background(), "CounterDecremented")
+	require.NoError(t, err)
+	assert.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000001234::counter::CounterDecremented", eventType)
+
+	_, err = FilterEvents(context.Background(), contract, "CounterReset", nil, 2, decodeTestEvent)
+	require.ErrorContains(t, err, "event counter::CounterReset not defined by package")
+}
+
+func TestWatchEvents(t *testing.T) {
+	t.Parallel()
+
+	api := &fakeEventsAPI{originID: "0x1234"}
+	contract, err := NewBoundContract("0x1234", "test", "counter", api)
+	require.NoError(t, err)
+
+	// events emitted before the watch starts are not delivered
+	api.emit(t, 1, BcsEncodingBase64)
+
+	ch := make(chan *Event[testEvent])
+	sub, err := WatchEvents(context.Background(), contract, "CounterIncremented", &WatchOpts{PollInterval: 10 * time.Millisecond}, ch, decodeTestEvent)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	api.emit(t, 2, BcsEncodingBase64)
+	api.emit(t, 3, BcsEncodingBase64)
+
+	for _, expected := range []uint64{2, 3} {
+		select {
+		case event := <-ch:
+			assert.Equal(t, expected, event.Data.Value)
+		case err := <-sub.Err():
+			t.Fatalf("watch failed: %v", err)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("event %d not delivered", expected)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/block-vision/sui-go-sdk/models"
 	"github.com/block-vision/sui-go-sdk/sui"
@@ -11,6 +12,54 @@ import (
 	bindutils "github.com/smartcontractkit/chainlink-sui/bindings/utils"
 )
 
+const (
+	// MaxGasBudget is the maximum gas budget of a Sui transaction
+	MaxGasBudget uint64 = 50_000_000_000
+	// gasSafeOverhead is the number of computation units added to estimated gas budgets, as in the Sui SDKs,
+	// absorbing the variations of the cost between the dry run and the execution
+	gasSafeOverhead uint64 = 1000
+)
+
+// EstimateGasBudget dry runs the BCS encoded transaction and returns a gas budget covering its computation and
+// storage costs net of the storage rebate, with a safety overhead. The transaction must have its gas data set,
+// with a budget large enough for the dry run to succeed.
+func EstimateGasBudget(ctx context.Context, client sui.ISuiAPI, txBytes []byte, gasPrice uint64) (uint64, error) {
+	response, err := client.SuiDryRunTransactionBlock(ctx, models.SuiDryRunTransactionBlockRequest{
+		TxBytes: bindutils.EncodeBase64(txBytes),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to dry run transaction: %w", err)
+	}
+	if response.Effects.Status.Status != "success" {
+		return 0, fmt.Errorf("dry run of transaction failed: %s", response.Effects.Status.Error)
+	}
+
+	return gasBudgetFromCosts(response.Effects.GasUsed, gasPrice)
+}
+
+func gasBudgetFromCosts(gasUsed models.GasCostSummary, gasPrice uint64) (uint64, error) {
+	computationCost, err := strconv.ParseUint(gasUsed.ComputationCost, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse computation cost: %w", err)
+	}
+	storageCost, err := strconv.ParseUint(gasUsed.StorageCost, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse storage cost: %w", err)
+	}
+	storageRebate, err := strconv.ParseUint(gasUsed.StorageRebate, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse storage rebate: %w", err)
+	}
+
+	// the budget covers the computation even when the storage rebate exceeds the storage cost
+	budget := computationCost + gasSafeOverhead*gasPrice
+	if storageCost > storageRebate {
+		budget += storageCost - storageRebate
+	}
+
+	return budget, nil
+}
+
 // Fetches every coin owned by the address. Looks for a SUI object, returns the first it finds
 func FetchDefaultGasCoinRef(ctx context.Context, client sui.ISuiAPI, address string) (*models.SuiObjectRef, error) {
 	suiCoins, err := fetchOwnedSuiCoins(ctx, client, address)
@@ -46,6 +95,50 @@ func ToSuiObjectRef(ctx context.Context, client sui.ISuiAPI, objectId string, ad
 }
 
 func fetchOwnedSuiCoins(ctx context.Context, client sui.ISuiAPI, address string) ([]*models.SuiObjectRef, error) {
+	coins, err := fetchOwnedSuiCoinData(ctx, client, address)
+	if err != nil {
+		return nil, err
+	}
+
+	coinRefs := make([]*models.SuiObjectRef, 0, len(coins))
+	for _, coinData := range coins {
+		version, err := parseVersionString(coinData.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse coin version: %w", err)
+		}
+
+		coinRefs = append(coinRefs, &models.SuiObjectRef{
+			ObjectId: coinData.CoinObjectId,
+			Version:  version,
+			Digest:   coinData.Digest,
+		})
+	}
+
+	return coinRefs, nil
+}
+
+// fetchSuiCoinBalance returns the balance in MIST of a SUI coin owned by the address.
+func fetchSuiCoinBalance(ctx context.Context, client sui.ISuiAPI, address string, coinId string) (uint64, error) {
+	normalizedCoinId, err := bindutils.ConvertAddressToString(coinId)
+	if err != nil {
+		return 0, fmt.Errorf("invalid coin ID %v: %w", coinId, err)
+	}
+
+	coins, err := fetchOwnedSuiCoinData(ctx, client, address)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, coinData := range coins {
+		if coinData.CoinObjectId == normalizedCoinId {
+			return strconv.ParseUint(coinData.Balance, 10, 64)
+		}
+	}
+
+	return 0, fmt.Errorf("SUI coin %s not found in coins owned by %s", coinId, address)
+}
+
+func fetchOwnedSuiCoinData(ctx context.Context, client sui.ISuiAPI, address string) ([]models.CoinData, error) {
 	// Normalize address
 	normalizedAddr, err := bindutils.ConvertAddressToString(address)
 	if err != nil {
@@ -68,24 +161,14 @@ func fetchOwnedSuiCoins(ctx context.Context, client sui.ISuiAPI, address string)
 		return nil, fmt.Errorf("no coin data found for signer: %s", address)
 	}
 
-	var coinRefs []*models.SuiObjectRef
+	var suiCoins []models.CoinData
 	for _, coinData := range coins.Data {
 		if isSuiCoin(coinData) {
-			version, err := parseVersionString(coinData.Version)
-			if err != nil {
-				return nil, fmt.Errorf("failed to parse coin version: %w", err)
-			}
-
-			coinRef := &models.SuiObjectRef{
-				ObjectId: coinData.CoinObjectId,
-				Version:  version,
-				Digest:   coinData.Digest,
-			}
-			coinRefs = append(coinRefs, coinRef)
+			suiCoins = append(suiCoins, coinData)
 		}
 	}
 
-	return coinRefs, nil
+	return suiCoins, nil
 }
 
 func isSuiCoin(coin models.CoinData) bool {
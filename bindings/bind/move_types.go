@@ -0,0 +1,61 @@
+package bind
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/block-vision/sui-go-sdk/transaction"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
+)
+
+// moveTypes encodes call arguments and decodes return values, holding the layouts of the structs and enums registered
+// by RegisterNormalizedModule besides the builtin ones.
+var moveTypes = movebcs.NewRegistry()
+
+// RegisterNormalizedModule registers the structs and enums of a normalized Move module, given as the raw JSON result
+// of sui_getNormalizedMoveModule, so that values of these types can be passed to and returned from calls.
+func RegisterNormalizedModule(normalizedModuleJSON []byte) error {
+	return moveTypes.AddNormalizedModuleJSON(normalizedModuleJSON)
+}
+
+// isOptionType reports whether moveType is an Option, the only type accepting a nil value.
+func isOptionType(moveType string) bool {
+	parsed, err := movebcs.ParseType(moveType)
+
+	return err == nil && parsed.Is("0x1", "option", "Option")
+}
+
+// isReceivingType reports whether moveType is a sui::transfer::Receiving, the argument receiving an object sent to
+// another object, whether its name is qualified or not as in Move source.
+func isReceivingType(moveType string) bool {
+	base := strings.TrimSpace(moveType)
+	if i := strings.Index(base, "<"); i != -1 {
+		base = base[:i]
+	}
+	switch base {
+	case "Receiving", "transfer::Receiving", "sui::transfer::Receiving":
+		return true
+	}
+	parsed, err := movebcs.ParseType(moveType)
+
+	return err == nil && parsed.Is("0x2", "transfer", "Receiving")
+}
+
+// convertMoveValueToCallArg encodes a value of a Move type as a pure CallArg.
+func convertMoveValueToCallArg(moveType movebcs.Type, value any) (*transaction.CallArg, error) {
+	if missing := moveTypes.Missing(moveType); len(missing) > 0 {
+		return nil, fmt.Errorf("unsupported type for CallArg: %s: no layout registered for %s", moveType, missing[0].String())
+	}
+
+	bcsBytes, err := moveTypes.Encode(moveType, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction.CallArg{
+		Pure: &transaction.Pure{
+			Bytes: bcsBytes,
+		},
+	}, nil
+}
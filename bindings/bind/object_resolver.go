@@ -1,12 +1,14 @@
 package bind
 
 import (
+	"container/list"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/block-vision/sui-go-sdk/models"
 	"github.com/block-vision/sui-go-sdk/sui"
@@ -15,14 +17,40 @@ import (
 	bindutils "github.com/smartcontractkit/chainlink-sui/bindings/utils"
 )
 
+const (
+	// DefaultObjectCacheSize bounds the number of objects cached by an ObjectResolver
+	DefaultObjectCacheSize = 4096
+	// DefaultSharedObjectTTL bounds how long the metadata of a shared object is cached without being re-read
+	DefaultSharedObjectTTL = 10 * time.Minute
+)
+
+// ObjectResolver resolves the object arguments of PTBs. Resolved objects are cached in a bounded LRU cache:
+// shared objects only for the TTL, owned objects until they are evicted or changed by a transaction whose
+// effects are passed to UpdateFromEffects, which keeps their versions and digests current.
+//
+// A nil *ObjectResolver ignores transaction effects.
 type ObjectResolver struct {
 	client sui.ISuiAPI
 	cache  *objectCache
+	// pinned holds the objects of an OfflineContext, which are neither fetched nor updated
+	pinned map[string]*resolvedObject
 }
 
 type objectCache struct {
-	mu    sync.RWMutex
-	cache map[string]*resolvedObject
+	size      int
+	sharedTTL time.Duration
+	now       func() time.Time // overridable for testing
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// recency orders the cached objects from the most to the least recently used
+	recency *list.List
+}
+
+type objectCacheEntry struct {
+	objectId string
+	object   *resolvedObject
+	cachedAt time.Time
 }
 
 type resolvedObject struct {
@@ -34,10 +62,27 @@ type resolvedObject struct {
 }
 
 func NewObjectResolver(client sui.ISuiAPI) *ObjectResolver {
+	return NewObjectResolverWithCache(client, DefaultObjectCacheSize, DefaultSharedObjectTTL)
+}
+
+// NewObjectResolverWithCache creates an ObjectResolver caching at most size objects, and shared objects for
+// sharedTTL. Non-positive values use DefaultObjectCacheSize and DefaultSharedObjectTTL.
+func NewObjectResolverWithCache(client sui.ISuiAPI, size int, sharedTTL time.Duration) *ObjectResolver {
+	if size <= 0 {
+		size = DefaultObjectCacheSize
+	}
+	if sharedTTL <= 0 {
+		sharedTTL = DefaultSharedObjectTTL
+	}
+
 	return &ObjectResolver{
 		client: client,
 		cache: &objectCache{
-			cache: make(map[string]*resolvedObject),
+			size:      size,
+			sharedTTL: sharedTTL,
+			now:       time.Now,
+			entries:   make(map[string]*list.Element),
+			recency:   list.New(),
 		},
 	}
 }
@@ -78,8 +123,12 @@ func (r *ObjectResolver) ResolveCallArg(ctx context.Context, arg *transaction.Ca
 			return nil, fmt.Errorf("failed to resolve UnresolvedObject %s: %w", objectId, err)
 		}
 
-		isMutable := strings.HasPrefix(typeName, "&mut ")
-		objectArg, err := r.createObjectArgWithMutability(resolved, isMutable)
+		var objectArg *transaction.ObjectArg
+		if isReceivingType(typeName) {
+			objectArg, err = r.createReceivingObjectArg(resolved)
+		} else {
+			objectArg, err = r.createObjectArgWithMutability(resolved, strings.HasPrefix(typeName, "&mut "))
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -97,9 +146,15 @@ func (r *ObjectResolver) ResolveCallArg(ctx context.Context, arg *transaction.Ca
 }
 
 func (r *ObjectResolver) resolveObject(ctx context.Context, objectId string) (*resolvedObject, error) {
+	if pinned, ok := r.pinned[objectId]; ok {
+		return pinned, nil
+	}
 	if cached := r.cache.get(objectId); cached != nil {
 		return cached, nil
 	}
+	if r.client == nil {
+		return nil, fmt.Errorf("object %s not found in offline context", objectId)
+	}
 	resp, err := r.client.SuiGetObject(ctx, models.SuiGetObjectRequest{
 		ObjectId: objectId,
 		Options: models.SuiObjectDataOptions{
@@ -132,28 +187,7 @@ func (r *ObjectResolver) resolveObject(ctx context.Context, objectId string) (*r
 	}
 
 	if resp.Data.Owner != nil {
-		// TODO: check this logic, use mapstructure if this is a map[string]any{}
-		ownerBytes, err := json.Marshal(resp.Data.Owner)
-		if err == nil {
-			var owner models.ObjectOwner
-			if err := json.Unmarshal(ownerBytes, &owner); err == nil {
-				resolved.Owner = owner
-
-				if owner.AddressOwner != "" {
-					resolved.InitialSharedVersion = nil
-				} else if owner.ObjectOwner != "" {
-					resolved.InitialSharedVersion = nil
-				} else if owner.Shared.InitialSharedVersion > 0 {
-					v := owner.Shared.InitialSharedVersion
-					resolved.InitialSharedVersion = &v
-				}
-			} else {
-				var immutableOwner string
-				if err := json.Unmarshal(ownerBytes, &immutableOwner); err == nil && immutableOwner == "Immutable" {
-					resolved.Owner = models.ObjectOwner{}
-				}
-			}
-		}
+		resolved.Owner, resolved.InitialSharedVersion = parseObjectOwner(resp.Data.Owner)
 	}
 
 	r.cache.set(objectId, resolved)
@@ -161,6 +195,83 @@ func (r *ObjectResolver) resolveObject(ctx context.Context, objectId string) (*r
 	return resolved, nil
 }
 
+// parseObjectOwner returns the owner of an object as returned by the RPC, and its initial shared version if it
+// is shared. Immutable objects have no owner.
+func parseObjectOwner(owner any) (models.ObjectOwner, *uint64) {
+	// TODO: check this logic, use mapstructure if this is a map[string]any{}
+	ownerBytes, err := json.Marshal(owner)
+	if err != nil {
+		return models.ObjectOwner{}, nil
+	}
+
+	var objectOwner models.ObjectOwner
+	if err := json.Unmarshal(ownerBytes, &objectOwner); err != nil {
+		// "Immutable" objects, or owners unknown to the SDK
+		return models.ObjectOwner{}, nil
+	}
+	if objectOwner.AddressOwner == "" && objectOwner.ObjectOwner == "" && objectOwner.Shared.InitialSharedVersion > 0 {
+		v := objectOwner.Shared.InitialSharedVersion
+		return objectOwner, &v
+	}
+
+	return objectOwner, nil
+}
+
+// UpdateFromTransaction updates the cached objects with the effects of an executed transaction.
+func (r *ObjectResolver) UpdateFromTransaction(tx *SuiTransactionBlockResponse) {
+	if tx == nil {
+		return
+	}
+	r.UpdateFromEffects(tx.Effects)
+}
+
+// UpdateFromEffects updates the cached objects with the effects of an executed transaction, successful or not:
+// created, mutated and unwrapped objects are cached with their new version, digest and owner, and deleted, wrapped
+// and unwrapped then deleted objects are dropped. Effects of transactions older than the cached version of an
+// object are ignored for that object. Effects of dry runs must not be passed, as their changes aren't committed.
+func (r *ObjectResolver) UpdateFromEffects(effects SuiEffects) {
+	if r == nil {
+		return
+	}
+
+	changed := make([]models.OwnedObjectRef, 0, len(effects.Created)+len(effects.Mutated)+len(effects.Unwrapped)+1)
+	changed = append(changed, effects.Created...)
+	changed = append(changed, effects.Mutated...)
+	changed = append(changed, effects.Unwrapped...)
+	if effects.GasObject.Reference.ObjectId != "" {
+		changed = append(changed, effects.GasObject)
+	}
+
+	for _, ref := range changed {
+		objectId, err := bindutils.ConvertAddressToString(ref.Reference.ObjectId)
+		if err != nil {
+			continue
+		}
+		resolved := &resolvedObject{
+			ObjectId: objectId,
+			Version:  ref.Reference.Version,
+			Digest:   ref.Reference.Digest,
+		}
+		if ref.Owner != nil {
+			resolved.Owner, resolved.InitialSharedVersion = parseObjectOwner(ref.Owner)
+		}
+		r.cache.update(objectId, resolved)
+	}
+
+	// wrapped objects are no longer objects of their own, until they are unwrapped with a new version
+	removed := make([]models.SuiObjectRef, 0, len(effects.Deleted)+len(effects.Wrapped)+len(effects.UnwrappedThenDeleted))
+	removed = append(removed, effects.Deleted...)
+	removed = append(removed, effects.Wrapped...)
+	removed = append(removed, effects.UnwrappedThenDeleted...)
+	for _, ref := range removed {
+		objectId, err := bindutils.ConvertAddressToString(ref.ObjectId)
+		if err != nil {
+			continue
+		}
+		r.cache.remove(objectId)
+	}
+}
+
 func (r *ObjectResolver) createObjectFromResolved(resolved *resolvedObject) *Object {
 	return &Object{
 		Id:                   resolved.ObjectId,
@@ -168,7 +279,13 @@ func (r *ObjectResolver) createObjectFromResolved(resolved *resolvedObject) *Obj
 	}
 }
 
-func (r *ObjectResolver) createObjectArgWithMutability(resolved *resolvedObject, isMutable bool) (*transaction.ObjectArg, error) {
+// createReceivingObjectArg returns the Receiving argument of an object sent to another object, which must be
+// owned by that object.
+func (r *ObjectResolver) createReceivingObjectArg(resolved *resolvedObject) (*transaction.ObjectArg, error) {
+	if resolved.InitialSharedVersion != nil {
+		return nil, fmt.Errorf("shared object %s can't be received", resolved.ObjectId)
+	}
+
 	objIdBytes, err := transaction.ConvertSuiAddressStringToBytes(models.SuiAddress(resolved.ObjectId))
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert object ID to bytes: %w", err)
@@ -179,6 +296,21 @@ func (r *ObjectResolver) createObjectArgWithMutability(resolved *resolvedObject,
 		return nil, fmt.Errorf("failed to convert digest to bytes: %w", err)
 	}
 
+	return &transaction.ObjectArg{
+		Receiving: &transaction.SuiObjectRef{
+			ObjectId: *objIdBytes,
+			Version:  resolved.Version,
+			Digest:   *digestBytes,
+		},
+	}, nil
+}
+
+func (r *ObjectResolver) createObjectArgWithMutability(resolved *resolvedObject, isMutable bool) (*transaction.ObjectArg, error) {
+	objIdBytes, err := transaction.ConvertSuiAddressStringToBytes(models.SuiAddress(resolved.ObjectId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert object ID to bytes: %w", err)
+	}
+
 	if resolved.Owner.Shared.InitialSharedVersion > 0 {
 		if resolved.InitialSharedVersion == nil {
 			return nil, fmt.Errorf("shared object %s missing initial shared version", resolved.ObjectId)
@@ -193,6 +325,11 @@ func (r *ObjectResolver) createObjectArgWithMutability(resolved *resolvedObject,
 		}, nil
 	}
 
+	digestBytes, err := bindutils.ConvertStringToDigestBytes(resolved.Digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert digest to bytes: %w", err)
+	}
+
 	if resolved.Owner.AddressOwner != "" {
 		return &transaction.ObjectArg{
 			ImmOrOwnedObject: &transaction.SuiObjectRef{
@@ -222,21 +359,75 @@ func (r *ObjectResolver) createObjectArgWithMutability(resolved *resolvedObject,
 	}, nil
 }
 
+// get returns the cached object, or nil if it isn't cached or is a shared object cached for longer than the TTL.
 func (c *objectCache) get(objectId string) *resolvedObject {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-	return c.cache[objectId]
+	element, ok := c.entries[objectId]
+	if !ok {
+		return nil
+	}
+	entry := element.Value.(*objectCacheEntry)
+	if entry.object.InitialSharedVersion != nil && c.now().Sub(entry.cachedAt) >= c.sharedTTL {
+		c.removeElement(objectId, element)
+		return nil
+	}
+	c.recency.MoveToFront(element)
+
+	return entry.object
 }
 
 func (c *objectCache) set(objectId string, resolved *resolvedObject) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	c.cache[objectId] = resolved
+	c.setLocked(objectId, resolved)
+}
+
+// update caches the object unless a later version of it is already cached.
+func (c *objectCache) update(objectId string, resolved *resolvedObject) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[objectId]; ok && element.Value.(*objectCacheEntry).object.Version > resolved.Version {
+		return
+	}
+	c.setLocked(objectId, resolved)
+}
+
+func (c *objectCache) setLocked(objectId string, resolved *resolvedObject) {
+	entry := &objectCacheEntry{objectId: objectId, object: resolved, cachedAt: c.now()}
+	if element, ok := c.entries[objectId]; ok {
+		element.Value = entry
+		c.recency.MoveToFront(element)
+
+		return
+	}
+
+	c.entries[objectId] = c.recency.PushFront(entry)
+	for c.recency.Len() > c.size {
+		oldest := c.recency.Back()
+		c.removeElement(oldest.Value.(*objectCacheEntry).objectId, oldest)
+	}
+}
+
+func (c *objectCache) remove(objectId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[objectId]; ok {
+		c.removeElement(objectId, element)
+	}
+}
+
+func (c *objectCache) removeElement(objectId string, element *list.Element) {
+	c.recency.Remove(element)
+	delete(c.entries, objectId)
 }
 
 func (r *ObjectResolver) ClearCache() {
 	r.cache.mu.Lock()
 	defer r.cache.mu.Unlock()
-	r.cache.cache = make(map[string]*resolvedObject)
+	r.cache.entries = make(map[string]*list.Element)
+	r.cache.recency.Init()
 }
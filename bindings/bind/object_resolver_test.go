@@ -0,0 +1,285 @@
+package bind
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const resolverTestOwner = "0x00000000000000000000000000000000000000000000000000000000000000a1"
+
+func resolverTestObjectId(i byte) string {
+	return fmt.Sprintf("0x%064x", i)
+}
+
+func resolverTestDigest(i byte) string {
+	return base58.Encode(bytes.Repeat([]byte{i}, 32))
+}
+
+// fakeObjectsAPI serves the objects set on it from SuiGetObject and counts the requests per object.
+type fakeObjectsAPI struct {
+	sui.ISuiAPI
+	mu       sync.Mutex
+	objects  map[string]models.SuiObjectData
+	requests map[string]int
+}
+
+func newFakeObjectsAPI() *fakeObjectsAPI {
+	return &fakeObjectsAPI{
+		objects:  map[string]models.SuiObjectData{},
+		requests: map[string]int{},
+	}
+}
+
+func (f *fakeObjectsAPI) setOwned(objectId string, version uint64, digest string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.objects[objectId] = models.SuiObjectData{
+		ObjectId: objectId,
+		Version:  fmt.Sprint(version),
+		Digest:   digest,
+		Owner:    map[string]any{"AddressOwner": resolverTestOwner},
+	}
+}
+
+func (f *fakeObjectsAPI) setShared(objectId string, initialSharedVersion uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.objects[objectId] = models.SuiObjectData{
+		ObjectId: objectId,
+		Version:  fmt.Sprint(initialSharedVersion + 10),
+		Digest:   resolverTestDigest(0xee),
+		Owner:    map[string]any{"Shared": map[string]any{"initial_shared_version": initialSharedVersion}},
+	}
+}
+
+func (f *fakeObjectsAPI) requestCount(objectId string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.requests[objectId]
+}
+
+func (f *fakeObjectsAPI) SuiGetObject(_ context.Context, req models.SuiGetObjectRequest) (models.SuiObjectResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests[req.ObjectId]++
+
+	object, ok := f.objects[req.ObjectId]
+	if !ok {
+		return models.SuiObjectResponse{}, nil
+	}
+
+	return models.SuiObjectResponse{Data: &object}, nil
+}
+
+func resolveOwned(t *testing.T, resolver *ObjectResolver, objectId string) *transaction.SuiObjectRef {
+	t.Helper()
+
+	id, err := transaction.ConvertSuiAddressStringToBytes(models.SuiAddress(objectId))
+	require.NoError(t, err)
+	arg, err := resolver.ResolveCallArg(context.Background(), &transaction.CallArg{
+		UnresolvedObject: &transaction.UnresolvedObject{ObjectId: *id},
+	}, "&mut Coin<SUI>")
+	require.NoError(t, err)
+	require.NotNil(t, arg.Object)
+	require.NotNil(t, arg.Object.ImmOrOwnedObject)
+
+	return arg.Object.ImmOrOwnedObject
+}
+
+func ownedObjectRef(objectId string, version uint64, digest string) models.OwnedObjectRef {
+	return models.OwnedObjectRef{
+		Owner:     map[string]any{"AddressOwner": resolverTestOwner},
+		Reference: models.SuiObjectRef{ObjectId: objectId, Version: version, Digest: digest},
+	}
+}
+
+func TestObjectResolverUpdateFromEffects(t *testing.T) {
+	t.Parallel()
+
+	coin := resolverTestObjectId(1)
+	created := resolverTestObjectId(2)
+	client := newFakeObjectsAPI()
+	client.setOwned(coin, 5, resolverTestDigest(1))
+	resolver := NewObjectResolver(client)
+
+	ref := resolveOwned(t, resolver, coin)
+	assert.Equal(t, uint64(5), ref.Version)
+
+	resolver.UpdateFromTransaction(&SuiTransactionBlockResponse{
+		Effects: SuiEffects{SuiEffects: models.SuiEffects{
+			Mutated: []models.OwnedObjectRef{ownedObjectRef(coin, 8, resolverTestDigest(2))},
+			Created: []models.OwnedObjectRef{ownedObjectRef(created, 8, resolverTestDigest(3))},
+		}},
+	})
+
+	ref = resolveOwned(t, resolver, coin)
+	assert.Equal(t, uint64(8), ref.Version)
+	assert.Equal(t, resolverTestDigest(2), base58.Encode(ref.Digest[:]))
+	assert.Equal(t, 1, client.requestCount(coin))
+
+	ref = resolveOwned(t, resolver, created)
+	assert.Equal(t, uint64(8), ref.Version)
+	assert.Zero(t, client.requestCount(created))
+
+	// effects of an older transaction don't overwrite the cached version
+	resolver.UpdateFromEffects(SuiEffects{SuiEffects: models.SuiEffects{
+		Mutated: []models.OwnedObjectRef{ownedObjectRef(coin, 6, resolverTestDigest(4))},
+	}})
+	ref = resolveOwned(t, resolver, coin)
+	assert.Equal(t, uint64(8), ref.Version)
+
+	// deleted objects are read again
+	resolver.UpdateFromEffects(SuiEffects{SuiEffects: models.SuiEffects{
+		Deleted: []models.SuiObjectRef{{ObjectId: coin, Version: 9, Digest: resolverTestDigest(5)}},
+	}})
+	resolveOwned(t, resolver, coin)
+	assert.Equal(t, 2, client.requestCount(coin))
+
+	// wrapped objects are dropped, and cached again once unwrapped
+	wrapped := resolverTestObjectId(3)
+	client.setOwned(wrapped, 4, resolverTestDigest(6))
+	resolveOwned(t, resolver, wrapped)
+	resolver.UpdateFromEffects(SuiEffects{Wrapped: []models.SuiObjectRef{{ObjectId: wrapped, Version: 10, Digest: resolverTestDigest(7)}}})
+	resolveOwned(t, resolver, wrapped)
+	assert.Equal(t, 2, client.requestCount(wrapped))
+
+	resolver.UpdateFromEffects(SuiEffects{Unwrapped: []models.OwnedObjectRef{ownedObjectRef(wrapped, 12, resolverTestDigest(8))}})
+	ref = resolveOwned(t, resolver, wrapped)
+	assert.Equal(t, uint64(12), ref.Version)
+	assert.Equal(t, resolverTestDigest(8), base58.Encode(ref.Digest[:]))
+	assert.Equal(t, 2, client.requestCount(wrapped))
+
+	resolver.UpdateFromEffects(SuiEffects{UnwrappedThenDeleted: []models.SuiObjectRef{{ObjectId: wrapped, Version: 13}}})
+	resolveOwned(t, resolver, wrapped)
+	assert.Equal(t, 3, client.requestCount(wrapped))
+
+	// a nil resolver ignores effects
+	var nilResolver *ObjectResolver
+	nilResolver.UpdateFromEffects(SuiEffects{SuiEffects: models.SuiEffects{Deleted: []models.SuiObjectRef{{ObjectId: coin}}}})
+}
+
+func TestSuiTransactionBlockResponseUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	var tx SuiTransactionBlockResponse
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"digest": "tx",
+		"effects": {
+			"status": {"status": "success"},
+			"mutated": [{"owner": {"AddressOwner": "0x1"}, "reference": {"objectId": "0xa", "version": 2, "digest": "d"}}],
+			"unwrapped": [{"owner": {"AddressOwner": "0x1"}, "reference": {"objectId": "0xb", "version": 2, "digest": "d"}}],
+			"wrapped": [{"objectId": "0xc", "version": 2, "digest": "d"}],
+			"unwrappedThenDeleted": [{"objectId": "0xd", "version": 2, "digest": "d"}]
+		}
+	}`), &tx))
+
+	assert.Equal(t, "tx", tx.Digest)
+	// the effects of the embedded response are set too
+	assert.Equal(t, "success", tx.SuiTransactionBlockResponse.Effects.Status.Status)
+	assert.Equal(t, tx.Effects.SuiEffects, tx.SuiTransactionBlockResponse.Effects)
+	require.Len(t, tx.Effects.Mutated, 1)
+	assert.Equal(t, []models.OwnedObjectRef{{
+		Owner:     map[string]any{"AddressOwner": "0x1"},
+		Reference: models.SuiObjectRef{ObjectId: "0xb", Version: 2, Digest: "d"},
+	}}, tx.Effects.Unwrapped)
+	assert.Equal(t, []models.SuiObjectRef{{ObjectId: "0xc", Version: 2, Digest: "d"}}, tx.Effects.Wrapped)
+	assert.Equal(t, []models.SuiObjectRef{{ObjectId: "0xd", Version: 2, Digest: "d"}}, tx.Effects.UnwrappedThenDeleted)
+}
+
+func TestObjectResolverSharedObjectTTL(t *testing.T) {
+	t.Parallel()
+
+	shared := resolverTestObjectId(1)
+	client := newFakeObjectsAPI()
+	client.setShared(shared, 3)
+	resolver := NewObjectResolverWithCache(client, 0, time.Minute)
+	now := time.Now()
+	resolver.cache.now = func() time.Time { return now }
+
+	object, err := resolver.GetSharedObject(context.Background(), shared)
+	require.NoError(t, err)
+	require.NotNil(t, object.InitialSharedVersion)
+	assert.Equal(t, uint64(3), *object.InitialSharedVersion)
+
+	now = now.Add(59 * time.Second)
+	_, err = resolver.GetSharedObject(context.Background(), shared)
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.requestCount(shared))
+
+	now = now.Add(time.Second)
+	_, err = resolver.GetSharedObject(context.Background(), shared)
+	require.NoError(t, err)
+	assert.Equal(t, 2, client.requestCount(shared))
+}
+
+func TestObjectResolverEvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	first, second, third := resolverTestObjectId(1), resolverTestObjectId(2), resolverTestObjectId(3)
+	client := newFakeObjectsAPI()
+	for i, objectId := range []string{first, second, third} {
+		client.setOwned(objectId, uint64(i+1), resolverTestDigest(byte(i+1)))
+	}
+	resolver := NewObjectResolverWithCache(client, 2, 0)
+
+	resolveOwned(t, resolver, first)
+	resolveOwned(t, resolver, second)
+	// using the first object makes the second one the least recently used
+	resolveOwned(t, resolver, first)
+	resolveOwned(t, resolver, third)
+
+	resolveOwned(t, resolver, first)
+	assert.Equal(t, 1, client.requestCount(first))
+	resolveOwned(t, resolver, second)
+	assert.Equal(t, 2, client.requestCount(second))
+}
+
+func TestObjectResolverReceivingArgs(t *testing.T) {
+	t.Parallel()
+
+	sent := resolverTestObjectId(1)
+	shared := resolverTestObjectId(2)
+	client := newFakeObjectsAPI()
+	client.setOwned(sent, 4, resolverTestDigest(1))
+	client.setShared(shared, 3)
+	resolver := NewObjectResolver(client)
+
+	for _, typeName := range []string{"Receiving<Counter>", "0x2::transfer::Receiving<0xa1::counter::Counter>"} {
+		arg, err := ConvertToCallArg(typeName, Object{Id: sent})
+		require.NoError(t, err)
+		require.NotNil(t, arg.UnresolvedObject, typeName)
+
+		resolved, err := resolver.ResolveCallArg(context.Background(), arg, typeName)
+		require.NoError(t, err)
+		require.NotNil(t, resolved.Object)
+		require.NotNil(t, resolved.Object.Receiving, typeName)
+		assert.Equal(t, uint64(4), resolved.Object.Receiving.Version)
+		assert.Equal(t, resolverTestDigest(1), base58.Encode(resolved.Object.Receiving.Digest[:]))
+	}
+
+	arg, err := ConvertToCallArg("Receiving<Counter>", sent)
+	require.NoError(t, err)
+	require.NotNil(t, arg.UnresolvedObject)
+
+	version := uint64(3)
+	_, err = ConvertToCallArg("Receiving<Counter>", Object{Id: shared, InitialSharedVersion: &version})
+	require.ErrorContains(t, err, "can't be received")
+
+	arg, err = ConvertToCallArg("Receiving<Counter>", Object{Id: shared})
+	require.NoError(t, err)
+	_, err = resolver.ResolveCallArg(context.Background(), arg, "Receiving<Counter>")
+	require.ErrorContains(t, err, "can't be received")
+}
@@ -0,0 +1,146 @@
+package bind
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/transaction"
+
+	bindutils "github.com/smartcontractkit/chainlink-sui/bindings/utils"
+)
+
+// ErrOfflineNetworkCall is returned by the calls which would make an RPC call with an OfflineContext in the
+// CallOpts, e.g. dev inspecting or resolving objects with an ObjectResolver backed by the RPC.
+var ErrOfflineNetworkCall = errors.New("network call with an offline context")
+
+// OfflineObject is the reference of an object used by an offline PTB: the version and digest of an owned,
+// immutable or received object, or the initial shared version of a shared object.
+type OfflineObject struct {
+	Id                   string
+	Version              uint64
+	Digest               string
+	InitialSharedVersion *uint64
+}
+
+// OfflineContext supplies the chain state a PTB is built from, so that it is built and serialized without
+// network access, e.g. for deterministic tests or to be signed on an air-gapped machine. Set in the CallOpts,
+// AppendPTB resolves the objects of the calls from Objects only, and ExecutePTB takes the sender and the gas
+// data from the context instead of the RPC, without estimating the gas budget. The only RPC call made is the
+// execution of the signed transaction, any other fails with ErrOfflineNetworkCall.
+type OfflineContext struct {
+	Sender  string
+	Objects []OfflineObject
+
+	GasPayment []models.SuiObjectRef
+	// GasOwner pays for the gas, the sender by default
+	GasOwner  string
+	GasPrice  uint64
+	GasBudget uint64
+}
+
+// NewObjectResolver returns an ObjectResolver resolving the objects of the context, which fails to resolve
+// any other object.
+func (o *OfflineContext) NewObjectResolver() (*ObjectResolver, error) {
+	resolver := NewObjectResolverWithCache(nil, 0, 0)
+	resolver.pinned = make(map[string]*resolvedObject, len(o.Objects))
+	for _, object := range o.Objects {
+		objectId, err := bindutils.ConvertAddressToString(object.Id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid offline object ID %s: %w", object.Id, err)
+		}
+
+		resolved := &resolvedObject{
+			ObjectId:             objectId,
+			Version:              object.Version,
+			Digest:               object.Digest,
+			InitialSharedVersion: object.InitialSharedVersion,
+		}
+		if object.InitialSharedVersion != nil {
+			resolved.Owner.Shared.InitialSharedVersion = *object.InitialSharedVersion
+		} else if object.Digest == "" {
+			return nil, fmt.Errorf("offline object %s needs a digest or an initial shared version", object.Id)
+		}
+		resolver.pinned[objectId] = resolved
+	}
+
+	return resolver, nil
+}
+
+// SetTransactionData sets the sender and the gas data of the PTB from the context.
+func (o *OfflineContext) SetTransactionData(ptb *transaction.Transaction) error {
+	if ptb.Data.V1 == nil {
+		return errors.New("unexpected PTB with missing fields")
+	}
+	if o.Sender == "" {
+		return errors.New("offline context without sender")
+	}
+	if len(o.GasPayment) == 0 {
+		return errors.New("offline context without gas payment")
+	}
+	if o.GasPrice == 0 || o.GasBudget == 0 {
+		return errors.New("offline context without gas price or budget")
+	}
+
+	sender, err := bindutils.ConvertAddressToString(o.Sender)
+	if err != nil {
+		return fmt.Errorf("invalid sender %s: %w", o.Sender, err)
+	}
+	gasOwner := sender
+	if o.GasOwner != "" {
+		gasOwner, err = bindutils.ConvertAddressToString(o.GasOwner)
+		if err != nil {
+			return fmt.Errorf("invalid gas owner %s: %w", o.GasOwner, err)
+		}
+	}
+
+	payment := make([]transaction.SuiObjectRef, len(o.GasPayment))
+	for i, coin := range o.GasPayment {
+		objIdBytes, objIdErr := bindutils.ConvertStringToAddressBytes(coin.ObjectId)
+		if objIdErr != nil {
+			return fmt.Errorf("failed to convert gas object ID: %w", objIdErr)
+		}
+		digestBytes, digestErr := bindutils.ConvertStringToDigestBytes(coin.Digest)
+		if digestErr != nil {
+			return fmt.Errorf("failed to convert gas object digest: %w", digestErr)
+		}
+		payment[i] = transaction.SuiObjectRef{
+			ObjectId: *objIdBytes,
+			Version:  coin.Version,
+			Digest:   *digestBytes,
+		}
+	}
+
+	ptb.SetSender(models.SuiAddress(sender))
+	ptb.SetGasOwner(models.SuiAddress(gasOwner))
+	ptb.SetGasPayment(payment)
+	ptb.SetGasPrice(o.GasPrice)
+	ptb.SetGasBudget(o.GasBudget)
+
+	return nil
+}
+
+// BuildTransaction sets the sender and the gas data of the PTB from the context and returns its TransactionData
+// encoded as BCS, ready to be signed. Its inputs must be resolved, e.g. by AppendPTB with the context in the
+// CallOpts.
+func (o *OfflineContext) BuildTransaction(ptb *transaction.Transaction) ([]byte, error) {
+	if err := o.SetTransactionData(ptb); err != nil {
+		return nil, err
+	}
+	if ptb.Data.V1.Kind == nil || ptb.Data.V1.Kind.ProgrammableTransaction == nil {
+		return nil, errors.New("unexpected PTB with missing fields")
+	}
+
+	for i, input := range ptb.Data.V1.Kind.ProgrammableTransaction.Inputs {
+		if input.UnresolvedPure != nil || input.UnresolvedObject != nil {
+			return nil, fmt.Errorf("unresolved input %d in offline PTB", i)
+		}
+	}
+
+	txBytes, err := ptb.Data.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal transaction: %w", err)
+	}
+
+	return txBytes, nil
+}
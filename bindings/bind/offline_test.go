@@ -0,0 +1,246 @@
+package bind
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bindutils "github.com/smartcontractkit/chainlink-sui/bindings/utils"
+)
+
+func newTestOfflineContext() *OfflineContext {
+	initialSharedVersion := uint64(3)
+
+	return &OfflineContext{
+		Sender: resolverTestOwner,
+		Objects: []OfflineObject{
+			{Id: resolverTestObjectId(1), InitialSharedVersion: &initialSharedVersion},
+			{Id: resolverTestObjectId(2), Version: 7, Digest: resolverTestDigest(2)},
+			{Id: resolverTestObjectId(3), Version: 9, Digest: resolverTestDigest(3)},
+		},
+		GasPayment: []models.SuiObjectRef{
+			{ObjectId: resolverTestObjectId(0xc0), Version: 11, Digest: resolverTestDigest(0xc0)},
+		},
+		GasPrice:  750,
+		GasBudget: 20_000_000,
+	}
+}
+
+// buildOfflineTransfer builds a PTB calling the counter, splitting a coin from the gas and transferring both.
+func buildOfflineTransfer(t *testing.T, offline *OfflineContext) []byte {
+	t.Helper()
+
+	// without client, any RPC call of the contract would panic
+	contract, err := NewBoundContract("0xa1", "test", "counter", nil)
+	require.NoError(t, err)
+	encoded, err := contract.EncodeCallArgsWithGenerics(
+		"increment_by",
+		[]string{"0x2::sui::SUI"},
+		[]string{"T"},
+		[]string{"&mut Counter", "&AdminCap", "Receiving<Counter>", "u64"},
+		[]any{Object{Id: resolverTestObjectId(1)}, Object{Id: resolverTestObjectId(2)}, Object{Id: resolverTestObjectId(3)}, uint64(5)},
+		nil,
+	)
+	require.NoError(t, err)
+
+	ptb := transaction.NewTransaction()
+	result, err := contract.AppendPTB(context.Background(), &CallOpts{Offline: offline}, ptb, encoded)
+	require.NoError(t, err)
+	amount := ptb.Data.V1.AddInput(transaction.CallArg{Pure: &transaction.Pure{Bytes: binary.LittleEndian.AppendUint64(nil, 100)}})
+	coin := ptb.SplitCoins(ptb.Gas(), []transaction.Argument{amount})
+	recipient := ptb.Data.V1.AddInput(transaction.CallArg{Pure: &transaction.Pure{Bytes: make([]byte, 32)}})
+	ptb.TransferObjects([]transaction.Argument{*result, coin}, recipient)
+
+	txBytes, err := offline.BuildTransaction(ptb)
+	require.NoError(t, err)
+
+	return txBytes
+}
+
+func TestOfflineContextBuildTransaction(t *testing.T) {
+	t.Parallel()
+
+	offline := newTestOfflineContext()
+	txBytes := buildOfflineTransfer(t, offline)
+	// the same context builds the same bytes
+	assert.Equal(t, txBytes, buildOfflineTransfer(t, offline))
+
+	decoded, err := DecodeTransactionData(txBytes)
+	require.NoError(t, err)
+	assert.Equal(t, resolverTestOwner, decoded.Sender)
+	assert.Equal(t, resolverTestOwner, decoded.GasOwner)
+	assert.Equal(t, uint64(750), decoded.GasPrice)
+	assert.Equal(t, uint64(20_000_000), decoded.GasBudget)
+	assert.Equal(t, offline.GasPayment, decoded.GasPayment)
+	assert.Nil(t, decoded.Expiration)
+	assert.Equal(t, []string{
+		fmt.Sprintf("SharedObject(%s, initial 3, mutable)", resolverTestObjectId(1)),
+		fmt.Sprintf("ImmOrOwnedObject(%s@7 %s)", resolverTestObjectId(2), resolverTestDigest(2)),
+		fmt.Sprintf("Receiving(%s@9 %s)", resolverTestObjectId(3), resolverTestDigest(3)),
+		"Pure(0x0500000000000000)",
+		"Pure(0x6400000000000000)",
+		"Pure(0x0000000000000000000000000000000000000000000000000000000000000000)",
+	}, decoded.Inputs)
+	assert.Equal(t, []string{
+		fmt.Sprintf("MoveCall %s::counter::increment_by<%s::sui::SUI>(Input(0), Input(1), Input(2), Input(3))",
+			resolverTestObjectId(0xa1), resolverTestObjectId(2)),
+		"SplitCoins(GasCoin, [Input(4)])",
+		"TransferObjects([Result(0), Result(1)], Input(5))",
+	}, decoded.Commands)
+	assert.Contains(t, decoded.String(), "2: TransferObjects([Result(0), Result(1)], Input(5))")
+}
+
+func TestOfflineContextMissingData(t *testing.T) {
+	t.Parallel()
+
+	contract, err := NewBoundContract("0xa1", "test", "counter", nil)
+	require.NoError(t, err)
+	encoded, err := contract.EncodeCallArgs("increment", nil, []string{"&mut Counter"}, []any{Object{Id: resolverTestObjectId(4)}})
+	require.NoError(t, err)
+
+	_, err = contract.AppendPTB(context.Background(), &CallOpts{Offline: newTestOfflineContext()}, transaction.NewTransaction(), encoded)
+	require.ErrorContains(t, err, "not found in offline context")
+
+	offline := newTestOfflineContext()
+	offline.GasPayment = nil
+	_, err = offline.BuildTransaction(transaction.NewTransaction())
+	require.ErrorContains(t, err, "without gas payment")
+
+	offline = newTestOfflineContext()
+	offline.Objects = append(offline.Objects, OfflineObject{Id: resolverTestObjectId(5), Version: 1})
+	_, err = offline.NewObjectResolver()
+	require.ErrorContains(t, err, "needs a digest")
+}
+
+func TestDecodeTransactionDataPackageCommands(t *testing.T) {
+	t.Parallel()
+
+	ptb := newPackageTransaction()
+	upgradeCap := ptb.Publish([][]byte{{1, 2, 3}, {4}}, []models.SuiAddressBytes{{31: 1}, {31: 2}})
+	ptb.Upgrade([][]byte{{5}}, []models.SuiAddressBytes{{31: 1}}, models.SuiAddressBytes{31: 0xa1}, upgradeCap)
+	require.NoError(t, newTestOfflineContext().SetTransactionData(ptb.Transaction))
+	txBytes, err := ptb.Marshal()
+	require.NoError(t, err)
+
+	decoded, err := DecodeTransactionData(txBytes)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		fmt.Sprintf("Publish(2 modules, dependencies [%s, %s])", resolverTestObjectId(1), resolverTestObjectId(2)),
+		fmt.Sprintf("Upgrade(1 modules, dependencies [%s], package %s, ticket Result(0))", resolverTestObjectId(1), resolverTestObjectId(0xa1)),
+	}, decoded.Commands)
+}
+
+func TestDecodeTransactionDataInvalid(t *testing.T) {
+	t.Parallel()
+
+	offline := newTestOfflineContext()
+	ptb := transaction.NewTransaction()
+	ptb.TransferObjects([]transaction.Argument{ptb.Gas()}, transaction.Argument{Result: new(uint16)})
+	txBytes, err := offline.BuildTransaction(ptb)
+	require.NoError(t, err)
+	_, err = DecodeTransactionData(txBytes)
+	require.ErrorContains(t, err, "Result(0) refers to a missing input or result")
+
+	ptb = transaction.NewTransaction()
+	ptb.SplitCoins(ptb.Gas(), []transaction.Argument{{Input: new(uint16)}})
+	txBytes, err = offline.BuildTransaction(ptb)
+	require.NoError(t, err)
+	_, err = DecodeTransactionData(txBytes)
+	require.ErrorContains(t, err, "Input(0) refers to a missing input or result")
+
+	ptb = transaction.NewTransaction()
+	ptb.SplitCoins(ptb.Gas(), []transaction.Argument{ptb.Data.V1.AddInput(transaction.CallArg{Pure: &transaction.Pure{Bytes: []byte{1}}})})
+	txBytes, err = offline.BuildTransaction(ptb)
+	require.NoError(t, err)
+	_, err = DecodeTransactionData(txBytes)
+	require.NoError(t, err)
+
+	_, err = DecodeTransactionData(append(txBytes, 0))
+	require.ErrorContains(t, err, "unexpected bytes")
+
+	_, err = DecodeTransactionData(txBytes[:len(txBytes)-4])
+	require.Error(t, err)
+
+	// an unknown CallArg variant
+	_, err = DecodeTransactionData([]byte{0, 0, 1, 9})
+	require.Error(t, err)
+}
+
+type offlineTestSigner string
+
+func (s offlineTestSigner) Sign(_ []byte) ([]string, error) {
+	return []string{"signature"}, nil
+}
+
+func (s offlineTestSigner) GetAddress() (string, error) {
+	return string(s), nil
+}
+
+// fakeExecuteAPI executes any transaction and records its bytes, any other RPC call panics.
+type fakeExecuteAPI struct {
+	sui.ISuiAPI
+	txBytes []string
+}
+
+func (f *fakeExecuteAPI) SuiCall(_ context.Context, method string, params ...interface{}) (interface{}, error) {
+	if method != "sui_executeTransactionBlock" {
+		return nil, fmt.Errorf("unexpected call of %s", method)
+	}
+	f.txBytes = append(f.txBytes, params[0].(string))
+
+	return `{"jsonrpc":"2.0","id":1,"result":{"digest":"tx","effects":{"status":{"status":"success"}}}}`, nil
+}
+
+func TestOfflineContextNetworkCalls(t *testing.T) {
+	t.Parallel()
+
+	api := newFakeObjectsAPI()
+	contract, err := NewBoundContract("0xa1", "test", "counter", api)
+	require.NoError(t, err)
+	encoded, err := contract.EncodeCallArgs("increment", nil, []string{"&mut Counter"}, []any{Object{Id: resolverTestObjectId(1)}})
+	require.NoError(t, err)
+
+	// the objects are not fetched by an RPC backed resolver
+	opts := &CallOpts{Offline: newTestOfflineContext(), ObjectResolver: NewObjectResolver(api)}
+	_, err = contract.AppendPTB(context.Background(), opts, transaction.NewTransaction(), encoded)
+	require.ErrorIs(t, err, ErrOfflineNetworkCall)
+	assert.Zero(t, api.requestCount(resolverTestObjectId(1)))
+
+	// the resolver of the context is kept across calls
+	opts = &CallOpts{Offline: newTestOfflineContext()}
+	for range 2 {
+		_, err = contract.AppendPTB(context.Background(), opts, transaction.NewTransaction(), encoded)
+		require.NoError(t, err)
+	}
+
+	_, err = contract.Call(context.Background(), &CallOpts{Signer: offlineTestSigner(resolverTestOwner), Offline: newTestOfflineContext()}, encoded)
+	require.ErrorIs(t, err, ErrOfflineNetworkCall)
+}
+
+func TestOfflineContextPackageTransaction(t *testing.T) {
+	t.Parallel()
+
+	// without gas budget in the CallOpts, the budget of the context is used instead of dry running the transaction
+	api := &fakeExecuteAPI{}
+	ptb := newPackageTransaction()
+	ptb.Publish([][]byte{{1}}, []models.SuiAddressBytes{{31: 1}})
+	opts := &CallOpts{Signer: offlineTestSigner(resolverTestOwner), Offline: newTestOfflineContext()}
+	tx, err := executePackageTransaction(context.Background(), opts, api, ptb)
+	require.NoError(t, err)
+	assert.Equal(t, "tx", tx.Digest)
+
+	require.Len(t, api.txBytes, 1)
+	txBytes, err := bindutils.DecodeBase64(api.txBytes[0])
+	require.NoError(t, err)
+	decoded, err := DecodeTransactionData(txBytes)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20_000_000), decoded.GasBudget)
+	assert.Equal(t, resolverTestOwner, decoded.Sender)
+}
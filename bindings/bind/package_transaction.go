@@ -0,0 +1,148 @@
+package bind
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/transaction"
+)
+
+// BCS tags of the commands of a ProgrammableTransaction carrying compiled modules
+const (
+	publishCommandTag = 4
+	upgradeCommandTag = 6
+)
+
+// packageTransaction is a PTB publishing or upgrading packages. The SDK models compiled modules as 32 byte
+// addresses, so its commands are added without their modules, which are encoded when marshalling the transaction.
+type packageTransaction struct {
+	*transaction.Transaction
+
+	// modules holds the compiled modules of the commands at each index
+	modules map[uint16][][]byte
+}
+
+func newPackageTransaction() *packageTransaction {
+	return &packageTransaction{
+		Transaction: transaction.NewTransaction(),
+		modules:     map[uint16][][]byte{},
+	}
+}
+
+// Publish adds a Publish command of a package with the modules and dependencies, and returns its UpgradeCap.
+func (tx *packageTransaction) Publish(modules [][]byte, dependencies []models.SuiAddressBytes) transaction.Argument {
+	upgradeCap := tx.Add(transaction.Command{
+		Publish: &transaction.Publish{
+			Dependencies: dependencies,
+		},
+	})
+	tx.modules[*upgradeCap.Result] = modules
+
+	return upgradeCap
+}
+
+// Upgrade adds an Upgrade command of the package with the modules and dependencies, authorized by the ticket,
+// and returns its UpgradeReceipt.
+func (tx *packageTransaction) Upgrade(modules [][]byte, dependencies []models.SuiAddressBytes, packageId models.SuiAddressBytes, ticket transaction.Argument) transaction.Argument {
+	receipt := tx.Add(transaction.Command{
+		Upgrade: &transaction.Upgrade{
+			Dependencies: dependencies,
+			Package:      packageId,
+			Ticket:       &ticket,
+		},
+	})
+	tx.modules[*receipt.Result] = modules
+
+	return receipt
+}
+
+// Marshal encodes the transaction data as BCS like TransactionData.Marshal, with the modules of its package commands.
+func (tx *packageTransaction) Marshal() ([]byte, error) {
+	data := tx.Data.V1
+	if data == nil || data.Kind == nil || data.Kind.ProgrammableTransaction == nil {
+		return nil, errors.New("unexpected PTB with missing fields")
+	}
+	ptb := data.Kind.ProgrammableTransaction
+
+	// TransactionData::V1 and TransactionKind::ProgrammableTransaction
+	buffer := bytes.NewBuffer(mystenbcs.ULEB128Encode(0))
+	buffer.Write(mystenbcs.ULEB128Encode(0))
+
+	buffer.Write(mystenbcs.ULEB128Encode(len(ptb.Inputs)))
+	for i, input := range ptb.Inputs {
+		encoded, err := mystenbcs.Marshal(input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode input %d: %w", i, err)
+		}
+		buffer.Write(encoded)
+	}
+
+	buffer.Write(mystenbcs.ULEB128Encode(len(ptb.Commands)))
+	for i, command := range ptb.Commands {
+		var encoded []byte
+		var err error
+		if modules, ok := tx.modules[uint16(i)]; ok { // #nosec G115
+			encoded, err = encodePackageCommand(command, modules)
+		} else {
+			encoded, err = mystenbcs.Marshal(command)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode command %d: %w", i, err)
+		}
+		buffer.Write(encoded)
+	}
+
+	// the remaining fields of TransactionDataV1, tagged as in the SDK
+	remaining, err := mystenbcs.Marshal(struct {
+		Sender     *models.SuiAddressBytes
+		GasData    *transaction.GasData
+		Expiration *transaction.TransactionExpiration `bcs:"optional"`
+	}{data.Sender, data.GasData, data.Expiration})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transaction data: %w", err)
+	}
+	buffer.Write(remaining)
+
+	return buffer.Bytes(), nil
+}
+
+// encodePackageCommand encodes a Publish or Upgrade command with its compiled modules as vector<vector<u8>>.
+func encodePackageCommand(command *transaction.Command, modules [][]byte) ([]byte, error) {
+	var buffer bytes.Buffer
+	writeModulesAndDependencies := func(dependencies []models.SuiAddressBytes) {
+		buffer.Write(mystenbcs.ULEB128Encode(len(modules)))
+		for _, module := range modules {
+			buffer.Write(mystenbcs.ULEB128Encode(len(module)))
+			buffer.Write(module)
+		}
+		buffer.Write(mystenbcs.ULEB128Encode(len(dependencies)))
+		for _, dependency := range dependencies {
+			buffer.Write(dependency[:])
+		}
+	}
+
+	switch {
+	case command.Publish != nil:
+		buffer.Write(mystenbcs.ULEB128Encode(publishCommandTag))
+		writeModulesAndDependencies(command.Publish.Dependencies)
+	case command.Upgrade != nil:
+		if command.Upgrade.Ticket == nil {
+			return nil, errors.New("upgrade command without ticket")
+		}
+		buffer.Write(mystenbcs.ULEB128Encode(upgradeCommandTag))
+		writeModulesAndDependencies(command.Upgrade.Dependencies)
+		buffer.Write(command.Upgrade.Package[:])
+		ticket, err := mystenbcs.Marshal(command.Upgrade.Ticket)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode upgrade ticket: %w", err)
+		}
+		buffer.Write(ticket)
+	default:
+		return nil, errors.New("command with modules must be a Publish or an Upgrade")
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -2,13 +2,13 @@ package bind
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/block-vision/sui-go-sdk/models"
 	"github.com/block-vision/sui-go-sdk/sui"
+	"github.com/block-vision/sui-go-sdk/transaction"
 
 	bindutils "github.com/smartcontractkit/chainlink-sui/bindings/utils"
 )
@@ -20,90 +20,197 @@ type PublishRequest struct {
 	Dependencies    []string `json:"dependencies"`
 }
 
+// PublishResult is the result of a transaction publishing or upgrading a package.
+type PublishResult struct {
+	PackageId PackageID
+	// Version of the package, 1 for a new package and incremented by each upgrade
+	Version uint64
+	Digest  string
+	// Objects are the IDs of the objects created by the transaction by fully-qualified type,
+	// e.g. 0x2::package::UpgradeCap
+	Objects map[string][]string
+}
+
+// NewPublishResult returns the result of a successful transaction publishing or upgrading a package.
+func NewPublishResult(tx models.SuiTransactionBlockResponse) (*PublishResult, error) {
+	result := &PublishResult{
+		Digest:  tx.Digest,
+		Objects: map[string][]string{},
+	}
+	for _, change := range tx.ObjectChanges {
+		switch change.Type {
+		case "published":
+			version, err := parseVersionString(change.Version)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse package version: %w", err)
+			}
+			result.PackageId = change.PackageId
+			result.Version = version
+		case "created":
+			result.Objects[change.ObjectType] = append(result.Objects[change.ObjectType], change.ObjectId)
+		}
+	}
+	if result.PackageId == "" {
+		return nil, errors.New("package ID not found in transaction")
+	}
+
+	return result, nil
+}
+
+// ObjectIds returns the IDs of the objects created by the transaction whose type, without its type arguments,
+// is module::name of any package.
+func (r *PublishResult) ObjectIds(module, name string) []string {
+	var ids []string
+	for objectType, objectIds := range r.Objects {
+		if genericStart := strings.Index(objectType, "<"); genericStart != -1 {
+			objectType = objectType[:genericStart]
+		}
+		if strings.HasSuffix(objectType, fmt.Sprintf("::%s::%s", module, name)) {
+			ids = append(ids, objectIds...)
+		}
+	}
+
+	return ids
+}
+
+// ObjectId returns the ID of the object of type module::name created by the transaction, which must be the only one.
+func (r *PublishResult) ObjectId(module, name string) (string, error) {
+	ids := r.ObjectIds(module, name)
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("object ID (module %s, object %s) not found in transaction", module, name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d objects (module %s, object %s) created by transaction", len(ids), module, name)
+	}
+}
+
+// UpgradeCapId returns the ID of the UpgradeCap of a published package, sent to the publisher.
+func (r *PublishResult) UpgradeCapId() (string, error) {
+	return r.ObjectId("package", "UpgradeCap")
+}
+
+// PublishPackage publishes a package in a PTB sending its UpgradeCap to the signer. Without a gas budget in the
+// CallOpts, the budget is estimated by dry running the transaction.
 func PublishPackage(
 	ctx context.Context,
 	opts *CallOpts,
 	client sui.ISuiAPI,
 	req PublishRequest,
-) (PackageID, *models.SuiTransactionBlockResponse, error) {
-	var modules = make([][]byte, 0, len(req.CompiledModules))
-	for _, encodedModule := range req.CompiledModules {
-		decodedModule, err := bindutils.DecodeBase64(encodedModule)
-		if err != nil {
-			return "", nil, fmt.Errorf("failed to decode module: %w", err)
-		}
-		modules = append(modules, decodedModule)
+) (*PublishResult, *models.SuiTransactionBlockResponse, error) {
+	if opts == nil || opts.Signer == nil {
+		return nil, nil, fmt.Errorf("CallOpts with Signer is required")
 	}
 
-	// dependencies are already strings
-	deps := req.Dependencies
+	modules, dependencies, err := decodePackage(req.CompiledModules, req.Dependencies)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	signerAddressStr, err := opts.Signer.GetAddress()
 	if err != nil {
-		return "", nil, err
+		return nil, nil, err
 	}
 	signerAddress, err := bindutils.ConvertAddressToString(signerAddressStr)
 	if err != nil {
-		return "", nil, fmt.Errorf("invalid signer address %v: %w", signerAddressStr, err)
+		return nil, nil, fmt.Errorf("invalid signer address %v: %w", signerAddressStr, err)
+	}
+
+	ptb := newPackageTransaction()
+	upgradeCap := ptb.Publish(modules, dependencies)
+	ptb.TransferObjects([]transaction.Argument{upgradeCap}, ptb.Pure(signerAddress))
+
+	tx, err := executePackageTransaction(ctx, opts, client, ptb)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to execute tx when publishing: %w", err)
+	}
+
+	result, err := NewPublishResult(*tx)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// TODO: we are using unsafe_publish here because module fields are []models.SuiAddressBytes?
-	// https://github.com/block-vision/sui-go-sdk/blob/b382e3a4ec2e9233461cdecbd45e6c031166234a/transaction/transaction_data.go#L232
-	// https://github.com/MystenLabs/sui/blob/6d8ceed4d727a6c9ce9f5879b3cd1b2e8605affa/crates/sui-graphql-rpc/src/types/transaction_block_kind/programmable.rs#L134
+	return result, tx, nil
+}
 
-	// Convert modules to base64
-	moduleStrs := make([]string, len(modules))
-	for i, module := range modules {
-		moduleB64 := base64.StdEncoding.EncodeToString(module)
-		moduleStrs[i] = moduleB64
+// decodePackage decodes the base64 encoded modules and the dependency IDs of a compiled package.
+func decodePackage(compiledModules []string, dependencyIds []string) ([][]byte, []models.SuiAddressBytes, error) {
+	modules := make([][]byte, 0, len(compiledModules))
+	for _, encodedModule := range compiledModules {
+		decodedModule, err := bindutils.DecodeBase64(encodedModule)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode module: %w", err)
+		}
+		modules = append(modules, decodedModule)
 	}
 
-	// Set gas budget
-	gasBudget := "200000000" // 200M MIST default for publish
-	if opts.GasBudget != nil {
-		gasBudget = fmt.Sprintf("%d", *opts.GasBudget)
+	dependencies := make([]models.SuiAddressBytes, 0, len(dependencyIds))
+	for _, dependency := range dependencyIds {
+		id, err := bindutils.ConvertStringToAddressBytes(dependency)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid dependency %s: %w", dependency, err)
+		}
+		dependencies = append(dependencies, *id)
 	}
 
-	// Convert gas object to pointer if provided
-	var gasObj *string
-	if opts.GasObject != "" {
-		gasObj = &opts.GasObject
+	return modules, dependencies, nil
+}
+
+// executePackageTransaction signs and executes a package transaction. Without a gas budget in the CallOpts,
+// the transaction is dry run with the balance of its gas coin as budget, and then executed with the estimated budget.
+// With an OfflineContext, the transaction is executed with the gas budget of the context.
+func executePackageTransaction(ctx context.Context, opts *CallOpts, client sui.ISuiAPI, ptb *packageTransaction) (*models.SuiTransactionBlockResponse, error) {
+	if err := setTransactionDefaults(ctx, opts, client, ptb.Transaction); err != nil {
+		return nil, err
 	}
 
-	// Use the client's Publish method instead of PTB
-	publishResp, err := client.Publish(ctx, models.PublishRequest{
-		Sender:          signerAddress,
-		CompiledModules: moduleStrs,
-		Dependencies:    deps,
-		GasBudget:       gasBudget,
-		Gas:             gasObj,
-	})
-	if err != nil {
-		return "", nil, fmt.Errorf("failed to create publish transaction: %w", err)
+	if opts.GasBudget == nil && opts.Offline == nil {
+		gasData := ptb.Data.V1.GasData
+		if gasData.Payment == nil || len(*gasData.Payment) == 0 {
+			return nil, errors.New("no gas payment to estimate the gas budget")
+		}
+		owner, err := bindutils.ConvertBytesToAddress(gasData.Owner[:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid gas owner: %w", err)
+		}
+		gasCoin, err := bindutils.ConvertBytesToAddress((*gasData.Payment)[0].ObjectId[:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid gas coin: %w", err)
+		}
+		balance, err := fetchSuiCoinBalance(ctx, client, owner, gasCoin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gas coin balance: %w", err)
+		}
+		ptb.SetGasBudget(min(balance, MaxGasBudget))
+
+		dryRunBytes, err := ptb.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal transaction: %w", err)
+		}
+		budget, err := EstimateGasBudget(ctx, client, dryRunBytes, *gasData.Price)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas budget: %w", err)
+		}
+		ptb.SetGasBudget(budget)
 	}
 
-	// the SDK returns base64 encoded bytes, we need to decode them
-	txBytesDecoded, err := base64.StdEncoding.DecodeString(publishResp.TxBytes)
+	txBytes, err := ptb.Marshal()
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to decode transaction bytes: %w", err)
+		return nil, fmt.Errorf("failed to marshal transaction: %w", err)
 	}
 
-	tx, err := SignAndSendTx(ctx, opts.Signer, client, txBytesDecoded, opts.WaitForExecution)
+	tx, err := signAndExecuteTx(ctx, opts.Signer, client, txBytes, opts.WaitForExecution)
+	opts.ObjectResolver.UpdateFromTransaction(tx)
 	if err != nil {
-		msg := fmt.Errorf("failed to execute tx when publishing: %w", err)
-		return "", nil, msg
+		return nil, err
 	}
 
 	if tx.Effects.Status.Status == "failure" {
-		return "", nil, fmt.Errorf("transaction failed: %v", tx.Effects.Status.Error)
-	}
-
-	pkgId, err := FindPackageIdFromPublishTx(*tx)
-	if err != nil {
-		return "", nil, err
+		return nil, fmt.Errorf("transaction failed: %v", tx.Effects.Status.Error)
 	}
 
-	return pkgId, tx, nil
+	return &tx.SuiTransactionBlockResponse, nil
 }
 
 func FindPackageIdFromPublishTx(tx models.SuiTransactionBlockResponse) (string, error) {
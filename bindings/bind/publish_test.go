@@ -0,0 +1,119 @@
+package bind
+
+import (
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPublishResult(t *testing.T) {
+	t.Parallel()
+
+	tx := models.SuiTransactionBlockResponse{
+		Digest: "digest",
+		ObjectChanges: []models.ObjectChange{
+			{Type: "mutated", ObjectType: "0x2::coin::Coin<0x2::sui::SUI>", ObjectId: "0xc0"},
+			{Type: "published", PackageId: "0xa1", Version: "1"},
+			{Type: "created", ObjectType: "0x2::package::UpgradeCap", ObjectId: "0x01"},
+			{Type: "created", ObjectType: "0xa1::ownable::OwnerCap", ObjectId: "0x02"},
+			{Type: "created", ObjectType: "0xa1::state::State", ObjectId: "0x03"},
+			{Type: "created", ObjectType: "0xa1::state::StateRef", ObjectId: "0x04"},
+			{Type: "created", ObjectType: "0x2::coin::TreasuryCap<0xa1::token::TOKEN>", ObjectId: "0x05"},
+			{Type: "created", ObjectType: "0xa1::state::Cap", ObjectId: "0x06"},
+			{Type: "created", ObjectType: "0xa1::state::Cap", ObjectId: "0x07"},
+		},
+	}
+
+	result, err := NewPublishResult(tx)
+	require.NoError(t, err)
+	assert.Equal(t, "0xa1", result.PackageId)
+	assert.Equal(t, uint64(1), result.Version)
+	assert.Equal(t, "digest", result.Digest)
+	assert.Equal(t, []string{"0x01"}, result.Objects["0x2::package::UpgradeCap"])
+	assert.NotContains(t, result.Objects, "0x2::coin::Coin<0x2::sui::SUI>")
+
+	t.Run("UpgradeCap", func(t *testing.T) {
+		t.Parallel()
+
+		id, err := result.UpgradeCapId()
+		require.NoError(t, err)
+		assert.Equal(t, "0x01", id)
+	})
+
+	t.Run("ObjectId matches the whole name", func(t *testing.T) {
+		t.Parallel()
+
+		id, err := result.ObjectId("state", "State")
+		require.NoError(t, err)
+		assert.Equal(t, "0x03", id)
+	})
+
+	t.Run("ObjectId strips type arguments", func(t *testing.T) {
+		t.Parallel()
+
+		id, err := result.ObjectId("coin", "TreasuryCap")
+		require.NoError(t, err)
+		assert.Equal(t, "0x05", id)
+	})
+
+	t.Run("ObjectId fails without object", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := result.ObjectId("state", "Missing")
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("ObjectId fails with several objects", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := result.ObjectId("state", "Cap")
+		require.ErrorContains(t, err, "2 objects")
+		assert.ElementsMatch(t, []string{"0x06", "0x07"}, result.ObjectIds("state", "Cap"))
+	})
+}
+
+func TestNewPublishResultWithoutPackage(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewPublishResult(models.SuiTransactionBlockResponse{
+		ObjectChanges: []models.ObjectChange{
+			{Type: "created", ObjectType: "0xa1::state::State", ObjectId: "0x03"},
+		},
+	})
+	require.ErrorContains(t, err, "package ID not found")
+}
+
+func TestGasBudgetFromCosts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		costs    models.GasCostSummary
+		expected uint64
+	}{
+		{
+			name:     "storage cost above rebate",
+			costs:    models.GasCostSummary{ComputationCost: "1000000", StorageCost: "5000000", StorageRebate: "2000000"},
+			expected: 1_000_000 + 1000*750 + 3_000_000,
+		},
+		{
+			name:     "storage rebate above cost",
+			costs:    models.GasCostSummary{ComputationCost: "1000000", StorageCost: "2000000", StorageRebate: "5000000"},
+			expected: 1_000_000 + 1000*750,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			budget, err := gasBudgetFromCosts(tt.costs, 750)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, budget)
+		})
+	}
+
+	_, err := gasBudgetFromCosts(models.GasCostSummary{ComputationCost: "x", StorageCost: "0", StorageRebate: "0"}, 750)
+	require.Error(t, err)
+}
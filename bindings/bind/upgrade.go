@@ -0,0 +1,202 @@
+package bind
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"golang.org/x/crypto/blake2b"
+
+	bindutils "github.com/smartcontractkit/chainlink-sui/bindings/utils"
+)
+
+// UpgradePolicy is the policy of an upgrade, which restricts the changes it can make to the package.
+// An UpgradeCap only authorizes upgrades whose policy is at least as restrictive as its own.
+type UpgradePolicy uint8
+
+const (
+	// UpgradePolicyCompatible allows any change keeping the public API of the package compatible
+	UpgradePolicyCompatible UpgradePolicy = 0
+	// UpgradePolicyAdditive only allows adding new code to the package
+	UpgradePolicyAdditive UpgradePolicy = 128
+	// UpgradePolicyDepOnly only allows changing the dependencies of the package
+	UpgradePolicyDepOnly UpgradePolicy = 192
+)
+
+func (p UpgradePolicy) String() string {
+	switch p {
+	case UpgradePolicyCompatible:
+		return "compatible"
+	case UpgradePolicyAdditive:
+		return "additive"
+	case UpgradePolicyDepOnly:
+		return "dep_only"
+	default:
+		return fmt.Sprintf("UpgradePolicy(%d)", uint8(p))
+	}
+}
+
+// ParseUpgradePolicy returns the policy named compatible, additive or dep_only. An empty name is compatible.
+func ParseUpgradePolicy(name string) (UpgradePolicy, error) {
+	switch name {
+	case "", "compatible":
+		return UpgradePolicyCompatible, nil
+	case "additive":
+		return UpgradePolicyAdditive, nil
+	case "dep_only":
+		return UpgradePolicyDepOnly, nil
+	default:
+		return 0, fmt.Errorf("unknown upgrade policy %q, expected compatible, additive or dep_only", name)
+	}
+}
+
+// UpgradeAuthorizer adds the commands authorizing and committing a package upgrade to its PTB,
+// e.g. with an UpgradeCap owned by the signer or one held by MCMS.
+type UpgradeAuthorizer interface {
+	// AuthorizeUpgrade adds the commands authorizing the upgrade of the package and returns the UpgradeTicket
+	AuthorizeUpgrade(ctx context.Context, opts *CallOpts, ptb *transaction.Transaction, packageId string, policy UpgradePolicy, digest []byte) (*transaction.Argument, error)
+	// CommitUpgrade adds the commands consuming the UpgradeReceipt of the upgrade
+	CommitUpgrade(ctx context.Context, opts *CallOpts, ptb *transaction.Transaction, receipt *transaction.Argument) error
+}
+
+var _ UpgradeAuthorizer = (*UpgradeCapAuthorizer)(nil)
+
+// UpgradeCapAuthorizer authorizes upgrades with an UpgradeCap owned by the signer.
+type UpgradeCapAuthorizer struct {
+	upgradeCapId string
+	contract     *BoundContract
+}
+
+func NewUpgradeCapAuthorizer(upgradeCapId string, client sui.ISuiAPI) (*UpgradeCapAuthorizer, error) {
+	contract, err := NewBoundContract("0x2", "sui", "package", client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpgradeCapAuthorizer{
+		upgradeCapId: upgradeCapId,
+		contract:     contract,
+	}, nil
+}
+
+func (a *UpgradeCapAuthorizer) AuthorizeUpgrade(ctx context.Context, opts *CallOpts, ptb *transaction.Transaction, _ string, policy UpgradePolicy, digest []byte) (*transaction.Argument, error) {
+	encoded, err := a.contract.EncodeCallArgsWithReturnTypes(
+		"authorize_upgrade",
+		nil,
+		[]string{"&mut UpgradeCap", "u8", "vector<u8>"},
+		[]any{Object{Id: a.upgradeCapId}, uint8(policy), digest},
+		[]string{"UpgradeTicket"},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode authorize_upgrade: %w", err)
+	}
+
+	return a.contract.AppendPTB(ctx, opts, ptb, encoded)
+}
+
+func (a *UpgradeCapAuthorizer) CommitUpgrade(ctx context.Context, opts *CallOpts, ptb *transaction.Transaction, receipt *transaction.Argument) error {
+	encoded, err := a.contract.EncodeCallArgs(
+		"commit_upgrade",
+		nil,
+		[]string{"&mut UpgradeCap", "UpgradeReceipt"},
+		[]any{Object{Id: a.upgradeCapId}, receipt},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to encode commit_upgrade: %w", err)
+	}
+
+	_, err = a.contract.AppendPTB(ctx, opts, ptb, encoded)
+
+	return err
+}
+
+type UpgradeRequest struct {
+	CompiledModules []string `json:"compiled_modules"`
+	Dependencies    []string `json:"dependencies"`
+	// PackageId is the ID of the current version of the package
+	PackageId string        `json:"package_id"`
+	Policy    UpgradePolicy `json:"policy"`
+}
+
+// ComputePackageDigest returns the digest of a package authorized by an UpgradeTicket: the Blake2b-256 hash of the
+// sorted hashes of its modules and IDs of its dependencies.
+func ComputePackageDigest(modules [][]byte, dependencies []string) ([]byte, error) {
+	components := make([][]byte, 0, len(modules)+len(dependencies))
+	for _, module := range modules {
+		hash := blake2b.Sum256(module)
+		components = append(components, hash[:])
+	}
+	for _, dependency := range dependencies {
+		id, err := bindutils.ConvertStringToAddressBytes(dependency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dependency %s: %w", dependency, err)
+		}
+		components = append(components, id[:])
+	}
+	slices.SortFunc(components, bytes.Compare)
+
+	hasher, err := blake2b.New256(nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, component := range components {
+		hasher.Write(component)
+	}
+
+	return hasher.Sum(nil), nil
+}
+
+// UpgradePackage upgrades a package in a single PTB authorizing the upgrade, upgrading the package and committing
+// the upgrade. Without a gas budget in the CallOpts, the budget is estimated by dry running the transaction.
+func UpgradePackage(
+	ctx context.Context,
+	opts *CallOpts,
+	client sui.ISuiAPI,
+	req UpgradeRequest,
+	authorizer UpgradeAuthorizer,
+) (*PublishResult, *models.SuiTransactionBlockResponse, error) {
+	if opts == nil || opts.Signer == nil {
+		return nil, nil, fmt.Errorf("CallOpts with Signer is required")
+	}
+
+	modules, dependencies, err := decodePackage(req.CompiledModules, req.Dependencies)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	packageId, err := bindutils.ConvertStringToAddressBytes(req.PackageId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid package ID %s: %w", req.PackageId, err)
+	}
+
+	digest, err := ComputePackageDigest(modules, req.Dependencies)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to compute package digest: %w", err)
+	}
+
+	ptb := newPackageTransaction()
+	ticket, err := authorizer.AuthorizeUpgrade(ctx, opts, ptb.Transaction, req.PackageId, req.Policy, digest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to authorize upgrade: %w", err)
+	}
+	receipt := ptb.Upgrade(modules, dependencies, *packageId, *ticket)
+	if err = authorizer.CommitUpgrade(ctx, opts, ptb.Transaction, &receipt); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit upgrade: %w", err)
+	}
+
+	tx, err := executePackageTransaction(ctx, opts, client, ptb)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to execute tx when upgrading: %w", err)
+	}
+
+	result, err := NewPublishResult(*tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, tx, nil
+}
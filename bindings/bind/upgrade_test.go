@@ -0,0 +1,160 @@
+package bind
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os/exec"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/smartcontractkit/chainlink-sui/contracts"
+)
+
+const upgradeTestSender = "0x00000000000000000000000000000000000000000000000000000000000000a1"
+
+func newUpgradeTestTransaction(t *testing.T) *packageTransaction {
+	t.Helper()
+
+	tx := newPackageTransaction()
+	tx.SetSender(upgradeTestSender)
+	tx.SetGasOwner(upgradeTestSender)
+	tx.SetGasPrice(1000)
+	tx.SetGasBudget(DefaultGasBudget)
+	tx.SetGasPayment([]transaction.SuiObjectRef{{
+		ObjectId: models.SuiAddressBytes{0xc0},
+		Version:  7,
+		Digest:   models.ObjectDigestBytes(bytes.Repeat([]byte{0xd1}, 32)),
+	}})
+
+	return tx
+}
+
+func TestComputePackageDigest(t *testing.T) {
+	t.Parallel()
+
+	modules := [][]byte{{0xa1, 0x1c, 0xeb, 0x0b}, {0x01, 0x02}}
+	dependencies := []string{"0x1", "0x2"}
+
+	digest, err := ComputePackageDigest(modules, dependencies)
+	require.NoError(t, err)
+	assert.Len(t, digest, blake2b.Size256)
+
+	t.Run("Digest of sui move build", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := exec.LookPath("sui"); err != nil {
+			t.Skip("sui is not installed")
+		}
+
+		// the digest dumped by sui move build --dump-bytecode-as-base64 along the modules
+		artifact, err := CompilePackage(contracts.Test, map[string]string{"test": "0x0"})
+		require.NoError(t, err)
+		require.NotEmpty(t, artifact.Digest)
+
+		modules := make([][]byte, len(artifact.Modules))
+		for i, module := range artifact.Modules {
+			modules[i], err = base64.StdEncoding.DecodeString(module)
+			require.NoError(t, err)
+		}
+		built, err := ComputePackageDigest(modules, artifact.Dependencies)
+		require.NoError(t, err)
+		assert.Equal(t, artifact.Digest, built)
+	})
+
+	t.Run("Order independent", func(t *testing.T) {
+		t.Parallel()
+
+		reordered, err := ComputePackageDigest([][]byte{modules[1], modules[0]}, []string{"0x2", "0x1"})
+		require.NoError(t, err)
+		assert.Equal(t, digest, reordered)
+	})
+
+	t.Run("Dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		withoutDependency, err := ComputePackageDigest(modules, dependencies[:1])
+		require.NoError(t, err)
+		assert.NotEqual(t, digest, withoutDependency)
+	})
+
+	t.Run("Invalid dependency", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ComputePackageDigest(modules, []string{"not an address"})
+		require.Error(t, err)
+	})
+}
+
+func TestParseUpgradePolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, policy := range []UpgradePolicy{UpgradePolicyCompatible, UpgradePolicyAdditive, UpgradePolicyDepOnly} {
+		parsed, err := ParseUpgradePolicy(policy.String())
+		require.NoError(t, err)
+		assert.Equal(t, policy, parsed)
+	}
+
+	parsed, err := ParseUpgradePolicy("")
+	require.NoError(t, err)
+	assert.Equal(t, UpgradePolicyCompatible, parsed)
+
+	_, err = ParseUpgradePolicy("immutable")
+	require.Error(t, err)
+}
+
+func TestPackageTransactionMarshal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Without package commands", func(t *testing.T) {
+		t.Parallel()
+
+		tx := newUpgradeTestTransaction(t)
+		tx.MoveCall("0x2", "package", "only_dep_upgrades", nil, []transaction.Argument{tx.Pure(uint64(42))})
+
+		expected, err := tx.Data.Marshal()
+		require.NoError(t, err)
+		encoded, err := tx.Marshal()
+		require.NoError(t, err)
+		assert.Equal(t, expected, encoded)
+	})
+
+	t.Run("Upgrade", func(t *testing.T) {
+		t.Parallel()
+
+		tx := newUpgradeTestTransaction(t)
+		ticket := tx.MoveCall("0x2", "package", "authorize_upgrade", nil, []transaction.Argument{tx.Pure(uint8(0))})
+		receipt := tx.Upgrade(
+			[][]byte{{0xa1, 0x1c}, {0xeb}},
+			[]models.SuiAddressBytes{{0x01}},
+			models.SuiAddressBytes{0xbe},
+			ticket,
+		)
+		assert.Equal(t, uint16(1), *receipt.Result)
+
+		encoded, err := tx.Marshal()
+		require.NoError(t, err)
+
+		modules := []byte{upgradeCommandTag, 2, 2, 0xa1, 0x1c, 1, 0xeb}
+		command := append(append([]byte{}, modules...), 1)
+		command = append(command, append([]byte{0x01}, make([]byte, 31)...)...)
+		command = append(command, append([]byte{0xbe}, make([]byte, 31)...)...)
+		// Argument::Result(0)
+		command = append(command, 2, 0, 0)
+		assert.True(t, bytes.Contains(encoded, command), "encoded transaction must contain the upgrade command")
+
+		// the SDK encodes the same transaction except for the modules, given as addresses
+		tx.Data.V1.Kind.ProgrammableTransaction.Commands[1].Upgrade.Modules = []models.SuiAddressBytes{{}, {}}
+		sdkEncoded, err := tx.Data.Marshal()
+		require.NoError(t, err)
+		sdkModules := append([]byte{upgradeCommandTag, 2}, make([]byte, 64)...)
+		start := bytes.Index(sdkEncoded, sdkModules)
+		require.Positive(t, start)
+		assert.Equal(t, sdkEncoded[:start], encoded[:start])
+		assert.Equal(t, sdkEncoded[start+len(sdkModules):], encoded[start+len(modules):])
+	})
+}
@@ -0,0 +1,409 @@
+package bind
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/mr-tron/base58"
+
+	bindutils "github.com/smartcontractkit/chainlink-sui/bindings/utils"
+)
+
+// DecodedTransaction is a readable view of a PTB decoded from its TransactionData, to review what a
+// transaction does before signing it.
+type DecodedTransaction struct {
+	Sender     string
+	GasOwner   string
+	GasPayment []models.SuiObjectRef
+	GasPrice   uint64
+	GasBudget  uint64
+	// Expiration is the epoch after which the transaction can't be executed, if any
+	Expiration *uint64
+
+	// Inputs are the inputs of the PTB, e.g. "SharedObject(0x…, initial 3, mutable)"
+	Inputs []string
+	// Commands are the commands of the PTB, e.g. "MoveCall 0x…::counter::increment(Input(0))"
+	Commands []string
+
+	// CallArgs are the decoded inputs of the PTB, in the order of Inputs
+	CallArgs []*transaction.CallArg
+	// RawCommands are the BCS encoded commands of the PTB, in the order of Commands
+	RawCommands [][]byte
+}
+
+func (d *DecodedTransaction) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "sender: %s\n", d.Sender)
+	fmt.Fprintf(&b, "gas: owner %s, price %d, budget %d\n", d.GasOwner, d.GasPrice, d.GasBudget)
+	for _, coin := range d.GasPayment {
+		fmt.Fprintf(&b, "  payment %s@%d %s\n", coin.ObjectId, coin.Version, coin.Digest)
+	}
+	if d.Expiration != nil {
+		fmt.Fprintf(&b, "expiration: epoch %d\n", *d.Expiration)
+	}
+	b.WriteString("inputs:\n")
+	for i, input := range d.Inputs {
+		fmt.Fprintf(&b, "  %d: %s\n", i, input)
+	}
+	b.WriteString("commands:\n")
+	for i, command := range d.Commands {
+		fmt.Fprintf(&b, "  %d: %s\n", i, command)
+	}
+
+	return b.String()
+}
+
+// DecodeTransactionData decodes the BCS of a TransactionData, e.g. built by OfflineContext.BuildTransaction,
+// and verifies that it is a PTB whose arguments refer to existing inputs and results.
+func DecodeTransactionData(txBytes []byte) (*DecodedTransaction, error) {
+	reader := bytes.NewReader(txBytes)
+
+	// TransactionData::V1 and TransactionKind::ProgrammableTransaction
+	for _, expected := range []struct {
+		name string
+		tag  int
+	}{{"TransactionData version", 0}, {"TransactionKind", 0}} {
+		tag, _, err := mystenbcs.ULEB128Decode[int](reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", expected.name, err)
+		}
+		if tag != expected.tag {
+			return nil, fmt.Errorf("unsupported %s %d", expected.name, tag)
+		}
+	}
+
+	var inputs []*transaction.CallArg
+	if err := decodeBCS(reader, &inputs); err != nil {
+		return nil, fmt.Errorf("failed to decode inputs: %w", err)
+	}
+
+	commandCount, _, err := mystenbcs.ULEB128Decode[int](reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode commands: %w", err)
+	}
+	decoded := &DecodedTransaction{
+		Inputs:   make([]string, len(inputs)),
+		CallArgs: inputs,
+	}
+	for i, input := range inputs {
+		decoded.Inputs[i], err = formatCallArg(input)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input %d: %w", i, err)
+		}
+	}
+	for i := range commandCount {
+		start := len(txBytes) - reader.Len()
+		command, commandErr := decodeCommand(reader, len(inputs), i)
+		if commandErr != nil {
+			return nil, fmt.Errorf("invalid command %d: %w", i, commandErr)
+		}
+		decoded.Commands = append(decoded.Commands, command)
+		decoded.RawCommands = append(decoded.RawCommands, txBytes[start:len(txBytes)-reader.Len()])
+	}
+
+	var remaining struct {
+		Sender  models.SuiAddressBytes
+		GasData transaction.GasData
+	}
+	if err = decodeBCS(reader, &remaining); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction data: %w", err)
+	}
+	// TransactionExpiration::None or TransactionExpiration::Epoch
+	expiration, _, err := mystenbcs.ULEB128Decode[int](reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode expiration: %w", err)
+	}
+	switch expiration {
+	case 0:
+	case 1:
+		var epoch uint64
+		if err = binary.Read(reader, binary.LittleEndian, &epoch); err != nil {
+			return nil, fmt.Errorf("failed to decode expiration: %w", err)
+		}
+		decoded.Expiration = &epoch
+	default:
+		return nil, fmt.Errorf("unknown expiration %d", expiration)
+	}
+	if reader.Len() > 0 {
+		return nil, fmt.Errorf("%d unexpected bytes after transaction data", reader.Len())
+	}
+
+	decoded.Sender = formatAddress(remaining.Sender)
+	gas := remaining.GasData
+	if gas.Payment == nil || gas.Owner == nil || gas.Price == nil || gas.Budget == nil {
+		return nil, errors.New("incomplete gas data")
+	}
+	decoded.GasOwner = formatAddress(*gas.Owner)
+	decoded.GasPrice = *gas.Price
+	decoded.GasBudget = *gas.Budget
+	for _, coin := range *gas.Payment {
+		decoded.GasPayment = append(decoded.GasPayment, models.SuiObjectRef{
+			ObjectId: formatAddress(coin.ObjectId),
+			Version:  coin.Version,
+			Digest:   base58.Encode(coin.Digest),
+		})
+	}
+
+	return decoded, nil
+}
+
+// BCS tags of the commands of a ProgrammableTransaction decoded with the SDK types
+const (
+	moveCallCommandTag        = 0
+	transferObjectsCommandTag = 1
+	splitCoinsCommandTag      = 2
+	mergeCoinsCommandTag      = 3
+	makeMoveVecCommandTag     = 5
+)
+
+// bcsArgument is a transaction.Argument whose GasCoin variant can be decoded.
+type bcsArgument struct {
+	GasCoin      *struct{}
+	Input        *uint16
+	Result       *uint16
+	NestedResult *transaction.NestedResult
+}
+
+func (*bcsArgument) IsBcsEnum() {}
+
+type bcsMoveCall struct {
+	Package       models.SuiAddressBytes
+	Module        string
+	Function      string
+	TypeArguments []*transaction.TypeTag
+	Arguments     []*bcsArgument
+}
+
+type bcsTransferObjects struct {
+	Objects []*bcsArgument
+	Address *bcsArgument
+}
+
+type bcsSplitCoins struct {
+	Coin   *bcsArgument
+	Amount []*bcsArgument
+}
+
+type bcsMergeCoins struct {
+	Destination *bcsArgument
+	Sources     []*bcsArgument
+}
+
+type bcsMakeMoveVec struct {
+	Type     *string
+	Elements []*bcsArgument
+}
+
+type bcsUpgradeTarget struct {
+	Package models.SuiAddressBytes
+	Ticket  *bcsArgument
+}
+
+// decodeBCS decodes the next value of the reader, failing instead of panicking on malformed enums.
+func decodeBCS(reader io.Reader, v any) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("malformed BCS: %v", r)
+		}
+	}()
+	_, err = mystenbcs.NewDecoder(reader).Decode(v)
+
+	return err
+}
+
+// decodeCommand decodes the command at the index of a PTB with inputCount inputs. Publish and Upgrade commands are
+// decoded as encoded by packageTransaction, with their compiled modules.
+func decodeCommand(reader *bytes.Reader, inputCount int, index int) (string, error) {
+	tag, _, err := mystenbcs.ULEB128Decode[int](reader)
+	if err != nil {
+		return "", err
+	}
+	args := argumentFormatter{inputCount: inputCount, resultCount: index}
+
+	var formatted string
+	switch tag {
+	case moveCallCommandTag:
+		var call bcsMoveCall
+		if err = decodeBCS(reader, &call); err != nil {
+			return "", err
+		}
+		formatted = fmt.Sprintf("MoveCall %s::%s::%s", formatAddress(call.Package), call.Module, call.Function)
+		if len(call.TypeArguments) > 0 {
+			typeArgs := make([]string, len(call.TypeArguments))
+			for i, typeArg := range call.TypeArguments {
+				typeArgs[i] = typeTagToString(typeArg)
+			}
+			formatted += "<" + strings.Join(typeArgs, ", ") + ">"
+		}
+		formatted += "(" + args.formatAll(call.Arguments) + ")"
+	case transferObjectsCommandTag:
+		var transfer bcsTransferObjects
+		if err = decodeBCS(reader, &transfer); err != nil {
+			return "", err
+		}
+		formatted = fmt.Sprintf("TransferObjects([%s], %s)", args.formatAll(transfer.Objects), args.format(transfer.Address))
+	case splitCoinsCommandTag:
+		var split bcsSplitCoins
+		if err = decodeBCS(reader, &split); err != nil {
+			return "", err
+		}
+		formatted = fmt.Sprintf("SplitCoins(%s, [%s])", args.format(split.Coin), args.formatAll(split.Amount))
+	case mergeCoinsCommandTag:
+		var merge bcsMergeCoins
+		if err = decodeBCS(reader, &merge); err != nil {
+			return "", err
+		}
+		formatted = fmt.Sprintf("MergeCoins(%s, [%s])", args.format(merge.Destination), args.formatAll(merge.Sources))
+	case makeMoveVecCommandTag:
+		var vec bcsMakeMoveVec
+		if err = decodeBCS(reader, &vec); err != nil {
+			return "", err
+		}
+		vecType := "_"
+		if vec.Type != nil {
+			vecType = *vec.Type
+		}
+		formatted = fmt.Sprintf("MakeMoveVec<%s>([%s])", vecType, args.formatAll(vec.Elements))
+	case publishCommandTag, upgradeCommandTag:
+		modules, dependencies, decodeErr := decodeModulesAndDependencies(reader)
+		if decodeErr != nil {
+			return "", decodeErr
+		}
+		if tag == publishCommandTag {
+			return fmt.Sprintf("Publish(%d modules, dependencies [%s])", modules, dependencies), nil
+		}
+
+		var target bcsUpgradeTarget
+		if err = decodeBCS(reader, &target); err != nil {
+			return "", err
+		}
+		formatted = fmt.Sprintf("Upgrade(%d modules, dependencies [%s], package %s, ticket %s)",
+			modules, dependencies, formatAddress(target.Package), args.format(target.Ticket))
+	default:
+		return "", fmt.Errorf("unknown command %d", tag)
+	}
+
+	return formatted, args.err
+}
+
+// decodeModulesAndDependencies decodes the modules and dependencies of a Publish or Upgrade command, and returns
+// the number of modules and the list of dependencies.
+func decodeModulesAndDependencies(reader *bytes.Reader) (int, string, error) {
+	moduleCount, _, err := mystenbcs.ULEB128Decode[int](reader)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to decode modules: %w", err)
+	}
+	for i := range moduleCount {
+		size, _, sizeErr := mystenbcs.ULEB128Decode[int](reader)
+		if sizeErr != nil {
+			return 0, "", fmt.Errorf("failed to decode module %d: %w", i, sizeErr)
+		}
+		if size > reader.Len() {
+			return 0, "", fmt.Errorf("failed to decode module %d: %w", i, io.ErrUnexpectedEOF)
+		}
+		if _, err = reader.Seek(int64(size), io.SeekCurrent); err != nil {
+			return 0, "", fmt.Errorf("failed to decode module %d: %w", i, err)
+		}
+	}
+
+	var dependencies []models.SuiAddressBytes
+	dependencyCount, _, err := mystenbcs.ULEB128Decode[int](reader)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to decode dependencies: %w", err)
+	}
+	for range dependencyCount {
+		var dependency models.SuiAddressBytes
+		if _, err = io.ReadFull(reader, dependency[:]); err != nil {
+			return 0, "", fmt.Errorf("failed to decode dependencies: %w", err)
+		}
+		dependencies = append(dependencies, dependency)
+	}
+
+	formatted := make([]string, len(dependencies))
+	for i, dependency := range dependencies {
+		formatted[i] = formatAddress(dependency)
+	}
+
+	return moduleCount, strings.Join(formatted, ", "), nil
+}
+
+func formatCallArg(arg *transaction.CallArg) (string, error) {
+	switch {
+	case arg.Pure != nil:
+		return fmt.Sprintf("Pure(0x%s)", hex.EncodeToString(arg.Pure.Bytes)), nil
+	case arg.Object != nil && arg.Object.ImmOrOwnedObject != nil:
+		return "ImmOrOwnedObject(" + formatObjectRef(arg.Object.ImmOrOwnedObject) + ")", nil
+	case arg.Object != nil && arg.Object.Receiving != nil:
+		return "Receiving(" + formatObjectRef(arg.Object.Receiving) + ")", nil
+	case arg.Object != nil && arg.Object.SharedObject != nil:
+		shared := arg.Object.SharedObject
+		mutability := "immutable"
+		if shared.Mutable {
+			mutability = "mutable"
+		}
+
+		return fmt.Sprintf("SharedObject(%s, initial %d, %s)",
+			formatAddress(shared.ObjectId), shared.InitialSharedVersion, mutability), nil
+	default:
+		return "", errors.New("input must be Pure or Object")
+	}
+}
+
+func formatObjectRef(ref *transaction.SuiObjectRef) string {
+	return fmt.Sprintf("%s@%d %s", formatAddress(ref.ObjectId), ref.Version, base58.Encode(ref.Digest))
+}
+
+func formatAddress(address models.SuiAddressBytes) string {
+	formatted, _ := bindutils.ConvertBytesToAddress(address[:])
+	return formatted
+}
+
+// argumentFormatter formats the arguments of a command, recording the first argument referring to an input or
+// result that doesn't exist yet.
+type argumentFormatter struct {
+	inputCount  int
+	resultCount int
+	err         error
+}
+
+func (f *argumentFormatter) formatAll(args []*bcsArgument) string {
+	formatted := make([]string, len(args))
+	for i, arg := range args {
+		formatted[i] = f.format(arg)
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+func (f *argumentFormatter) format(arg *bcsArgument) string {
+	var formatted string
+	var invalid bool
+	switch {
+	case arg == nil:
+		formatted, invalid = "<nil>", true
+	case arg.Input != nil:
+		formatted, invalid = fmt.Sprintf("Input(%d)", *arg.Input), int(*arg.Input) >= f.inputCount
+	case arg.Result != nil:
+		formatted, invalid = fmt.Sprintf("Result(%d)", *arg.Result), int(*arg.Result) >= f.resultCount
+	case arg.NestedResult != nil:
+		formatted = fmt.Sprintf("NestedResult(%d, %d)", arg.NestedResult.Index, arg.NestedResult.ResultIndex)
+		invalid = int(arg.NestedResult.Index) >= f.resultCount
+	case arg.GasCoin != nil:
+		formatted = "GasCoin"
+	default:
+		formatted, invalid = "<empty>", true
+	}
+	if invalid && f.err == nil {
+		f.err = fmt.Errorf("argument %s refers to a missing input or result", formatted)
+	}
+
+	return formatted
+}
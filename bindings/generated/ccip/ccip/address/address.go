@@ -0,0 +1,157 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package module_address
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+)
+
+var (
+	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
+)
+
+type IAddress interface {
+	AssertNonZeroAddressVector(ctx context.Context, opts *bind.CallOpts, addr []byte) (*models.SuiTransactionBlockResponse, error)
+	AssertNonZeroAddress(ctx context.Context, opts *bind.CallOpts, addr string) (*models.SuiTransactionBlockResponse, error)
+	DevInspect() IAddressDevInspect
+	Encoder() AddressEncoder
+	Bound() bind.IBoundContract
+}
+
+type IAddressDevInspect interface {
+}
+
+type AddressEncoder interface {
+	AssertNonZeroAddressVector(addr []byte) (*bind.EncodedCall, error)
+	AssertNonZeroAddressVectorWithArgs(args ...any) (*bind.EncodedCall, error)
+	AssertNonZeroAddress(addr string) (*bind.EncodedCall, error)
+	AssertNonZeroAddressWithArgs(args ...any) (*bind.EncodedCall, error)
+}
+
+type AddressContract struct {
+	*bind.BoundContract
+	addressEncoder
+	devInspect *AddressDevInspect
+}
+
+type AddressDevInspect struct {
+	contract *AddressContract
+}
+
+var _ IAddress = (*AddressContract)(nil)
+var _ IAddressDevInspect = (*AddressDevInspect)(nil)
+
+func NewAddress(packageID string, client sui.ISuiAPI) (IAddress, error) {
+	contract, err := bind.NewBoundContract(packageID, "ccip", "address", client)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &AddressContract{
+		BoundContract:  contract,
+		addressEncoder: addressEncoder{BoundContract: contract},
+	}
+	c.devInspect = &AddressDevInspect{contract: c}
+	return c, nil
+}
+
+func (c *AddressContract) Bound() bind.IBoundContract {
+	return c.BoundContract
+}
+
+func (c *AddressContract) Encoder() AddressEncoder {
+	return c.addressEncoder
+}
+
+func (c *AddressContract) DevInspect() IAddressDevInspect {
+	return c.devInspect
+}
+
+func init() {
+}
+
+// AssertNonZeroAddressVector executes the assert_non_zero_address_vector Move function.
+func (c *AddressContract) AssertNonZeroAddressVector(ctx context.Context, opts *bind.CallOpts, addr []byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.addressEncoder.AssertNonZeroAddressVector(addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// AssertNonZeroAddress executes the assert_non_zero_address Move function.
+func (c *AddressContract) AssertNonZeroAddress(ctx context.Context, opts *bind.CallOpts, addr string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.addressEncoder.AssertNonZeroAddress(addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+type addressEncoder struct {
+	*bind.BoundContract
+}
+
+// AssertNonZeroAddressVector encodes a call to the assert_non_zero_address_vector Move function.
+func (c addressEncoder) AssertNonZeroAddressVector(addr []byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("assert_non_zero_address_vector", typeArgsList, typeParamsList, []string{
+		"&vector<u8>",
+	}, []any{
+		addr,
+	}, nil)
+}
+
+// AssertNonZeroAddressVectorWithArgs encodes a call to the assert_non_zero_address_vector Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c addressEncoder) AssertNonZeroAddressVectorWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&vector<u8>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("assert_non_zero_address_vector", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// AssertNonZeroAddress encodes a call to the assert_non_zero_address Move function.
+func (c addressEncoder) AssertNonZeroAddress(addr string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("assert_non_zero_address", typeArgsList, typeParamsList, []string{
+		"address",
+	}, []any{
+		addr,
+	}, nil)
+}
+
+// AssertNonZeroAddressWithArgs encodes a call to the assert_non_zero_address Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c addressEncoder) AssertNonZeroAddressWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"address",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("assert_non_zero_address", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
@@ -0,0 +1,574 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package module_allowlist
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+)
+
+var (
+	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
+)
+
+type IAllowlist interface {
+	New(ctx context.Context, opts *bind.CallOpts, allowlist []string) (*models.SuiTransactionBlockResponse, error)
+	GetAllowlistEnabled(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
+	SetAllowlistEnabled(ctx context.Context, opts *bind.CallOpts, state bind.Object, enabled bool) (*models.SuiTransactionBlockResponse, error)
+	GetAllowlist(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
+	IsAllowed(ctx context.Context, opts *bind.CallOpts, state bind.Object, sender string) (*models.SuiTransactionBlockResponse, error)
+	ApplyAllowlistUpdates(ctx context.Context, opts *bind.CallOpts, state bind.Object, removes []string, adds []string) (*models.SuiTransactionBlockResponse, error)
+	DestroyAllowlist(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
+	FilterAllowlistRemove(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[AllowlistRemove], error)
+	WatchAllowlistRemove(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[AllowlistRemove]) (*bind.EventSubscription, error)
+	FilterAllowlistAdd(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[AllowlistAdd], error)
+	WatchAllowlistAdd(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[AllowlistAdd]) (*bind.EventSubscription, error)
+	DevInspect() IAllowlistDevInspect
+	Encoder() AllowlistEncoder
+	Bound() bind.IBoundContract
+}
+
+type IAllowlistDevInspect interface {
+	New(ctx context.Context, opts *bind.CallOpts, allowlist []string) (bind.Object, error)
+	GetAllowlistEnabled(ctx context.Context, opts *bind.CallOpts, state bind.Object) (bool, error)
+	GetAllowlist(ctx context.Context, opts *bind.CallOpts, state bind.Object) ([]string, error)
+	IsAllowed(ctx context.Context, opts *bind.CallOpts, state bind.Object, sender string) (bool, error)
+}
+
+type AllowlistEncoder interface {
+	New(allowlist []string) (*bind.EncodedCall, error)
+	NewWithArgs(args ...any) (*bind.EncodedCall, error)
+	GetAllowlistEnabled(state bind.Object) (*bind.EncodedCall, error)
+	GetAllowlistEnabledWithArgs(args ...any) (*bind.EncodedCall, error)
+	SetAllowlistEnabled(state bind.Object, enabled bool) (*bind.EncodedCall, error)
+	SetAllowlistEnabledWithArgs(args ...any) (*bind.EncodedCall, error)
+	GetAllowlist(state bind.Object) (*bind.EncodedCall, error)
+	GetAllowlistWithArgs(args ...any) (*bind.EncodedCall, error)
+	IsAllowed(state bind.Object, sender string) (*bind.EncodedCall, error)
+	IsAllowedWithArgs(args ...any) (*bind.EncodedCall, error)
+	ApplyAllowlistUpdates(state bind.Object, removes []string, adds []string) (*bind.EncodedCall, error)
+	ApplyAllowlistUpdatesWithArgs(args ...any) (*bind.EncodedCall, error)
+	DestroyAllowlist(state bind.Object) (*bind.EncodedCall, error)
+	DestroyAllowlistWithArgs(args ...any) (*bind.EncodedCall, error)
+}
+
+type AllowlistContract struct {
+	*bind.BoundContract
+	allowlistEncoder
+	devInspect *AllowlistDevInspect
+}
+
+type AllowlistDevInspect struct {
+	contract *AllowlistContract
+}
+
+var _ IAllowlist = (*AllowlistContract)(nil)
+var _ IAllowlistDevInspect = (*AllowlistDevInspect)(nil)
+
+func NewAllowlist(packageID string, client sui.ISuiAPI) (IAllowlist, error) {
+	contract, err := bind.NewBoundContract(packageID, "ccip", "allowlist", client)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &AllowlistContract{
+		BoundContract:    contract,
+		allowlistEncoder: allowlistEncoder{BoundContract: contract},
+	}
+	c.devInspect = &AllowlistDevInspect{contract: c}
+	return c, nil
+}
+
+func (c *AllowlistContract) Bound() bind.IBoundContract {
+	return c.BoundContract
+}
+
+func (c *AllowlistContract) Encoder() AllowlistEncoder {
+	return c.allowlistEncoder
+}
+
+func (c *AllowlistContract) DevInspect() IAllowlistDevInspect {
+	return c.devInspect
+}
+
+type AllowlistRemove struct {
+	Sender string `move:"address"`
+}
+
+type AllowlistAdd struct {
+	Sender string `move:"address"`
+}
+
+type bcsAllowlistRemove struct {
+	Sender [32]byte
+}
+
+func convertAllowlistRemoveFromBCS(bcs bcsAllowlistRemove) (AllowlistRemove, error) {
+
+	return AllowlistRemove{
+		Sender: fmt.Sprintf("0x%x", bcs.Sender),
+	}, nil
+}
+
+type bcsAllowlistAdd struct {
+	Sender [32]byte
+}
+
+func convertAllowlistAddFromBCS(bcs bcsAllowlistAdd) (AllowlistAdd, error) {
+
+	return AllowlistAdd{
+		Sender: fmt.Sprintf("0x%x", bcs.Sender),
+	}, nil
+}
+
+func init() {
+	bind.RegisterStructDecoder("ccip::allowlist::AllowlistRemove", func(data []byte) (interface{}, error) {
+		var temp bcsAllowlistRemove
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertAllowlistRemoveFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip::allowlist::AllowlistAdd", func(data []byte) (interface{}, error) {
+		var temp bcsAllowlistAdd
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertAllowlistAddFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
+// DecodeAllowlistRemove decodes the BCS of a AllowlistRemove event.
+func DecodeAllowlistRemove(data []byte) (AllowlistRemove, error) {
+	var temp bcsAllowlistRemove
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return AllowlistRemove{}, err
+	}
+
+	return convertAllowlistRemoveFromBCS(temp)
+}
+
+// FilterAllowlistRemove returns a page of the AllowlistRemove events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *AllowlistContract) FilterAllowlistRemove(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[AllowlistRemove], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "AllowlistRemove", cursor, limit, DecodeAllowlistRemove)
+}
+
+// WatchAllowlistRemove polls the AllowlistRemove events and sends them to ch until the subscription is stopped.
+func (c *AllowlistContract) WatchAllowlistRemove(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[AllowlistRemove]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "AllowlistRemove", opts, ch, DecodeAllowlistRemove)
+}
+
+// DecodeAllowlistAdd decodes the BCS of a AllowlistAdd event.
+func DecodeAllowlistAdd(data []byte) (AllowlistAdd, error) {
+	var temp bcsAllowlistAdd
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return AllowlistAdd{}, err
+	}
+
+	return convertAllowlistAddFromBCS(temp)
+}
+
+// FilterAllowlistAdd returns a page of the AllowlistAdd events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *AllowlistContract) FilterAllowlistAdd(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[AllowlistAdd], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "AllowlistAdd", cursor, limit, DecodeAllowlistAdd)
+}
+
+// WatchAllowlistAdd polls the AllowlistAdd events and sends them to ch until the subscription is stopped.
+func (c *AllowlistContract) WatchAllowlistAdd(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[AllowlistAdd]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "AllowlistAdd", opts, ch, DecodeAllowlistAdd)
+}
+
+// New executes the new Move function.
+func (c *AllowlistContract) New(ctx context.Context, opts *bind.CallOpts, allowlist []string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.allowlistEncoder.New(allowlist)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// GetAllowlistEnabled executes the get_allowlist_enabled Move function.
+func (c *AllowlistContract) GetAllowlistEnabled(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.allowlistEncoder.GetAllowlistEnabled(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// SetAllowlistEnabled executes the set_allowlist_enabled Move function.
+func (c *AllowlistContract) SetAllowlistEnabled(ctx context.Context, opts *bind.CallOpts, state bind.Object, enabled bool) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.allowlistEncoder.SetAllowlistEnabled(state, enabled)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// GetAllowlist executes the get_allowlist Move function.
+func (c *AllowlistContract) GetAllowlist(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.allowlistEncoder.GetAllowlist(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// IsAllowed executes the is_allowed Move function.
+func (c *AllowlistContract) IsAllowed(ctx context.Context, opts *bind.CallOpts, state bind.Object, sender string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.allowlistEncoder.IsAllowed(state, sender)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// ApplyAllowlistUpdates executes the apply_allowlist_updates Move function.
+func (c *AllowlistContract) ApplyAllowlistUpdates(ctx context.Context, opts *bind.CallOpts, state bind.Object, removes []string, adds []string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.allowlistEncoder.ApplyAllowlistUpdates(state, removes, adds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DestroyAllowlist executes the destroy_allowlist Move function.
+func (c *AllowlistContract) DestroyAllowlist(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.allowlistEncoder.DestroyAllowlist(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// New executes the new Move function using DevInspect to get return values.
+//
+// Returns: AllowlistState
+func (d *AllowlistDevInspect) New(ctx context.Context, opts *bind.CallOpts, allowlist []string) (bind.Object, error) {
+	encoded, err := d.contract.allowlistEncoder.New(allowlist)
+	if err != nil {
+		return bind.Object{}, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return bind.Object{}, err
+	}
+	if len(results) == 0 {
+		return bind.Object{}, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(bind.Object)
+	if !ok {
+		return bind.Object{}, fmt.Errorf("unexpected return type: expected bind.Object, got %T", results[0])
+	}
+	return result, nil
+}
+
+// GetAllowlistEnabled executes the get_allowlist_enabled Move function using DevInspect to get return values.
+//
+// Returns: bool
+func (d *AllowlistDevInspect) GetAllowlistEnabled(ctx context.Context, opts *bind.CallOpts, state bind.Object) (bool, error) {
+	encoded, err := d.contract.allowlistEncoder.GetAllowlistEnabled(state)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return false, err
+	}
+	if len(results) == 0 {
+		return false, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected return type: expected bool, got %T", results[0])
+	}
+	return result, nil
+}
+
+// GetAllowlist executes the get_allowlist Move function using DevInspect to get return values.
+//
+// Returns: vector<address>
+func (d *AllowlistDevInspect) GetAllowlist(ctx context.Context, opts *bind.CallOpts, state bind.Object) ([]string, error) {
+	encoded, err := d.contract.allowlistEncoder.GetAllowlist(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []string, got %T", results[0])
+	}
+	return result, nil
+}
+
+// IsAllowed executes the is_allowed Move function using DevInspect to get return values.
+//
+// Returns: bool
+func (d *AllowlistDevInspect) IsAllowed(ctx context.Context, opts *bind.CallOpts, state bind.Object, sender string) (bool, error) {
+	encoded, err := d.contract.allowlistEncoder.IsAllowed(state, sender)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return false, err
+	}
+	if len(results) == 0 {
+		return false, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected return type: expected bool, got %T", results[0])
+	}
+	return result, nil
+}
+
+type allowlistEncoder struct {
+	*bind.BoundContract
+}
+
+// New encodes a call to the new Move function.
+func (c allowlistEncoder) New(allowlist []string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("new", typeArgsList, typeParamsList, []string{
+		"vector<address>",
+	}, []any{
+		allowlist,
+	}, []string{
+		"AllowlistState",
+	})
+}
+
+// NewWithArgs encodes a call to the new Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c allowlistEncoder) NewWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"vector<address>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("new", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"AllowlistState",
+	})
+}
+
+// GetAllowlistEnabled encodes a call to the get_allowlist_enabled Move function.
+func (c allowlistEncoder) GetAllowlistEnabled(state bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_allowlist_enabled", typeArgsList, typeParamsList, []string{
+		"&AllowlistState",
+	}, []any{
+		state,
+	}, []string{
+		"bool",
+	})
+}
+
+// GetAllowlistEnabledWithArgs encodes a call to the get_allowlist_enabled Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c allowlistEncoder) GetAllowlistEnabledWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&AllowlistState",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_allowlist_enabled", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"bool",
+	})
+}
+
+// SetAllowlistEnabled encodes a call to the set_allowlist_enabled Move function.
+func (c allowlistEncoder) SetAllowlistEnabled(state bind.Object, enabled bool) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("set_allowlist_enabled", typeArgsList, typeParamsList, []string{
+		"&mut AllowlistState",
+		"bool",
+	}, []any{
+		state,
+		enabled,
+	}, nil)
+}
+
+// SetAllowlistEnabledWithArgs encodes a call to the set_allowlist_enabled Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c allowlistEncoder) SetAllowlistEnabledWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut AllowlistState",
+		"bool",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("set_allowlist_enabled", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// GetAllowlist encodes a call to the get_allowlist Move function.
+func (c allowlistEncoder) GetAllowlist(state bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_allowlist", typeArgsList, typeParamsList, []string{
+		"&AllowlistState",
+	}, []any{
+		state,
+	}, []string{
+		"vector<address>",
+	})
+}
+
+// GetAllowlistWithArgs encodes a call to the get_allowlist Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c allowlistEncoder) GetAllowlistWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&AllowlistState",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_allowlist", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<address>",
+	})
+}
+
+// IsAllowed encodes a call to the is_allowed Move function.
+func (c allowlistEncoder) IsAllowed(state bind.Object, sender string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("is_allowed", typeArgsList, typeParamsList, []string{
+		"&AllowlistState",
+		"address",
+	}, []any{
+		state,
+		sender,
+	}, []string{
+		"bool",
+	})
+}
+
+// IsAllowedWithArgs encodes a call to the is_allowed Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c allowlistEncoder) IsAllowedWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&AllowlistState",
+		"address",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("is_allowed", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"bool",
+	})
+}
+
+// ApplyAllowlistUpdates encodes a call to the apply_allowlist_updates Move function.
+func (c allowlistEncoder) ApplyAllowlistUpdates(state bind.Object, removes []string, adds []string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("apply_allowlist_updates", typeArgsList, typeParamsList, []string{
+		"&mut AllowlistState",
+		"vector<address>",
+		"vector<address>",
+	}, []any{
+		state,
+		removes,
+		adds,
+	}, nil)
+}
+
+// ApplyAllowlistUpdatesWithArgs encodes a call to the apply_allowlist_updates Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c allowlistEncoder) ApplyAllowlistUpdatesWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut AllowlistState",
+		"vector<address>",
+		"vector<address>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("apply_allowlist_updates", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// DestroyAllowlist encodes a call to the destroy_allowlist Move function.
+func (c allowlistEncoder) DestroyAllowlist(state bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("destroy_allowlist", typeArgsList, typeParamsList, []string{
+		"AllowlistState",
+	}, []any{
+		state,
+	}, nil)
+}
+
+// DestroyAllowlistWithArgs encodes a call to the destroy_allowlist Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c allowlistEncoder) DestroyAllowlistWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"AllowlistState",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("destroy_allowlist", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
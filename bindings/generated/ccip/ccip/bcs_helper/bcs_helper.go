@@ -0,0 +1,164 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package module_bcs_helper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	module_bcs_stream "github.com/smartcontractkit/chainlink-sui/bindings/generated/mcms/bcs_stream"
+)
+
+var (
+	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
+)
+
+type IBcsHelper interface {
+	ValidateObjAddr(ctx context.Context, opts *bind.CallOpts, addr string, stream module_bcs_stream.BCSStream) (*models.SuiTransactionBlockResponse, error)
+	ValidateObjAddrs(ctx context.Context, opts *bind.CallOpts, addrs []string, stream module_bcs_stream.BCSStream) (*models.SuiTransactionBlockResponse, error)
+	DevInspect() IBcsHelperDevInspect
+	Encoder() BcsHelperEncoder
+	Bound() bind.IBoundContract
+}
+
+type IBcsHelperDevInspect interface {
+}
+
+type BcsHelperEncoder interface {
+	ValidateObjAddr(addr string, stream module_bcs_stream.BCSStream) (*bind.EncodedCall, error)
+	ValidateObjAddrWithArgs(args ...any) (*bind.EncodedCall, error)
+	ValidateObjAddrs(addrs []string, stream module_bcs_stream.BCSStream) (*bind.EncodedCall, error)
+	ValidateObjAddrsWithArgs(args ...any) (*bind.EncodedCall, error)
+}
+
+type BcsHelperContract struct {
+	*bind.BoundContract
+	bcsHelperEncoder
+	devInspect *BcsHelperDevInspect
+}
+
+type BcsHelperDevInspect struct {
+	contract *BcsHelperContract
+}
+
+var _ IBcsHelper = (*BcsHelperContract)(nil)
+var _ IBcsHelperDevInspect = (*BcsHelperDevInspect)(nil)
+
+func NewBcsHelper(packageID string, client sui.ISuiAPI) (IBcsHelper, error) {
+	contract, err := bind.NewBoundContract(packageID, "ccip", "bcs_helper", client)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &BcsHelperContract{
+		BoundContract:    contract,
+		bcsHelperEncoder: bcsHelperEncoder{BoundContract: contract},
+	}
+	c.devInspect = &BcsHelperDevInspect{contract: c}
+	return c, nil
+}
+
+func (c *BcsHelperContract) Bound() bind.IBoundContract {
+	return c.BoundContract
+}
+
+func (c *BcsHelperContract) Encoder() BcsHelperEncoder {
+	return c.bcsHelperEncoder
+}
+
+func (c *BcsHelperContract) DevInspect() IBcsHelperDevInspect {
+	return c.devInspect
+}
+
+func init() {
+}
+
+// ValidateObjAddr executes the validate_obj_addr Move function.
+func (c *BcsHelperContract) ValidateObjAddr(ctx context.Context, opts *bind.CallOpts, addr string, stream module_bcs_stream.BCSStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsHelperEncoder.ValidateObjAddr(addr, stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// ValidateObjAddrs executes the validate_obj_addrs Move function.
+func (c *BcsHelperContract) ValidateObjAddrs(ctx context.Context, opts *bind.CallOpts, addrs []string, stream module_bcs_stream.BCSStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsHelperEncoder.ValidateObjAddrs(addrs, stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+type bcsHelperEncoder struct {
+	*bind.BoundContract
+}
+
+// ValidateObjAddr encodes a call to the validate_obj_addr Move function.
+func (c bcsHelperEncoder) ValidateObjAddr(addr string, stream module_bcs_stream.BCSStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("validate_obj_addr", typeArgsList, typeParamsList, []string{
+		"address",
+		"&mut BCSStream",
+	}, []any{
+		addr,
+		stream,
+	}, nil)
+}
+
+// ValidateObjAddrWithArgs encodes a call to the validate_obj_addr Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsHelperEncoder) ValidateObjAddrWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"address",
+		"&mut BCSStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("validate_obj_addr", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// ValidateObjAddrs encodes a call to the validate_obj_addrs Move function.
+func (c bcsHelperEncoder) ValidateObjAddrs(addrs []string, stream module_bcs_stream.BCSStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("validate_obj_addrs", typeArgsList, typeParamsList, []string{
+		"vector<address>",
+		"&mut BCSStream",
+	}, []any{
+		addrs,
+		stream,
+	}, nil)
+}
+
+// ValidateObjAddrsWithArgs encodes a call to the validate_obj_addrs Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsHelperEncoder) ValidateObjAddrsWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"vector<address>",
+		"&mut BCSStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("validate_obj_addrs", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
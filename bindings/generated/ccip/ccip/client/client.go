@@ -0,0 +1,1146 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package module_client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+)
+
+var (
+	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
+)
+
+type IClient interface {
+	SuiExtraArgsV1Tag(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error)
+	EncodeSuiExtraArgsV1(ctx context.Context, opts *bind.CallOpts, gasLimit uint64, allowOutOfOrderExecution bool, tokenReceiver []byte, receiverObjectIds [][]byte) (*models.SuiTransactionBlockResponse, error)
+	EncodeGenericExtraArgsV2(ctx context.Context, opts *bind.CallOpts, gasLimit *big.Int, allowOutOfOrderExecution bool) (*models.SuiTransactionBlockResponse, error)
+	EncodeSvmExtraArgsV1(ctx context.Context, opts *bind.CallOpts, computeUnits uint32, accountIsWritableBitmap uint64, allowOutOfOrderExecution bool, tokenReceiver []byte, accounts [][]byte) (*models.SuiTransactionBlockResponse, error)
+	NewAny2suiMessage(ctx context.Context, opts *bind.CallOpts, messageId []byte, sourceChainSelector uint64, sender []byte, data []byte, destTokenAmounts []Any2SuiTokenAmount) (*models.SuiTransactionBlockResponse, error)
+	ConsumeAny2suiMessage(ctx context.Context, opts *bind.CallOpts, message Any2SuiMessage) (*models.SuiTransactionBlockResponse, error)
+	NewDestTokenAmounts(ctx context.Context, opts *bind.CallOpts, tokenAddresses []string, tokenAmounts []uint64) (*models.SuiTransactionBlockResponse, error)
+	GetMessageId(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) (*models.SuiTransactionBlockResponse, error)
+	GetSourceChainSelector(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) (*models.SuiTransactionBlockResponse, error)
+	GetSender(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) (*models.SuiTransactionBlockResponse, error)
+	GetData(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) (*models.SuiTransactionBlockResponse, error)
+	GetDestTokenAmounts(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) (*models.SuiTransactionBlockResponse, error)
+	GetToken(ctx context.Context, opts *bind.CallOpts, input Any2SuiTokenAmount) (*models.SuiTransactionBlockResponse, error)
+	GetAmount(ctx context.Context, opts *bind.CallOpts, input Any2SuiTokenAmount) (*models.SuiTransactionBlockResponse, error)
+	GetTokenAndAmount(ctx context.Context, opts *bind.CallOpts, input Any2SuiTokenAmount) (*models.SuiTransactionBlockResponse, error)
+	DevInspect() IClientDevInspect
+	Encoder() ClientEncoder
+	Bound() bind.IBoundContract
+}
+
+type IClientDevInspect interface {
+	SuiExtraArgsV1Tag(ctx context.Context, opts *bind.CallOpts) ([]byte, error)
+	EncodeSuiExtraArgsV1(ctx context.Context, opts *bind.CallOpts, gasLimit uint64, allowOutOfOrderExecution bool, tokenReceiver []byte, receiverObjectIds [][]byte) ([]byte, error)
+	EncodeGenericExtraArgsV2(ctx context.Context, opts *bind.CallOpts, gasLimit *big.Int, allowOutOfOrderExecution bool) ([]byte, error)
+	EncodeSvmExtraArgsV1(ctx context.Context, opts *bind.CallOpts, computeUnits uint32, accountIsWritableBitmap uint64, allowOutOfOrderExecution bool, tokenReceiver []byte, accounts [][]byte) ([]byte, error)
+	NewAny2suiMessage(ctx context.Context, opts *bind.CallOpts, messageId []byte, sourceChainSelector uint64, sender []byte, data []byte, destTokenAmounts []Any2SuiTokenAmount) (Any2SuiMessage, error)
+	ConsumeAny2suiMessage(ctx context.Context, opts *bind.CallOpts, message Any2SuiMessage) ([]any, error)
+	NewDestTokenAmounts(ctx context.Context, opts *bind.CallOpts, tokenAddresses []string, tokenAmounts []uint64) ([]Any2SuiTokenAmount, error)
+	GetMessageId(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) ([]byte, error)
+	GetSourceChainSelector(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) (uint64, error)
+	GetSender(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) ([]byte, error)
+	GetData(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) ([]byte, error)
+	GetDestTokenAmounts(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) ([]Any2SuiTokenAmount, error)
+	GetToken(ctx context.Context, opts *bind.CallOpts, input Any2SuiTokenAmount) (string, error)
+	GetAmount(ctx context.Context, opts *bind.CallOpts, input Any2SuiTokenAmount) (uint64, error)
+	GetTokenAndAmount(ctx context.Context, opts *bind.CallOpts, input Any2SuiTokenAmount) ([]any, error)
+}
+
+type ClientEncoder interface {
+	SuiExtraArgsV1Tag() (*bind.EncodedCall, error)
+	SuiExtraArgsV1TagWithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodeSuiExtraArgsV1(gasLimit uint64, allowOutOfOrderExecution bool, tokenReceiver []byte, receiverObjectIds [][]byte) (*bind.EncodedCall, error)
+	EncodeSuiExtraArgsV1WithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodeGenericExtraArgsV2(gasLimit *big.Int, allowOutOfOrderExecution bool) (*bind.EncodedCall, error)
+	EncodeGenericExtraArgsV2WithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodeSvmExtraArgsV1(computeUnits uint32, accountIsWritableBitmap uint64, allowOutOfOrderExecution bool, tokenReceiver []byte, accounts [][]byte) (*bind.EncodedCall, error)
+	EncodeSvmExtraArgsV1WithArgs(args ...any) (*bind.EncodedCall, error)
+	NewAny2suiMessage(messageId []byte, sourceChainSelector uint64, sender []byte, data []byte, destTokenAmounts []Any2SuiTokenAmount) (*bind.EncodedCall, error)
+	NewAny2suiMessageWithArgs(args ...any) (*bind.EncodedCall, error)
+	ConsumeAny2suiMessage(message Any2SuiMessage) (*bind.EncodedCall, error)
+	ConsumeAny2suiMessageWithArgs(args ...any) (*bind.EncodedCall, error)
+	NewDestTokenAmounts(tokenAddresses []string, tokenAmounts []uint64) (*bind.EncodedCall, error)
+	NewDestTokenAmountsWithArgs(args ...any) (*bind.EncodedCall, error)
+	GetMessageId(input Any2SuiMessage) (*bind.EncodedCall, error)
+	GetMessageIdWithArgs(args ...any) (*bind.EncodedCall, error)
+	GetSourceChainSelector(input Any2SuiMessage) (*bind.EncodedCall, error)
+	GetSourceChainSelectorWithArgs(args ...any) (*bind.EncodedCall, error)
+	GetSender(input Any2SuiMessage) (*bind.EncodedCall, error)
+	GetSenderWithArgs(args ...any) (*bind.EncodedCall, error)
+	GetData(input Any2SuiMessage) (*bind.EncodedCall, error)
+	GetDataWithArgs(args ...any) (*bind.EncodedCall, error)
+	GetDestTokenAmounts(input Any2SuiMessage) (*bind.EncodedCall, error)
+	GetDestTokenAmountsWithArgs(args ...any) (*bind.EncodedCall, error)
+	GetToken(input Any2SuiTokenAmount) (*bind.EncodedCall, error)
+	GetTokenWithArgs(args ...any) (*bind.EncodedCall, error)
+	GetAmount(input Any2SuiTokenAmount) (*bind.EncodedCall, error)
+	GetAmountWithArgs(args ...any) (*bind.EncodedCall, error)
+	GetTokenAndAmount(input Any2SuiTokenAmount) (*bind.EncodedCall, error)
+	GetTokenAndAmountWithArgs(args ...any) (*bind.EncodedCall, error)
+}
+
+type ClientContract struct {
+	*bind.BoundContract
+	clientEncoder
+	devInspect *ClientDevInspect
+}
+
+type ClientDevInspect struct {
+	contract *ClientContract
+}
+
+var _ IClient = (*ClientContract)(nil)
+var _ IClientDevInspect = (*ClientDevInspect)(nil)
+
+func NewClient(packageID string, client sui.ISuiAPI) (IClient, error) {
+	contract, err := bind.NewBoundContract(packageID, "ccip", "client", client)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &ClientContract{
+		BoundContract: contract,
+		clientEncoder: clientEncoder{BoundContract: contract},
+	}
+	c.devInspect = &ClientDevInspect{contract: c}
+	return c, nil
+}
+
+func (c *ClientContract) Bound() bind.IBoundContract {
+	return c.BoundContract
+}
+
+func (c *ClientContract) Encoder() ClientEncoder {
+	return c.clientEncoder
+}
+
+func (c *ClientContract) DevInspect() IClientDevInspect {
+	return c.devInspect
+}
+
+type Any2SuiMessage struct {
+	MessageId           []byte               `move:"vector<u8>"`
+	SourceChainSelector uint64               `move:"u64"`
+	Sender              []byte               `move:"vector<u8>"`
+	Data                []byte               `move:"vector<u8>"`
+	DestTokenAmounts    []Any2SuiTokenAmount `move:"vector<Any2SuiTokenAmount>"`
+}
+
+type Any2SuiTokenAmount struct {
+	Token  string `move:"address"`
+	Amount uint64 `move:"u64"`
+}
+
+type bcsAny2SuiTokenAmount struct {
+	Token  [32]byte
+	Amount uint64
+}
+
+func convertAny2SuiTokenAmountFromBCS(bcs bcsAny2SuiTokenAmount) (Any2SuiTokenAmount, error) {
+
+	return Any2SuiTokenAmount{
+		Token:  fmt.Sprintf("0x%x", bcs.Token),
+		Amount: bcs.Amount,
+	}, nil
+}
+
+func init() {
+	bind.RegisterStructDecoder("ccip::client::Any2SuiMessage", func(data []byte) (interface{}, error) {
+		var result Any2SuiMessage
+		_, err := mystenbcs.Unmarshal(data, &result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip::client::Any2SuiTokenAmount", func(data []byte) (interface{}, error) {
+		var temp bcsAny2SuiTokenAmount
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertAny2SuiTokenAmountFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
+// SuiExtraArgsV1Tag executes the sui_extra_args_v1_tag Move function.
+func (c *ClientContract) SuiExtraArgsV1Tag(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.SuiExtraArgsV1Tag()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodeSuiExtraArgsV1 executes the encode_sui_extra_args_v1 Move function.
+func (c *ClientContract) EncodeSuiExtraArgsV1(ctx context.Context, opts *bind.CallOpts, gasLimit uint64, allowOutOfOrderExecution bool, tokenReceiver []byte, receiverObjectIds [][]byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.EncodeSuiExtraArgsV1(gasLimit, allowOutOfOrderExecution, tokenReceiver, receiverObjectIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodeGenericExtraArgsV2 executes the encode_generic_extra_args_v2 Move function.
+func (c *ClientContract) EncodeGenericExtraArgsV2(ctx context.Context, opts *bind.CallOpts, gasLimit *big.Int, allowOutOfOrderExecution bool) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.EncodeGenericExtraArgsV2(gasLimit, allowOutOfOrderExecution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodeSvmExtraArgsV1 executes the encode_svm_extra_args_v1 Move function.
+func (c *ClientContract) EncodeSvmExtraArgsV1(ctx context.Context, opts *bind.CallOpts, computeUnits uint32, accountIsWritableBitmap uint64, allowOutOfOrderExecution bool, tokenReceiver []byte, accounts [][]byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.EncodeSvmExtraArgsV1(computeUnits, accountIsWritableBitmap, allowOutOfOrderExecution, tokenReceiver, accounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// NewAny2suiMessage executes the new_any2sui_message Move function.
+func (c *ClientContract) NewAny2suiMessage(ctx context.Context, opts *bind.CallOpts, messageId []byte, sourceChainSelector uint64, sender []byte, data []byte, destTokenAmounts []Any2SuiTokenAmount) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.NewAny2suiMessage(messageId, sourceChainSelector, sender, data, destTokenAmounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// ConsumeAny2suiMessage executes the consume_any2sui_message Move function.
+func (c *ClientContract) ConsumeAny2suiMessage(ctx context.Context, opts *bind.CallOpts, message Any2SuiMessage) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.ConsumeAny2suiMessage(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// NewDestTokenAmounts executes the new_dest_token_amounts Move function.
+func (c *ClientContract) NewDestTokenAmounts(ctx context.Context, opts *bind.CallOpts, tokenAddresses []string, tokenAmounts []uint64) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.NewDestTokenAmounts(tokenAddresses, tokenAmounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// GetMessageId executes the get_message_id Move function.
+func (c *ClientContract) GetMessageId(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.GetMessageId(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// GetSourceChainSelector executes the get_source_chain_selector Move function.
+func (c *ClientContract) GetSourceChainSelector(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.GetSourceChainSelector(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// GetSender executes the get_sender Move function.
+func (c *ClientContract) GetSender(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.GetSender(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// GetData executes the get_data Move function.
+func (c *ClientContract) GetData(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.GetData(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// GetDestTokenAmounts executes the get_dest_token_amounts Move function.
+func (c *ClientContract) GetDestTokenAmounts(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.GetDestTokenAmounts(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// GetToken executes the get_token Move function.
+func (c *ClientContract) GetToken(ctx context.Context, opts *bind.CallOpts, input Any2SuiTokenAmount) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.GetToken(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// GetAmount executes the get_amount Move function.
+func (c *ClientContract) GetAmount(ctx context.Context, opts *bind.CallOpts, input Any2SuiTokenAmount) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.GetAmount(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// GetTokenAndAmount executes the get_token_and_amount Move function.
+func (c *ClientContract) GetTokenAndAmount(ctx context.Context, opts *bind.CallOpts, input Any2SuiTokenAmount) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.clientEncoder.GetTokenAndAmount(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// SuiExtraArgsV1Tag executes the sui_extra_args_v1_tag Move function using DevInspect to get return values.
+//
+// Returns: vector<u8>
+func (d *ClientDevInspect) SuiExtraArgsV1Tag(ctx context.Context, opts *bind.CallOpts) ([]byte, error) {
+	encoded, err := d.contract.clientEncoder.SuiExtraArgsV1Tag()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// EncodeSuiExtraArgsV1 executes the encode_sui_extra_args_v1 Move function using DevInspect to get return values.
+//
+// Returns: vector<u8>
+func (d *ClientDevInspect) EncodeSuiExtraArgsV1(ctx context.Context, opts *bind.CallOpts, gasLimit uint64, allowOutOfOrderExecution bool, tokenReceiver []byte, receiverObjectIds [][]byte) ([]byte, error) {
+	encoded, err := d.contract.clientEncoder.EncodeSuiExtraArgsV1(gasLimit, allowOutOfOrderExecution, tokenReceiver, receiverObjectIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// EncodeGenericExtraArgsV2 executes the encode_generic_extra_args_v2 Move function using DevInspect to get return values.
+//
+// Returns: vector<u8>
+func (d *ClientDevInspect) EncodeGenericExtraArgsV2(ctx context.Context, opts *bind.CallOpts, gasLimit *big.Int, allowOutOfOrderExecution bool) ([]byte, error) {
+	encoded, err := d.contract.clientEncoder.EncodeGenericExtraArgsV2(gasLimit, allowOutOfOrderExecution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// EncodeSvmExtraArgsV1 executes the encode_svm_extra_args_v1 Move function using DevInspect to get return values.
+//
+// Returns: vector<u8>
+func (d *ClientDevInspect) EncodeSvmExtraArgsV1(ctx context.Context, opts *bind.CallOpts, computeUnits uint32, accountIsWritableBitmap uint64, allowOutOfOrderExecution bool, tokenReceiver []byte, accounts [][]byte) ([]byte, error) {
+	encoded, err := d.contract.clientEncoder.EncodeSvmExtraArgsV1(computeUnits, accountIsWritableBitmap, allowOutOfOrderExecution, tokenReceiver, accounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// NewAny2suiMessage executes the new_any2sui_message Move function using DevInspect to get return values.
+//
+// Returns: Any2SuiMessage
+func (d *ClientDevInspect) NewAny2suiMessage(ctx context.Context, opts *bind.CallOpts, messageId []byte, sourceChainSelector uint64, sender []byte, data []byte, destTokenAmounts []Any2SuiTokenAmount) (Any2SuiMessage, error) {
+	encoded, err := d.contract.clientEncoder.NewAny2suiMessage(messageId, sourceChainSelector, sender, data, destTokenAmounts)
+	if err != nil {
+		return Any2SuiMessage{}, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return Any2SuiMessage{}, err
+	}
+	if len(results) == 0 {
+		return Any2SuiMessage{}, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(Any2SuiMessage)
+	if !ok {
+		return Any2SuiMessage{}, fmt.Errorf("unexpected return type: expected Any2SuiMessage, got %T", results[0])
+	}
+	return result, nil
+}
+
+// ConsumeAny2suiMessage executes the consume_any2sui_message Move function using DevInspect to get return values.
+//
+// Returns:
+//
+//	[0]: vector<u8>
+//	[1]: u64
+//	[2]: vector<u8>
+//	[3]: vector<u8>
+//	[4]: vector<Any2SuiTokenAmount>
+func (d *ClientDevInspect) ConsumeAny2suiMessage(ctx context.Context, opts *bind.CallOpts, message Any2SuiMessage) ([]any, error) {
+	encoded, err := d.contract.clientEncoder.ConsumeAny2suiMessage(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	return d.contract.Call(ctx, opts, encoded)
+}
+
+// NewDestTokenAmounts executes the new_dest_token_amounts Move function using DevInspect to get return values.
+//
+// Returns: vector<Any2SuiTokenAmount>
+func (d *ClientDevInspect) NewDestTokenAmounts(ctx context.Context, opts *bind.CallOpts, tokenAddresses []string, tokenAmounts []uint64) ([]Any2SuiTokenAmount, error) {
+	encoded, err := d.contract.clientEncoder.NewDestTokenAmounts(tokenAddresses, tokenAmounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]Any2SuiTokenAmount)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []Any2SuiTokenAmount, got %T", results[0])
+	}
+	return result, nil
+}
+
+// GetMessageId executes the get_message_id Move function using DevInspect to get return values.
+//
+// Returns: vector<u8>
+func (d *ClientDevInspect) GetMessageId(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) ([]byte, error) {
+	encoded, err := d.contract.clientEncoder.GetMessageId(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// GetSourceChainSelector executes the get_source_chain_selector Move function using DevInspect to get return values.
+//
+// Returns: u64
+func (d *ClientDevInspect) GetSourceChainSelector(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) (uint64, error) {
+	encoded, err := d.contract.clientEncoder.GetSourceChainSelector(input)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(uint64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected return type: expected uint64, got %T", results[0])
+	}
+	return result, nil
+}
+
+// GetSender executes the get_sender Move function using DevInspect to get return values.
+//
+// Returns: vector<u8>
+func (d *ClientDevInspect) GetSender(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) ([]byte, error) {
+	encoded, err := d.contract.clientEncoder.GetSender(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// GetData executes the get_data Move function using DevInspect to get return values.
+//
+// Returns: vector<u8>
+func (d *ClientDevInspect) GetData(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) ([]byte, error) {
+	encoded, err := d.contract.clientEncoder.GetData(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// GetDestTokenAmounts executes the get_dest_token_amounts Move function using DevInspect to get return values.
+//
+// Returns: vector<Any2SuiTokenAmount>
+func (d *ClientDevInspect) GetDestTokenAmounts(ctx context.Context, opts *bind.CallOpts, input Any2SuiMessage) ([]Any2SuiTokenAmount, error) {
+	encoded, err := d.contract.clientEncoder.GetDestTokenAmounts(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]Any2SuiTokenAmount)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []Any2SuiTokenAmount, got %T", results[0])
+	}
+	return result, nil
+}
+
+// GetToken executes the get_token Move function using DevInspect to get return values.
+//
+// Returns: address
+func (d *ClientDevInspect) GetToken(ctx context.Context, opts *bind.CallOpts, input Any2SuiTokenAmount) (string, error) {
+	encoded, err := d.contract.clientEncoder.GetToken(input)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return "", err
+	}
+	if len(results) == 0 {
+		return "", fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected return type: expected string, got %T", results[0])
+	}
+	return result, nil
+}
+
+// GetAmount executes the get_amount Move function using DevInspect to get return values.
+//
+// Returns: u64
+func (d *ClientDevInspect) GetAmount(ctx context.Context, opts *bind.CallOpts, input Any2SuiTokenAmount) (uint64, error) {
+	encoded, err := d.contract.clientEncoder.GetAmount(input)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(uint64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected return type: expected uint64, got %T", results[0])
+	}
+	return result, nil
+}
+
+// GetTokenAndAmount executes the get_token_and_amount Move function using DevInspect to get return values.
+//
+// Returns:
+//
+//	[0]: address
+//	[1]: u64
+func (d *ClientDevInspect) GetTokenAndAmount(ctx context.Context, opts *bind.CallOpts, input Any2SuiTokenAmount) ([]any, error) {
+	encoded, err := d.contract.clientEncoder.GetTokenAndAmount(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	return d.contract.Call(ctx, opts, encoded)
+}
+
+type clientEncoder struct {
+	*bind.BoundContract
+}
+
+// SuiExtraArgsV1Tag encodes a call to the sui_extra_args_v1_tag Move function.
+func (c clientEncoder) SuiExtraArgsV1Tag() (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("sui_extra_args_v1_tag", typeArgsList, typeParamsList, []string{}, []any{}, []string{
+		"vector<u8>",
+	})
+}
+
+// SuiExtraArgsV1TagWithArgs encodes a call to the sui_extra_args_v1_tag Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) SuiExtraArgsV1TagWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("sui_extra_args_v1_tag", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<u8>",
+	})
+}
+
+// EncodeSuiExtraArgsV1 encodes a call to the encode_sui_extra_args_v1 Move function.
+func (c clientEncoder) EncodeSuiExtraArgsV1(gasLimit uint64, allowOutOfOrderExecution bool, tokenReceiver []byte, receiverObjectIds [][]byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_sui_extra_args_v1", typeArgsList, typeParamsList, []string{
+		"u64",
+		"bool",
+		"vector<u8>",
+		"vector<vector<u8>>",
+	}, []any{
+		gasLimit,
+		allowOutOfOrderExecution,
+		tokenReceiver,
+		receiverObjectIds,
+	}, []string{
+		"vector<u8>",
+	})
+}
+
+// EncodeSuiExtraArgsV1WithArgs encodes a call to the encode_sui_extra_args_v1 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) EncodeSuiExtraArgsV1WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"u64",
+		"bool",
+		"vector<u8>",
+		"vector<vector<u8>>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_sui_extra_args_v1", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<u8>",
+	})
+}
+
+// EncodeGenericExtraArgsV2 encodes a call to the encode_generic_extra_args_v2 Move function.
+func (c clientEncoder) EncodeGenericExtraArgsV2(gasLimit *big.Int, allowOutOfOrderExecution bool) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_generic_extra_args_v2", typeArgsList, typeParamsList, []string{
+		"u256",
+		"bool",
+	}, []any{
+		gasLimit,
+		allowOutOfOrderExecution,
+	}, []string{
+		"vector<u8>",
+	})
+}
+
+// EncodeGenericExtraArgsV2WithArgs encodes a call to the encode_generic_extra_args_v2 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) EncodeGenericExtraArgsV2WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"u256",
+		"bool",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_generic_extra_args_v2", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<u8>",
+	})
+}
+
+// EncodeSvmExtraArgsV1 encodes a call to the encode_svm_extra_args_v1 Move function.
+func (c clientEncoder) EncodeSvmExtraArgsV1(computeUnits uint32, accountIsWritableBitmap uint64, allowOutOfOrderExecution bool, tokenReceiver []byte, accounts [][]byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_svm_extra_args_v1", typeArgsList, typeParamsList, []string{
+		"u32",
+		"u64",
+		"bool",
+		"vector<u8>",
+		"vector<vector<u8>>",
+	}, []any{
+		computeUnits,
+		accountIsWritableBitmap,
+		allowOutOfOrderExecution,
+		tokenReceiver,
+		accounts,
+	}, []string{
+		"vector<u8>",
+	})
+}
+
+// EncodeSvmExtraArgsV1WithArgs encodes a call to the encode_svm_extra_args_v1 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) EncodeSvmExtraArgsV1WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"u32",
+		"u64",
+		"bool",
+		"vector<u8>",
+		"vector<vector<u8>>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_svm_extra_args_v1", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<u8>",
+	})
+}
+
+// NewAny2suiMessage encodes a call to the new_any2sui_message Move function.
+func (c clientEncoder) NewAny2suiMessage(messageId []byte, sourceChainSelector uint64, sender []byte, data []byte, destTokenAmounts []Any2SuiTokenAmount) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("new_any2sui_message", typeArgsList, typeParamsList, []string{
+		"vector<u8>",
+		"u64",
+		"vector<u8>",
+		"vector<u8>",
+		"vector<ccip::client::Any2SuiTokenAmount>",
+	}, []any{
+		messageId,
+		sourceChainSelector,
+		sender,
+		data,
+		destTokenAmounts,
+	}, []string{
+		"ccip::client::Any2SuiMessage",
+	})
+}
+
+// NewAny2suiMessageWithArgs encodes a call to the new_any2sui_message Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) NewAny2suiMessageWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"vector<u8>",
+		"u64",
+		"vector<u8>",
+		"vector<u8>",
+		"vector<ccip::client::Any2SuiTokenAmount>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("new_any2sui_message", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"ccip::client::Any2SuiMessage",
+	})
+}
+
+// ConsumeAny2suiMessage encodes a call to the consume_any2sui_message Move function.
+func (c clientEncoder) ConsumeAny2suiMessage(message Any2SuiMessage) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("consume_any2sui_message", typeArgsList, typeParamsList, []string{
+		"ccip::client::Any2SuiMessage",
+	}, []any{
+		message,
+	}, []string{
+		"vector<u8>",
+		"u64",
+		"vector<u8>",
+		"vector<u8>",
+		"vector<ccip::client::Any2SuiTokenAmount>",
+	})
+}
+
+// ConsumeAny2suiMessageWithArgs encodes a call to the consume_any2sui_message Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) ConsumeAny2suiMessageWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"ccip::client::Any2SuiMessage",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("consume_any2sui_message", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<u8>",
+		"u64",
+		"vector<u8>",
+		"vector<u8>",
+		"vector<ccip::client::Any2SuiTokenAmount>",
+	})
+}
+
+// NewDestTokenAmounts encodes a call to the new_dest_token_amounts Move function.
+func (c clientEncoder) NewDestTokenAmounts(tokenAddresses []string, tokenAmounts []uint64) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("new_dest_token_amounts", typeArgsList, typeParamsList, []string{
+		"vector<address>",
+		"vector<u64>",
+	}, []any{
+		tokenAddresses,
+		tokenAmounts,
+	}, []string{
+		"vector<ccip::client::Any2SuiTokenAmount>",
+	})
+}
+
+// NewDestTokenAmountsWithArgs encodes a call to the new_dest_token_amounts Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) NewDestTokenAmountsWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"vector<address>",
+		"vector<u64>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("new_dest_token_amounts", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<ccip::client::Any2SuiTokenAmount>",
+	})
+}
+
+// GetMessageId encodes a call to the get_message_id Move function.
+func (c clientEncoder) GetMessageId(input Any2SuiMessage) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_message_id", typeArgsList, typeParamsList, []string{
+		"&Any2SuiMessage",
+	}, []any{
+		input,
+	}, []string{
+		"vector<u8>",
+	})
+}
+
+// GetMessageIdWithArgs encodes a call to the get_message_id Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) GetMessageIdWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&Any2SuiMessage",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_message_id", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<u8>",
+	})
+}
+
+// GetSourceChainSelector encodes a call to the get_source_chain_selector Move function.
+func (c clientEncoder) GetSourceChainSelector(input Any2SuiMessage) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_source_chain_selector", typeArgsList, typeParamsList, []string{
+		"&Any2SuiMessage",
+	}, []any{
+		input,
+	}, []string{
+		"u64",
+	})
+}
+
+// GetSourceChainSelectorWithArgs encodes a call to the get_source_chain_selector Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) GetSourceChainSelectorWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&Any2SuiMessage",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_source_chain_selector", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u64",
+	})
+}
+
+// GetSender encodes a call to the get_sender Move function.
+func (c clientEncoder) GetSender(input Any2SuiMessage) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_sender", typeArgsList, typeParamsList, []string{
+		"&Any2SuiMessage",
+	}, []any{
+		input,
+	}, []string{
+		"vector<u8>",
+	})
+}
+
+// GetSenderWithArgs encodes a call to the get_sender Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) GetSenderWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&Any2SuiMessage",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_sender", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<u8>",
+	})
+}
+
+// GetData encodes a call to the get_data Move function.
+func (c clientEncoder) GetData(input Any2SuiMessage) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_data", typeArgsList, typeParamsList, []string{
+		"&Any2SuiMessage",
+	}, []any{
+		input,
+	}, []string{
+		"vector<u8>",
+	})
+}
+
+// GetDataWithArgs encodes a call to the get_data Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) GetDataWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&Any2SuiMessage",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_data", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<u8>",
+	})
+}
+
+// GetDestTokenAmounts encodes a call to the get_dest_token_amounts Move function.
+func (c clientEncoder) GetDestTokenAmounts(input Any2SuiMessage) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_dest_token_amounts", typeArgsList, typeParamsList, []string{
+		"&Any2SuiMessage",
+	}, []any{
+		input,
+	}, []string{
+		"vector<ccip::client::Any2SuiTokenAmount>",
+	})
+}
+
+// GetDestTokenAmountsWithArgs encodes a call to the get_dest_token_amounts Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) GetDestTokenAmountsWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&Any2SuiMessage",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_dest_token_amounts", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<ccip::client::Any2SuiTokenAmount>",
+	})
+}
+
+// GetToken encodes a call to the get_token Move function.
+func (c clientEncoder) GetToken(input Any2SuiTokenAmount) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_token", typeArgsList, typeParamsList, []string{
+		"&Any2SuiTokenAmount",
+	}, []any{
+		input,
+	}, []string{
+		"address",
+	})
+}
+
+// GetTokenWithArgs encodes a call to the get_token Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) GetTokenWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&Any2SuiTokenAmount",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_token", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"address",
+	})
+}
+
+// GetAmount encodes a call to the get_amount Move function.
+func (c clientEncoder) GetAmount(input Any2SuiTokenAmount) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_amount", typeArgsList, typeParamsList, []string{
+		"&Any2SuiTokenAmount",
+	}, []any{
+		input,
+	}, []string{
+		"u64",
+	})
+}
+
+// GetAmountWithArgs encodes a call to the get_amount Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) GetAmountWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&Any2SuiTokenAmount",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_amount", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u64",
+	})
+}
+
+// GetTokenAndAmount encodes a call to the get_token_and_amount Move function.
+func (c clientEncoder) GetTokenAndAmount(input Any2SuiTokenAmount) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_token_and_amount", typeArgsList, typeParamsList, []string{
+		"&Any2SuiTokenAmount",
+	}, []any{
+		input,
+	}, []string{
+		"address",
+		"u64",
+	})
+}
+
+// GetTokenAndAmountWithArgs encodes a call to the get_token_and_amount Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c clientEncoder) GetTokenAndAmountWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&Any2SuiTokenAmount",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_token_and_amount", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"address",
+		"u64",
+	})
+}
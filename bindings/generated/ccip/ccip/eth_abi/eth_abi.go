@@ -0,0 +1,1527 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package module_eth_abi
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+)
+
+var (
+	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
+)
+
+type IEthAbi interface {
+	EncodeU32(ctx context.Context, opts *bind.CallOpts, out []byte, value uint32) (*models.SuiTransactionBlockResponse, error)
+	EncodeU64(ctx context.Context, opts *bind.CallOpts, out []byte, value uint64) (*models.SuiTransactionBlockResponse, error)
+	EncodeU256(ctx context.Context, opts *bind.CallOpts, out []byte, value *big.Int) (*models.SuiTransactionBlockResponse, error)
+	EncodeBool(ctx context.Context, opts *bind.CallOpts, out []byte, value bool) (*models.SuiTransactionBlockResponse, error)
+	EncodeLeftPaddedBytes32(ctx context.Context, opts *bind.CallOpts, out []byte, value []byte) (*models.SuiTransactionBlockResponse, error)
+	EncodeRightPaddedBytes32(ctx context.Context, opts *bind.CallOpts, out []byte, value []byte) (*models.SuiTransactionBlockResponse, error)
+	EncodeBytes(ctx context.Context, opts *bind.CallOpts, out []byte, value []byte) (*models.SuiTransactionBlockResponse, error)
+	EncodeSelector(ctx context.Context, opts *bind.CallOpts, out []byte, value []byte) (*models.SuiTransactionBlockResponse, error)
+	EncodePackedAddress(ctx context.Context, opts *bind.CallOpts, out []byte, value string) (*models.SuiTransactionBlockResponse, error)
+	EncodePackedBytes(ctx context.Context, opts *bind.CallOpts, out []byte, value []byte) (*models.SuiTransactionBlockResponse, error)
+	EncodePackedBytes32(ctx context.Context, opts *bind.CallOpts, out []byte, value []byte) (*models.SuiTransactionBlockResponse, error)
+	EncodePackedU8(ctx context.Context, opts *bind.CallOpts, out []byte, value byte) (*models.SuiTransactionBlockResponse, error)
+	EncodePackedU32(ctx context.Context, opts *bind.CallOpts, out []byte, value uint32) (*models.SuiTransactionBlockResponse, error)
+	EncodePackedU64(ctx context.Context, opts *bind.CallOpts, out []byte, value uint64) (*models.SuiTransactionBlockResponse, error)
+	EncodePackedU256(ctx context.Context, opts *bind.CallOpts, out []byte, value *big.Int) (*models.SuiTransactionBlockResponse, error)
+	NewStream(ctx context.Context, opts *bind.CallOpts, data []byte) (*models.SuiTransactionBlockResponse, error)
+	DecodeAddress(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error)
+	DecodeU256(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error)
+	DecodeU8(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error)
+	DecodeU32(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error)
+	DecodeU64(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error)
+	DecodeBool(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error)
+	DecodeBytes32(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error)
+	DecodeBytes(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error)
+	DecodeVector(ctx context.Context, opts *bind.CallOpts, typeArgs []string, stream ABIStream, f bind.Object) (*models.SuiTransactionBlockResponse, error)
+	DecodeU256Value(ctx context.Context, opts *bind.CallOpts, valueBytes []byte) (*models.SuiTransactionBlockResponse, error)
+	Slice(ctx context.Context, opts *bind.CallOpts, typeArgs []string, vec []bind.Object, start uint64, len_ uint64) (*models.SuiTransactionBlockResponse, error)
+	DevInspect() IEthAbiDevInspect
+	Encoder() EthAbiEncoder
+	Bound() bind.IBoundContract
+}
+
+type IEthAbiDevInspect interface {
+	NewStream(ctx context.Context, opts *bind.CallOpts, data []byte) (ABIStream, error)
+	DecodeAddress(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (string, error)
+	DecodeU256(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*big.Int, error)
+	DecodeU8(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (byte, error)
+	DecodeU32(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (uint32, error)
+	DecodeU64(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (uint64, error)
+	DecodeBool(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (bool, error)
+	DecodeBytes32(ctx context.Context, opts *bind.CallOpts, stream ABIStream) ([]byte, error)
+	DecodeBytes(ctx context.Context, opts *bind.CallOpts, stream ABIStream) ([]byte, error)
+	DecodeVector(ctx context.Context, opts *bind.CallOpts, typeArgs []string, stream ABIStream, f bind.Object) ([]bind.Object, error)
+	DecodeU256Value(ctx context.Context, opts *bind.CallOpts, valueBytes []byte) (*big.Int, error)
+	Slice(ctx context.Context, opts *bind.CallOpts, typeArgs []string, vec []bind.Object, start uint64, len_ uint64) (any, error)
+}
+
+type EthAbiEncoder interface {
+	EncodeU32(out []byte, value uint32) (*bind.EncodedCall, error)
+	EncodeU32WithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodeU64(out []byte, value uint64) (*bind.EncodedCall, error)
+	EncodeU64WithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodeU256(out []byte, value *big.Int) (*bind.EncodedCall, error)
+	EncodeU256WithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodeBool(out []byte, value bool) (*bind.EncodedCall, error)
+	EncodeBoolWithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodeLeftPaddedBytes32(out []byte, value []byte) (*bind.EncodedCall, error)
+	EncodeLeftPaddedBytes32WithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodeRightPaddedBytes32(out []byte, value []byte) (*bind.EncodedCall, error)
+	EncodeRightPaddedBytes32WithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodeBytes(out []byte, value []byte) (*bind.EncodedCall, error)
+	EncodeBytesWithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodeSelector(out []byte, value []byte) (*bind.EncodedCall, error)
+	EncodeSelectorWithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodePackedAddress(out []byte, value string) (*bind.EncodedCall, error)
+	EncodePackedAddressWithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodePackedBytes(out []byte, value []byte) (*bind.EncodedCall, error)
+	EncodePackedBytesWithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodePackedBytes32(out []byte, value []byte) (*bind.EncodedCall, error)
+	EncodePackedBytes32WithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodePackedU8(out []byte, value byte) (*bind.EncodedCall, error)
+	EncodePackedU8WithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodePackedU32(out []byte, value uint32) (*bind.EncodedCall, error)
+	EncodePackedU32WithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodePackedU64(out []byte, value uint64) (*bind.EncodedCall, error)
+	EncodePackedU64WithArgs(args ...any) (*bind.EncodedCall, error)
+	EncodePackedU256(out []byte, value *big.Int) (*bind.EncodedCall, error)
+	EncodePackedU256WithArgs(args ...any) (*bind.EncodedCall, error)
+	NewStream(data []byte) (*bind.EncodedCall, error)
+	NewStreamWithArgs(args ...any) (*bind.EncodedCall, error)
+	DecodeAddress(stream ABIStream) (*bind.EncodedCall, error)
+	DecodeAddressWithArgs(args ...any) (*bind.EncodedCall, error)
+	DecodeU256(stream ABIStream) (*bind.EncodedCall, error)
+	DecodeU256WithArgs(args ...any) (*bind.EncodedCall, error)
+	DecodeU8(stream ABIStream) (*bind.EncodedCall, error)
+	DecodeU8WithArgs(args ...any) (*bind.EncodedCall, error)
+	DecodeU32(stream ABIStream) (*bind.EncodedCall, error)
+	DecodeU32WithArgs(args ...any) (*bind.EncodedCall, error)
+	DecodeU64(stream ABIStream) (*bind.EncodedCall, error)
+	DecodeU64WithArgs(args ...any) (*bind.EncodedCall, error)
+	DecodeBool(stream ABIStream) (*bind.EncodedCall, error)
+	DecodeBoolWithArgs(args ...any) (*bind.EncodedCall, error)
+	DecodeBytes32(stream ABIStream) (*bind.EncodedCall, error)
+	DecodeBytes32WithArgs(args ...any) (*bind.EncodedCall, error)
+	DecodeBytes(stream ABIStream) (*bind.EncodedCall, error)
+	DecodeBytesWithArgs(args ...any) (*bind.EncodedCall, error)
+	DecodeVector(typeArgs []string, stream ABIStream, f bind.Object) (*bind.EncodedCall, error)
+	DecodeVectorWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error)
+	DecodeU256Value(valueBytes []byte) (*bind.EncodedCall, error)
+	DecodeU256ValueWithArgs(args ...any) (*bind.EncodedCall, error)
+	Slice(typeArgs []string, vec []bind.Object, start uint64, len_ uint64) (*bind.EncodedCall, error)
+	SliceWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error)
+}
+
+type EthAbiContract struct {
+	*bind.BoundContract
+	ethAbiEncoder
+	devInspect *EthAbiDevInspect
+}
+
+type EthAbiDevInspect struct {
+	contract *EthAbiContract
+}
+
+var _ IEthAbi = (*EthAbiContract)(nil)
+var _ IEthAbiDevInspect = (*EthAbiDevInspect)(nil)
+
+func NewEthAbi(packageID string, client sui.ISuiAPI) (IEthAbi, error) {
+	contract, err := bind.NewBoundContract(packageID, "ccip", "eth_abi", client)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &EthAbiContract{
+		BoundContract: contract,
+		ethAbiEncoder: ethAbiEncoder{BoundContract: contract},
+	}
+	c.devInspect = &EthAbiDevInspect{contract: c}
+	return c, nil
+}
+
+func (c *EthAbiContract) Bound() bind.IBoundContract {
+	return c.BoundContract
+}
+
+func (c *EthAbiContract) Encoder() EthAbiEncoder {
+	return c.ethAbiEncoder
+}
+
+func (c *EthAbiContract) DevInspect() IEthAbiDevInspect {
+	return c.devInspect
+}
+
+type ABIStream struct {
+	Data []byte `move:"vector<u8>"`
+	Cur  uint64 `move:"u64"`
+}
+
+func init() {
+	bind.RegisterStructDecoder("ccip::eth_abi::ABIStream", func(data []byte) (interface{}, error) {
+		var result ABIStream
+		_, err := mystenbcs.Unmarshal(data, &result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
+// EncodeU32 executes the encode_u32 Move function.
+func (c *EthAbiContract) EncodeU32(ctx context.Context, opts *bind.CallOpts, out []byte, value uint32) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodeU32(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodeU64 executes the encode_u64 Move function.
+func (c *EthAbiContract) EncodeU64(ctx context.Context, opts *bind.CallOpts, out []byte, value uint64) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodeU64(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodeU256 executes the encode_u256 Move function.
+func (c *EthAbiContract) EncodeU256(ctx context.Context, opts *bind.CallOpts, out []byte, value *big.Int) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodeU256(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodeBool executes the encode_bool Move function.
+func (c *EthAbiContract) EncodeBool(ctx context.Context, opts *bind.CallOpts, out []byte, value bool) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodeBool(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodeLeftPaddedBytes32 executes the encode_left_padded_bytes32 Move function.
+func (c *EthAbiContract) EncodeLeftPaddedBytes32(ctx context.Context, opts *bind.CallOpts, out []byte, value []byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodeLeftPaddedBytes32(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodeRightPaddedBytes32 executes the encode_right_padded_bytes32 Move function.
+func (c *EthAbiContract) EncodeRightPaddedBytes32(ctx context.Context, opts *bind.CallOpts, out []byte, value []byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodeRightPaddedBytes32(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodeBytes executes the encode_bytes Move function.
+func (c *EthAbiContract) EncodeBytes(ctx context.Context, opts *bind.CallOpts, out []byte, value []byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodeBytes(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodeSelector executes the encode_selector Move function.
+func (c *EthAbiContract) EncodeSelector(ctx context.Context, opts *bind.CallOpts, out []byte, value []byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodeSelector(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodePackedAddress executes the encode_packed_address Move function.
+func (c *EthAbiContract) EncodePackedAddress(ctx context.Context, opts *bind.CallOpts, out []byte, value string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodePackedAddress(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodePackedBytes executes the encode_packed_bytes Move function.
+func (c *EthAbiContract) EncodePackedBytes(ctx context.Context, opts *bind.CallOpts, out []byte, value []byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodePackedBytes(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodePackedBytes32 executes the encode_packed_bytes32 Move function.
+func (c *EthAbiContract) EncodePackedBytes32(ctx context.Context, opts *bind.CallOpts, out []byte, value []byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodePackedBytes32(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodePackedU8 executes the encode_packed_u8 Move function.
+func (c *EthAbiContract) EncodePackedU8(ctx context.Context, opts *bind.CallOpts, out []byte, value byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodePackedU8(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodePackedU32 executes the encode_packed_u32 Move function.
+func (c *EthAbiContract) EncodePackedU32(ctx context.Context, opts *bind.CallOpts, out []byte, value uint32) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodePackedU32(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodePackedU64 executes the encode_packed_u64 Move function.
+func (c *EthAbiContract) EncodePackedU64(ctx context.Context, opts *bind.CallOpts, out []byte, value uint64) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodePackedU64(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodePackedU256 executes the encode_packed_u256 Move function.
+func (c *EthAbiContract) EncodePackedU256(ctx context.Context, opts *bind.CallOpts, out []byte, value *big.Int) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.EncodePackedU256(out, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// NewStream executes the new_stream Move function.
+func (c *EthAbiContract) NewStream(ctx context.Context, opts *bind.CallOpts, data []byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.NewStream(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DecodeAddress executes the decode_address Move function.
+func (c *EthAbiContract) DecodeAddress(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.DecodeAddress(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DecodeU256 executes the decode_u256 Move function.
+func (c *EthAbiContract) DecodeU256(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.DecodeU256(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DecodeU8 executes the decode_u8 Move function.
+func (c *EthAbiContract) DecodeU8(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.DecodeU8(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DecodeU32 executes the decode_u32 Move function.
+func (c *EthAbiContract) DecodeU32(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.DecodeU32(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DecodeU64 executes the decode_u64 Move function.
+func (c *EthAbiContract) DecodeU64(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.DecodeU64(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DecodeBool executes the decode_bool Move function.
+func (c *EthAbiContract) DecodeBool(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.DecodeBool(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DecodeBytes32 executes the decode_bytes32 Move function.
+func (c *EthAbiContract) DecodeBytes32(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.DecodeBytes32(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DecodeBytes executes the decode_bytes Move function.
+func (c *EthAbiContract) DecodeBytes(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.DecodeBytes(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DecodeVector executes the decode_vector Move function.
+func (c *EthAbiContract) DecodeVector(ctx context.Context, opts *bind.CallOpts, typeArgs []string, stream ABIStream, f bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.DecodeVector(typeArgs, stream, f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DecodeU256Value executes the decode_u256_value Move function.
+func (c *EthAbiContract) DecodeU256Value(ctx context.Context, opts *bind.CallOpts, valueBytes []byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.DecodeU256Value(valueBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// Slice executes the slice Move function.
+func (c *EthAbiContract) Slice(ctx context.Context, opts *bind.CallOpts, typeArgs []string, vec []bind.Object, start uint64, len_ uint64) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ethAbiEncoder.Slice(typeArgs, vec, start, len_)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// NewStream executes the new_stream Move function using DevInspect to get return values.
+//
+// Returns: ABIStream
+func (d *EthAbiDevInspect) NewStream(ctx context.Context, opts *bind.CallOpts, data []byte) (ABIStream, error) {
+	encoded, err := d.contract.ethAbiEncoder.NewStream(data)
+	if err != nil {
+		return ABIStream{}, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return ABIStream{}, err
+	}
+	if len(results) == 0 {
+		return ABIStream{}, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(ABIStream)
+	if !ok {
+		return ABIStream{}, fmt.Errorf("unexpected return type: expected ABIStream, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DecodeAddress executes the decode_address Move function using DevInspect to get return values.
+//
+// Returns: address
+func (d *EthAbiDevInspect) DecodeAddress(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (string, error) {
+	encoded, err := d.contract.ethAbiEncoder.DecodeAddress(stream)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return "", err
+	}
+	if len(results) == 0 {
+		return "", fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected return type: expected string, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DecodeU256 executes the decode_u256 Move function using DevInspect to get return values.
+//
+// Returns: u256
+func (d *EthAbiDevInspect) DecodeU256(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (*big.Int, error) {
+	encoded, err := d.contract.ethAbiEncoder.DecodeU256(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected *big.Int, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DecodeU8 executes the decode_u8 Move function using DevInspect to get return values.
+//
+// Returns: u8
+func (d *EthAbiDevInspect) DecodeU8(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (byte, error) {
+	encoded, err := d.contract.ethAbiEncoder.DecodeU8(stream)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(byte)
+	if !ok {
+		return 0, fmt.Errorf("unexpected return type: expected byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DecodeU32 executes the decode_u32 Move function using DevInspect to get return values.
+//
+// Returns: u32
+func (d *EthAbiDevInspect) DecodeU32(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (uint32, error) {
+	encoded, err := d.contract.ethAbiEncoder.DecodeU32(stream)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(uint32)
+	if !ok {
+		return 0, fmt.Errorf("unexpected return type: expected uint32, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DecodeU64 executes the decode_u64 Move function using DevInspect to get return values.
+//
+// Returns: u64
+func (d *EthAbiDevInspect) DecodeU64(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (uint64, error) {
+	encoded, err := d.contract.ethAbiEncoder.DecodeU64(stream)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(uint64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected return type: expected uint64, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DecodeBool executes the decode_bool Move function using DevInspect to get return values.
+//
+// Returns: bool
+func (d *EthAbiDevInspect) DecodeBool(ctx context.Context, opts *bind.CallOpts, stream ABIStream) (bool, error) {
+	encoded, err := d.contract.ethAbiEncoder.DecodeBool(stream)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return false, err
+	}
+	if len(results) == 0 {
+		return false, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected return type: expected bool, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DecodeBytes32 executes the decode_bytes32 Move function using DevInspect to get return values.
+//
+// Returns: vector<u8>
+func (d *EthAbiDevInspect) DecodeBytes32(ctx context.Context, opts *bind.CallOpts, stream ABIStream) ([]byte, error) {
+	encoded, err := d.contract.ethAbiEncoder.DecodeBytes32(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DecodeBytes executes the decode_bytes Move function using DevInspect to get return values.
+//
+// Returns: vector<u8>
+func (d *EthAbiDevInspect) DecodeBytes(ctx context.Context, opts *bind.CallOpts, stream ABIStream) ([]byte, error) {
+	encoded, err := d.contract.ethAbiEncoder.DecodeBytes(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DecodeVector executes the decode_vector Move function using DevInspect to get return values.
+//
+// Returns: vector<$E>
+func (d *EthAbiDevInspect) DecodeVector(ctx context.Context, opts *bind.CallOpts, typeArgs []string, stream ABIStream, f bind.Object) ([]bind.Object, error) {
+	encoded, err := d.contract.ethAbiEncoder.DecodeVector(typeArgs, stream, f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]bind.Object)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []bind.Object, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DecodeU256Value executes the decode_u256_value Move function using DevInspect to get return values.
+//
+// Returns: u256
+func (d *EthAbiDevInspect) DecodeU256Value(ctx context.Context, opts *bind.CallOpts, valueBytes []byte) (*big.Int, error) {
+	encoded, err := d.contract.ethAbiEncoder.DecodeU256Value(valueBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected *big.Int, got %T", results[0])
+	}
+	return result, nil
+}
+
+// Slice executes the slice Move function using DevInspect to get return values.
+//
+// Returns: vector<T>
+func (d *EthAbiDevInspect) Slice(ctx context.Context, opts *bind.CallOpts, typeArgs []string, vec []bind.Object, start uint64, len_ uint64) (any, error) {
+	encoded, err := d.contract.ethAbiEncoder.Slice(typeArgs, vec, start, len_)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	return results[0], nil
+}
+
+type ethAbiEncoder struct {
+	*bind.BoundContract
+}
+
+// EncodeU32 encodes a call to the encode_u32 Move function.
+func (c ethAbiEncoder) EncodeU32(out []byte, value uint32) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_u32", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"u32",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodeU32WithArgs encodes a call to the encode_u32 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodeU32WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"u32",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_u32", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// EncodeU64 encodes a call to the encode_u64 Move function.
+func (c ethAbiEncoder) EncodeU64(out []byte, value uint64) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_u64", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"u64",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodeU64WithArgs encodes a call to the encode_u64 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodeU64WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"u64",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_u64", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// EncodeU256 encodes a call to the encode_u256 Move function.
+func (c ethAbiEncoder) EncodeU256(out []byte, value *big.Int) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_u256", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"u256",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodeU256WithArgs encodes a call to the encode_u256 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodeU256WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"u256",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_u256", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// EncodeBool encodes a call to the encode_bool Move function.
+func (c ethAbiEncoder) EncodeBool(out []byte, value bool) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_bool", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"bool",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodeBoolWithArgs encodes a call to the encode_bool Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodeBoolWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"bool",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_bool", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// EncodeLeftPaddedBytes32 encodes a call to the encode_left_padded_bytes32 Move function.
+func (c ethAbiEncoder) EncodeLeftPaddedBytes32(out []byte, value []byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_left_padded_bytes32", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"vector<u8>",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodeLeftPaddedBytes32WithArgs encodes a call to the encode_left_padded_bytes32 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodeLeftPaddedBytes32WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"vector<u8>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_left_padded_bytes32", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// EncodeRightPaddedBytes32 encodes a call to the encode_right_padded_bytes32 Move function.
+func (c ethAbiEncoder) EncodeRightPaddedBytes32(out []byte, value []byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_right_padded_bytes32", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"vector<u8>",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodeRightPaddedBytes32WithArgs encodes a call to the encode_right_padded_bytes32 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodeRightPaddedBytes32WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"vector<u8>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_right_padded_bytes32", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// EncodeBytes encodes a call to the encode_bytes Move function.
+func (c ethAbiEncoder) EncodeBytes(out []byte, value []byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_bytes", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"vector<u8>",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodeBytesWithArgs encodes a call to the encode_bytes Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodeBytesWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"vector<u8>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_bytes", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// EncodeSelector encodes a call to the encode_selector Move function.
+func (c ethAbiEncoder) EncodeSelector(out []byte, value []byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_selector", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"vector<u8>",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodeSelectorWithArgs encodes a call to the encode_selector Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodeSelectorWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"vector<u8>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_selector", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// EncodePackedAddress encodes a call to the encode_packed_address Move function.
+func (c ethAbiEncoder) EncodePackedAddress(out []byte, value string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_packed_address", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"address",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodePackedAddressWithArgs encodes a call to the encode_packed_address Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodePackedAddressWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"address",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_packed_address", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// EncodePackedBytes encodes a call to the encode_packed_bytes Move function.
+func (c ethAbiEncoder) EncodePackedBytes(out []byte, value []byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_packed_bytes", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"vector<u8>",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodePackedBytesWithArgs encodes a call to the encode_packed_bytes Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodePackedBytesWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"vector<u8>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_packed_bytes", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// EncodePackedBytes32 encodes a call to the encode_packed_bytes32 Move function.
+func (c ethAbiEncoder) EncodePackedBytes32(out []byte, value []byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_packed_bytes32", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"vector<u8>",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodePackedBytes32WithArgs encodes a call to the encode_packed_bytes32 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodePackedBytes32WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"vector<u8>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_packed_bytes32", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// EncodePackedU8 encodes a call to the encode_packed_u8 Move function.
+func (c ethAbiEncoder) EncodePackedU8(out []byte, value byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_packed_u8", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"u8",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodePackedU8WithArgs encodes a call to the encode_packed_u8 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodePackedU8WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"u8",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_packed_u8", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// EncodePackedU32 encodes a call to the encode_packed_u32 Move function.
+func (c ethAbiEncoder) EncodePackedU32(out []byte, value uint32) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_packed_u32", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"u32",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodePackedU32WithArgs encodes a call to the encode_packed_u32 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodePackedU32WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"u32",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_packed_u32", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// EncodePackedU64 encodes a call to the encode_packed_u64 Move function.
+func (c ethAbiEncoder) EncodePackedU64(out []byte, value uint64) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_packed_u64", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"u64",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodePackedU64WithArgs encodes a call to the encode_packed_u64 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodePackedU64WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"u64",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_packed_u64", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// EncodePackedU256 encodes a call to the encode_packed_u256 Move function.
+func (c ethAbiEncoder) EncodePackedU256(out []byte, value *big.Int) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_packed_u256", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"u256",
+	}, []any{
+		out,
+		value,
+	}, nil)
+}
+
+// EncodePackedU256WithArgs encodes a call to the encode_packed_u256 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) EncodePackedU256WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"u256",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("encode_packed_u256", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// NewStream encodes a call to the new_stream Move function.
+func (c ethAbiEncoder) NewStream(data []byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("new_stream", typeArgsList, typeParamsList, []string{
+		"vector<u8>",
+	}, []any{
+		data,
+	}, []string{
+		"ccip::eth_abi::ABIStream",
+	})
+}
+
+// NewStreamWithArgs encodes a call to the new_stream Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) NewStreamWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"vector<u8>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("new_stream", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"ccip::eth_abi::ABIStream",
+	})
+}
+
+// DecodeAddress encodes a call to the decode_address Move function.
+func (c ethAbiEncoder) DecodeAddress(stream ABIStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_address", typeArgsList, typeParamsList, []string{
+		"&mut ABIStream",
+	}, []any{
+		stream,
+	}, []string{
+		"address",
+	})
+}
+
+// DecodeAddressWithArgs encodes a call to the decode_address Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) DecodeAddressWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut ABIStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_address", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"address",
+	})
+}
+
+// DecodeU256 encodes a call to the decode_u256 Move function.
+func (c ethAbiEncoder) DecodeU256(stream ABIStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_u256", typeArgsList, typeParamsList, []string{
+		"&mut ABIStream",
+	}, []any{
+		stream,
+	}, []string{
+		"u256",
+	})
+}
+
+// DecodeU256WithArgs encodes a call to the decode_u256 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) DecodeU256WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut ABIStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_u256", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u256",
+	})
+}
+
+// DecodeU8 encodes a call to the decode_u8 Move function.
+func (c ethAbiEncoder) DecodeU8(stream ABIStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_u8", typeArgsList, typeParamsList, []string{
+		"&mut ABIStream",
+	}, []any{
+		stream,
+	}, []string{
+		"u8",
+	})
+}
+
+// DecodeU8WithArgs encodes a call to the decode_u8 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) DecodeU8WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut ABIStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_u8", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u8",
+	})
+}
+
+// DecodeU32 encodes a call to the decode_u32 Move function.
+func (c ethAbiEncoder) DecodeU32(stream ABIStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_u32", typeArgsList, typeParamsList, []string{
+		"&mut ABIStream",
+	}, []any{
+		stream,
+	}, []string{
+		"u32",
+	})
+}
+
+// DecodeU32WithArgs encodes a call to the decode_u32 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) DecodeU32WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut ABIStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_u32", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u32",
+	})
+}
+
+// DecodeU64 encodes a call to the decode_u64 Move function.
+func (c ethAbiEncoder) DecodeU64(stream ABIStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_u64", typeArgsList, typeParamsList, []string{
+		"&mut ABIStream",
+	}, []any{
+		stream,
+	}, []string{
+		"u64",
+	})
+}
+
+// DecodeU64WithArgs encodes a call to the decode_u64 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) DecodeU64WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut ABIStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_u64", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u64",
+	})
+}
+
+// DecodeBool encodes a call to the decode_bool Move function.
+func (c ethAbiEncoder) DecodeBool(stream ABIStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_bool", typeArgsList, typeParamsList, []string{
+		"&mut ABIStream",
+	}, []any{
+		stream,
+	}, []string{
+		"bool",
+	})
+}
+
+// DecodeBoolWithArgs encodes a call to the decode_bool Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) DecodeBoolWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut ABIStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_bool", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"bool",
+	})
+}
+
+// DecodeBytes32 encodes a call to the decode_bytes32 Move function.
+func (c ethAbiEncoder) DecodeBytes32(stream ABIStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_bytes32", typeArgsList, typeParamsList, []string{
+		"&mut ABIStream",
+	}, []any{
+		stream,
+	}, []string{
+		"vector<u8>",
+	})
+}
+
+// DecodeBytes32WithArgs encodes a call to the decode_bytes32 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) DecodeBytes32WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut ABIStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_bytes32", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<u8>",
+	})
+}
+
+// DecodeBytes encodes a call to the decode_bytes Move function.
+func (c ethAbiEncoder) DecodeBytes(stream ABIStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_bytes", typeArgsList, typeParamsList, []string{
+		"&mut ABIStream",
+	}, []any{
+		stream,
+	}, []string{
+		"vector<u8>",
+	})
+}
+
+// DecodeBytesWithArgs encodes a call to the decode_bytes Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) DecodeBytesWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut ABIStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_bytes", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<u8>",
+	})
+}
+
+// DecodeVector encodes a call to the decode_vector Move function.
+func (c ethAbiEncoder) DecodeVector(typeArgs []string, stream ABIStream, f bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"E",
+	}
+	return c.EncodeCallArgsWithGenerics("decode_vector", typeArgsList, typeParamsList, []string{
+		"&mut ABIStream",
+		"|&mut ABIStream| -> $E",
+	}, []any{
+		stream,
+		f,
+	}, []string{
+		"vector<$E>",
+	})
+}
+
+// DecodeVectorWithArgs encodes a call to the decode_vector Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) DecodeVectorWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut ABIStream",
+		"|&mut ABIStream| -> $E",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"E",
+	}
+	return c.EncodeCallArgsWithGenerics("decode_vector", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<$E>",
+	})
+}
+
+// DecodeU256Value encodes a call to the decode_u256_value Move function.
+func (c ethAbiEncoder) DecodeU256Value(valueBytes []byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_u256_value", typeArgsList, typeParamsList, []string{
+		"vector<u8>",
+	}, []any{
+		valueBytes,
+	}, []string{
+		"u256",
+	})
+}
+
+// DecodeU256ValueWithArgs encodes a call to the decode_u256_value Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) DecodeU256ValueWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"vector<u8>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("decode_u256_value", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u256",
+	})
+}
+
+// Slice encodes a call to the slice Move function.
+func (c ethAbiEncoder) Slice(typeArgs []string, vec []bind.Object, start uint64, len_ uint64) (*bind.EncodedCall, error) {
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"T",
+	}
+	return c.EncodeCallArgsWithGenerics("slice", typeArgsList, typeParamsList, []string{
+		"&vector<T>",
+		"u64",
+		"u64",
+	}, []any{
+		vec,
+		start,
+		len_,
+	}, []string{
+		"vector<T>",
+	})
+}
+
+// SliceWithArgs encodes a call to the slice Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ethAbiEncoder) SliceWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&vector<T>",
+		"u64",
+		"u64",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"T",
+	}
+	return c.EncodeCallArgsWithGenerics("slice", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<T>",
+	})
+}
@@ -13,10 +13,12 @@ import (
 	"github.com/block-vision/sui-go-sdk/sui"
 
 	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	module_mcms_registry "github.com/smartcontractkit/chainlink-sui/bindings/generated/mcms/mcms_registry"
 )
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type IFeeQuoter interface {
@@ -44,7 +46,25 @@ type IFeeQuoter interface {
 	GetStaticConfig(ctx context.Context, opts *bind.CallOpts, ref bind.Object) (*models.SuiTransactionBlockResponse, error)
 	GetStaticConfigFields(ctx context.Context, opts *bind.CallOpts, cfg StaticConfig) (*models.SuiTransactionBlockResponse, error)
 	GetTokenTransferFeeConfigFields(ctx context.Context, opts *bind.CallOpts, cfg TokenTransferFeeConfig) (*models.SuiTransactionBlockResponse, error)
-	McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error)
+	McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	FilterFeeTokenAdded(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[FeeTokenAdded], error)
+	WatchFeeTokenAdded(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[FeeTokenAdded]) (*bind.EventSubscription, error)
+	FilterFeeTokenRemoved(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[FeeTokenRemoved], error)
+	WatchFeeTokenRemoved(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[FeeTokenRemoved]) (*bind.EventSubscription, error)
+	FilterTokenTransferFeeConfigAdded(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[TokenTransferFeeConfigAdded], error)
+	WatchTokenTransferFeeConfigAdded(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[TokenTransferFeeConfigAdded]) (*bind.EventSubscription, error)
+	FilterTokenTransferFeeConfigRemoved(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[TokenTransferFeeConfigRemoved], error)
+	WatchTokenTransferFeeConfigRemoved(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[TokenTransferFeeConfigRemoved]) (*bind.EventSubscription, error)
+	FilterUsdPerTokenUpdated(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[UsdPerTokenUpdated], error)
+	WatchUsdPerTokenUpdated(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[UsdPerTokenUpdated]) (*bind.EventSubscription, error)
+	FilterUsdPerUnitGasUpdated(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[UsdPerUnitGasUpdated], error)
+	WatchUsdPerUnitGasUpdated(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[UsdPerUnitGasUpdated]) (*bind.EventSubscription, error)
+	FilterDestChainAdded(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[DestChainAdded], error)
+	WatchDestChainAdded(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[DestChainAdded]) (*bind.EventSubscription, error)
+	FilterDestChainConfigUpdated(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[DestChainConfigUpdated], error)
+	WatchDestChainConfigUpdated(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[DestChainConfigUpdated]) (*bind.EventSubscription, error)
+	FilterPremiumMultiplierWeiPerEthUpdated(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[PremiumMultiplierWeiPerEthUpdated], error)
+	WatchPremiumMultiplierWeiPerEthUpdated(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[PremiumMultiplierWeiPerEthUpdated]) (*bind.EventSubscription, error)
 	DevInspect() IFeeQuoterDevInspect
 	Encoder() FeeQuoterEncoder
 	Bound() bind.IBoundContract
@@ -120,7 +140,7 @@ type FeeQuoterEncoder interface {
 	GetStaticConfigFieldsWithArgs(args ...any) (*bind.EncodedCall, error)
 	GetTokenTransferFeeConfigFields(cfg TokenTransferFeeConfig) (*bind.EncodedCall, error)
 	GetTokenTransferFeeConfigFieldsWithArgs(args ...any) (*bind.EncodedCall, error)
-	McmsEntrypoint(ref bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error)
+	McmsEntrypoint(ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	McmsEntrypointWithArgs(args ...any) (*bind.EncodedCall, error)
 }
 
@@ -639,6 +659,195 @@ func init() {
 	})
 }
 
+// DecodeFeeTokenAdded decodes the BCS of a FeeTokenAdded event.
+func DecodeFeeTokenAdded(data []byte) (FeeTokenAdded, error) {
+	var temp bcsFeeTokenAdded
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return FeeTokenAdded{}, err
+	}
+
+	return convertFeeTokenAddedFromBCS(temp)
+}
+
+// FilterFeeTokenAdded returns a page of the FeeTokenAdded events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *FeeQuoterContract) FilterFeeTokenAdded(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[FeeTokenAdded], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "FeeTokenAdded", cursor, limit, DecodeFeeTokenAdded)
+}
+
+// WatchFeeTokenAdded polls the FeeTokenAdded events and sends them to ch until the subscription is stopped.
+func (c *FeeQuoterContract) WatchFeeTokenAdded(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[FeeTokenAdded]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "FeeTokenAdded", opts, ch, DecodeFeeTokenAdded)
+}
+
+// DecodeFeeTokenRemoved decodes the BCS of a FeeTokenRemoved event.
+func DecodeFeeTokenRemoved(data []byte) (FeeTokenRemoved, error) {
+	var temp bcsFeeTokenRemoved
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return FeeTokenRemoved{}, err
+	}
+
+	return convertFeeTokenRemovedFromBCS(temp)
+}
+
+// FilterFeeTokenRemoved returns a page of the FeeTokenRemoved events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *FeeQuoterContract) FilterFeeTokenRemoved(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[FeeTokenRemoved], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "FeeTokenRemoved", cursor, limit, DecodeFeeTokenRemoved)
+}
+
+// WatchFeeTokenRemoved polls the FeeTokenRemoved events and sends them to ch until the subscription is stopped.
+func (c *FeeQuoterContract) WatchFeeTokenRemoved(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[FeeTokenRemoved]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "FeeTokenRemoved", opts, ch, DecodeFeeTokenRemoved)
+}
+
+// DecodeTokenTransferFeeConfigAdded decodes the BCS of a TokenTransferFeeConfigAdded event.
+func DecodeTokenTransferFeeConfigAdded(data []byte) (TokenTransferFeeConfigAdded, error) {
+	var temp bcsTokenTransferFeeConfigAdded
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return TokenTransferFeeConfigAdded{}, err
+	}
+
+	return convertTokenTransferFeeConfigAddedFromBCS(temp)
+}
+
+// FilterTokenTransferFeeConfigAdded returns a page of the TokenTransferFeeConfigAdded events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *FeeQuoterContract) FilterTokenTransferFeeConfigAdded(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[TokenTransferFeeConfigAdded], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "TokenTransferFeeConfigAdded", cursor, limit, DecodeTokenTransferFeeConfigAdded)
+}
+
+// WatchTokenTransferFeeConfigAdded polls the TokenTransferFeeConfigAdded events and sends them to ch until the subscription is stopped.
+func (c *FeeQuoterContract) WatchTokenTransferFeeConfigAdded(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[TokenTransferFeeConfigAdded]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "TokenTransferFeeConfigAdded", opts, ch, DecodeTokenTransferFeeConfigAdded)
+}
+
+// DecodeTokenTransferFeeConfigRemoved decodes the BCS of a TokenTransferFeeConfigRemoved event.
+func DecodeTokenTransferFeeConfigRemoved(data []byte) (TokenTransferFeeConfigRemoved, error) {
+	var temp bcsTokenTransferFeeConfigRemoved
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return TokenTransferFeeConfigRemoved{}, err
+	}
+
+	return convertTokenTransferFeeConfigRemovedFromBCS(temp)
+}
+
+// FilterTokenTransferFeeConfigRemoved returns a page of the TokenTransferFeeConfigRemoved events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *FeeQuoterContract) FilterTokenTransferFeeConfigRemoved(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[TokenTransferFeeConfigRemoved], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "TokenTransferFeeConfigRemoved", cursor, limit, DecodeTokenTransferFeeConfigRemoved)
+}
+
+// WatchTokenTransferFeeConfigRemoved polls the TokenTransferFeeConfigRemoved events and sends them to ch until the subscription is stopped.
+func (c *FeeQuoterContract) WatchTokenTransferFeeConfigRemoved(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[TokenTransferFeeConfigRemoved]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "TokenTransferFeeConfigRemoved", opts, ch, DecodeTokenTransferFeeConfigRemoved)
+}
+
+// DecodeUsdPerTokenUpdated decodes the BCS of a UsdPerTokenUpdated event.
+func DecodeUsdPerTokenUpdated(data []byte) (UsdPerTokenUpdated, error) {
+	var temp bcsUsdPerTokenUpdated
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return UsdPerTokenUpdated{}, err
+	}
+
+	return convertUsdPerTokenUpdatedFromBCS(temp)
+}
+
+// FilterUsdPerTokenUpdated returns a page of the UsdPerTokenUpdated events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *FeeQuoterContract) FilterUsdPerTokenUpdated(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[UsdPerTokenUpdated], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "UsdPerTokenUpdated", cursor, limit, DecodeUsdPerTokenUpdated)
+}
+
+// WatchUsdPerTokenUpdated polls the UsdPerTokenUpdated events and sends them to ch until the subscription is stopped.
+func (c *FeeQuoterContract) WatchUsdPerTokenUpdated(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[UsdPerTokenUpdated]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "UsdPerTokenUpdated", opts, ch, DecodeUsdPerTokenUpdated)
+}
+
+// DecodeUsdPerUnitGasUpdated decodes the BCS of a UsdPerUnitGasUpdated event.
+func DecodeUsdPerUnitGasUpdated(data []byte) (UsdPerUnitGasUpdated, error) {
+	var temp bcsUsdPerUnitGasUpdated
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return UsdPerUnitGasUpdated{}, err
+	}
+
+	return convertUsdPerUnitGasUpdatedFromBCS(temp)
+}
+
+// FilterUsdPerUnitGasUpdated returns a page of the UsdPerUnitGasUpdated events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *FeeQuoterContract) FilterUsdPerUnitGasUpdated(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[UsdPerUnitGasUpdated], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "UsdPerUnitGasUpdated", cursor, limit, DecodeUsdPerUnitGasUpdated)
+}
+
+// WatchUsdPerUnitGasUpdated polls the UsdPerUnitGasUpdated events and sends them to ch until the subscription is stopped.
+func (c *FeeQuoterContract) WatchUsdPerUnitGasUpdated(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[UsdPerUnitGasUpdated]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "UsdPerUnitGasUpdated", opts, ch, DecodeUsdPerUnitGasUpdated)
+}
+
+// DecodeDestChainAdded decodes the BCS of a DestChainAdded event.
+func DecodeDestChainAdded(data []byte) (DestChainAdded, error) {
+	var result DestChainAdded
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return DestChainAdded{}, err
+	}
+
+	return result, nil
+}
+
+// FilterDestChainAdded returns a page of the DestChainAdded events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *FeeQuoterContract) FilterDestChainAdded(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[DestChainAdded], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "DestChainAdded", cursor, limit, DecodeDestChainAdded)
+}
+
+// WatchDestChainAdded polls the DestChainAdded events and sends them to ch until the subscription is stopped.
+func (c *FeeQuoterContract) WatchDestChainAdded(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[DestChainAdded]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "DestChainAdded", opts, ch, DecodeDestChainAdded)
+}
+
+// DecodeDestChainConfigUpdated decodes the BCS of a DestChainConfigUpdated event.
+func DecodeDestChainConfigUpdated(data []byte) (DestChainConfigUpdated, error) {
+	var result DestChainConfigUpdated
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return DestChainConfigUpdated{}, err
+	}
+
+	return result, nil
+}
+
+// FilterDestChainConfigUpdated returns a page of the DestChainConfigUpdated events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *FeeQuoterContract) FilterDestChainConfigUpdated(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[DestChainConfigUpdated], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "DestChainConfigUpdated", cursor, limit, DecodeDestChainConfigUpdated)
+}
+
+// WatchDestChainConfigUpdated polls the DestChainConfigUpdated events and sends them to ch until the subscription is stopped.
+func (c *FeeQuoterContract) WatchDestChainConfigUpdated(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[DestChainConfigUpdated]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "DestChainConfigUpdated", opts, ch, DecodeDestChainConfigUpdated)
+}
+
+// DecodePremiumMultiplierWeiPerEthUpdated decodes the BCS of a PremiumMultiplierWeiPerEthUpdated event.
+func DecodePremiumMultiplierWeiPerEthUpdated(data []byte) (PremiumMultiplierWeiPerEthUpdated, error) {
+	var temp bcsPremiumMultiplierWeiPerEthUpdated
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return PremiumMultiplierWeiPerEthUpdated{}, err
+	}
+
+	return convertPremiumMultiplierWeiPerEthUpdatedFromBCS(temp)
+}
+
+// FilterPremiumMultiplierWeiPerEthUpdated returns a page of the PremiumMultiplierWeiPerEthUpdated events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *FeeQuoterContract) FilterPremiumMultiplierWeiPerEthUpdated(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[PremiumMultiplierWeiPerEthUpdated], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "PremiumMultiplierWeiPerEthUpdated", cursor, limit, DecodePremiumMultiplierWeiPerEthUpdated)
+}
+
+// WatchPremiumMultiplierWeiPerEthUpdated polls the PremiumMultiplierWeiPerEthUpdated events and sends them to ch until the subscription is stopped.
+func (c *FeeQuoterContract) WatchPremiumMultiplierWeiPerEthUpdated(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[PremiumMultiplierWeiPerEthUpdated]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "PremiumMultiplierWeiPerEthUpdated", opts, ch, DecodePremiumMultiplierWeiPerEthUpdated)
+}
+
 // TypeAndVersion executes the type_and_version Move function.
 func (c *FeeQuoterContract) TypeAndVersion(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.feeQuoterEncoder.TypeAndVersion()
@@ -880,7 +1089,7 @@ func (c *FeeQuoterContract) GetTokenTransferFeeConfigFields(ctx context.Context,
 }
 
 // McmsEntrypoint executes the mcms_entrypoint Move function.
-func (c *FeeQuoterContract) McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *FeeQuoterContract) McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.feeQuoterEncoder.McmsEntrypoint(ref, registry, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -2253,7 +2462,7 @@ func (c feeQuoterEncoder) GetTokenTransferFeeConfigFieldsWithArgs(args ...any) (
 }
 
 // McmsEntrypoint encodes a call to the mcms_entrypoint Move function.
-func (c feeQuoterEncoder) McmsEntrypoint(ref bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error) {
+func (c feeQuoterEncoder) McmsEntrypoint(ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("mcms_entrypoint", typeArgsList, typeParamsList, []string{
@@ -0,0 +1,148 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package module_merkle_proof
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+)
+
+var (
+	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
+)
+
+type IMerkleProof interface {
+	VectorU8Gt(ctx context.Context, opts *bind.CallOpts, a []byte, b []byte) (*models.SuiTransactionBlockResponse, error)
+	DevInspect() IMerkleProofDevInspect
+	Encoder() MerkleProofEncoder
+	Bound() bind.IBoundContract
+}
+
+type IMerkleProofDevInspect interface {
+	VectorU8Gt(ctx context.Context, opts *bind.CallOpts, a []byte, b []byte) (bool, error)
+}
+
+type MerkleProofEncoder interface {
+	VectorU8Gt(a []byte, b []byte) (*bind.EncodedCall, error)
+	VectorU8GtWithArgs(args ...any) (*bind.EncodedCall, error)
+}
+
+type MerkleProofContract struct {
+	*bind.BoundContract
+	merkleProofEncoder
+	devInspect *MerkleProofDevInspect
+}
+
+type MerkleProofDevInspect struct {
+	contract *MerkleProofContract
+}
+
+var _ IMerkleProof = (*MerkleProofContract)(nil)
+var _ IMerkleProofDevInspect = (*MerkleProofDevInspect)(nil)
+
+func NewMerkleProof(packageID string, client sui.ISuiAPI) (IMerkleProof, error) {
+	contract, err := bind.NewBoundContract(packageID, "ccip", "merkle_proof", client)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &MerkleProofContract{
+		BoundContract:      contract,
+		merkleProofEncoder: merkleProofEncoder{BoundContract: contract},
+	}
+	c.devInspect = &MerkleProofDevInspect{contract: c}
+	return c, nil
+}
+
+func (c *MerkleProofContract) Bound() bind.IBoundContract {
+	return c.BoundContract
+}
+
+func (c *MerkleProofContract) Encoder() MerkleProofEncoder {
+	return c.merkleProofEncoder
+}
+
+func (c *MerkleProofContract) DevInspect() IMerkleProofDevInspect {
+	return c.devInspect
+}
+
+func init() {
+}
+
+// VectorU8Gt executes the vector_u8_gt Move function.
+func (c *MerkleProofContract) VectorU8Gt(ctx context.Context, opts *bind.CallOpts, a []byte, b []byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.merkleProofEncoder.VectorU8Gt(a, b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// VectorU8Gt executes the vector_u8_gt Move function using DevInspect to get return values.
+//
+// Returns: bool
+func (d *MerkleProofDevInspect) VectorU8Gt(ctx context.Context, opts *bind.CallOpts, a []byte, b []byte) (bool, error) {
+	encoded, err := d.contract.merkleProofEncoder.VectorU8Gt(a, b)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return false, err
+	}
+	if len(results) == 0 {
+		return false, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected return type: expected bool, got %T", results[0])
+	}
+	return result, nil
+}
+
+type merkleProofEncoder struct {
+	*bind.BoundContract
+}
+
+// VectorU8Gt encodes a call to the vector_u8_gt Move function.
+func (c merkleProofEncoder) VectorU8Gt(a []byte, b []byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("vector_u8_gt", typeArgsList, typeParamsList, []string{
+		"&vector<u8>",
+		"&vector<u8>",
+	}, []any{
+		a,
+		b,
+	}, []string{
+		"bool",
+	})
+}
+
+// VectorU8GtWithArgs encodes a call to the vector_u8_gt Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c merkleProofEncoder) VectorU8GtWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&vector<u8>",
+		"&vector<u8>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("vector_u8_gt", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"bool",
+	})
+}
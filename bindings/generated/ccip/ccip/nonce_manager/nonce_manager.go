@@ -17,6 +17,7 @@ import (
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type INonceManager interface {
@@ -0,0 +1,541 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package module_onramp_state_helper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+)
+
+var (
+	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
+)
+
+type IOnrampStateHelper interface {
+	GetTokenReceiver(ctx context.Context, opts *bind.CallOpts, params TokenTransferParams) (*models.SuiTransactionBlockResponse, error)
+	CreateTokenTransferParams(ctx context.Context, opts *bind.CallOpts, tokenReceiver string) (*models.SuiTransactionBlockResponse, error)
+	AddTokenTransferParam(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ref bind.Object, tokenTransferParams TokenTransferParams, remoteChainSelector uint64, amount uint64, sourceTokenCoinMetadataAddress string, destTokenAddress []byte, extraData []byte, param bind.Object) (*models.SuiTransactionBlockResponse, error)
+	HasTokenTransfer(ctx context.Context, opts *bind.CallOpts, tokenTransferParams TokenTransferParams) (*models.SuiTransactionBlockResponse, error)
+	DeconstructTokenParams(ctx context.Context, opts *bind.CallOpts, param bind.Object, tokenTransferParams TokenTransferParams) (*models.SuiTransactionBlockResponse, error)
+	GetSourceTokenTransferData(ctx context.Context, opts *bind.CallOpts, tokenTransferParams TokenTransferParams) (*models.SuiTransactionBlockResponse, error)
+	DevInspect() IOnrampStateHelperDevInspect
+	Encoder() OnrampStateHelperEncoder
+	Bound() bind.IBoundContract
+}
+
+type IOnrampStateHelperDevInspect interface {
+	GetTokenReceiver(ctx context.Context, opts *bind.CallOpts, params TokenTransferParams) (string, error)
+	CreateTokenTransferParams(ctx context.Context, opts *bind.CallOpts, tokenReceiver string) (TokenTransferParams, error)
+	HasTokenTransfer(ctx context.Context, opts *bind.CallOpts, tokenTransferParams TokenTransferParams) (bool, error)
+	GetSourceTokenTransferData(ctx context.Context, opts *bind.CallOpts, tokenTransferParams TokenTransferParams) ([]any, error)
+}
+
+type OnrampStateHelperEncoder interface {
+	GetTokenReceiver(params TokenTransferParams) (*bind.EncodedCall, error)
+	GetTokenReceiverWithArgs(args ...any) (*bind.EncodedCall, error)
+	CreateTokenTransferParams(tokenReceiver string) (*bind.EncodedCall, error)
+	CreateTokenTransferParamsWithArgs(args ...any) (*bind.EncodedCall, error)
+	AddTokenTransferParam(typeArgs []string, ref bind.Object, tokenTransferParams TokenTransferParams, remoteChainSelector uint64, amount uint64, sourceTokenCoinMetadataAddress string, destTokenAddress []byte, extraData []byte, param bind.Object) (*bind.EncodedCall, error)
+	AddTokenTransferParamWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error)
+	HasTokenTransfer(tokenTransferParams TokenTransferParams) (*bind.EncodedCall, error)
+	HasTokenTransferWithArgs(args ...any) (*bind.EncodedCall, error)
+	DeconstructTokenParams(param bind.Object, tokenTransferParams TokenTransferParams) (*bind.EncodedCall, error)
+	DeconstructTokenParamsWithArgs(args ...any) (*bind.EncodedCall, error)
+	GetSourceTokenTransferData(tokenTransferParams TokenTransferParams) (*bind.EncodedCall, error)
+	GetSourceTokenTransferDataWithArgs(args ...any) (*bind.EncodedCall, error)
+}
+
+type OnrampStateHelperContract struct {
+	*bind.BoundContract
+	onrampStateHelperEncoder
+	devInspect *OnrampStateHelperDevInspect
+}
+
+type OnrampStateHelperDevInspect struct {
+	contract *OnrampStateHelperContract
+}
+
+var _ IOnrampStateHelper = (*OnrampStateHelperContract)(nil)
+var _ IOnrampStateHelperDevInspect = (*OnrampStateHelperDevInspect)(nil)
+
+func NewOnrampStateHelper(packageID string, client sui.ISuiAPI) (IOnrampStateHelper, error) {
+	contract, err := bind.NewBoundContract(packageID, "ccip", "onramp_state_helper", client)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &OnrampStateHelperContract{
+		BoundContract:            contract,
+		onrampStateHelperEncoder: onrampStateHelperEncoder{BoundContract: contract},
+	}
+	c.devInspect = &OnrampStateHelperDevInspect{contract: c}
+	return c, nil
+}
+
+func (c *OnrampStateHelperContract) Bound() bind.IBoundContract {
+	return c.BoundContract
+}
+
+func (c *OnrampStateHelperContract) Encoder() OnrampStateHelperEncoder {
+	return c.onrampStateHelperEncoder
+}
+
+func (c *OnrampStateHelperContract) DevInspect() IOnrampStateHelperDevInspect {
+	return c.devInspect
+}
+
+type SourceTransferCap struct {
+	Id string `move:"sui::object::UID"`
+}
+
+type TokenTransferParams struct {
+	TokenTransfer *TokenTransferMetadata `move:"0x1::option::Option<TokenTransferMetadata>"`
+	TokenReceiver string                 `move:"address"`
+}
+
+type TokenTransferMetadata struct {
+	RemoteChainSelector            uint64 `move:"u64"`
+	TokenPoolPackageId             string `move:"address"`
+	Amount                         uint64 `move:"u64"`
+	SourceTokenCoinMetadataAddress string `move:"address"`
+	DestTokenAddress               []byte `move:"vector<u8>"`
+	ExtraData                      []byte `move:"vector<u8>"`
+}
+
+type bcsTokenTransferParams struct {
+	TokenTransfer *TokenTransferMetadata
+	TokenReceiver [32]byte
+}
+
+func convertTokenTransferParamsFromBCS(bcs bcsTokenTransferParams) (TokenTransferParams, error) {
+
+	return TokenTransferParams{
+		TokenTransfer: bcs.TokenTransfer,
+		TokenReceiver: fmt.Sprintf("0x%x", bcs.TokenReceiver),
+	}, nil
+}
+
+type bcsTokenTransferMetadata struct {
+	RemoteChainSelector            uint64
+	TokenPoolPackageId             [32]byte
+	Amount                         uint64
+	SourceTokenCoinMetadataAddress [32]byte
+	DestTokenAddress               []byte
+	ExtraData                      []byte
+}
+
+func convertTokenTransferMetadataFromBCS(bcs bcsTokenTransferMetadata) (TokenTransferMetadata, error) {
+
+	return TokenTransferMetadata{
+		RemoteChainSelector:            bcs.RemoteChainSelector,
+		TokenPoolPackageId:             fmt.Sprintf("0x%x", bcs.TokenPoolPackageId),
+		Amount:                         bcs.Amount,
+		SourceTokenCoinMetadataAddress: fmt.Sprintf("0x%x", bcs.SourceTokenCoinMetadataAddress),
+		DestTokenAddress:               bcs.DestTokenAddress,
+		ExtraData:                      bcs.ExtraData,
+	}, nil
+}
+
+func init() {
+	bind.RegisterStructDecoder("ccip::onramp_state_helper::SourceTransferCap", func(data []byte) (interface{}, error) {
+		var result SourceTransferCap
+		_, err := mystenbcs.Unmarshal(data, &result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip::onramp_state_helper::TokenTransferParams", func(data []byte) (interface{}, error) {
+		var temp bcsTokenTransferParams
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertTokenTransferParamsFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip::onramp_state_helper::TokenTransferMetadata", func(data []byte) (interface{}, error) {
+		var temp bcsTokenTransferMetadata
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertTokenTransferMetadataFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
+// GetTokenReceiver executes the get_token_receiver Move function.
+func (c *OnrampStateHelperContract) GetTokenReceiver(ctx context.Context, opts *bind.CallOpts, params TokenTransferParams) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.onrampStateHelperEncoder.GetTokenReceiver(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// CreateTokenTransferParams executes the create_token_transfer_params Move function.
+func (c *OnrampStateHelperContract) CreateTokenTransferParams(ctx context.Context, opts *bind.CallOpts, tokenReceiver string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.onrampStateHelperEncoder.CreateTokenTransferParams(tokenReceiver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// AddTokenTransferParam executes the add_token_transfer_param Move function.
+func (c *OnrampStateHelperContract) AddTokenTransferParam(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ref bind.Object, tokenTransferParams TokenTransferParams, remoteChainSelector uint64, amount uint64, sourceTokenCoinMetadataAddress string, destTokenAddress []byte, extraData []byte, param bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.onrampStateHelperEncoder.AddTokenTransferParam(typeArgs, ref, tokenTransferParams, remoteChainSelector, amount, sourceTokenCoinMetadataAddress, destTokenAddress, extraData, param)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// HasTokenTransfer executes the has_token_transfer Move function.
+func (c *OnrampStateHelperContract) HasTokenTransfer(ctx context.Context, opts *bind.CallOpts, tokenTransferParams TokenTransferParams) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.onrampStateHelperEncoder.HasTokenTransfer(tokenTransferParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeconstructTokenParams executes the deconstruct_token_params Move function.
+func (c *OnrampStateHelperContract) DeconstructTokenParams(ctx context.Context, opts *bind.CallOpts, param bind.Object, tokenTransferParams TokenTransferParams) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.onrampStateHelperEncoder.DeconstructTokenParams(param, tokenTransferParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// GetSourceTokenTransferData executes the get_source_token_transfer_data Move function.
+func (c *OnrampStateHelperContract) GetSourceTokenTransferData(ctx context.Context, opts *bind.CallOpts, tokenTransferParams TokenTransferParams) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.onrampStateHelperEncoder.GetSourceTokenTransferData(tokenTransferParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// GetTokenReceiver executes the get_token_receiver Move function using DevInspect to get return values.
+//
+// Returns: address
+func (d *OnrampStateHelperDevInspect) GetTokenReceiver(ctx context.Context, opts *bind.CallOpts, params TokenTransferParams) (string, error) {
+	encoded, err := d.contract.onrampStateHelperEncoder.GetTokenReceiver(params)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return "", err
+	}
+	if len(results) == 0 {
+		return "", fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected return type: expected string, got %T", results[0])
+	}
+	return result, nil
+}
+
+// CreateTokenTransferParams executes the create_token_transfer_params Move function using DevInspect to get return values.
+//
+// Returns: TokenTransferParams
+func (d *OnrampStateHelperDevInspect) CreateTokenTransferParams(ctx context.Context, opts *bind.CallOpts, tokenReceiver string) (TokenTransferParams, error) {
+	encoded, err := d.contract.onrampStateHelperEncoder.CreateTokenTransferParams(tokenReceiver)
+	if err != nil {
+		return TokenTransferParams{}, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return TokenTransferParams{}, err
+	}
+	if len(results) == 0 {
+		return TokenTransferParams{}, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(TokenTransferParams)
+	if !ok {
+		return TokenTransferParams{}, fmt.Errorf("unexpected return type: expected TokenTransferParams, got %T", results[0])
+	}
+	return result, nil
+}
+
+// HasTokenTransfer executes the has_token_transfer Move function using DevInspect to get return values.
+//
+// Returns: bool
+func (d *OnrampStateHelperDevInspect) HasTokenTransfer(ctx context.Context, opts *bind.CallOpts, tokenTransferParams TokenTransferParams) (bool, error) {
+	encoded, err := d.contract.onrampStateHelperEncoder.HasTokenTransfer(tokenTransferParams)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return false, err
+	}
+	if len(results) == 0 {
+		return false, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected return type: expected bool, got %T", results[0])
+	}
+	return result, nil
+}
+
+// GetSourceTokenTransferData executes the get_source_token_transfer_data Move function using DevInspect to get return values.
+//
+// Returns:
+//
+//	[0]: u64
+//	[1]: address
+//	[2]: u64
+//	[3]: address
+//	[4]: vector<u8>
+//	[5]: vector<u8>
+func (d *OnrampStateHelperDevInspect) GetSourceTokenTransferData(ctx context.Context, opts *bind.CallOpts, tokenTransferParams TokenTransferParams) ([]any, error) {
+	encoded, err := d.contract.onrampStateHelperEncoder.GetSourceTokenTransferData(tokenTransferParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	return d.contract.Call(ctx, opts, encoded)
+}
+
+type onrampStateHelperEncoder struct {
+	*bind.BoundContract
+}
+
+// GetTokenReceiver encodes a call to the get_token_receiver Move function.
+func (c onrampStateHelperEncoder) GetTokenReceiver(params TokenTransferParams) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_token_receiver", typeArgsList, typeParamsList, []string{
+		"&TokenTransferParams",
+	}, []any{
+		params,
+	}, []string{
+		"address",
+	})
+}
+
+// GetTokenReceiverWithArgs encodes a call to the get_token_receiver Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c onrampStateHelperEncoder) GetTokenReceiverWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&TokenTransferParams",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_token_receiver", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"address",
+	})
+}
+
+// CreateTokenTransferParams encodes a call to the create_token_transfer_params Move function.
+func (c onrampStateHelperEncoder) CreateTokenTransferParams(tokenReceiver string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("create_token_transfer_params", typeArgsList, typeParamsList, []string{
+		"address",
+	}, []any{
+		tokenReceiver,
+	}, []string{
+		"ccip::onramp_state_helper::TokenTransferParams",
+	})
+}
+
+// CreateTokenTransferParamsWithArgs encodes a call to the create_token_transfer_params Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c onrampStateHelperEncoder) CreateTokenTransferParamsWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"address",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("create_token_transfer_params", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"ccip::onramp_state_helper::TokenTransferParams",
+	})
+}
+
+// AddTokenTransferParam encodes a call to the add_token_transfer_param Move function.
+func (c onrampStateHelperEncoder) AddTokenTransferParam(typeArgs []string, ref bind.Object, tokenTransferParams TokenTransferParams, remoteChainSelector uint64, amount uint64, sourceTokenCoinMetadataAddress string, destTokenAddress []byte, extraData []byte, param bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"TypeProof",
+	}
+	return c.EncodeCallArgsWithGenerics("add_token_transfer_param", typeArgsList, typeParamsList, []string{
+		"&CCIPObjectRef",
+		"&mut TokenTransferParams",
+		"u64",
+		"u64",
+		"address",
+		"vector<u8>",
+		"vector<u8>",
+		"TypeProof",
+	}, []any{
+		ref,
+		tokenTransferParams,
+		remoteChainSelector,
+		amount,
+		sourceTokenCoinMetadataAddress,
+		destTokenAddress,
+		extraData,
+		param,
+	}, nil)
+}
+
+// AddTokenTransferParamWithArgs encodes a call to the add_token_transfer_param Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c onrampStateHelperEncoder) AddTokenTransferParamWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&CCIPObjectRef",
+		"&mut TokenTransferParams",
+		"u64",
+		"u64",
+		"address",
+		"vector<u8>",
+		"vector<u8>",
+		"TypeProof",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"TypeProof",
+	}
+	return c.EncodeCallArgsWithGenerics("add_token_transfer_param", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// HasTokenTransfer encodes a call to the has_token_transfer Move function.
+func (c onrampStateHelperEncoder) HasTokenTransfer(tokenTransferParams TokenTransferParams) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("has_token_transfer", typeArgsList, typeParamsList, []string{
+		"&TokenTransferParams",
+	}, []any{
+		tokenTransferParams,
+	}, []string{
+		"bool",
+	})
+}
+
+// HasTokenTransferWithArgs encodes a call to the has_token_transfer Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c onrampStateHelperEncoder) HasTokenTransferWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&TokenTransferParams",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("has_token_transfer", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"bool",
+	})
+}
+
+// DeconstructTokenParams encodes a call to the deconstruct_token_params Move function.
+func (c onrampStateHelperEncoder) DeconstructTokenParams(param bind.Object, tokenTransferParams TokenTransferParams) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deconstruct_token_params", typeArgsList, typeParamsList, []string{
+		"&SourceTransferCap",
+		"ccip::onramp_state_helper::TokenTransferParams",
+	}, []any{
+		param,
+		tokenTransferParams,
+	}, nil)
+}
+
+// DeconstructTokenParamsWithArgs encodes a call to the deconstruct_token_params Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c onrampStateHelperEncoder) DeconstructTokenParamsWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&SourceTransferCap",
+		"ccip::onramp_state_helper::TokenTransferParams",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deconstruct_token_params", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// GetSourceTokenTransferData encodes a call to the get_source_token_transfer_data Move function.
+func (c onrampStateHelperEncoder) GetSourceTokenTransferData(tokenTransferParams TokenTransferParams) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_source_token_transfer_data", typeArgsList, typeParamsList, []string{
+		"&TokenTransferParams",
+	}, []any{
+		tokenTransferParams,
+	}, []string{
+		"u64",
+		"address",
+		"u64",
+		"address",
+		"vector<u8>",
+		"vector<u8>",
+	})
+}
+
+// GetSourceTokenTransferDataWithArgs encodes a call to the get_source_token_transfer_data Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c onrampStateHelperEncoder) GetSourceTokenTransferDataWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&TokenTransferParams",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_source_token_transfer_data", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u64",
+		"address",
+		"u64",
+		"address",
+		"vector<u8>",
+		"vector<u8>",
+	})
+}
@@ -17,6 +17,7 @@ import (
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type IReceiverRegistry interface {
@@ -28,6 +29,10 @@ type IReceiverRegistry interface {
 	GetReceiverConfig(ctx context.Context, opts *bind.CallOpts, ref bind.Object, receiverPackageId string) (*models.SuiTransactionBlockResponse, error)
 	GetReceiverConfigFields(ctx context.Context, opts *bind.CallOpts, rc ReceiverConfig) (*models.SuiTransactionBlockResponse, error)
 	GetReceiverInfo(ctx context.Context, opts *bind.CallOpts, ref bind.Object, receiverPackageId string) (*models.SuiTransactionBlockResponse, error)
+	FilterReceiverRegistered(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ReceiverRegistered], error)
+	WatchReceiverRegistered(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ReceiverRegistered]) (*bind.EventSubscription, error)
+	FilterReceiverUnregistered(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ReceiverUnregistered], error)
+	WatchReceiverUnregistered(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ReceiverUnregistered]) (*bind.EventSubscription, error)
 	DevInspect() IReceiverRegistryDevInspect
 	Encoder() ReceiverRegistryEncoder
 	Bound() bind.IBoundContract
@@ -190,6 +195,48 @@ func init() {
 	})
 }
 
+// DecodeReceiverRegistered decodes the BCS of a ReceiverRegistered event.
+func DecodeReceiverRegistered(data []byte) (ReceiverRegistered, error) {
+	var temp bcsReceiverRegistered
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return ReceiverRegistered{}, err
+	}
+
+	return convertReceiverRegisteredFromBCS(temp)
+}
+
+// FilterReceiverRegistered returns a page of the ReceiverRegistered events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *ReceiverRegistryContract) FilterReceiverRegistered(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ReceiverRegistered], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "ReceiverRegistered", cursor, limit, DecodeReceiverRegistered)
+}
+
+// WatchReceiverRegistered polls the ReceiverRegistered events and sends them to ch until the subscription is stopped.
+func (c *ReceiverRegistryContract) WatchReceiverRegistered(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ReceiverRegistered]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "ReceiverRegistered", opts, ch, DecodeReceiverRegistered)
+}
+
+// DecodeReceiverUnregistered decodes the BCS of a ReceiverUnregistered event.
+func DecodeReceiverUnregistered(data []byte) (ReceiverUnregistered, error) {
+	var temp bcsReceiverUnregistered
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return ReceiverUnregistered{}, err
+	}
+
+	return convertReceiverUnregisteredFromBCS(temp)
+}
+
+// FilterReceiverUnregistered returns a page of the ReceiverUnregistered events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *ReceiverRegistryContract) FilterReceiverUnregistered(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ReceiverUnregistered], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "ReceiverUnregistered", cursor, limit, DecodeReceiverUnregistered)
+}
+
+// WatchReceiverUnregistered polls the ReceiverUnregistered events and sends them to ch until the subscription is stopped.
+func (c *ReceiverRegistryContract) WatchReceiverUnregistered(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ReceiverUnregistered]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "ReceiverUnregistered", opts, ch, DecodeReceiverUnregistered)
+}
+
 // TypeAndVersion executes the type_and_version Move function.
 func (c *ReceiverRegistryContract) TypeAndVersion(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.receiverRegistryEncoder.TypeAndVersion()
@@ -17,6 +17,7 @@ import (
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type IRmnRemote interface {
@@ -36,6 +37,12 @@ type IRmnRemote interface {
 	IsCursedGlobal(ctx context.Context, opts *bind.CallOpts, ref bind.Object) (*models.SuiTransactionBlockResponse, error)
 	IsCursed(ctx context.Context, opts *bind.CallOpts, ref bind.Object, subject []byte) (*models.SuiTransactionBlockResponse, error)
 	IsCursedU128(ctx context.Context, opts *bind.CallOpts, ref bind.Object, subjectValue *big.Int) (*models.SuiTransactionBlockResponse, error)
+	FilterConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ConfigSet], error)
+	WatchConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ConfigSet]) (*bind.EventSubscription, error)
+	FilterCursed(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[Cursed], error)
+	WatchCursed(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[Cursed]) (*bind.EventSubscription, error)
+	FilterUncursed(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[Uncursed], error)
+	WatchUncursed(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[Uncursed]) (*bind.EventSubscription, error)
 	DevInspect() IRmnRemoteDevInspect
 	Encoder() RmnRemoteEncoder
 	Bound() bind.IBoundContract
@@ -268,6 +275,69 @@ func init() {
 	})
 }
 
+// DecodeConfigSet decodes the BCS of a ConfigSet event.
+func DecodeConfigSet(data []byte) (ConfigSet, error) {
+	var result ConfigSet
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return ConfigSet{}, err
+	}
+
+	return result, nil
+}
+
+// FilterConfigSet returns a page of the ConfigSet events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *RmnRemoteContract) FilterConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ConfigSet], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "ConfigSet", cursor, limit, DecodeConfigSet)
+}
+
+// WatchConfigSet polls the ConfigSet events and sends them to ch until the subscription is stopped.
+func (c *RmnRemoteContract) WatchConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ConfigSet]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "ConfigSet", opts, ch, DecodeConfigSet)
+}
+
+// DecodeCursed decodes the BCS of a Cursed event.
+func DecodeCursed(data []byte) (Cursed, error) {
+	var result Cursed
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return Cursed{}, err
+	}
+
+	return result, nil
+}
+
+// FilterCursed returns a page of the Cursed events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *RmnRemoteContract) FilterCursed(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[Cursed], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "Cursed", cursor, limit, DecodeCursed)
+}
+
+// WatchCursed polls the Cursed events and sends them to ch until the subscription is stopped.
+func (c *RmnRemoteContract) WatchCursed(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[Cursed]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "Cursed", opts, ch, DecodeCursed)
+}
+
+// DecodeUncursed decodes the BCS of a Uncursed event.
+func DecodeUncursed(data []byte) (Uncursed, error) {
+	var result Uncursed
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return Uncursed{}, err
+	}
+
+	return result, nil
+}
+
+// FilterUncursed returns a page of the Uncursed events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *RmnRemoteContract) FilterUncursed(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[Uncursed], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "Uncursed", cursor, limit, DecodeUncursed)
+}
+
+// WatchUncursed polls the Uncursed events and sends them to ch until the subscription is stopped.
+func (c *RmnRemoteContract) WatchUncursed(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[Uncursed]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "Uncursed", opts, ch, DecodeUncursed)
+}
+
 // TypeAndVersion executes the type_and_version Move function.
 func (c *RmnRemoteContract) TypeAndVersion(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.rmnRemoteEncoder.TypeAndVersion()
@@ -13,10 +13,12 @@ import (
 	"github.com/block-vision/sui-go-sdk/sui"
 
 	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	module_mcms_registry "github.com/smartcontractkit/chainlink-sui/bindings/generated/mcms/mcms_registry"
 )
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type IStateObject interface {
@@ -35,8 +37,8 @@ type IStateObject interface {
 	PendingTransferFrom(ctx context.Context, opts *bind.CallOpts, ref bind.Object) (*models.SuiTransactionBlockResponse, error)
 	PendingTransferTo(ctx context.Context, opts *bind.CallOpts, ref bind.Object) (*models.SuiTransactionBlockResponse, error)
 	PendingTransferAccepted(ctx context.Context, opts *bind.CallOpts, ref bind.Object) (*models.SuiTransactionBlockResponse, error)
-	McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error)
-	McmsProofEntrypoint(ctx context.Context, opts *bind.CallOpts, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error)
+	McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	McmsProofEntrypoint(ctx context.Context, opts *bind.CallOpts, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
 	DevInspect() IStateObjectDevInspect
 	Encoder() StateObjectEncoder
 	Bound() bind.IBoundContract
@@ -53,7 +55,7 @@ type IStateObjectDevInspect interface {
 	PendingTransferFrom(ctx context.Context, opts *bind.CallOpts, ref bind.Object) (*string, error)
 	PendingTransferTo(ctx context.Context, opts *bind.CallOpts, ref bind.Object) (*string, error)
 	PendingTransferAccepted(ctx context.Context, opts *bind.CallOpts, ref bind.Object) (*bool, error)
-	McmsProofEntrypoint(ctx context.Context, opts *bind.CallOpts, registry bind.Object, params bind.Object) (CCIPAdminProof, error)
+	McmsProofEntrypoint(ctx context.Context, opts *bind.CallOpts, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (CCIPAdminProof, error)
 }
 
 type StateObjectEncoder interface {
@@ -87,9 +89,9 @@ type StateObjectEncoder interface {
 	PendingTransferToWithArgs(args ...any) (*bind.EncodedCall, error)
 	PendingTransferAccepted(ref bind.Object) (*bind.EncodedCall, error)
 	PendingTransferAcceptedWithArgs(args ...any) (*bind.EncodedCall, error)
-	McmsEntrypoint(ref bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error)
+	McmsEntrypoint(ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	McmsEntrypointWithArgs(args ...any) (*bind.EncodedCall, error)
-	McmsProofEntrypoint(registry bind.Object, params bind.Object) (*bind.EncodedCall, error)
+	McmsProofEntrypoint(registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	McmsProofEntrypointWithArgs(args ...any) (*bind.EncodedCall, error)
 }
 
@@ -366,7 +368,7 @@ func (c *StateObjectContract) PendingTransferAccepted(ctx context.Context, opts
 }
 
 // McmsEntrypoint executes the mcms_entrypoint Move function.
-func (c *StateObjectContract) McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *StateObjectContract) McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.stateObjectEncoder.McmsEntrypoint(ref, registry, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -376,7 +378,7 @@ func (c *StateObjectContract) McmsEntrypoint(ctx context.Context, opts *bind.Cal
 }
 
 // McmsProofEntrypoint executes the mcms_proof_entrypoint Move function.
-func (c *StateObjectContract) McmsProofEntrypoint(ctx context.Context, opts *bind.CallOpts, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *StateObjectContract) McmsProofEntrypoint(ctx context.Context, opts *bind.CallOpts, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.stateObjectEncoder.McmsProofEntrypoint(registry, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -604,7 +606,7 @@ func (d *StateObjectDevInspect) PendingTransferAccepted(ctx context.Context, opt
 // McmsProofEntrypoint executes the mcms_proof_entrypoint Move function using DevInspect to get return values.
 //
 // Returns: CCIPAdminProof
-func (d *StateObjectDevInspect) McmsProofEntrypoint(ctx context.Context, opts *bind.CallOpts, registry bind.Object, params bind.Object) (CCIPAdminProof, error) {
+func (d *StateObjectDevInspect) McmsProofEntrypoint(ctx context.Context, opts *bind.CallOpts, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (CCIPAdminProof, error) {
 	encoded, err := d.contract.stateObjectEncoder.McmsProofEntrypoint(registry, params)
 	if err != nil {
 		return CCIPAdminProof{}, fmt.Errorf("failed to encode function call: %w", err)
@@ -1108,7 +1110,7 @@ func (c stateObjectEncoder) PendingTransferAcceptedWithArgs(args ...any) (*bind.
 }
 
 // McmsEntrypoint encodes a call to the mcms_entrypoint Move function.
-func (c stateObjectEncoder) McmsEntrypoint(ref bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error) {
+func (c stateObjectEncoder) McmsEntrypoint(ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("mcms_entrypoint", typeArgsList, typeParamsList, []string{
@@ -1140,7 +1142,7 @@ func (c stateObjectEncoder) McmsEntrypointWithArgs(args ...any) (*bind.EncodedCa
 }
 
 // McmsProofEntrypoint encodes a call to the mcms_proof_entrypoint Move function.
-func (c stateObjectEncoder) McmsProofEntrypoint(registry bind.Object, params bind.Object) (*bind.EncodedCall, error) {
+func (c stateObjectEncoder) McmsProofEntrypoint(registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("mcms_proof_entrypoint", typeArgsList, typeParamsList, []string{
@@ -13,10 +13,13 @@ import (
 	"github.com/block-vision/sui-go-sdk/sui"
 
 	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	module_state_object "github.com/smartcontractkit/chainlink-sui/bindings/generated/ccip/ccip/state_object"
+	module_mcms_registry "github.com/smartcontractkit/chainlink-sui/bindings/generated/mcms/mcms_registry"
 )
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type ITokenAdminRegistry interface {
@@ -29,16 +32,26 @@ type ITokenAdminRegistry interface {
 	GetTokenConfigData(ctx context.Context, opts *bind.CallOpts, tokenConfig TokenConfig) (*models.SuiTransactionBlockResponse, error)
 	GetAllConfiguredTokens(ctx context.Context, opts *bind.CallOpts, ref bind.Object, startKey string, maxCount uint64) (*models.SuiTransactionBlockResponse, error)
 	RegisterPool(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ref bind.Object, param bind.Object, coinMetadata bind.Object, tokenPoolPackageId string, tokenPoolModule string, initialAdministrator string, lockOrBurnParams []string, releaseOrMintParams []string, proof bind.Object) (*models.SuiTransactionBlockResponse, error)
-	RegisterPoolByAdmin(ctx context.Context, opts *bind.CallOpts, ref bind.Object, param bind.Object, coinMetadataAddress string, tokenPoolPackageId string, tokenPoolModule string, tokenType string, initialAdministrator string, tokenPoolTypeProof string, lockOrBurnParams []string, releaseOrMintParams []string) (*models.SuiTransactionBlockResponse, error)
+	RegisterPoolByAdmin(ctx context.Context, opts *bind.CallOpts, ref bind.Object, param module_state_object.CCIPAdminProof, coinMetadataAddress string, tokenPoolPackageId string, tokenPoolModule string, tokenType string, initialAdministrator string, tokenPoolTypeProof string, lockOrBurnParams []string, releaseOrMintParams []string) (*models.SuiTransactionBlockResponse, error)
 	UnregisterPool(ctx context.Context, opts *bind.CallOpts, ref bind.Object, coinMetadataAddress string) (*models.SuiTransactionBlockResponse, error)
 	SetPool(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ref bind.Object, coinMetadataAddress string, tokenPoolPackageId string, tokenPoolModule string, lockOrBurnParams []string, releaseOrMintParams []string, param bind.Object) (*models.SuiTransactionBlockResponse, error)
 	TransferAdminRole(ctx context.Context, opts *bind.CallOpts, ref bind.Object, coinMetadataAddress string, newAdmin string) (*models.SuiTransactionBlockResponse, error)
 	AcceptAdminRole(ctx context.Context, opts *bind.CallOpts, ref bind.Object, coinMetadataAddress string) (*models.SuiTransactionBlockResponse, error)
 	IsAdministrator(ctx context.Context, opts *bind.CallOpts, ref bind.Object, coinMetadataAddress string, administrator string) (*models.SuiTransactionBlockResponse, error)
-	McmsUnregisterPool(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error)
-	McmsSetPool(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error)
-	McmsTransferAdminRole(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error)
-	McmsAcceptAdminRole(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error)
+	McmsUnregisterPool(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	McmsSetPool(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	McmsTransferAdminRole(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	McmsAcceptAdminRole(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	FilterPoolSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[PoolSet], error)
+	WatchPoolSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[PoolSet]) (*bind.EventSubscription, error)
+	FilterPoolRegistered(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[PoolRegistered], error)
+	WatchPoolRegistered(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[PoolRegistered]) (*bind.EventSubscription, error)
+	FilterPoolUnregistered(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[PoolUnregistered], error)
+	WatchPoolUnregistered(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[PoolUnregistered]) (*bind.EventSubscription, error)
+	FilterAdministratorTransferRequested(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[AdministratorTransferRequested], error)
+	WatchAdministratorTransferRequested(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[AdministratorTransferRequested]) (*bind.EventSubscription, error)
+	FilterAdministratorTransferred(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[AdministratorTransferred], error)
+	WatchAdministratorTransferred(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[AdministratorTransferred]) (*bind.EventSubscription, error)
 	DevInspect() ITokenAdminRegistryDevInspect
 	Encoder() TokenAdminRegistryEncoder
 	Bound() bind.IBoundContract
@@ -74,7 +87,7 @@ type TokenAdminRegistryEncoder interface {
 	GetAllConfiguredTokensWithArgs(args ...any) (*bind.EncodedCall, error)
 	RegisterPool(typeArgs []string, ref bind.Object, param bind.Object, coinMetadata bind.Object, tokenPoolPackageId string, tokenPoolModule string, initialAdministrator string, lockOrBurnParams []string, releaseOrMintParams []string, proof bind.Object) (*bind.EncodedCall, error)
 	RegisterPoolWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error)
-	RegisterPoolByAdmin(ref bind.Object, param bind.Object, coinMetadataAddress string, tokenPoolPackageId string, tokenPoolModule string, tokenType string, initialAdministrator string, tokenPoolTypeProof string, lockOrBurnParams []string, releaseOrMintParams []string) (*bind.EncodedCall, error)
+	RegisterPoolByAdmin(ref bind.Object, param module_state_object.CCIPAdminProof, coinMetadataAddress string, tokenPoolPackageId string, tokenPoolModule string, tokenType string, initialAdministrator string, tokenPoolTypeProof string, lockOrBurnParams []string, releaseOrMintParams []string) (*bind.EncodedCall, error)
 	RegisterPoolByAdminWithArgs(args ...any) (*bind.EncodedCall, error)
 	UnregisterPool(ref bind.Object, coinMetadataAddress string) (*bind.EncodedCall, error)
 	UnregisterPoolWithArgs(args ...any) (*bind.EncodedCall, error)
@@ -86,13 +99,13 @@ type TokenAdminRegistryEncoder interface {
 	AcceptAdminRoleWithArgs(args ...any) (*bind.EncodedCall, error)
 	IsAdministrator(ref bind.Object, coinMetadataAddress string, administrator string) (*bind.EncodedCall, error)
 	IsAdministratorWithArgs(args ...any) (*bind.EncodedCall, error)
-	McmsUnregisterPool(ref bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error)
+	McmsUnregisterPool(ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	McmsUnregisterPoolWithArgs(args ...any) (*bind.EncodedCall, error)
-	McmsSetPool(ref bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error)
+	McmsSetPool(ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	McmsSetPoolWithArgs(args ...any) (*bind.EncodedCall, error)
-	McmsTransferAdminRole(ref bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error)
+	McmsTransferAdminRole(ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	McmsTransferAdminRoleWithArgs(args ...any) (*bind.EncodedCall, error)
-	McmsAcceptAdminRole(ref bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error)
+	McmsAcceptAdminRole(ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	McmsAcceptAdminRoleWithArgs(args ...any) (*bind.EncodedCall, error)
 }
 
@@ -411,6 +424,111 @@ func init() {
 	})
 }
 
+// DecodePoolSet decodes the BCS of a PoolSet event.
+func DecodePoolSet(data []byte) (PoolSet, error) {
+	var temp bcsPoolSet
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return PoolSet{}, err
+	}
+
+	return convertPoolSetFromBCS(temp)
+}
+
+// FilterPoolSet returns a page of the PoolSet events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *TokenAdminRegistryContract) FilterPoolSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[PoolSet], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "PoolSet", cursor, limit, DecodePoolSet)
+}
+
+// WatchPoolSet polls the PoolSet events and sends them to ch until the subscription is stopped.
+func (c *TokenAdminRegistryContract) WatchPoolSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[PoolSet]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "PoolSet", opts, ch, DecodePoolSet)
+}
+
+// DecodePoolRegistered decodes the BCS of a PoolRegistered event.
+func DecodePoolRegistered(data []byte) (PoolRegistered, error) {
+	var temp bcsPoolRegistered
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return PoolRegistered{}, err
+	}
+
+	return convertPoolRegisteredFromBCS(temp)
+}
+
+// FilterPoolRegistered returns a page of the PoolRegistered events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *TokenAdminRegistryContract) FilterPoolRegistered(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[PoolRegistered], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "PoolRegistered", cursor, limit, DecodePoolRegistered)
+}
+
+// WatchPoolRegistered polls the PoolRegistered events and sends them to ch until the subscription is stopped.
+func (c *TokenAdminRegistryContract) WatchPoolRegistered(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[PoolRegistered]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "PoolRegistered", opts, ch, DecodePoolRegistered)
+}
+
+// DecodePoolUnregistered decodes the BCS of a PoolUnregistered event.
+func DecodePoolUnregistered(data []byte) (PoolUnregistered, error) {
+	var temp bcsPoolUnregistered
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return PoolUnregistered{}, err
+	}
+
+	return convertPoolUnregisteredFromBCS(temp)
+}
+
+// FilterPoolUnregistered returns a page of the PoolUnregistered events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *TokenAdminRegistryContract) FilterPoolUnregistered(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[PoolUnregistered], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "PoolUnregistered", cursor, limit, DecodePoolUnregistered)
+}
+
+// WatchPoolUnregistered polls the PoolUnregistered events and sends them to ch until the subscription is stopped.
+func (c *TokenAdminRegistryContract) WatchPoolUnregistered(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[PoolUnregistered]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "PoolUnregistered", opts, ch, DecodePoolUnregistered)
+}
+
+// DecodeAdministratorTransferRequested decodes the BCS of a AdministratorTransferRequested event.
+func DecodeAdministratorTransferRequested(data []byte) (AdministratorTransferRequested, error) {
+	var temp bcsAdministratorTransferRequested
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return AdministratorTransferRequested{}, err
+	}
+
+	return convertAdministratorTransferRequestedFromBCS(temp)
+}
+
+// FilterAdministratorTransferRequested returns a page of the AdministratorTransferRequested events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *TokenAdminRegistryContract) FilterAdministratorTransferRequested(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[AdministratorTransferRequested], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "AdministratorTransferRequested", cursor, limit, DecodeAdministratorTransferRequested)
+}
+
+// WatchAdministratorTransferRequested polls the AdministratorTransferRequested events and sends them to ch until the subscription is stopped.
+func (c *TokenAdminRegistryContract) WatchAdministratorTransferRequested(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[AdministratorTransferRequested]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "AdministratorTransferRequested", opts, ch, DecodeAdministratorTransferRequested)
+}
+
+// DecodeAdministratorTransferred decodes the BCS of a AdministratorTransferred event.
+func DecodeAdministratorTransferred(data []byte) (AdministratorTransferred, error) {
+	var temp bcsAdministratorTransferred
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return AdministratorTransferred{}, err
+	}
+
+	return convertAdministratorTransferredFromBCS(temp)
+}
+
+// FilterAdministratorTransferred returns a page of the AdministratorTransferred events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *TokenAdminRegistryContract) FilterAdministratorTransferred(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[AdministratorTransferred], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "AdministratorTransferred", cursor, limit, DecodeAdministratorTransferred)
+}
+
+// WatchAdministratorTransferred polls the AdministratorTransferred events and sends them to ch until the subscription is stopped.
+func (c *TokenAdminRegistryContract) WatchAdministratorTransferred(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[AdministratorTransferred]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "AdministratorTransferred", opts, ch, DecodeAdministratorTransferred)
+}
+
 // TypeAndVersion executes the type_and_version Move function.
 func (c *TokenAdminRegistryContract) TypeAndVersion(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.tokenAdminRegistryEncoder.TypeAndVersion()
@@ -502,7 +620,7 @@ func (c *TokenAdminRegistryContract) RegisterPool(ctx context.Context, opts *bin
 }
 
 // RegisterPoolByAdmin executes the register_pool_by_admin Move function.
-func (c *TokenAdminRegistryContract) RegisterPoolByAdmin(ctx context.Context, opts *bind.CallOpts, ref bind.Object, param bind.Object, coinMetadataAddress string, tokenPoolPackageId string, tokenPoolModule string, tokenType string, initialAdministrator string, tokenPoolTypeProof string, lockOrBurnParams []string, releaseOrMintParams []string) (*models.SuiTransactionBlockResponse, error) {
+func (c *TokenAdminRegistryContract) RegisterPoolByAdmin(ctx context.Context, opts *bind.CallOpts, ref bind.Object, param module_state_object.CCIPAdminProof, coinMetadataAddress string, tokenPoolPackageId string, tokenPoolModule string, tokenType string, initialAdministrator string, tokenPoolTypeProof string, lockOrBurnParams []string, releaseOrMintParams []string) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.tokenAdminRegistryEncoder.RegisterPoolByAdmin(ref, param, coinMetadataAddress, tokenPoolPackageId, tokenPoolModule, tokenType, initialAdministrator, tokenPoolTypeProof, lockOrBurnParams, releaseOrMintParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -562,7 +680,7 @@ func (c *TokenAdminRegistryContract) IsAdministrator(ctx context.Context, opts *
 }
 
 // McmsUnregisterPool executes the mcms_unregister_pool Move function.
-func (c *TokenAdminRegistryContract) McmsUnregisterPool(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *TokenAdminRegistryContract) McmsUnregisterPool(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.tokenAdminRegistryEncoder.McmsUnregisterPool(ref, registry, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -572,7 +690,7 @@ func (c *TokenAdminRegistryContract) McmsUnregisterPool(ctx context.Context, opt
 }
 
 // McmsSetPool executes the mcms_set_pool Move function.
-func (c *TokenAdminRegistryContract) McmsSetPool(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *TokenAdminRegistryContract) McmsSetPool(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.tokenAdminRegistryEncoder.McmsSetPool(ref, registry, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -582,7 +700,7 @@ func (c *TokenAdminRegistryContract) McmsSetPool(ctx context.Context, opts *bind
 }
 
 // McmsTransferAdminRole executes the mcms_transfer_admin_role Move function.
-func (c *TokenAdminRegistryContract) McmsTransferAdminRole(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *TokenAdminRegistryContract) McmsTransferAdminRole(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.tokenAdminRegistryEncoder.McmsTransferAdminRole(ref, registry, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -592,7 +710,7 @@ func (c *TokenAdminRegistryContract) McmsTransferAdminRole(ctx context.Context,
 }
 
 // McmsAcceptAdminRole executes the mcms_accept_admin_role Move function.
-func (c *TokenAdminRegistryContract) McmsAcceptAdminRole(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *TokenAdminRegistryContract) McmsAcceptAdminRole(ctx context.Context, opts *bind.CallOpts, ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.tokenAdminRegistryEncoder.McmsAcceptAdminRole(ref, registry, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1098,7 +1216,7 @@ func (c tokenAdminRegistryEncoder) RegisterPoolWithArgs(typeArgs []string, args
 }
 
 // RegisterPoolByAdmin encodes a call to the register_pool_by_admin Move function.
-func (c tokenAdminRegistryEncoder) RegisterPoolByAdmin(ref bind.Object, param bind.Object, coinMetadataAddress string, tokenPoolPackageId string, tokenPoolModule string, tokenType string, initialAdministrator string, tokenPoolTypeProof string, lockOrBurnParams []string, releaseOrMintParams []string) (*bind.EncodedCall, error) {
+func (c tokenAdminRegistryEncoder) RegisterPoolByAdmin(ref bind.Object, param module_state_object.CCIPAdminProof, coinMetadataAddress string, tokenPoolPackageId string, tokenPoolModule string, tokenType string, initialAdministrator string, tokenPoolTypeProof string, lockOrBurnParams []string, releaseOrMintParams []string) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("register_pool_by_admin", typeArgsList, typeParamsList, []string{
@@ -1325,7 +1443,7 @@ func (c tokenAdminRegistryEncoder) IsAdministratorWithArgs(args ...any) (*bind.E
 }
 
 // McmsUnregisterPool encodes a call to the mcms_unregister_pool Move function.
-func (c tokenAdminRegistryEncoder) McmsUnregisterPool(ref bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error) {
+func (c tokenAdminRegistryEncoder) McmsUnregisterPool(ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("mcms_unregister_pool", typeArgsList, typeParamsList, []string{
@@ -1357,7 +1475,7 @@ func (c tokenAdminRegistryEncoder) McmsUnregisterPoolWithArgs(args ...any) (*bin
 }
 
 // McmsSetPool encodes a call to the mcms_set_pool Move function.
-func (c tokenAdminRegistryEncoder) McmsSetPool(ref bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error) {
+func (c tokenAdminRegistryEncoder) McmsSetPool(ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("mcms_set_pool", typeArgsList, typeParamsList, []string{
@@ -1389,7 +1507,7 @@ func (c tokenAdminRegistryEncoder) McmsSetPoolWithArgs(args ...any) (*bind.Encod
 }
 
 // McmsTransferAdminRole encodes a call to the mcms_transfer_admin_role Move function.
-func (c tokenAdminRegistryEncoder) McmsTransferAdminRole(ref bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error) {
+func (c tokenAdminRegistryEncoder) McmsTransferAdminRole(ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("mcms_transfer_admin_role", typeArgsList, typeParamsList, []string{
@@ -1421,7 +1539,7 @@ func (c tokenAdminRegistryEncoder) McmsTransferAdminRoleWithArgs(args ...any) (*
 }
 
 // McmsAcceptAdminRole encodes a call to the mcms_accept_admin_role Move function.
-func (c tokenAdminRegistryEncoder) McmsAcceptAdminRole(ref bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error) {
+func (c tokenAdminRegistryEncoder) McmsAcceptAdminRole(ref bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("mcms_accept_admin_role", typeArgsList, typeParamsList, []string{
@@ -17,6 +17,7 @@ import (
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type IDummyReceiver interface {
@@ -31,6 +32,8 @@ type IDummyReceiver interface {
 	ReceiveAndSendCoinNoOwnerCap(ctx context.Context, opts *bind.CallOpts, typeArgs []string, state bind.Object, coinReceiving bind.Object, recipient string) (*models.SuiTransactionBlockResponse, error)
 	ReceiveCoinNoOwnerCap(ctx context.Context, opts *bind.CallOpts, typeArgs []string, state bind.Object, coinReceiving bind.Object) (*models.SuiTransactionBlockResponse, error)
 	CcipReceive(ctx context.Context, opts *bind.CallOpts, expectedMessageId []byte, ref bind.Object, message bind.Object, param bind.Object, state bind.Object) (*models.SuiTransactionBlockResponse, error)
+	FilterReceivedMessage(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ReceivedMessage], error)
+	WatchReceivedMessage(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ReceivedMessage]) (*bind.EventSubscription, error)
 	DevInspect() IDummyReceiverDevInspect
 	Encoder() DummyReceiverEncoder
 	Bound() bind.IBoundContract
@@ -222,6 +225,27 @@ func init() {
 	})
 }
 
+// DecodeReceivedMessage decodes the BCS of a ReceivedMessage event.
+func DecodeReceivedMessage(data []byte) (ReceivedMessage, error) {
+	var result ReceivedMessage
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return ReceivedMessage{}, err
+	}
+
+	return result, nil
+}
+
+// FilterReceivedMessage returns a page of the ReceivedMessage events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *DummyReceiverContract) FilterReceivedMessage(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ReceivedMessage], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "ReceivedMessage", cursor, limit, DecodeReceivedMessage)
+}
+
+// WatchReceivedMessage polls the ReceivedMessage events and sends them to ch until the subscription is stopped.
+func (c *DummyReceiverContract) WatchReceivedMessage(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ReceivedMessage]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "ReceivedMessage", opts, ch, DecodeReceivedMessage)
+}
+
 // TypeAndVersion executes the type_and_version Move function.
 func (c *DummyReceiverContract) TypeAndVersion(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.dummyReceiverEncoder.TypeAndVersion()
@@ -0,0 +1,756 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package module_ocr3_base
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+)
+
+var (
+	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
+)
+
+type IOcr3Base interface {
+	New(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error)
+	OcrPluginTypeCommit(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error)
+	OcrPluginTypeExecution(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error)
+	SetOcr3Config(ctx context.Context, opts *bind.CallOpts, ocr3State bind.Object, configDigest []byte, ocrPluginType byte, bigF byte, isSignatureVerificationEnabled bool, signers [][]byte, transmitters []string) (*models.SuiTransactionBlockResponse, error)
+	Transmit(ctx context.Context, opts *bind.CallOpts, ocr3State bind.Object, transmitter string, ocrPluginType byte, reportContext [][]byte, report []byte, signatures [][]byte) (*models.SuiTransactionBlockResponse, error)
+	DeserializeSequenceBytes(ctx context.Context, opts *bind.CallOpts, sequenceBytes []byte) (*models.SuiTransactionBlockResponse, error)
+	ConfigSigners(ctx context.Context, opts *bind.CallOpts, ocrConfig OCRConfig) (*models.SuiTransactionBlockResponse, error)
+	ConfigTransmitters(ctx context.Context, opts *bind.CallOpts, ocrConfig OCRConfig) (*models.SuiTransactionBlockResponse, error)
+	FilterConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ConfigSet], error)
+	WatchConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ConfigSet]) (*bind.EventSubscription, error)
+	FilterTransmitted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[Transmitted], error)
+	WatchTransmitted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[Transmitted]) (*bind.EventSubscription, error)
+	DevInspect() IOcr3BaseDevInspect
+	Encoder() Ocr3BaseEncoder
+	Bound() bind.IBoundContract
+}
+
+type IOcr3BaseDevInspect interface {
+	New(ctx context.Context, opts *bind.CallOpts) (bind.Object, error)
+	OcrPluginTypeCommit(ctx context.Context, opts *bind.CallOpts) (byte, error)
+	OcrPluginTypeExecution(ctx context.Context, opts *bind.CallOpts) (byte, error)
+	DeserializeSequenceBytes(ctx context.Context, opts *bind.CallOpts, sequenceBytes []byte) (uint64, error)
+	ConfigSigners(ctx context.Context, opts *bind.CallOpts, ocrConfig OCRConfig) ([][]byte, error)
+	ConfigTransmitters(ctx context.Context, opts *bind.CallOpts, ocrConfig OCRConfig) ([]string, error)
+}
+
+type Ocr3BaseEncoder interface {
+	New() (*bind.EncodedCall, error)
+	NewWithArgs(args ...any) (*bind.EncodedCall, error)
+	OcrPluginTypeCommit() (*bind.EncodedCall, error)
+	OcrPluginTypeCommitWithArgs(args ...any) (*bind.EncodedCall, error)
+	OcrPluginTypeExecution() (*bind.EncodedCall, error)
+	OcrPluginTypeExecutionWithArgs(args ...any) (*bind.EncodedCall, error)
+	SetOcr3Config(ocr3State bind.Object, configDigest []byte, ocrPluginType byte, bigF byte, isSignatureVerificationEnabled bool, signers [][]byte, transmitters []string) (*bind.EncodedCall, error)
+	SetOcr3ConfigWithArgs(args ...any) (*bind.EncodedCall, error)
+	Transmit(ocr3State bind.Object, transmitter string, ocrPluginType byte, reportContext [][]byte, report []byte, signatures [][]byte) (*bind.EncodedCall, error)
+	TransmitWithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeSequenceBytes(sequenceBytes []byte) (*bind.EncodedCall, error)
+	DeserializeSequenceBytesWithArgs(args ...any) (*bind.EncodedCall, error)
+	ConfigSigners(ocrConfig OCRConfig) (*bind.EncodedCall, error)
+	ConfigSignersWithArgs(args ...any) (*bind.EncodedCall, error)
+	ConfigTransmitters(ocrConfig OCRConfig) (*bind.EncodedCall, error)
+	ConfigTransmittersWithArgs(args ...any) (*bind.EncodedCall, error)
+}
+
+type Ocr3BaseContract struct {
+	*bind.BoundContract
+	ocr3BaseEncoder
+	devInspect *Ocr3BaseDevInspect
+}
+
+type Ocr3BaseDevInspect struct {
+	contract *Ocr3BaseContract
+}
+
+var _ IOcr3Base = (*Ocr3BaseContract)(nil)
+var _ IOcr3BaseDevInspect = (*Ocr3BaseDevInspect)(nil)
+
+func NewOcr3Base(packageID string, client sui.ISuiAPI) (IOcr3Base, error) {
+	contract, err := bind.NewBoundContract(packageID, "ccip_offramp", "ocr3_base", client)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Ocr3BaseContract{
+		BoundContract:   contract,
+		ocr3BaseEncoder: ocr3BaseEncoder{BoundContract: contract},
+	}
+	c.devInspect = &Ocr3BaseDevInspect{contract: c}
+	return c, nil
+}
+
+func (c *Ocr3BaseContract) Bound() bind.IBoundContract {
+	return c.BoundContract
+}
+
+func (c *Ocr3BaseContract) Encoder() Ocr3BaseEncoder {
+	return c.ocr3BaseEncoder
+}
+
+func (c *Ocr3BaseContract) DevInspect() IOcr3BaseDevInspect {
+	return c.devInspect
+}
+
+type UnvalidatedPublicKey struct {
+	Bytes []byte `move:"vector<u8>"`
+}
+
+type ConfigInfo struct {
+	ConfigDigest                   []byte `move:"vector<u8>"`
+	BigF                           byte   `move:"u8"`
+	N                              byte   `move:"u8"`
+	IsSignatureVerificationEnabled bool   `move:"bool"`
+}
+
+type OCRConfig struct {
+	ConfigInfo   ConfigInfo `move:"ConfigInfo"`
+	Signers      [][]byte   `move:"vector<vector<u8>>"`
+	Transmitters []string   `move:"vector<address>"`
+}
+
+type OCR3BaseState struct {
+	Id                 string      `move:"sui::object::UID"`
+	Ocr3Configs        bind.Object `move:"Table<u8, OCRConfig>"`
+	SignerOracles      bind.Object `move:"Table<u8, vector<UnvalidatedPublicKey>>"`
+	TransmitterOracles bind.Object `move:"Table<u8, vector<address>>"`
+}
+
+type ConfigSet struct {
+	OcrPluginType byte     `move:"u8"`
+	ConfigDigest  []byte   `move:"vector<u8>"`
+	Signers       [][]byte `move:"vector<vector<u8>>"`
+	Transmitters  []string `move:"vector<address>"`
+	BigF          byte     `move:"u8"`
+}
+
+type Transmitted struct {
+	OcrPluginType  byte   `move:"u8"`
+	ConfigDigest   []byte `move:"vector<u8>"`
+	SequenceNumber uint64 `move:"u64"`
+}
+
+type bcsOCRConfig struct {
+	ConfigInfo   ConfigInfo
+	Signers      [][]byte
+	Transmitters [][32]byte
+}
+
+func convertOCRConfigFromBCS(bcs bcsOCRConfig) (OCRConfig, error) {
+
+	return OCRConfig{
+		ConfigInfo: bcs.ConfigInfo,
+		Signers:    bcs.Signers,
+		Transmitters: func() []string {
+			addrs := make([]string, len(bcs.Transmitters))
+			for i, addr := range bcs.Transmitters {
+				addrs[i] = fmt.Sprintf("0x%x", addr)
+			}
+			return addrs
+		}(),
+	}, nil
+}
+
+type bcsConfigSet struct {
+	OcrPluginType byte
+	ConfigDigest  []byte
+	Signers       [][]byte
+	Transmitters  [][32]byte
+	BigF          byte
+}
+
+func convertConfigSetFromBCS(bcs bcsConfigSet) (ConfigSet, error) {
+
+	return ConfigSet{
+		OcrPluginType: bcs.OcrPluginType,
+		ConfigDigest:  bcs.ConfigDigest,
+		Signers:       bcs.Signers,
+		Transmitters: func() []string {
+			addrs := make([]string, len(bcs.Transmitters))
+			for i, addr := range bcs.Transmitters {
+				addrs[i] = fmt.Sprintf("0x%x", addr)
+			}
+			return addrs
+		}(),
+		BigF: bcs.BigF,
+	}, nil
+}
+
+func init() {
+	bind.RegisterStructDecoder("ccip_offramp::ocr3_base::UnvalidatedPublicKey", func(data []byte) (interface{}, error) {
+		var result UnvalidatedPublicKey
+		_, err := mystenbcs.Unmarshal(data, &result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip_offramp::ocr3_base::ConfigInfo", func(data []byte) (interface{}, error) {
+		var result ConfigInfo
+		_, err := mystenbcs.Unmarshal(data, &result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip_offramp::ocr3_base::OCRConfig", func(data []byte) (interface{}, error) {
+		var temp bcsOCRConfig
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertOCRConfigFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip_offramp::ocr3_base::OCR3BaseState", func(data []byte) (interface{}, error) {
+		var result OCR3BaseState
+		_, err := mystenbcs.Unmarshal(data, &result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip_offramp::ocr3_base::ConfigSet", func(data []byte) (interface{}, error) {
+		var temp bcsConfigSet
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertConfigSetFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip_offramp::ocr3_base::Transmitted", func(data []byte) (interface{}, error) {
+		var result Transmitted
+		_, err := mystenbcs.Unmarshal(data, &result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
+// DecodeConfigSet decodes the BCS of a ConfigSet event.
+func DecodeConfigSet(data []byte) (ConfigSet, error) {
+	var temp bcsConfigSet
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return ConfigSet{}, err
+	}
+
+	return convertConfigSetFromBCS(temp)
+}
+
+// FilterConfigSet returns a page of the ConfigSet events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *Ocr3BaseContract) FilterConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ConfigSet], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "ConfigSet", cursor, limit, DecodeConfigSet)
+}
+
+// WatchConfigSet polls the ConfigSet events and sends them to ch until the subscription is stopped.
+func (c *Ocr3BaseContract) WatchConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ConfigSet]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "ConfigSet", opts, ch, DecodeConfigSet)
+}
+
+// DecodeTransmitted decodes the BCS of a Transmitted event.
+func DecodeTransmitted(data []byte) (Transmitted, error) {
+	var result Transmitted
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return Transmitted{}, err
+	}
+
+	return result, nil
+}
+
+// FilterTransmitted returns a page of the Transmitted events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *Ocr3BaseContract) FilterTransmitted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[Transmitted], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "Transmitted", cursor, limit, DecodeTransmitted)
+}
+
+// WatchTransmitted polls the Transmitted events and sends them to ch until the subscription is stopped.
+func (c *Ocr3BaseContract) WatchTransmitted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[Transmitted]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "Transmitted", opts, ch, DecodeTransmitted)
+}
+
+// New executes the new Move function.
+func (c *Ocr3BaseContract) New(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ocr3BaseEncoder.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// OcrPluginTypeCommit executes the ocr_plugin_type_commit Move function.
+func (c *Ocr3BaseContract) OcrPluginTypeCommit(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ocr3BaseEncoder.OcrPluginTypeCommit()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// OcrPluginTypeExecution executes the ocr_plugin_type_execution Move function.
+func (c *Ocr3BaseContract) OcrPluginTypeExecution(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ocr3BaseEncoder.OcrPluginTypeExecution()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// SetOcr3Config executes the set_ocr3_config Move function.
+func (c *Ocr3BaseContract) SetOcr3Config(ctx context.Context, opts *bind.CallOpts, ocr3State bind.Object, configDigest []byte, ocrPluginType byte, bigF byte, isSignatureVerificationEnabled bool, signers [][]byte, transmitters []string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ocr3BaseEncoder.SetOcr3Config(ocr3State, configDigest, ocrPluginType, bigF, isSignatureVerificationEnabled, signers, transmitters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// Transmit executes the transmit Move function.
+func (c *Ocr3BaseContract) Transmit(ctx context.Context, opts *bind.CallOpts, ocr3State bind.Object, transmitter string, ocrPluginType byte, reportContext [][]byte, report []byte, signatures [][]byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ocr3BaseEncoder.Transmit(ocr3State, transmitter, ocrPluginType, reportContext, report, signatures)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeSequenceBytes executes the deserialize_sequence_bytes Move function.
+func (c *Ocr3BaseContract) DeserializeSequenceBytes(ctx context.Context, opts *bind.CallOpts, sequenceBytes []byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ocr3BaseEncoder.DeserializeSequenceBytes(sequenceBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// ConfigSigners executes the config_signers Move function.
+func (c *Ocr3BaseContract) ConfigSigners(ctx context.Context, opts *bind.CallOpts, ocrConfig OCRConfig) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ocr3BaseEncoder.ConfigSigners(ocrConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// ConfigTransmitters executes the config_transmitters Move function.
+func (c *Ocr3BaseContract) ConfigTransmitters(ctx context.Context, opts *bind.CallOpts, ocrConfig OCRConfig) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ocr3BaseEncoder.ConfigTransmitters(ocrConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// New executes the new Move function using DevInspect to get return values.
+//
+// Returns: OCR3BaseState
+func (d *Ocr3BaseDevInspect) New(ctx context.Context, opts *bind.CallOpts) (bind.Object, error) {
+	encoded, err := d.contract.ocr3BaseEncoder.New()
+	if err != nil {
+		return bind.Object{}, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return bind.Object{}, err
+	}
+	if len(results) == 0 {
+		return bind.Object{}, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(bind.Object)
+	if !ok {
+		return bind.Object{}, fmt.Errorf("unexpected return type: expected bind.Object, got %T", results[0])
+	}
+	return result, nil
+}
+
+// OcrPluginTypeCommit executes the ocr_plugin_type_commit Move function using DevInspect to get return values.
+//
+// Returns: u8
+func (d *Ocr3BaseDevInspect) OcrPluginTypeCommit(ctx context.Context, opts *bind.CallOpts) (byte, error) {
+	encoded, err := d.contract.ocr3BaseEncoder.OcrPluginTypeCommit()
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(byte)
+	if !ok {
+		return 0, fmt.Errorf("unexpected return type: expected byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// OcrPluginTypeExecution executes the ocr_plugin_type_execution Move function using DevInspect to get return values.
+//
+// Returns: u8
+func (d *Ocr3BaseDevInspect) OcrPluginTypeExecution(ctx context.Context, opts *bind.CallOpts) (byte, error) {
+	encoded, err := d.contract.ocr3BaseEncoder.OcrPluginTypeExecution()
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(byte)
+	if !ok {
+		return 0, fmt.Errorf("unexpected return type: expected byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DeserializeSequenceBytes executes the deserialize_sequence_bytes Move function using DevInspect to get return values.
+//
+// Returns: u64
+func (d *Ocr3BaseDevInspect) DeserializeSequenceBytes(ctx context.Context, opts *bind.CallOpts, sequenceBytes []byte) (uint64, error) {
+	encoded, err := d.contract.ocr3BaseEncoder.DeserializeSequenceBytes(sequenceBytes)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(uint64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected return type: expected uint64, got %T", results[0])
+	}
+	return result, nil
+}
+
+// ConfigSigners executes the config_signers Move function using DevInspect to get return values.
+//
+// Returns: vector<vector<u8>>
+func (d *Ocr3BaseDevInspect) ConfigSigners(ctx context.Context, opts *bind.CallOpts, ocrConfig OCRConfig) ([][]byte, error) {
+	encoded, err := d.contract.ocr3BaseEncoder.ConfigSigners(ocrConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([][]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected [][]byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// ConfigTransmitters executes the config_transmitters Move function using DevInspect to get return values.
+//
+// Returns: vector<address>
+func (d *Ocr3BaseDevInspect) ConfigTransmitters(ctx context.Context, opts *bind.CallOpts, ocrConfig OCRConfig) ([]string, error) {
+	encoded, err := d.contract.ocr3BaseEncoder.ConfigTransmitters(ocrConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []string, got %T", results[0])
+	}
+	return result, nil
+}
+
+type ocr3BaseEncoder struct {
+	*bind.BoundContract
+}
+
+// New encodes a call to the new Move function.
+func (c ocr3BaseEncoder) New() (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("new", typeArgsList, typeParamsList, []string{}, []any{}, []string{
+		"ccip_offramp::ocr3_base::OCR3BaseState",
+	})
+}
+
+// NewWithArgs encodes a call to the new Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ocr3BaseEncoder) NewWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("new", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"ccip_offramp::ocr3_base::OCR3BaseState",
+	})
+}
+
+// OcrPluginTypeCommit encodes a call to the ocr_plugin_type_commit Move function.
+func (c ocr3BaseEncoder) OcrPluginTypeCommit() (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("ocr_plugin_type_commit", typeArgsList, typeParamsList, []string{}, []any{}, []string{
+		"u8",
+	})
+}
+
+// OcrPluginTypeCommitWithArgs encodes a call to the ocr_plugin_type_commit Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ocr3BaseEncoder) OcrPluginTypeCommitWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("ocr_plugin_type_commit", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u8",
+	})
+}
+
+// OcrPluginTypeExecution encodes a call to the ocr_plugin_type_execution Move function.
+func (c ocr3BaseEncoder) OcrPluginTypeExecution() (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("ocr_plugin_type_execution", typeArgsList, typeParamsList, []string{}, []any{}, []string{
+		"u8",
+	})
+}
+
+// OcrPluginTypeExecutionWithArgs encodes a call to the ocr_plugin_type_execution Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ocr3BaseEncoder) OcrPluginTypeExecutionWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("ocr_plugin_type_execution", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u8",
+	})
+}
+
+// SetOcr3Config encodes a call to the set_ocr3_config Move function.
+func (c ocr3BaseEncoder) SetOcr3Config(ocr3State bind.Object, configDigest []byte, ocrPluginType byte, bigF byte, isSignatureVerificationEnabled bool, signers [][]byte, transmitters []string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("set_ocr3_config", typeArgsList, typeParamsList, []string{
+		"&mut OCR3BaseState",
+		"vector<u8>",
+		"u8",
+		"u8",
+		"bool",
+		"vector<vector<u8>>",
+		"vector<address>",
+	}, []any{
+		ocr3State,
+		configDigest,
+		ocrPluginType,
+		bigF,
+		isSignatureVerificationEnabled,
+		signers,
+		transmitters,
+	}, nil)
+}
+
+// SetOcr3ConfigWithArgs encodes a call to the set_ocr3_config Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ocr3BaseEncoder) SetOcr3ConfigWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut OCR3BaseState",
+		"vector<u8>",
+		"u8",
+		"u8",
+		"bool",
+		"vector<vector<u8>>",
+		"vector<address>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("set_ocr3_config", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// Transmit encodes a call to the transmit Move function.
+func (c ocr3BaseEncoder) Transmit(ocr3State bind.Object, transmitter string, ocrPluginType byte, reportContext [][]byte, report []byte, signatures [][]byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("transmit", typeArgsList, typeParamsList, []string{
+		"&OCR3BaseState",
+		"address",
+		"u8",
+		"vector<vector<u8>>",
+		"vector<u8>",
+		"vector<vector<u8>>",
+	}, []any{
+		ocr3State,
+		transmitter,
+		ocrPluginType,
+		reportContext,
+		report,
+		signatures,
+	}, nil)
+}
+
+// TransmitWithArgs encodes a call to the transmit Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ocr3BaseEncoder) TransmitWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&OCR3BaseState",
+		"address",
+		"u8",
+		"vector<vector<u8>>",
+		"vector<u8>",
+		"vector<vector<u8>>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("transmit", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// DeserializeSequenceBytes encodes a call to the deserialize_sequence_bytes Move function.
+func (c ocr3BaseEncoder) DeserializeSequenceBytes(sequenceBytes []byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_sequence_bytes", typeArgsList, typeParamsList, []string{
+		"vector<u8>",
+	}, []any{
+		sequenceBytes,
+	}, []string{
+		"u64",
+	})
+}
+
+// DeserializeSequenceBytesWithArgs encodes a call to the deserialize_sequence_bytes Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ocr3BaseEncoder) DeserializeSequenceBytesWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"vector<u8>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_sequence_bytes", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u64",
+	})
+}
+
+// ConfigSigners encodes a call to the config_signers Move function.
+func (c ocr3BaseEncoder) ConfigSigners(ocrConfig OCRConfig) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("config_signers", typeArgsList, typeParamsList, []string{
+		"&OCRConfig",
+	}, []any{
+		ocrConfig,
+	}, []string{
+		"vector<vector<u8>>",
+	})
+}
+
+// ConfigSignersWithArgs encodes a call to the config_signers Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ocr3BaseEncoder) ConfigSignersWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&OCRConfig",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("config_signers", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<vector<u8>>",
+	})
+}
+
+// ConfigTransmitters encodes a call to the config_transmitters Move function.
+func (c ocr3BaseEncoder) ConfigTransmitters(ocrConfig OCRConfig) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("config_transmitters", typeArgsList, typeParamsList, []string{
+		"&OCRConfig",
+	}, []any{
+		ocrConfig,
+	}, []string{
+		"vector<address>",
+	})
+}
+
+// ConfigTransmittersWithArgs encodes a call to the config_transmitters Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ocr3BaseEncoder) ConfigTransmittersWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&OCRConfig",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("config_transmitters", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<address>",
+	})
+}
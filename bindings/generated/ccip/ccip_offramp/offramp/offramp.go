@@ -13,10 +13,14 @@ import (
 	"github.com/block-vision/sui-go-sdk/sui"
 
 	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	module_offramp_state_helper "github.com/smartcontractkit/chainlink-sui/bindings/generated/ccip/ccip/offramp_state_helper"
+	module_ocr3_base "github.com/smartcontractkit/chainlink-sui/bindings/generated/ccip/ccip_offramp/ocr3_base"
+	module_mcms_registry "github.com/smartcontractkit/chainlink-sui/bindings/generated/mcms/mcms_registry"
 )
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type IOfframp interface {
@@ -24,14 +28,14 @@ type IOfframp interface {
 	Initialize(ctx context.Context, opts *bind.CallOpts, state bind.Object, param bind.Object, feeQuoterCap bind.Object, destTransferCap bind.Object, chainSelector uint64, permissionlessExecutionThresholdSeconds uint32, sourceChainsSelectors []uint64, sourceChainsIsEnabled []bool, sourceChainsIsRmnVerificationDisabled []bool, sourceChainsOnRamp [][]byte) (*models.SuiTransactionBlockResponse, error)
 	GetOcr3Base(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
 	InitExecute(ctx context.Context, opts *bind.CallOpts, ref bind.Object, state bind.Object, clock bind.Object, reportContext [][]byte, report []byte, tokenReceiver string) (*models.SuiTransactionBlockResponse, error)
-	FinishExecute(ctx context.Context, opts *bind.CallOpts, state bind.Object, receiverParams bind.Object) (*models.SuiTransactionBlockResponse, error)
+	FinishExecute(ctx context.Context, opts *bind.CallOpts, state bind.Object, receiverParams module_offramp_state_helper.ReceiverParams) (*models.SuiTransactionBlockResponse, error)
 	ManuallyInitExecute(ctx context.Context, opts *bind.CallOpts, ref bind.Object, state bind.Object, clock bind.Object, reportBytes []byte, tokenReceiver string) (*models.SuiTransactionBlockResponse, error)
 	GetExecutionState(ctx context.Context, opts *bind.CallOpts, state bind.Object, sourceChainSelector uint64, sequenceNumber uint64) (*models.SuiTransactionBlockResponse, error)
 	CalculateMetadataHash(ctx context.Context, opts *bind.CallOpts, sourceChainSelector uint64, destChainSelector uint64, onRamp []byte) (*models.SuiTransactionBlockResponse, error)
 	CalculateMessageHash(ctx context.Context, opts *bind.CallOpts, messageId []byte, sourceChainSelector uint64, destChainSelector uint64, sequenceNumber uint64, nonce uint64, sender []byte, receiver string, onRamp []byte, data []byte, gasLimit *big.Int, sourcePoolAddresses [][]byte, destTokenAddresses []string, destGasAmounts []uint32, extraDatas [][]byte, amounts []*big.Int) (*models.SuiTransactionBlockResponse, error)
 	SetOcr3Config(ctx context.Context, opts *bind.CallOpts, state bind.Object, param bind.Object, configDigest []byte, ocrPluginType byte, bigF byte, isSignatureVerificationEnabled bool, signers [][]byte, transmitters []string) (*models.SuiTransactionBlockResponse, error)
-	ConfigSigners(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
-	ConfigTransmitters(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
+	ConfigSigners(ctx context.Context, opts *bind.CallOpts, state module_ocr3_base.OCRConfig) (*models.SuiTransactionBlockResponse, error)
+	ConfigTransmitters(ctx context.Context, opts *bind.CallOpts, state module_ocr3_base.OCRConfig) (*models.SuiTransactionBlockResponse, error)
 	Commit(ctx context.Context, opts *bind.CallOpts, ref bind.Object, state bind.Object, clock bind.Object, reportContext [][]byte, report []byte, signatures [][]byte) (*models.SuiTransactionBlockResponse, error)
 	GetMerkleRoot(ctx context.Context, opts *bind.CallOpts, state bind.Object, root []byte) (*models.SuiTransactionBlockResponse, error)
 	GetSourceChainConfig(ctx context.Context, opts *bind.CallOpts, state bind.Object, sourceChainSelector uint64) (*models.SuiTransactionBlockResponse, error)
@@ -52,11 +56,25 @@ type IOfframp interface {
 	TransferOwnership(ctx context.Context, opts *bind.CallOpts, state bind.Object, ownerCap bind.Object, newOwner string) (*models.SuiTransactionBlockResponse, error)
 	AcceptOwnership(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
 	AcceptOwnershipFromObject(ctx context.Context, opts *bind.CallOpts, state bind.Object, from string) (*models.SuiTransactionBlockResponse, error)
-	AcceptOwnershipAsMcms(ctx context.Context, opts *bind.CallOpts, state bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error)
+	AcceptOwnershipAsMcms(ctx context.Context, opts *bind.CallOpts, state bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
 	ExecuteOwnershipTransfer(ctx context.Context, opts *bind.CallOpts, ownerCap bind.Object, ownableState bind.Object, to string) (*models.SuiTransactionBlockResponse, error)
 	ExecuteOwnershipTransferToMcms(ctx context.Context, opts *bind.CallOpts, ownerCap bind.Object, state bind.Object, registry bind.Object, to string) (*models.SuiTransactionBlockResponse, error)
 	McmsRegisterUpgradeCap(ctx context.Context, opts *bind.CallOpts, upgradeCap bind.Object, registry bind.Object, state bind.Object) (*models.SuiTransactionBlockResponse, error)
-	McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, state bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error)
+	McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, state bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	FilterStaticConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[StaticConfigSet], error)
+	WatchStaticConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[StaticConfigSet]) (*bind.EventSubscription, error)
+	FilterDynamicConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[DynamicConfigSet], error)
+	WatchDynamicConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[DynamicConfigSet]) (*bind.EventSubscription, error)
+	FilterSourceChainConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[SourceChainConfigSet], error)
+	WatchSourceChainConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[SourceChainConfigSet]) (*bind.EventSubscription, error)
+	FilterSkippedAlreadyExecuted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[SkippedAlreadyExecuted], error)
+	WatchSkippedAlreadyExecuted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[SkippedAlreadyExecuted]) (*bind.EventSubscription, error)
+	FilterExecutionStateChanged(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ExecutionStateChanged], error)
+	WatchExecutionStateChanged(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ExecutionStateChanged]) (*bind.EventSubscription, error)
+	FilterCommitReportAccepted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[CommitReportAccepted], error)
+	WatchCommitReportAccepted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[CommitReportAccepted]) (*bind.EventSubscription, error)
+	FilterSkippedReportExecution(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[SkippedReportExecution], error)
+	WatchSkippedReportExecution(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[SkippedReportExecution]) (*bind.EventSubscription, error)
 	DevInspect() IOfframpDevInspect
 	Encoder() OfframpEncoder
 	Bound() bind.IBoundContract
@@ -65,13 +83,13 @@ type IOfframp interface {
 type IOfframpDevInspect interface {
 	TypeAndVersion(ctx context.Context, opts *bind.CallOpts) (string, error)
 	GetOcr3Base(ctx context.Context, opts *bind.CallOpts, state bind.Object) (bind.Object, error)
-	InitExecute(ctx context.Context, opts *bind.CallOpts, ref bind.Object, state bind.Object, clock bind.Object, reportContext [][]byte, report []byte, tokenReceiver string) (bind.Object, error)
-	ManuallyInitExecute(ctx context.Context, opts *bind.CallOpts, ref bind.Object, state bind.Object, clock bind.Object, reportBytes []byte, tokenReceiver string) (bind.Object, error)
+	InitExecute(ctx context.Context, opts *bind.CallOpts, ref bind.Object, state bind.Object, clock bind.Object, reportContext [][]byte, report []byte, tokenReceiver string) (module_offramp_state_helper.ReceiverParams, error)
+	ManuallyInitExecute(ctx context.Context, opts *bind.CallOpts, ref bind.Object, state bind.Object, clock bind.Object, reportBytes []byte, tokenReceiver string) (module_offramp_state_helper.ReceiverParams, error)
 	GetExecutionState(ctx context.Context, opts *bind.CallOpts, state bind.Object, sourceChainSelector uint64, sequenceNumber uint64) (byte, error)
 	CalculateMetadataHash(ctx context.Context, opts *bind.CallOpts, sourceChainSelector uint64, destChainSelector uint64, onRamp []byte) ([]byte, error)
 	CalculateMessageHash(ctx context.Context, opts *bind.CallOpts, messageId []byte, sourceChainSelector uint64, destChainSelector uint64, sequenceNumber uint64, nonce uint64, sender []byte, receiver string, onRamp []byte, data []byte, gasLimit *big.Int, sourcePoolAddresses [][]byte, destTokenAddresses []string, destGasAmounts []uint32, extraDatas [][]byte, amounts []*big.Int) ([]byte, error)
-	ConfigSigners(ctx context.Context, opts *bind.CallOpts, state bind.Object) ([][]byte, error)
-	ConfigTransmitters(ctx context.Context, opts *bind.CallOpts, state bind.Object) ([]string, error)
+	ConfigSigners(ctx context.Context, opts *bind.CallOpts, state module_ocr3_base.OCRConfig) ([][]byte, error)
+	ConfigTransmitters(ctx context.Context, opts *bind.CallOpts, state module_ocr3_base.OCRConfig) ([]string, error)
 	GetMerkleRoot(ctx context.Context, opts *bind.CallOpts, state bind.Object, root []byte) (uint64, error)
 	GetSourceChainConfig(ctx context.Context, opts *bind.CallOpts, state bind.Object, sourceChainSelector uint64) (SourceChainConfig, error)
 	GetSourceChainConfigFields(ctx context.Context, opts *bind.CallOpts, sourceChainConfig SourceChainConfig) ([]any, error)
@@ -97,7 +115,7 @@ type OfframpEncoder interface {
 	GetOcr3BaseWithArgs(args ...any) (*bind.EncodedCall, error)
 	InitExecute(ref bind.Object, state bind.Object, clock bind.Object, reportContext [][]byte, report []byte, tokenReceiver string) (*bind.EncodedCall, error)
 	InitExecuteWithArgs(args ...any) (*bind.EncodedCall, error)
-	FinishExecute(state bind.Object, receiverParams bind.Object) (*bind.EncodedCall, error)
+	FinishExecute(state bind.Object, receiverParams module_offramp_state_helper.ReceiverParams) (*bind.EncodedCall, error)
 	FinishExecuteWithArgs(args ...any) (*bind.EncodedCall, error)
 	ManuallyInitExecute(ref bind.Object, state bind.Object, clock bind.Object, reportBytes []byte, tokenReceiver string) (*bind.EncodedCall, error)
 	ManuallyInitExecuteWithArgs(args ...any) (*bind.EncodedCall, error)
@@ -109,9 +127,9 @@ type OfframpEncoder interface {
 	CalculateMessageHashWithArgs(args ...any) (*bind.EncodedCall, error)
 	SetOcr3Config(state bind.Object, param bind.Object, configDigest []byte, ocrPluginType byte, bigF byte, isSignatureVerificationEnabled bool, signers [][]byte, transmitters []string) (*bind.EncodedCall, error)
 	SetOcr3ConfigWithArgs(args ...any) (*bind.EncodedCall, error)
-	ConfigSigners(state bind.Object) (*bind.EncodedCall, error)
+	ConfigSigners(state module_ocr3_base.OCRConfig) (*bind.EncodedCall, error)
 	ConfigSignersWithArgs(args ...any) (*bind.EncodedCall, error)
-	ConfigTransmitters(state bind.Object) (*bind.EncodedCall, error)
+	ConfigTransmitters(state module_ocr3_base.OCRConfig) (*bind.EncodedCall, error)
 	ConfigTransmittersWithArgs(args ...any) (*bind.EncodedCall, error)
 	Commit(ref bind.Object, state bind.Object, clock bind.Object, reportContext [][]byte, report []byte, signatures [][]byte) (*bind.EncodedCall, error)
 	CommitWithArgs(args ...any) (*bind.EncodedCall, error)
@@ -153,7 +171,7 @@ type OfframpEncoder interface {
 	AcceptOwnershipWithArgs(args ...any) (*bind.EncodedCall, error)
 	AcceptOwnershipFromObject(state bind.Object, from string) (*bind.EncodedCall, error)
 	AcceptOwnershipFromObjectWithArgs(args ...any) (*bind.EncodedCall, error)
-	AcceptOwnershipAsMcms(state bind.Object, params bind.Object) (*bind.EncodedCall, error)
+	AcceptOwnershipAsMcms(state bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	AcceptOwnershipAsMcmsWithArgs(args ...any) (*bind.EncodedCall, error)
 	ExecuteOwnershipTransfer(ownerCap bind.Object, ownableState bind.Object, to string) (*bind.EncodedCall, error)
 	ExecuteOwnershipTransferWithArgs(args ...any) (*bind.EncodedCall, error)
@@ -161,7 +179,7 @@ type OfframpEncoder interface {
 	ExecuteOwnershipTransferToMcmsWithArgs(args ...any) (*bind.EncodedCall, error)
 	McmsRegisterUpgradeCap(upgradeCap bind.Object, registry bind.Object, state bind.Object) (*bind.EncodedCall, error)
 	McmsRegisterUpgradeCapWithArgs(args ...any) (*bind.EncodedCall, error)
-	McmsEntrypoint(state bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error)
+	McmsEntrypoint(state bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	McmsEntrypointWithArgs(args ...any) (*bind.EncodedCall, error)
 }
 
@@ -787,6 +805,161 @@ func init() {
 		}
 		return result, nil
 	})
+	bind.RegisterStructDecoder("ccip::offramp_state_helper::ReceiverParams", func(data []byte) (interface{}, error) {
+		var result module_offramp_state_helper.ReceiverParams
+		_, err := mystenbcs.Unmarshal(data, &result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
+// DecodeStaticConfigSet decodes the BCS of a StaticConfigSet event.
+func DecodeStaticConfigSet(data []byte) (StaticConfigSet, error) {
+	var result StaticConfigSet
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return StaticConfigSet{}, err
+	}
+
+	return result, nil
+}
+
+// FilterStaticConfigSet returns a page of the StaticConfigSet events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OfframpContract) FilterStaticConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[StaticConfigSet], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "StaticConfigSet", cursor, limit, DecodeStaticConfigSet)
+}
+
+// WatchStaticConfigSet polls the StaticConfigSet events and sends them to ch until the subscription is stopped.
+func (c *OfframpContract) WatchStaticConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[StaticConfigSet]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "StaticConfigSet", opts, ch, DecodeStaticConfigSet)
+}
+
+// DecodeDynamicConfigSet decodes the BCS of a DynamicConfigSet event.
+func DecodeDynamicConfigSet(data []byte) (DynamicConfigSet, error) {
+	var temp bcsDynamicConfigSet
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return DynamicConfigSet{}, err
+	}
+
+	return convertDynamicConfigSetFromBCS(temp)
+}
+
+// FilterDynamicConfigSet returns a page of the DynamicConfigSet events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OfframpContract) FilterDynamicConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[DynamicConfigSet], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "DynamicConfigSet", cursor, limit, DecodeDynamicConfigSet)
+}
+
+// WatchDynamicConfigSet polls the DynamicConfigSet events and sends them to ch until the subscription is stopped.
+func (c *OfframpContract) WatchDynamicConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[DynamicConfigSet]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "DynamicConfigSet", opts, ch, DecodeDynamicConfigSet)
+}
+
+// DecodeSourceChainConfigSet decodes the BCS of a SourceChainConfigSet event.
+func DecodeSourceChainConfigSet(data []byte) (SourceChainConfigSet, error) {
+	var temp bcsSourceChainConfigSet
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return SourceChainConfigSet{}, err
+	}
+
+	return convertSourceChainConfigSetFromBCS(temp)
+}
+
+// FilterSourceChainConfigSet returns a page of the SourceChainConfigSet events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OfframpContract) FilterSourceChainConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[SourceChainConfigSet], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "SourceChainConfigSet", cursor, limit, DecodeSourceChainConfigSet)
+}
+
+// WatchSourceChainConfigSet polls the SourceChainConfigSet events and sends them to ch until the subscription is stopped.
+func (c *OfframpContract) WatchSourceChainConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[SourceChainConfigSet]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "SourceChainConfigSet", opts, ch, DecodeSourceChainConfigSet)
+}
+
+// DecodeSkippedAlreadyExecuted decodes the BCS of a SkippedAlreadyExecuted event.
+func DecodeSkippedAlreadyExecuted(data []byte) (SkippedAlreadyExecuted, error) {
+	var result SkippedAlreadyExecuted
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return SkippedAlreadyExecuted{}, err
+	}
+
+	return result, nil
+}
+
+// FilterSkippedAlreadyExecuted returns a page of the SkippedAlreadyExecuted events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OfframpContract) FilterSkippedAlreadyExecuted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[SkippedAlreadyExecuted], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "SkippedAlreadyExecuted", cursor, limit, DecodeSkippedAlreadyExecuted)
+}
+
+// WatchSkippedAlreadyExecuted polls the SkippedAlreadyExecuted events and sends them to ch until the subscription is stopped.
+func (c *OfframpContract) WatchSkippedAlreadyExecuted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[SkippedAlreadyExecuted]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "SkippedAlreadyExecuted", opts, ch, DecodeSkippedAlreadyExecuted)
+}
+
+// DecodeExecutionStateChanged decodes the BCS of a ExecutionStateChanged event.
+func DecodeExecutionStateChanged(data []byte) (ExecutionStateChanged, error) {
+	var result ExecutionStateChanged
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return ExecutionStateChanged{}, err
+	}
+
+	return result, nil
+}
+
+// FilterExecutionStateChanged returns a page of the ExecutionStateChanged events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OfframpContract) FilterExecutionStateChanged(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ExecutionStateChanged], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "ExecutionStateChanged", cursor, limit, DecodeExecutionStateChanged)
+}
+
+// WatchExecutionStateChanged polls the ExecutionStateChanged events and sends them to ch until the subscription is stopped.
+func (c *OfframpContract) WatchExecutionStateChanged(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ExecutionStateChanged]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "ExecutionStateChanged", opts, ch, DecodeExecutionStateChanged)
+}
+
+// DecodeCommitReportAccepted decodes the BCS of a CommitReportAccepted event.
+func DecodeCommitReportAccepted(data []byte) (CommitReportAccepted, error) {
+	var result CommitReportAccepted
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return CommitReportAccepted{}, err
+	}
+
+	return result, nil
+}
+
+// FilterCommitReportAccepted returns a page of the CommitReportAccepted events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OfframpContract) FilterCommitReportAccepted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[CommitReportAccepted], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "CommitReportAccepted", cursor, limit, DecodeCommitReportAccepted)
+}
+
+// WatchCommitReportAccepted polls the CommitReportAccepted events and sends them to ch until the subscription is stopped.
+func (c *OfframpContract) WatchCommitReportAccepted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[CommitReportAccepted]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "CommitReportAccepted", opts, ch, DecodeCommitReportAccepted)
+}
+
+// DecodeSkippedReportExecution decodes the BCS of a SkippedReportExecution event.
+func DecodeSkippedReportExecution(data []byte) (SkippedReportExecution, error) {
+	var result SkippedReportExecution
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return SkippedReportExecution{}, err
+	}
+
+	return result, nil
+}
+
+// FilterSkippedReportExecution returns a page of the SkippedReportExecution events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OfframpContract) FilterSkippedReportExecution(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[SkippedReportExecution], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "SkippedReportExecution", cursor, limit, DecodeSkippedReportExecution)
+}
+
+// WatchSkippedReportExecution polls the SkippedReportExecution events and sends them to ch until the subscription is stopped.
+func (c *OfframpContract) WatchSkippedReportExecution(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[SkippedReportExecution]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "SkippedReportExecution", opts, ch, DecodeSkippedReportExecution)
 }
 
 // TypeAndVersion executes the type_and_version Move function.
@@ -830,7 +1003,7 @@ func (c *OfframpContract) InitExecute(ctx context.Context, opts *bind.CallOpts,
 }
 
 // FinishExecute executes the finish_execute Move function.
-func (c *OfframpContract) FinishExecute(ctx context.Context, opts *bind.CallOpts, state bind.Object, receiverParams bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *OfframpContract) FinishExecute(ctx context.Context, opts *bind.CallOpts, state bind.Object, receiverParams module_offramp_state_helper.ReceiverParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.offrampEncoder.FinishExecute(state, receiverParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -890,7 +1063,7 @@ func (c *OfframpContract) SetOcr3Config(ctx context.Context, opts *bind.CallOpts
 }
 
 // ConfigSigners executes the config_signers Move function.
-func (c *OfframpContract) ConfigSigners(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *OfframpContract) ConfigSigners(ctx context.Context, opts *bind.CallOpts, state module_ocr3_base.OCRConfig) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.offrampEncoder.ConfigSigners(state)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -900,7 +1073,7 @@ func (c *OfframpContract) ConfigSigners(ctx context.Context, opts *bind.CallOpts
 }
 
 // ConfigTransmitters executes the config_transmitters Move function.
-func (c *OfframpContract) ConfigTransmitters(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *OfframpContract) ConfigTransmitters(ctx context.Context, opts *bind.CallOpts, state module_ocr3_base.OCRConfig) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.offrampEncoder.ConfigTransmitters(state)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1110,7 +1283,7 @@ func (c *OfframpContract) AcceptOwnershipFromObject(ctx context.Context, opts *b
 }
 
 // AcceptOwnershipAsMcms executes the accept_ownership_as_mcms Move function.
-func (c *OfframpContract) AcceptOwnershipAsMcms(ctx context.Context, opts *bind.CallOpts, state bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *OfframpContract) AcceptOwnershipAsMcms(ctx context.Context, opts *bind.CallOpts, state bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.offrampEncoder.AcceptOwnershipAsMcms(state, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1150,7 +1323,7 @@ func (c *OfframpContract) McmsRegisterUpgradeCap(ctx context.Context, opts *bind
 }
 
 // McmsEntrypoint executes the mcms_entrypoint Move function.
-func (c *OfframpContract) McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, state bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *OfframpContract) McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, state bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.offrampEncoder.McmsEntrypoint(state, registry, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1205,44 +1378,44 @@ func (d *OfframpDevInspect) GetOcr3Base(ctx context.Context, opts *bind.CallOpts
 
 // InitExecute executes the init_execute Move function using DevInspect to get return values.
 //
-// Returns: osh::ReceiverParams
-func (d *OfframpDevInspect) InitExecute(ctx context.Context, opts *bind.CallOpts, ref bind.Object, state bind.Object, clock bind.Object, reportContext [][]byte, report []byte, tokenReceiver string) (bind.Object, error) {
+// Returns: ccip::offramp_state_helper::ReceiverParams
+func (d *OfframpDevInspect) InitExecute(ctx context.Context, opts *bind.CallOpts, ref bind.Object, state bind.Object, clock bind.Object, reportContext [][]byte, report []byte, tokenReceiver string) (module_offramp_state_helper.ReceiverParams, error) {
 	encoded, err := d.contract.offrampEncoder.InitExecute(ref, state, clock, reportContext, report, tokenReceiver)
 	if err != nil {
-		return bind.Object{}, fmt.Errorf("failed to encode function call: %w", err)
+		return module_offramp_state_helper.ReceiverParams{}, fmt.Errorf("failed to encode function call: %w", err)
 	}
 	results, err := d.contract.Call(ctx, opts, encoded)
 	if err != nil {
-		return bind.Object{}, err
+		return module_offramp_state_helper.ReceiverParams{}, err
 	}
 	if len(results) == 0 {
-		return bind.Object{}, fmt.Errorf("no return value")
+		return module_offramp_state_helper.ReceiverParams{}, fmt.Errorf("no return value")
 	}
-	result, ok := results[0].(bind.Object)
+	result, ok := results[0].(module_offramp_state_helper.ReceiverParams)
 	if !ok {
-		return bind.Object{}, fmt.Errorf("unexpected return type: expected bind.Object, got %T", results[0])
+		return module_offramp_state_helper.ReceiverParams{}, fmt.Errorf("unexpected return type: expected module_offramp_state_helper.ReceiverParams, got %T", results[0])
 	}
 	return result, nil
 }
 
 // ManuallyInitExecute executes the manually_init_execute Move function using DevInspect to get return values.
 //
-// Returns: osh::ReceiverParams
-func (d *OfframpDevInspect) ManuallyInitExecute(ctx context.Context, opts *bind.CallOpts, ref bind.Object, state bind.Object, clock bind.Object, reportBytes []byte, tokenReceiver string) (bind.Object, error) {
+// Returns: ccip::offramp_state_helper::ReceiverParams
+func (d *OfframpDevInspect) ManuallyInitExecute(ctx context.Context, opts *bind.CallOpts, ref bind.Object, state bind.Object, clock bind.Object, reportBytes []byte, tokenReceiver string) (module_offramp_state_helper.ReceiverParams, error) {
 	encoded, err := d.contract.offrampEncoder.ManuallyInitExecute(ref, state, clock, reportBytes, tokenReceiver)
 	if err != nil {
-		return bind.Object{}, fmt.Errorf("failed to encode function call: %w", err)
+		return module_offramp_state_helper.ReceiverParams{}, fmt.Errorf("failed to encode function call: %w", err)
 	}
 	results, err := d.contract.Call(ctx, opts, encoded)
 	if err != nil {
-		return bind.Object{}, err
+		return module_offramp_state_helper.ReceiverParams{}, err
 	}
 	if len(results) == 0 {
-		return bind.Object{}, fmt.Errorf("no return value")
+		return module_offramp_state_helper.ReceiverParams{}, fmt.Errorf("no return value")
 	}
-	result, ok := results[0].(bind.Object)
+	result, ok := results[0].(module_offramp_state_helper.ReceiverParams)
 	if !ok {
-		return bind.Object{}, fmt.Errorf("unexpected return type: expected bind.Object, got %T", results[0])
+		return module_offramp_state_helper.ReceiverParams{}, fmt.Errorf("unexpected return type: expected module_offramp_state_helper.ReceiverParams, got %T", results[0])
 	}
 	return result, nil
 }
@@ -1316,7 +1489,7 @@ func (d *OfframpDevInspect) CalculateMessageHash(ctx context.Context, opts *bind
 // ConfigSigners executes the config_signers Move function using DevInspect to get return values.
 //
 // Returns: vector<vector<u8>>
-func (d *OfframpDevInspect) ConfigSigners(ctx context.Context, opts *bind.CallOpts, state bind.Object) ([][]byte, error) {
+func (d *OfframpDevInspect) ConfigSigners(ctx context.Context, opts *bind.CallOpts, state module_ocr3_base.OCRConfig) ([][]byte, error) {
 	encoded, err := d.contract.offrampEncoder.ConfigSigners(state)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1338,7 +1511,7 @@ func (d *OfframpDevInspect) ConfigSigners(ctx context.Context, opts *bind.CallOp
 // ConfigTransmitters executes the config_transmitters Move function using DevInspect to get return values.
 //
 // Returns: vector<address>
-func (d *OfframpDevInspect) ConfigTransmitters(ctx context.Context, opts *bind.CallOpts, state bind.Object) ([]string, error) {
+func (d *OfframpDevInspect) ConfigTransmitters(ctx context.Context, opts *bind.CallOpts, state module_ocr3_base.OCRConfig) ([]string, error) {
 	encoded, err := d.contract.offrampEncoder.ConfigTransmitters(state)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1768,7 +1941,7 @@ func (c offrampEncoder) InitExecute(ref bind.Object, state bind.Object, clock bi
 		report,
 		tokenReceiver,
 	}, []string{
-		"osh::ReceiverParams",
+		"ccip::offramp_state_helper::ReceiverParams",
 	})
 }
 
@@ -1790,12 +1963,12 @@ func (c offrampEncoder) InitExecuteWithArgs(args ...any) (*bind.EncodedCall, err
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("init_execute", typeArgsList, typeParamsList, expectedParams, args, []string{
-		"osh::ReceiverParams",
+		"ccip::offramp_state_helper::ReceiverParams",
 	})
 }
 
 // FinishExecute encodes a call to the finish_execute Move function.
-func (c offrampEncoder) FinishExecute(state bind.Object, receiverParams bind.Object) (*bind.EncodedCall, error) {
+func (c offrampEncoder) FinishExecute(state bind.Object, receiverParams module_offramp_state_helper.ReceiverParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("finish_execute", typeArgsList, typeParamsList, []string{
@@ -1840,7 +2013,7 @@ func (c offrampEncoder) ManuallyInitExecute(ref bind.Object, state bind.Object,
 		reportBytes,
 		tokenReceiver,
 	}, []string{
-		"osh::ReceiverParams",
+		"ccip::offramp_state_helper::ReceiverParams",
 	})
 }
 
@@ -1861,7 +2034,7 @@ func (c offrampEncoder) ManuallyInitExecuteWithArgs(args ...any) (*bind.EncodedC
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("manually_init_execute", typeArgsList, typeParamsList, expectedParams, args, []string{
-		"osh::ReceiverParams",
+		"ccip::offramp_state_helper::ReceiverParams",
 	})
 }
 
@@ -2057,7 +2230,7 @@ func (c offrampEncoder) SetOcr3ConfigWithArgs(args ...any) (*bind.EncodedCall, e
 }
 
 // ConfigSigners encodes a call to the config_signers Move function.
-func (c offrampEncoder) ConfigSigners(state bind.Object) (*bind.EncodedCall, error) {
+func (c offrampEncoder) ConfigSigners(state module_ocr3_base.OCRConfig) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("config_signers", typeArgsList, typeParamsList, []string{
@@ -2087,7 +2260,7 @@ func (c offrampEncoder) ConfigSignersWithArgs(args ...any) (*bind.EncodedCall, e
 }
 
 // ConfigTransmitters encodes a call to the config_transmitters Move function.
-func (c offrampEncoder) ConfigTransmitters(state bind.Object) (*bind.EncodedCall, error) {
+func (c offrampEncoder) ConfigTransmitters(state module_ocr3_base.OCRConfig) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("config_transmitters", typeArgsList, typeParamsList, []string{
@@ -2756,7 +2929,7 @@ func (c offrampEncoder) AcceptOwnershipFromObjectWithArgs(args ...any) (*bind.En
 }
 
 // AcceptOwnershipAsMcms encodes a call to the accept_ownership_as_mcms Move function.
-func (c offrampEncoder) AcceptOwnershipAsMcms(state bind.Object, params bind.Object) (*bind.EncodedCall, error) {
+func (c offrampEncoder) AcceptOwnershipAsMcms(state bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("accept_ownership_as_mcms", typeArgsList, typeParamsList, []string{
@@ -2884,7 +3057,7 @@ func (c offrampEncoder) McmsRegisterUpgradeCapWithArgs(args ...any) (*bind.Encod
 }
 
 // McmsEntrypoint encodes a call to the mcms_entrypoint Move function.
-func (c offrampEncoder) McmsEntrypoint(state bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error) {
+func (c offrampEncoder) McmsEntrypoint(state bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("mcms_entrypoint", typeArgsList, typeParamsList, []string{
@@ -13,10 +13,13 @@ import (
 	"github.com/block-vision/sui-go-sdk/sui"
 
 	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	module_onramp_state_helper "github.com/smartcontractkit/chainlink-sui/bindings/generated/ccip/ccip/onramp_state_helper"
+	module_mcms_registry "github.com/smartcontractkit/chainlink-sui/bindings/generated/mcms/mcms_registry"
 )
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type IOnramp interface {
@@ -39,7 +42,7 @@ type IOnramp interface {
 	GetDynamicConfigFields(ctx context.Context, opts *bind.CallOpts, cfg DynamicConfig) (*models.SuiTransactionBlockResponse, error)
 	CalculateMessageHash(ctx context.Context, opts *bind.CallOpts, onRampAddress string, messageId []byte, sourceChainSelector uint64, destChainSelector uint64, sequenceNumber uint64, nonce uint64, sender string, receiver []byte, data []byte, feeToken string, feeTokenAmount uint64, sourcePoolAddresses []string, destTokenAddresses [][]byte, extraDatas [][]byte, amounts []uint64, destExecDatas [][]byte, extraArgs []byte) (*models.SuiTransactionBlockResponse, error)
 	CalculateMetadataHash(ctx context.Context, opts *bind.CallOpts, sourceChainSelector uint64, destChainSelector uint64, onRampAddress string) (*models.SuiTransactionBlockResponse, error)
-	CcipSend(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ref bind.Object, state bind.Object, clock bind.Object, destChainSelector uint64, receiver []byte, data []byte, tokenParams bind.Object, feeTokenMetadata bind.Object, feeToken bind.Object, extraArgs []byte) (*models.SuiTransactionBlockResponse, error)
+	CcipSend(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ref bind.Object, state bind.Object, clock bind.Object, destChainSelector uint64, receiver []byte, data []byte, tokenParams module_onramp_state_helper.TokenTransferParams, feeTokenMetadata bind.Object, feeToken bind.Object, extraArgs []byte) (*models.SuiTransactionBlockResponse, error)
 	GetCcipPackageId(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error)
 	Owner(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
 	HasPendingTransfer(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
@@ -49,11 +52,23 @@ type IOnramp interface {
 	TransferOwnership(ctx context.Context, opts *bind.CallOpts, state bind.Object, ownerCap bind.Object, newOwner string) (*models.SuiTransactionBlockResponse, error)
 	AcceptOwnership(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
 	AcceptOwnershipFromObject(ctx context.Context, opts *bind.CallOpts, state bind.Object, from string) (*models.SuiTransactionBlockResponse, error)
-	AcceptOwnershipAsMcms(ctx context.Context, opts *bind.CallOpts, state bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error)
+	AcceptOwnershipAsMcms(ctx context.Context, opts *bind.CallOpts, state bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
 	ExecuteOwnershipTransfer(ctx context.Context, opts *bind.CallOpts, ownerCap bind.Object, ownableState bind.Object, to string) (*models.SuiTransactionBlockResponse, error)
 	ExecuteOwnershipTransferToMcms(ctx context.Context, opts *bind.CallOpts, ownerCap bind.Object, state bind.Object, registry bind.Object, to string) (*models.SuiTransactionBlockResponse, error)
 	McmsRegisterUpgradeCap(ctx context.Context, opts *bind.CallOpts, upgradeCap bind.Object, registry bind.Object, state bind.Object) (*models.SuiTransactionBlockResponse, error)
-	McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, state bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error)
+	McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, state bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	FilterConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ConfigSet], error)
+	WatchConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ConfigSet]) (*bind.EventSubscription, error)
+	FilterDestChainConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[DestChainConfigSet], error)
+	WatchDestChainConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[DestChainConfigSet]) (*bind.EventSubscription, error)
+	FilterCCIPMessageSent(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[CCIPMessageSent], error)
+	WatchCCIPMessageSent(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[CCIPMessageSent]) (*bind.EventSubscription, error)
+	FilterAllowlistSendersAdded(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[AllowlistSendersAdded], error)
+	WatchAllowlistSendersAdded(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[AllowlistSendersAdded]) (*bind.EventSubscription, error)
+	FilterAllowlistSendersRemoved(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[AllowlistSendersRemoved], error)
+	WatchAllowlistSendersRemoved(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[AllowlistSendersRemoved]) (*bind.EventSubscription, error)
+	FilterFeeTokenWithdrawn(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[FeeTokenWithdrawn], error)
+	WatchFeeTokenWithdrawn(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[FeeTokenWithdrawn]) (*bind.EventSubscription, error)
 	DevInspect() IOnrampDevInspect
 	Encoder() OnrampEncoder
 	Bound() bind.IBoundContract
@@ -73,7 +88,7 @@ type IOnrampDevInspect interface {
 	GetDynamicConfigFields(ctx context.Context, opts *bind.CallOpts, cfg DynamicConfig) ([]any, error)
 	CalculateMessageHash(ctx context.Context, opts *bind.CallOpts, onRampAddress string, messageId []byte, sourceChainSelector uint64, destChainSelector uint64, sequenceNumber uint64, nonce uint64, sender string, receiver []byte, data []byte, feeToken string, feeTokenAmount uint64, sourcePoolAddresses []string, destTokenAddresses [][]byte, extraDatas [][]byte, amounts []uint64, destExecDatas [][]byte, extraArgs []byte) ([]byte, error)
 	CalculateMetadataHash(ctx context.Context, opts *bind.CallOpts, sourceChainSelector uint64, destChainSelector uint64, onRampAddress string) ([]byte, error)
-	CcipSend(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ref bind.Object, state bind.Object, clock bind.Object, destChainSelector uint64, receiver []byte, data []byte, tokenParams bind.Object, feeTokenMetadata bind.Object, feeToken bind.Object, extraArgs []byte) ([]byte, error)
+	CcipSend(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ref bind.Object, state bind.Object, clock bind.Object, destChainSelector uint64, receiver []byte, data []byte, tokenParams module_onramp_state_helper.TokenTransferParams, feeTokenMetadata bind.Object, feeToken bind.Object, extraArgs []byte) ([]byte, error)
 	GetCcipPackageId(ctx context.Context, opts *bind.CallOpts) (string, error)
 	Owner(ctx context.Context, opts *bind.CallOpts, state bind.Object) (string, error)
 	HasPendingTransfer(ctx context.Context, opts *bind.CallOpts, state bind.Object) (bool, error)
@@ -121,7 +136,7 @@ type OnrampEncoder interface {
 	CalculateMessageHashWithArgs(args ...any) (*bind.EncodedCall, error)
 	CalculateMetadataHash(sourceChainSelector uint64, destChainSelector uint64, onRampAddress string) (*bind.EncodedCall, error)
 	CalculateMetadataHashWithArgs(args ...any) (*bind.EncodedCall, error)
-	CcipSend(typeArgs []string, ref bind.Object, state bind.Object, clock bind.Object, destChainSelector uint64, receiver []byte, data []byte, tokenParams bind.Object, feeTokenMetadata bind.Object, feeToken bind.Object, extraArgs []byte) (*bind.EncodedCall, error)
+	CcipSend(typeArgs []string, ref bind.Object, state bind.Object, clock bind.Object, destChainSelector uint64, receiver []byte, data []byte, tokenParams module_onramp_state_helper.TokenTransferParams, feeTokenMetadata bind.Object, feeToken bind.Object, extraArgs []byte) (*bind.EncodedCall, error)
 	CcipSendWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error)
 	GetCcipPackageId() (*bind.EncodedCall, error)
 	GetCcipPackageIdWithArgs(args ...any) (*bind.EncodedCall, error)
@@ -141,7 +156,7 @@ type OnrampEncoder interface {
 	AcceptOwnershipWithArgs(args ...any) (*bind.EncodedCall, error)
 	AcceptOwnershipFromObject(state bind.Object, from string) (*bind.EncodedCall, error)
 	AcceptOwnershipFromObjectWithArgs(args ...any) (*bind.EncodedCall, error)
-	AcceptOwnershipAsMcms(state bind.Object, params bind.Object) (*bind.EncodedCall, error)
+	AcceptOwnershipAsMcms(state bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	AcceptOwnershipAsMcmsWithArgs(args ...any) (*bind.EncodedCall, error)
 	ExecuteOwnershipTransfer(ownerCap bind.Object, ownableState bind.Object, to string) (*bind.EncodedCall, error)
 	ExecuteOwnershipTransferWithArgs(args ...any) (*bind.EncodedCall, error)
@@ -149,7 +164,7 @@ type OnrampEncoder interface {
 	ExecuteOwnershipTransferToMcmsWithArgs(args ...any) (*bind.EncodedCall, error)
 	McmsRegisterUpgradeCap(upgradeCap bind.Object, registry bind.Object, state bind.Object) (*bind.EncodedCall, error)
 	McmsRegisterUpgradeCapWithArgs(args ...any) (*bind.EncodedCall, error)
-	McmsEntrypoint(state bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error)
+	McmsEntrypoint(state bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	McmsEntrypointWithArgs(args ...any) (*bind.EncodedCall, error)
 }
 
@@ -697,6 +712,132 @@ func init() {
 	})
 }
 
+// DecodeConfigSet decodes the BCS of a ConfigSet event.
+func DecodeConfigSet(data []byte) (ConfigSet, error) {
+	var temp bcsConfigSet
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return ConfigSet{}, err
+	}
+
+	return convertConfigSetFromBCS(temp)
+}
+
+// FilterConfigSet returns a page of the ConfigSet events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OnrampContract) FilterConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ConfigSet], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "ConfigSet", cursor, limit, DecodeConfigSet)
+}
+
+// WatchConfigSet polls the ConfigSet events and sends them to ch until the subscription is stopped.
+func (c *OnrampContract) WatchConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ConfigSet]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "ConfigSet", opts, ch, DecodeConfigSet)
+}
+
+// DecodeDestChainConfigSet decodes the BCS of a DestChainConfigSet event.
+func DecodeDestChainConfigSet(data []byte) (DestChainConfigSet, error) {
+	var result DestChainConfigSet
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return DestChainConfigSet{}, err
+	}
+
+	return result, nil
+}
+
+// FilterDestChainConfigSet returns a page of the DestChainConfigSet events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OnrampContract) FilterDestChainConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[DestChainConfigSet], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "DestChainConfigSet", cursor, limit, DecodeDestChainConfigSet)
+}
+
+// WatchDestChainConfigSet polls the DestChainConfigSet events and sends them to ch until the subscription is stopped.
+func (c *OnrampContract) WatchDestChainConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[DestChainConfigSet]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "DestChainConfigSet", opts, ch, DecodeDestChainConfigSet)
+}
+
+// DecodeCCIPMessageSent decodes the BCS of a CCIPMessageSent event.
+func DecodeCCIPMessageSent(data []byte) (CCIPMessageSent, error) {
+	var temp bcsCCIPMessageSent
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return CCIPMessageSent{}, err
+	}
+
+	return convertCCIPMessageSentFromBCS(temp)
+}
+
+// FilterCCIPMessageSent returns a page of the CCIPMessageSent events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OnrampContract) FilterCCIPMessageSent(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[CCIPMessageSent], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "CCIPMessageSent", cursor, limit, DecodeCCIPMessageSent)
+}
+
+// WatchCCIPMessageSent polls the CCIPMessageSent events and sends them to ch until the subscription is stopped.
+func (c *OnrampContract) WatchCCIPMessageSent(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[CCIPMessageSent]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "CCIPMessageSent", opts, ch, DecodeCCIPMessageSent)
+}
+
+// DecodeAllowlistSendersAdded decodes the BCS of a AllowlistSendersAdded event.
+func DecodeAllowlistSendersAdded(data []byte) (AllowlistSendersAdded, error) {
+	var temp bcsAllowlistSendersAdded
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return AllowlistSendersAdded{}, err
+	}
+
+	return convertAllowlistSendersAddedFromBCS(temp)
+}
+
+// FilterAllowlistSendersAdded returns a page of the AllowlistSendersAdded events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OnrampContract) FilterAllowlistSendersAdded(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[AllowlistSendersAdded], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "AllowlistSendersAdded", cursor, limit, DecodeAllowlistSendersAdded)
+}
+
+// WatchAllowlistSendersAdded polls the AllowlistSendersAdded events and sends them to ch until the subscription is stopped.
+func (c *OnrampContract) WatchAllowlistSendersAdded(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[AllowlistSendersAdded]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "AllowlistSendersAdded", opts, ch, DecodeAllowlistSendersAdded)
+}
+
+// DecodeAllowlistSendersRemoved decodes the BCS of a AllowlistSendersRemoved event.
+func DecodeAllowlistSendersRemoved(data []byte) (AllowlistSendersRemoved, error) {
+	var temp bcsAllowlistSendersRemoved
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return AllowlistSendersRemoved{}, err
+	}
+
+	return convertAllowlistSendersRemovedFromBCS(temp)
+}
+
+// FilterAllowlistSendersRemoved returns a page of the AllowlistSendersRemoved events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OnrampContract) FilterAllowlistSendersRemoved(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[AllowlistSendersRemoved], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "AllowlistSendersRemoved", cursor, limit, DecodeAllowlistSendersRemoved)
+}
+
+// WatchAllowlistSendersRemoved polls the AllowlistSendersRemoved events and sends them to ch until the subscription is stopped.
+func (c *OnrampContract) WatchAllowlistSendersRemoved(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[AllowlistSendersRemoved]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "AllowlistSendersRemoved", opts, ch, DecodeAllowlistSendersRemoved)
+}
+
+// DecodeFeeTokenWithdrawn decodes the BCS of a FeeTokenWithdrawn event.
+func DecodeFeeTokenWithdrawn(data []byte) (FeeTokenWithdrawn, error) {
+	var temp bcsFeeTokenWithdrawn
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return FeeTokenWithdrawn{}, err
+	}
+
+	return convertFeeTokenWithdrawnFromBCS(temp)
+}
+
+// FilterFeeTokenWithdrawn returns a page of the FeeTokenWithdrawn events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OnrampContract) FilterFeeTokenWithdrawn(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[FeeTokenWithdrawn], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "FeeTokenWithdrawn", cursor, limit, DecodeFeeTokenWithdrawn)
+}
+
+// WatchFeeTokenWithdrawn polls the FeeTokenWithdrawn events and sends them to ch until the subscription is stopped.
+func (c *OnrampContract) WatchFeeTokenWithdrawn(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[FeeTokenWithdrawn]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "FeeTokenWithdrawn", opts, ch, DecodeFeeTokenWithdrawn)
+}
+
 // TypeAndVersion executes the type_and_version Move function.
 func (c *OnrampContract) TypeAndVersion(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.onrampEncoder.TypeAndVersion()
@@ -888,7 +1029,7 @@ func (c *OnrampContract) CalculateMetadataHash(ctx context.Context, opts *bind.C
 }
 
 // CcipSend executes the ccip_send Move function.
-func (c *OnrampContract) CcipSend(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ref bind.Object, state bind.Object, clock bind.Object, destChainSelector uint64, receiver []byte, data []byte, tokenParams bind.Object, feeTokenMetadata bind.Object, feeToken bind.Object, extraArgs []byte) (*models.SuiTransactionBlockResponse, error) {
+func (c *OnrampContract) CcipSend(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ref bind.Object, state bind.Object, clock bind.Object, destChainSelector uint64, receiver []byte, data []byte, tokenParams module_onramp_state_helper.TokenTransferParams, feeTokenMetadata bind.Object, feeToken bind.Object, extraArgs []byte) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.onrampEncoder.CcipSend(typeArgs, ref, state, clock, destChainSelector, receiver, data, tokenParams, feeTokenMetadata, feeToken, extraArgs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -988,7 +1129,7 @@ func (c *OnrampContract) AcceptOwnershipFromObject(ctx context.Context, opts *bi
 }
 
 // AcceptOwnershipAsMcms executes the accept_ownership_as_mcms Move function.
-func (c *OnrampContract) AcceptOwnershipAsMcms(ctx context.Context, opts *bind.CallOpts, state bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *OnrampContract) AcceptOwnershipAsMcms(ctx context.Context, opts *bind.CallOpts, state bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.onrampEncoder.AcceptOwnershipAsMcms(state, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1028,7 +1169,7 @@ func (c *OnrampContract) McmsRegisterUpgradeCap(ctx context.Context, opts *bind.
 }
 
 // McmsEntrypoint executes the mcms_entrypoint Move function.
-func (c *OnrampContract) McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, state bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *OnrampContract) McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, state bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.onrampEncoder.McmsEntrypoint(state, registry, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1304,7 +1445,7 @@ func (d *OnrampDevInspect) CalculateMetadataHash(ctx context.Context, opts *bind
 // CcipSend executes the ccip_send Move function using DevInspect to get return values.
 //
 // Returns: vector<u8>
-func (d *OnrampDevInspect) CcipSend(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ref bind.Object, state bind.Object, clock bind.Object, destChainSelector uint64, receiver []byte, data []byte, tokenParams bind.Object, feeTokenMetadata bind.Object, feeToken bind.Object, extraArgs []byte) ([]byte, error) {
+func (d *OnrampDevInspect) CcipSend(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ref bind.Object, state bind.Object, clock bind.Object, destChainSelector uint64, receiver []byte, data []byte, tokenParams module_onramp_state_helper.TokenTransferParams, feeTokenMetadata bind.Object, feeToken bind.Object, extraArgs []byte) ([]byte, error) {
 	encoded, err := d.contract.onrampEncoder.CcipSend(typeArgs, ref, state, clock, destChainSelector, receiver, data, tokenParams, feeTokenMetadata, feeToken, extraArgs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -2195,7 +2336,7 @@ func (c onrampEncoder) CalculateMetadataHashWithArgs(args ...any) (*bind.Encoded
 }
 
 // CcipSend encodes a call to the ccip_send Move function.
-func (c onrampEncoder) CcipSend(typeArgs []string, ref bind.Object, state bind.Object, clock bind.Object, destChainSelector uint64, receiver []byte, data []byte, tokenParams bind.Object, feeTokenMetadata bind.Object, feeToken bind.Object, extraArgs []byte) (*bind.EncodedCall, error) {
+func (c onrampEncoder) CcipSend(typeArgs []string, ref bind.Object, state bind.Object, clock bind.Object, destChainSelector uint64, receiver []byte, data []byte, tokenParams module_onramp_state_helper.TokenTransferParams, feeTokenMetadata bind.Object, feeToken bind.Object, extraArgs []byte) (*bind.EncodedCall, error) {
 	typeArgsList := typeArgs
 	typeParamsList := []string{
 		"T",
@@ -2517,7 +2658,7 @@ func (c onrampEncoder) AcceptOwnershipFromObjectWithArgs(args ...any) (*bind.Enc
 }
 
 // AcceptOwnershipAsMcms encodes a call to the accept_ownership_as_mcms Move function.
-func (c onrampEncoder) AcceptOwnershipAsMcms(state bind.Object, params bind.Object) (*bind.EncodedCall, error) {
+func (c onrampEncoder) AcceptOwnershipAsMcms(state bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("accept_ownership_as_mcms", typeArgsList, typeParamsList, []string{
@@ -2645,7 +2786,7 @@ func (c onrampEncoder) McmsRegisterUpgradeCapWithArgs(args ...any) (*bind.Encode
 }
 
 // McmsEntrypoint encodes a call to the mcms_entrypoint Move function.
-func (c onrampEncoder) McmsEntrypoint(state bind.Object, registry bind.Object, params bind.Object) (*bind.EncodedCall, error) {
+func (c onrampEncoder) McmsEntrypoint(state bind.Object, registry bind.Object, params module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("mcms_entrypoint", typeArgsList, typeParamsList, []string{
@@ -0,0 +1,1177 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package module_ownable
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+)
+
+var (
+	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
+)
+
+type IOwnable interface {
+	New(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error)
+	OwnerCapId(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
+	Owner(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
+	HasPendingTransfer(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
+	PendingTransferFrom(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
+	PendingTransferTo(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
+	PendingTransferAccepted(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
+	TransferOwnership(ctx context.Context, opts *bind.CallOpts, ownerCap bind.Object, state bind.Object, to string) (*models.SuiTransactionBlockResponse, error)
+	AcceptOwnership(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
+	AcceptOwnershipFromObject(ctx context.Context, opts *bind.CallOpts, state bind.Object, from string) (*models.SuiTransactionBlockResponse, error)
+	AcceptOwnershipAsMcms(ctx context.Context, opts *bind.CallOpts, state bind.Object, mcms string) (*models.SuiTransactionBlockResponse, error)
+	ExecuteOwnershipTransfer(ctx context.Context, opts *bind.CallOpts, ownerCap bind.Object, state bind.Object, to string) (*models.SuiTransactionBlockResponse, error)
+	ExecuteOwnershipTransferToMcms(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ownerCap bind.Object, state bind.Object, registry bind.Object, to string, proof bind.Object) (*models.SuiTransactionBlockResponse, error)
+	DestroyOwnableState(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
+	DestroyOwnerCap(ctx context.Context, opts *bind.CallOpts, ownerCap bind.Object) (*models.SuiTransactionBlockResponse, error)
+	FilterNewOwnableStateEvent(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[NewOwnableStateEvent], error)
+	WatchNewOwnableStateEvent(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[NewOwnableStateEvent]) (*bind.EventSubscription, error)
+	FilterOwnershipTransferRequested(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OwnershipTransferRequested], error)
+	WatchOwnershipTransferRequested(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OwnershipTransferRequested]) (*bind.EventSubscription, error)
+	FilterOwnershipTransferAccepted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OwnershipTransferAccepted], error)
+	WatchOwnershipTransferAccepted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OwnershipTransferAccepted]) (*bind.EventSubscription, error)
+	FilterOwnershipTransferred(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OwnershipTransferred], error)
+	WatchOwnershipTransferred(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OwnershipTransferred]) (*bind.EventSubscription, error)
+	DevInspect() IOwnableDevInspect
+	Encoder() OwnableEncoder
+	Bound() bind.IBoundContract
+}
+
+type IOwnableDevInspect interface {
+	New(ctx context.Context, opts *bind.CallOpts) ([]any, error)
+	OwnerCapId(ctx context.Context, opts *bind.CallOpts, state bind.Object) (bind.Object, error)
+	Owner(ctx context.Context, opts *bind.CallOpts, state bind.Object) (string, error)
+	HasPendingTransfer(ctx context.Context, opts *bind.CallOpts, state bind.Object) (bool, error)
+	PendingTransferFrom(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*string, error)
+	PendingTransferTo(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*string, error)
+	PendingTransferAccepted(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*bool, error)
+}
+
+type OwnableEncoder interface {
+	New() (*bind.EncodedCall, error)
+	NewWithArgs(args ...any) (*bind.EncodedCall, error)
+	OwnerCapId(state bind.Object) (*bind.EncodedCall, error)
+	OwnerCapIdWithArgs(args ...any) (*bind.EncodedCall, error)
+	Owner(state bind.Object) (*bind.EncodedCall, error)
+	OwnerWithArgs(args ...any) (*bind.EncodedCall, error)
+	HasPendingTransfer(state bind.Object) (*bind.EncodedCall, error)
+	HasPendingTransferWithArgs(args ...any) (*bind.EncodedCall, error)
+	PendingTransferFrom(state bind.Object) (*bind.EncodedCall, error)
+	PendingTransferFromWithArgs(args ...any) (*bind.EncodedCall, error)
+	PendingTransferTo(state bind.Object) (*bind.EncodedCall, error)
+	PendingTransferToWithArgs(args ...any) (*bind.EncodedCall, error)
+	PendingTransferAccepted(state bind.Object) (*bind.EncodedCall, error)
+	PendingTransferAcceptedWithArgs(args ...any) (*bind.EncodedCall, error)
+	TransferOwnership(ownerCap bind.Object, state bind.Object, to string) (*bind.EncodedCall, error)
+	TransferOwnershipWithArgs(args ...any) (*bind.EncodedCall, error)
+	AcceptOwnership(state bind.Object) (*bind.EncodedCall, error)
+	AcceptOwnershipWithArgs(args ...any) (*bind.EncodedCall, error)
+	AcceptOwnershipFromObject(state bind.Object, from string) (*bind.EncodedCall, error)
+	AcceptOwnershipFromObjectWithArgs(args ...any) (*bind.EncodedCall, error)
+	AcceptOwnershipAsMcms(state bind.Object, mcms string) (*bind.EncodedCall, error)
+	AcceptOwnershipAsMcmsWithArgs(args ...any) (*bind.EncodedCall, error)
+	ExecuteOwnershipTransfer(ownerCap bind.Object, state bind.Object, to string) (*bind.EncodedCall, error)
+	ExecuteOwnershipTransferWithArgs(args ...any) (*bind.EncodedCall, error)
+	ExecuteOwnershipTransferToMcms(typeArgs []string, ownerCap bind.Object, state bind.Object, registry bind.Object, to string, proof bind.Object) (*bind.EncodedCall, error)
+	ExecuteOwnershipTransferToMcmsWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error)
+	DestroyOwnableState(state bind.Object) (*bind.EncodedCall, error)
+	DestroyOwnableStateWithArgs(args ...any) (*bind.EncodedCall, error)
+	DestroyOwnerCap(ownerCap bind.Object) (*bind.EncodedCall, error)
+	DestroyOwnerCapWithArgs(args ...any) (*bind.EncodedCall, error)
+}
+
+type OwnableContract struct {
+	*bind.BoundContract
+	ownableEncoder
+	devInspect *OwnableDevInspect
+}
+
+type OwnableDevInspect struct {
+	contract *OwnableContract
+}
+
+var _ IOwnable = (*OwnableContract)(nil)
+var _ IOwnableDevInspect = (*OwnableDevInspect)(nil)
+
+func NewOwnable(packageID string, client sui.ISuiAPI) (IOwnable, error) {
+	contract, err := bind.NewBoundContract(packageID, "ccip_onramp", "ownable", client)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &OwnableContract{
+		BoundContract:  contract,
+		ownableEncoder: ownableEncoder{BoundContract: contract},
+	}
+	c.devInspect = &OwnableDevInspect{contract: c}
+	return c, nil
+}
+
+func (c *OwnableContract) Bound() bind.IBoundContract {
+	return c.BoundContract
+}
+
+func (c *OwnableContract) Encoder() OwnableEncoder {
+	return c.ownableEncoder
+}
+
+func (c *OwnableContract) DevInspect() IOwnableDevInspect {
+	return c.devInspect
+}
+
+type OwnerCap struct {
+	Id string `move:"sui::object::UID"`
+}
+
+type OwnableState struct {
+	Id              string           `move:"sui::object::UID"`
+	Owner           string           `move:"address"`
+	PendingTransfer *PendingTransfer `move:"0x1::option::Option<PendingTransfer>"`
+	OwnerCapId      bind.Object      `move:"ID"`
+}
+
+type PendingTransfer struct {
+	From     string `move:"address"`
+	To       string `move:"address"`
+	Accepted bool   `move:"bool"`
+}
+
+type NewOwnableStateEvent struct {
+	OwnableStateId bind.Object `move:"ID"`
+	OwnerCapId     bind.Object `move:"ID"`
+	Owner          string      `move:"address"`
+}
+
+type OwnershipTransferRequested struct {
+	From string `move:"address"`
+	To   string `move:"address"`
+}
+
+type OwnershipTransferAccepted struct {
+	From string `move:"address"`
+	To   string `move:"address"`
+}
+
+type OwnershipTransferred struct {
+	From string `move:"address"`
+	To   string `move:"address"`
+}
+
+type bcsOwnableState struct {
+	Id              string
+	Owner           [32]byte
+	PendingTransfer *PendingTransfer
+	OwnerCapId      [32]byte
+}
+
+func convertOwnableStateFromBCS(bcs bcsOwnableState) (OwnableState, error) {
+
+	return OwnableState{
+		Id:              bcs.Id,
+		Owner:           fmt.Sprintf("0x%x", bcs.Owner),
+		PendingTransfer: bcs.PendingTransfer,
+		OwnerCapId:      bind.Object{Id: fmt.Sprintf("0x%x", bcs.OwnerCapId)},
+	}, nil
+}
+
+type bcsPendingTransfer struct {
+	From     [32]byte
+	To       [32]byte
+	Accepted bool
+}
+
+func convertPendingTransferFromBCS(bcs bcsPendingTransfer) (PendingTransfer, error) {
+
+	return PendingTransfer{
+		From:     fmt.Sprintf("0x%x", bcs.From),
+		To:       fmt.Sprintf("0x%x", bcs.To),
+		Accepted: bcs.Accepted,
+	}, nil
+}
+
+type bcsNewOwnableStateEvent struct {
+	OwnableStateId [32]byte
+	OwnerCapId     [32]byte
+	Owner          [32]byte
+}
+
+func convertNewOwnableStateEventFromBCS(bcs bcsNewOwnableStateEvent) (NewOwnableStateEvent, error) {
+
+	return NewOwnableStateEvent{
+		OwnableStateId: bind.Object{Id: fmt.Sprintf("0x%x", bcs.OwnableStateId)},
+		OwnerCapId:     bind.Object{Id: fmt.Sprintf("0x%x", bcs.OwnerCapId)},
+		Owner:          fmt.Sprintf("0x%x", bcs.Owner),
+	}, nil
+}
+
+type bcsOwnershipTransferRequested struct {
+	From [32]byte
+	To   [32]byte
+}
+
+func convertOwnershipTransferRequestedFromBCS(bcs bcsOwnershipTransferRequested) (OwnershipTransferRequested, error) {
+
+	return OwnershipTransferRequested{
+		From: fmt.Sprintf("0x%x", bcs.From),
+		To:   fmt.Sprintf("0x%x", bcs.To),
+	}, nil
+}
+
+type bcsOwnershipTransferAccepted struct {
+	From [32]byte
+	To   [32]byte
+}
+
+func convertOwnershipTransferAcceptedFromBCS(bcs bcsOwnershipTransferAccepted) (OwnershipTransferAccepted, error) {
+
+	return OwnershipTransferAccepted{
+		From: fmt.Sprintf("0x%x", bcs.From),
+		To:   fmt.Sprintf("0x%x", bcs.To),
+	}, nil
+}
+
+type bcsOwnershipTransferred struct {
+	From [32]byte
+	To   [32]byte
+}
+
+func convertOwnershipTransferredFromBCS(bcs bcsOwnershipTransferred) (OwnershipTransferred, error) {
+
+	return OwnershipTransferred{
+		From: fmt.Sprintf("0x%x", bcs.From),
+		To:   fmt.Sprintf("0x%x", bcs.To),
+	}, nil
+}
+
+func init() {
+	bind.RegisterStructDecoder("ccip_onramp::ownable::OwnerCap", func(data []byte) (interface{}, error) {
+		var result OwnerCap
+		_, err := mystenbcs.Unmarshal(data, &result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip_onramp::ownable::OwnableState", func(data []byte) (interface{}, error) {
+		var temp bcsOwnableState
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertOwnableStateFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip_onramp::ownable::PendingTransfer", func(data []byte) (interface{}, error) {
+		var temp bcsPendingTransfer
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertPendingTransferFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip_onramp::ownable::NewOwnableStateEvent", func(data []byte) (interface{}, error) {
+		var temp bcsNewOwnableStateEvent
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertNewOwnableStateEventFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip_onramp::ownable::OwnershipTransferRequested", func(data []byte) (interface{}, error) {
+		var temp bcsOwnershipTransferRequested
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertOwnershipTransferRequestedFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip_onramp::ownable::OwnershipTransferAccepted", func(data []byte) (interface{}, error) {
+		var temp bcsOwnershipTransferAccepted
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertOwnershipTransferAcceptedFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("ccip_onramp::ownable::OwnershipTransferred", func(data []byte) (interface{}, error) {
+		var temp bcsOwnershipTransferred
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertOwnershipTransferredFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
+// DecodeNewOwnableStateEvent decodes the BCS of a NewOwnableStateEvent event.
+func DecodeNewOwnableStateEvent(data []byte) (NewOwnableStateEvent, error) {
+	var temp bcsNewOwnableStateEvent
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return NewOwnableStateEvent{}, err
+	}
+
+	return convertNewOwnableStateEventFromBCS(temp)
+}
+
+// FilterNewOwnableStateEvent returns a page of the NewOwnableStateEvent events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OwnableContract) FilterNewOwnableStateEvent(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[NewOwnableStateEvent], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "NewOwnableStateEvent", cursor, limit, DecodeNewOwnableStateEvent)
+}
+
+// WatchNewOwnableStateEvent polls the NewOwnableStateEvent events and sends them to ch until the subscription is stopped.
+func (c *OwnableContract) WatchNewOwnableStateEvent(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[NewOwnableStateEvent]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "NewOwnableStateEvent", opts, ch, DecodeNewOwnableStateEvent)
+}
+
+// DecodeOwnershipTransferRequested decodes the BCS of a OwnershipTransferRequested event.
+func DecodeOwnershipTransferRequested(data []byte) (OwnershipTransferRequested, error) {
+	var temp bcsOwnershipTransferRequested
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return OwnershipTransferRequested{}, err
+	}
+
+	return convertOwnershipTransferRequestedFromBCS(temp)
+}
+
+// FilterOwnershipTransferRequested returns a page of the OwnershipTransferRequested events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OwnableContract) FilterOwnershipTransferRequested(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OwnershipTransferRequested], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "OwnershipTransferRequested", cursor, limit, DecodeOwnershipTransferRequested)
+}
+
+// WatchOwnershipTransferRequested polls the OwnershipTransferRequested events and sends them to ch until the subscription is stopped.
+func (c *OwnableContract) WatchOwnershipTransferRequested(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OwnershipTransferRequested]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "OwnershipTransferRequested", opts, ch, DecodeOwnershipTransferRequested)
+}
+
+// DecodeOwnershipTransferAccepted decodes the BCS of a OwnershipTransferAccepted event.
+func DecodeOwnershipTransferAccepted(data []byte) (OwnershipTransferAccepted, error) {
+	var temp bcsOwnershipTransferAccepted
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return OwnershipTransferAccepted{}, err
+	}
+
+	return convertOwnershipTransferAcceptedFromBCS(temp)
+}
+
+// FilterOwnershipTransferAccepted returns a page of the OwnershipTransferAccepted events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OwnableContract) FilterOwnershipTransferAccepted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OwnershipTransferAccepted], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "OwnershipTransferAccepted", cursor, limit, DecodeOwnershipTransferAccepted)
+}
+
+// WatchOwnershipTransferAccepted polls the OwnershipTransferAccepted events and sends them to ch until the subscription is stopped.
+func (c *OwnableContract) WatchOwnershipTransferAccepted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OwnershipTransferAccepted]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "OwnershipTransferAccepted", opts, ch, DecodeOwnershipTransferAccepted)
+}
+
+// DecodeOwnershipTransferred decodes the BCS of a OwnershipTransferred event.
+func DecodeOwnershipTransferred(data []byte) (OwnershipTransferred, error) {
+	var temp bcsOwnershipTransferred
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return OwnershipTransferred{}, err
+	}
+
+	return convertOwnershipTransferredFromBCS(temp)
+}
+
+// FilterOwnershipTransferred returns a page of the OwnershipTransferred events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *OwnableContract) FilterOwnershipTransferred(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OwnershipTransferred], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "OwnershipTransferred", cursor, limit, DecodeOwnershipTransferred)
+}
+
+// WatchOwnershipTransferred polls the OwnershipTransferred events and sends them to ch until the subscription is stopped.
+func (c *OwnableContract) WatchOwnershipTransferred(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OwnershipTransferred]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "OwnershipTransferred", opts, ch, DecodeOwnershipTransferred)
+}
+
+// New executes the new Move function.
+func (c *OwnableContract) New(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// OwnerCapId executes the owner_cap_id Move function.
+func (c *OwnableContract) OwnerCapId(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.OwnerCapId(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// Owner executes the owner Move function.
+func (c *OwnableContract) Owner(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.Owner(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// HasPendingTransfer executes the has_pending_transfer Move function.
+func (c *OwnableContract) HasPendingTransfer(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.HasPendingTransfer(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// PendingTransferFrom executes the pending_transfer_from Move function.
+func (c *OwnableContract) PendingTransferFrom(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.PendingTransferFrom(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// PendingTransferTo executes the pending_transfer_to Move function.
+func (c *OwnableContract) PendingTransferTo(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.PendingTransferTo(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// PendingTransferAccepted executes the pending_transfer_accepted Move function.
+func (c *OwnableContract) PendingTransferAccepted(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.PendingTransferAccepted(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// TransferOwnership executes the transfer_ownership Move function.
+func (c *OwnableContract) TransferOwnership(ctx context.Context, opts *bind.CallOpts, ownerCap bind.Object, state bind.Object, to string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.TransferOwnership(ownerCap, state, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// AcceptOwnership executes the accept_ownership Move function.
+func (c *OwnableContract) AcceptOwnership(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.AcceptOwnership(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// AcceptOwnershipFromObject executes the accept_ownership_from_object Move function.
+func (c *OwnableContract) AcceptOwnershipFromObject(ctx context.Context, opts *bind.CallOpts, state bind.Object, from string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.AcceptOwnershipFromObject(state, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// AcceptOwnershipAsMcms executes the accept_ownership_as_mcms Move function.
+func (c *OwnableContract) AcceptOwnershipAsMcms(ctx context.Context, opts *bind.CallOpts, state bind.Object, mcms string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.AcceptOwnershipAsMcms(state, mcms)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// ExecuteOwnershipTransfer executes the execute_ownership_transfer Move function.
+func (c *OwnableContract) ExecuteOwnershipTransfer(ctx context.Context, opts *bind.CallOpts, ownerCap bind.Object, state bind.Object, to string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.ExecuteOwnershipTransfer(ownerCap, state, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// ExecuteOwnershipTransferToMcms executes the execute_ownership_transfer_to_mcms Move function.
+func (c *OwnableContract) ExecuteOwnershipTransferToMcms(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ownerCap bind.Object, state bind.Object, registry bind.Object, to string, proof bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.ExecuteOwnershipTransferToMcms(typeArgs, ownerCap, state, registry, to, proof)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DestroyOwnableState executes the destroy_ownable_state Move function.
+func (c *OwnableContract) DestroyOwnableState(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.DestroyOwnableState(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DestroyOwnerCap executes the destroy_owner_cap Move function.
+func (c *OwnableContract) DestroyOwnerCap(ctx context.Context, opts *bind.CallOpts, ownerCap bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.ownableEncoder.DestroyOwnerCap(ownerCap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// New executes the new Move function using DevInspect to get return values.
+//
+// Returns:
+//
+//	[0]: OwnableState
+//	[1]: OwnerCap
+func (d *OwnableDevInspect) New(ctx context.Context, opts *bind.CallOpts) ([]any, error) {
+	encoded, err := d.contract.ownableEncoder.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	return d.contract.Call(ctx, opts, encoded)
+}
+
+// OwnerCapId executes the owner_cap_id Move function using DevInspect to get return values.
+//
+// Returns: ID
+func (d *OwnableDevInspect) OwnerCapId(ctx context.Context, opts *bind.CallOpts, state bind.Object) (bind.Object, error) {
+	encoded, err := d.contract.ownableEncoder.OwnerCapId(state)
+	if err != nil {
+		return bind.Object{}, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return bind.Object{}, err
+	}
+	if len(results) == 0 {
+		return bind.Object{}, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(bind.Object)
+	if !ok {
+		return bind.Object{}, fmt.Errorf("unexpected return type: expected bind.Object, got %T", results[0])
+	}
+	return result, nil
+}
+
+// Owner executes the owner Move function using DevInspect to get return values.
+//
+// Returns: address
+func (d *OwnableDevInspect) Owner(ctx context.Context, opts *bind.CallOpts, state bind.Object) (string, error) {
+	encoded, err := d.contract.ownableEncoder.Owner(state)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return "", err
+	}
+	if len(results) == 0 {
+		return "", fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected return type: expected string, got %T", results[0])
+	}
+	return result, nil
+}
+
+// HasPendingTransfer executes the has_pending_transfer Move function using DevInspect to get return values.
+//
+// Returns: bool
+func (d *OwnableDevInspect) HasPendingTransfer(ctx context.Context, opts *bind.CallOpts, state bind.Object) (bool, error) {
+	encoded, err := d.contract.ownableEncoder.HasPendingTransfer(state)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return false, err
+	}
+	if len(results) == 0 {
+		return false, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected return type: expected bool, got %T", results[0])
+	}
+	return result, nil
+}
+
+// PendingTransferFrom executes the pending_transfer_from Move function using DevInspect to get return values.
+//
+// Returns: 0x1::option::Option<address>
+func (d *OwnableDevInspect) PendingTransferFrom(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*string, error) {
+	encoded, err := d.contract.ownableEncoder.PendingTransferFrom(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(*string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected *string, got %T", results[0])
+	}
+	return result, nil
+}
+
+// PendingTransferTo executes the pending_transfer_to Move function using DevInspect to get return values.
+//
+// Returns: 0x1::option::Option<address>
+func (d *OwnableDevInspect) PendingTransferTo(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*string, error) {
+	encoded, err := d.contract.ownableEncoder.PendingTransferTo(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(*string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected *string, got %T", results[0])
+	}
+	return result, nil
+}
+
+// PendingTransferAccepted executes the pending_transfer_accepted Move function using DevInspect to get return values.
+//
+// Returns: 0x1::option::Option<bool>
+func (d *OwnableDevInspect) PendingTransferAccepted(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*bool, error) {
+	encoded, err := d.contract.ownableEncoder.PendingTransferAccepted(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(*bool)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected *bool, got %T", results[0])
+	}
+	return result, nil
+}
+
+type ownableEncoder struct {
+	*bind.BoundContract
+}
+
+// New encodes a call to the new Move function.
+func (c ownableEncoder) New() (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("new", typeArgsList, typeParamsList, []string{}, []any{}, []string{
+		"ccip_onramp::ownable::OwnableState",
+		"ccip_onramp::ownable::OwnerCap",
+	})
+}
+
+// NewWithArgs encodes a call to the new Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) NewWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("new", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"ccip_onramp::ownable::OwnableState",
+		"ccip_onramp::ownable::OwnerCap",
+	})
+}
+
+// OwnerCapId encodes a call to the owner_cap_id Move function.
+func (c ownableEncoder) OwnerCapId(state bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("owner_cap_id", typeArgsList, typeParamsList, []string{
+		"&OwnableState",
+	}, []any{
+		state,
+	}, []string{
+		"ID",
+	})
+}
+
+// OwnerCapIdWithArgs encodes a call to the owner_cap_id Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) OwnerCapIdWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&OwnableState",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("owner_cap_id", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"ID",
+	})
+}
+
+// Owner encodes a call to the owner Move function.
+func (c ownableEncoder) Owner(state bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("owner", typeArgsList, typeParamsList, []string{
+		"&OwnableState",
+	}, []any{
+		state,
+	}, []string{
+		"address",
+	})
+}
+
+// OwnerWithArgs encodes a call to the owner Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) OwnerWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&OwnableState",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("owner", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"address",
+	})
+}
+
+// HasPendingTransfer encodes a call to the has_pending_transfer Move function.
+func (c ownableEncoder) HasPendingTransfer(state bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("has_pending_transfer", typeArgsList, typeParamsList, []string{
+		"&OwnableState",
+	}, []any{
+		state,
+	}, []string{
+		"bool",
+	})
+}
+
+// HasPendingTransferWithArgs encodes a call to the has_pending_transfer Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) HasPendingTransferWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&OwnableState",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("has_pending_transfer", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"bool",
+	})
+}
+
+// PendingTransferFrom encodes a call to the pending_transfer_from Move function.
+func (c ownableEncoder) PendingTransferFrom(state bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("pending_transfer_from", typeArgsList, typeParamsList, []string{
+		"&OwnableState",
+	}, []any{
+		state,
+	}, []string{
+		"0x1::option::Option<address>",
+	})
+}
+
+// PendingTransferFromWithArgs encodes a call to the pending_transfer_from Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) PendingTransferFromWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&OwnableState",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("pending_transfer_from", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"0x1::option::Option<address>",
+	})
+}
+
+// PendingTransferTo encodes a call to the pending_transfer_to Move function.
+func (c ownableEncoder) PendingTransferTo(state bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("pending_transfer_to", typeArgsList, typeParamsList, []string{
+		"&OwnableState",
+	}, []any{
+		state,
+	}, []string{
+		"0x1::option::Option<address>",
+	})
+}
+
+// PendingTransferToWithArgs encodes a call to the pending_transfer_to Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) PendingTransferToWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&OwnableState",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("pending_transfer_to", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"0x1::option::Option<address>",
+	})
+}
+
+// PendingTransferAccepted encodes a call to the pending_transfer_accepted Move function.
+func (c ownableEncoder) PendingTransferAccepted(state bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("pending_transfer_accepted", typeArgsList, typeParamsList, []string{
+		"&OwnableState",
+	}, []any{
+		state,
+	}, []string{
+		"0x1::option::Option<bool>",
+	})
+}
+
+// PendingTransferAcceptedWithArgs encodes a call to the pending_transfer_accepted Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) PendingTransferAcceptedWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&OwnableState",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("pending_transfer_accepted", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"0x1::option::Option<bool>",
+	})
+}
+
+// TransferOwnership encodes a call to the transfer_ownership Move function.
+func (c ownableEncoder) TransferOwnership(ownerCap bind.Object, state bind.Object, to string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("transfer_ownership", typeArgsList, typeParamsList, []string{
+		"&OwnerCap",
+		"&mut OwnableState",
+		"address",
+	}, []any{
+		ownerCap,
+		state,
+		to,
+	}, nil)
+}
+
+// TransferOwnershipWithArgs encodes a call to the transfer_ownership Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) TransferOwnershipWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&OwnerCap",
+		"&mut OwnableState",
+		"address",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("transfer_ownership", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// AcceptOwnership encodes a call to the accept_ownership Move function.
+func (c ownableEncoder) AcceptOwnership(state bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("accept_ownership", typeArgsList, typeParamsList, []string{
+		"&mut OwnableState",
+	}, []any{
+		state,
+	}, nil)
+}
+
+// AcceptOwnershipWithArgs encodes a call to the accept_ownership Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) AcceptOwnershipWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut OwnableState",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("accept_ownership", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// AcceptOwnershipFromObject encodes a call to the accept_ownership_from_object Move function.
+func (c ownableEncoder) AcceptOwnershipFromObject(state bind.Object, from string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("accept_ownership_from_object", typeArgsList, typeParamsList, []string{
+		"&mut OwnableState",
+		"&mut UID",
+	}, []any{
+		state,
+		from,
+	}, nil)
+}
+
+// AcceptOwnershipFromObjectWithArgs encodes a call to the accept_ownership_from_object Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) AcceptOwnershipFromObjectWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut OwnableState",
+		"&mut UID",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("accept_ownership_from_object", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// AcceptOwnershipAsMcms encodes a call to the accept_ownership_as_mcms Move function.
+func (c ownableEncoder) AcceptOwnershipAsMcms(state bind.Object, mcms string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("accept_ownership_as_mcms", typeArgsList, typeParamsList, []string{
+		"&mut OwnableState",
+		"address",
+	}, []any{
+		state,
+		mcms,
+	}, nil)
+}
+
+// AcceptOwnershipAsMcmsWithArgs encodes a call to the accept_ownership_as_mcms Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) AcceptOwnershipAsMcmsWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut OwnableState",
+		"address",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("accept_ownership_as_mcms", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// ExecuteOwnershipTransfer encodes a call to the execute_ownership_transfer Move function.
+func (c ownableEncoder) ExecuteOwnershipTransfer(ownerCap bind.Object, state bind.Object, to string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("execute_ownership_transfer", typeArgsList, typeParamsList, []string{
+		"ccip_onramp::ownable::OwnerCap",
+		"&mut OwnableState",
+		"address",
+	}, []any{
+		ownerCap,
+		state,
+		to,
+	}, nil)
+}
+
+// ExecuteOwnershipTransferWithArgs encodes a call to the execute_ownership_transfer Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) ExecuteOwnershipTransferWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"ccip_onramp::ownable::OwnerCap",
+		"&mut OwnableState",
+		"address",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("execute_ownership_transfer", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// ExecuteOwnershipTransferToMcms encodes a call to the execute_ownership_transfer_to_mcms Move function.
+func (c ownableEncoder) ExecuteOwnershipTransferToMcms(typeArgs []string, ownerCap bind.Object, state bind.Object, registry bind.Object, to string, proof bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"T",
+	}
+	return c.EncodeCallArgsWithGenerics("execute_ownership_transfer_to_mcms", typeArgsList, typeParamsList, []string{
+		"ccip_onramp::ownable::OwnerCap",
+		"&mut OwnableState",
+		"&mut Registry",
+		"address",
+		"T",
+	}, []any{
+		ownerCap,
+		state,
+		registry,
+		to,
+		proof,
+	}, nil)
+}
+
+// ExecuteOwnershipTransferToMcmsWithArgs encodes a call to the execute_ownership_transfer_to_mcms Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) ExecuteOwnershipTransferToMcmsWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"ccip_onramp::ownable::OwnerCap",
+		"&mut OwnableState",
+		"&mut Registry",
+		"address",
+		"T",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"T",
+	}
+	return c.EncodeCallArgsWithGenerics("execute_ownership_transfer_to_mcms", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// DestroyOwnableState encodes a call to the destroy_ownable_state Move function.
+func (c ownableEncoder) DestroyOwnableState(state bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("destroy_ownable_state", typeArgsList, typeParamsList, []string{
+		"ccip_onramp::ownable::OwnableState",
+	}, []any{
+		state,
+	}, nil)
+}
+
+// DestroyOwnableStateWithArgs encodes a call to the destroy_ownable_state Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) DestroyOwnableStateWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"ccip_onramp::ownable::OwnableState",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("destroy_ownable_state", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// DestroyOwnerCap encodes a call to the destroy_owner_cap Move function.
+func (c ownableEncoder) DestroyOwnerCap(ownerCap bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("destroy_owner_cap", typeArgsList, typeParamsList, []string{
+		"ccip_onramp::ownable::OwnerCap",
+	}, []any{
+		ownerCap,
+	}, nil)
+}
+
+// DestroyOwnerCapWithArgs encodes a call to the destroy_owner_cap Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c ownableEncoder) DestroyOwnerCapWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"ccip_onramp::ownable::OwnerCap",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("destroy_owner_cap", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
@@ -17,6 +17,7 @@ import (
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type IRouter interface {
@@ -40,6 +41,8 @@ type IRouter interface {
 	ExecuteOwnershipTransferToMcms(ctx context.Context, opts *bind.CallOpts, ownerCap bind.Object, state bind.Object, registry bind.Object, to string) (*models.SuiTransactionBlockResponse, error)
 	McmsRegisterUpgradeCap(ctx context.Context, opts *bind.CallOpts, upgradeCap bind.Object, registry bind.Object, state bind.Object) (*models.SuiTransactionBlockResponse, error)
 	McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, state bind.Object, registry bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error)
+	FilterOnRampSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OnRampSet], error)
+	WatchOnRampSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OnRampSet]) (*bind.EventSubscription, error)
 	DevInspect() IRouterDevInspect
 	Encoder() RouterEncoder
 	Bound() bind.IBoundContract
@@ -246,6 +249,27 @@ func init() {
 	})
 }
 
+// DecodeOnRampSet decodes the BCS of a OnRampSet event.
+func DecodeOnRampSet(data []byte) (OnRampSet, error) {
+	var temp bcsOnRampSet
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return OnRampSet{}, err
+	}
+
+	return convertOnRampSetFromBCS(temp)
+}
+
+// FilterOnRampSet returns a page of the OnRampSet events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *RouterContract) FilterOnRampSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OnRampSet], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "OnRampSet", cursor, limit, DecodeOnRampSet)
+}
+
+// WatchOnRampSet polls the OnRampSet events and sends them to ch until the subscription is stopped.
+func (c *RouterContract) WatchOnRampSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OnRampSet]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "OnRampSet", opts, ch, DecodeOnRampSet)
+}
+
 // TypeAndVersion executes the type_and_version Move function.
 func (c *RouterContract) TypeAndVersion(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.routerEncoder.TypeAndVersion()
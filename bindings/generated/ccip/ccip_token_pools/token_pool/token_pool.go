@@ -17,6 +17,7 @@ import (
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type ITokenPool interface {
@@ -49,6 +50,24 @@ type ITokenPool interface {
 	GetAllowlist(ctx context.Context, opts *bind.CallOpts, state TokenPoolState) (*models.SuiTransactionBlockResponse, error)
 	ApplyAllowlistUpdates(ctx context.Context, opts *bind.CallOpts, state TokenPoolState, removes []string, adds []string) (*models.SuiTransactionBlockResponse, error)
 	DestroyTokenPool(ctx context.Context, opts *bind.CallOpts, state TokenPoolState) (*models.SuiTransactionBlockResponse, error)
+	FilterLockedOrBurned(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[LockedOrBurned], error)
+	WatchLockedOrBurned(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[LockedOrBurned]) (*bind.EventSubscription, error)
+	FilterReleasedOrMinted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ReleasedOrMinted], error)
+	WatchReleasedOrMinted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ReleasedOrMinted]) (*bind.EventSubscription, error)
+	FilterRemotePoolAdded(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[RemotePoolAdded], error)
+	WatchRemotePoolAdded(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[RemotePoolAdded]) (*bind.EventSubscription, error)
+	FilterRemotePoolRemoved(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[RemotePoolRemoved], error)
+	WatchRemotePoolRemoved(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[RemotePoolRemoved]) (*bind.EventSubscription, error)
+	FilterChainAdded(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ChainAdded], error)
+	WatchChainAdded(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ChainAdded]) (*bind.EventSubscription, error)
+	FilterChainRemoved(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ChainRemoved], error)
+	WatchChainRemoved(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ChainRemoved]) (*bind.EventSubscription, error)
+	FilterLiquidityAdded(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[LiquidityAdded], error)
+	WatchLiquidityAdded(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[LiquidityAdded]) (*bind.EventSubscription, error)
+	FilterLiquidityRemoved(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[LiquidityRemoved], error)
+	WatchLiquidityRemoved(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[LiquidityRemoved]) (*bind.EventSubscription, error)
+	FilterRebalancerSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[RebalancerSet], error)
+	WatchRebalancerSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[RebalancerSet]) (*bind.EventSubscription, error)
 	DevInspect() ITokenPoolDevInspect
 	Encoder() TokenPoolEncoder
 	Bound() bind.IBoundContract
@@ -453,6 +472,195 @@ func init() {
 	})
 }
 
+// DecodeLockedOrBurned decodes the BCS of a LockedOrBurned event.
+func DecodeLockedOrBurned(data []byte) (LockedOrBurned, error) {
+	var temp bcsLockedOrBurned
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return LockedOrBurned{}, err
+	}
+
+	return convertLockedOrBurnedFromBCS(temp)
+}
+
+// FilterLockedOrBurned returns a page of the LockedOrBurned events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *TokenPoolContract) FilterLockedOrBurned(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[LockedOrBurned], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "LockedOrBurned", cursor, limit, DecodeLockedOrBurned)
+}
+
+// WatchLockedOrBurned polls the LockedOrBurned events and sends them to ch until the subscription is stopped.
+func (c *TokenPoolContract) WatchLockedOrBurned(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[LockedOrBurned]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "LockedOrBurned", opts, ch, DecodeLockedOrBurned)
+}
+
+// DecodeReleasedOrMinted decodes the BCS of a ReleasedOrMinted event.
+func DecodeReleasedOrMinted(data []byte) (ReleasedOrMinted, error) {
+	var temp bcsReleasedOrMinted
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return ReleasedOrMinted{}, err
+	}
+
+	return convertReleasedOrMintedFromBCS(temp)
+}
+
+// FilterReleasedOrMinted returns a page of the ReleasedOrMinted events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *TokenPoolContract) FilterReleasedOrMinted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ReleasedOrMinted], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "ReleasedOrMinted", cursor, limit, DecodeReleasedOrMinted)
+}
+
+// WatchReleasedOrMinted polls the ReleasedOrMinted events and sends them to ch until the subscription is stopped.
+func (c *TokenPoolContract) WatchReleasedOrMinted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ReleasedOrMinted]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "ReleasedOrMinted", opts, ch, DecodeReleasedOrMinted)
+}
+
+// DecodeRemotePoolAdded decodes the BCS of a RemotePoolAdded event.
+func DecodeRemotePoolAdded(data []byte) (RemotePoolAdded, error) {
+	var result RemotePoolAdded
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return RemotePoolAdded{}, err
+	}
+
+	return result, nil
+}
+
+// FilterRemotePoolAdded returns a page of the RemotePoolAdded events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *TokenPoolContract) FilterRemotePoolAdded(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[RemotePoolAdded], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "RemotePoolAdded", cursor, limit, DecodeRemotePoolAdded)
+}
+
+// WatchRemotePoolAdded polls the RemotePoolAdded events and sends them to ch until the subscription is stopped.
+func (c *TokenPoolContract) WatchRemotePoolAdded(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[RemotePoolAdded]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "RemotePoolAdded", opts, ch, DecodeRemotePoolAdded)
+}
+
+// DecodeRemotePoolRemoved decodes the BCS of a RemotePoolRemoved event.
+func DecodeRemotePoolRemoved(data []byte) (RemotePoolRemoved, error) {
+	var result RemotePoolRemoved
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return RemotePoolRemoved{}, err
+	}
+
+	return result, nil
+}
+
+// FilterRemotePoolRemoved returns a page of the RemotePoolRemoved events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *TokenPoolContract) FilterRemotePoolRemoved(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[RemotePoolRemoved], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "RemotePoolRemoved", cursor, limit, DecodeRemotePoolRemoved)
+}
+
+// WatchRemotePoolRemoved polls the RemotePoolRemoved events and sends them to ch until the subscription is stopped.
+func (c *TokenPoolContract) WatchRemotePoolRemoved(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[RemotePoolRemoved]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "RemotePoolRemoved", opts, ch, DecodeRemotePoolRemoved)
+}
+
+// DecodeChainAdded decodes the BCS of a ChainAdded event.
+func DecodeChainAdded(data []byte) (ChainAdded, error) {
+	var result ChainAdded
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return ChainAdded{}, err
+	}
+
+	return result, nil
+}
+
+// FilterChainAdded returns a page of the ChainAdded events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *TokenPoolContract) FilterChainAdded(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ChainAdded], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "ChainAdded", cursor, limit, DecodeChainAdded)
+}
+
+// WatchChainAdded polls the ChainAdded events and sends them to ch until the subscription is stopped.
+func (c *TokenPoolContract) WatchChainAdded(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ChainAdded]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "ChainAdded", opts, ch, DecodeChainAdded)
+}
+
+// DecodeChainRemoved decodes the BCS of a ChainRemoved event.
+func DecodeChainRemoved(data []byte) (ChainRemoved, error) {
+	var result ChainRemoved
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return ChainRemoved{}, err
+	}
+
+	return result, nil
+}
+
+// FilterChainRemoved returns a page of the ChainRemoved events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *TokenPoolContract) FilterChainRemoved(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ChainRemoved], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "ChainRemoved", cursor, limit, DecodeChainRemoved)
+}
+
+// WatchChainRemoved polls the ChainRemoved events and sends them to ch until the subscription is stopped.
+func (c *TokenPoolContract) WatchChainRemoved(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ChainRemoved]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "ChainRemoved", opts, ch, DecodeChainRemoved)
+}
+
+// DecodeLiquidityAdded decodes the BCS of a LiquidityAdded event.
+func DecodeLiquidityAdded(data []byte) (LiquidityAdded, error) {
+	var temp bcsLiquidityAdded
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return LiquidityAdded{}, err
+	}
+
+	return convertLiquidityAddedFromBCS(temp)
+}
+
+// FilterLiquidityAdded returns a page of the LiquidityAdded events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *TokenPoolContract) FilterLiquidityAdded(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[LiquidityAdded], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "LiquidityAdded", cursor, limit, DecodeLiquidityAdded)
+}
+
+// WatchLiquidityAdded polls the LiquidityAdded events and sends them to ch until the subscription is stopped.
+func (c *TokenPoolContract) WatchLiquidityAdded(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[LiquidityAdded]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "LiquidityAdded", opts, ch, DecodeLiquidityAdded)
+}
+
+// DecodeLiquidityRemoved decodes the BCS of a LiquidityRemoved event.
+func DecodeLiquidityRemoved(data []byte) (LiquidityRemoved, error) {
+	var temp bcsLiquidityRemoved
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return LiquidityRemoved{}, err
+	}
+
+	return convertLiquidityRemovedFromBCS(temp)
+}
+
+// FilterLiquidityRemoved returns a page of the LiquidityRemoved events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *TokenPoolContract) FilterLiquidityRemoved(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[LiquidityRemoved], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "LiquidityRemoved", cursor, limit, DecodeLiquidityRemoved)
+}
+
+// WatchLiquidityRemoved polls the LiquidityRemoved events and sends them to ch until the subscription is stopped.
+func (c *TokenPoolContract) WatchLiquidityRemoved(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[LiquidityRemoved]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "LiquidityRemoved", opts, ch, DecodeLiquidityRemoved)
+}
+
+// DecodeRebalancerSet decodes the BCS of a RebalancerSet event.
+func DecodeRebalancerSet(data []byte) (RebalancerSet, error) {
+	var temp bcsRebalancerSet
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return RebalancerSet{}, err
+	}
+
+	return convertRebalancerSetFromBCS(temp)
+}
+
+// FilterRebalancerSet returns a page of the RebalancerSet events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *TokenPoolContract) FilterRebalancerSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[RebalancerSet], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "RebalancerSet", cursor, limit, DecodeRebalancerSet)
+}
+
+// WatchRebalancerSet polls the RebalancerSet events and sends them to ch until the subscription is stopped.
+func (c *TokenPoolContract) WatchRebalancerSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[RebalancerSet]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "RebalancerSet", opts, ch, DecodeRebalancerSet)
+}
+
 // Initialize executes the initialize Move function.
 func (c *TokenPoolContract) Initialize(ctx context.Context, opts *bind.CallOpts, coinMetadataAddress string, localDecimals byte, allowlist []string) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.tokenPoolEncoder.Initialize(coinMetadataAddress, localDecimals, allowlist)
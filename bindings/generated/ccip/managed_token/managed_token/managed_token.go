@@ -17,6 +17,7 @@ import (
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type IManagedToken interface {
@@ -52,6 +53,10 @@ type IManagedToken interface {
 	ExecuteOwnershipTransferToMcms(ctx context.Context, opts *bind.CallOpts, typeArgs []string, ownerCap bind.Object, state bind.Object, registry bind.Object, to string) (*models.SuiTransactionBlockResponse, error)
 	McmsRegisterUpgradeCap(ctx context.Context, opts *bind.CallOpts, upgradeCap bind.Object, registry bind.Object, state bind.Object) (*models.SuiTransactionBlockResponse, error)
 	McmsEntrypoint(ctx context.Context, opts *bind.CallOpts, typeArgs []string, state bind.Object, registry bind.Object, denyList bind.Object, params bind.Object) (*models.SuiTransactionBlockResponse, error)
+	FilterMinted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[Minted], error)
+	WatchMinted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[Minted]) (*bind.EventSubscription, error)
+	FilterBurnt(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[Burnt], error)
+	WatchBurnt(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[Burnt]) (*bind.EventSubscription, error)
 	DevInspect() IManagedTokenDevInspect
 	Encoder() ManagedTokenEncoder
 	Bound() bind.IBoundContract
@@ -243,9 +248,20 @@ type MinterUnlimitedAllowanceSet struct {
 type McmsCallback struct {
 }
 
+type bcsMintCapCreated struct {
+	MintCap [32]byte
+}
+
+func convertMintCapCreatedFromBCS(bcs bcsMintCapCreated) (MintCapCreated, error) {
+
+	return MintCapCreated{
+		MintCap: bind.Object{Id: fmt.Sprintf("0x%x", bcs.MintCap)},
+	}, nil
+}
+
 type bcsMinterConfigured struct {
 	MintCapOwner [32]byte
-	MintCap      bind.Object
+	MintCap      [32]byte
 	Allowance    uint64
 	IsUnlimited  bool
 }
@@ -254,14 +270,14 @@ func convertMinterConfiguredFromBCS(bcs bcsMinterConfigured) (MinterConfigured,
 
 	return MinterConfigured{
 		MintCapOwner: fmt.Sprintf("0x%x", bcs.MintCapOwner),
-		MintCap:      bcs.MintCap,
+		MintCap:      bind.Object{Id: fmt.Sprintf("0x%x", bcs.MintCap)},
 		Allowance:    bcs.Allowance,
 		IsUnlimited:  bcs.IsUnlimited,
 	}, nil
 }
 
 type bcsMinted struct {
-	MintCap bind.Object
+	MintCap [32]byte
 	Minter  [32]byte
 	To      [32]byte
 	Amount  uint64
@@ -270,7 +286,7 @@ type bcsMinted struct {
 func convertMintedFromBCS(bcs bcsMinted) (Minted, error) {
 
 	return Minted{
-		MintCap: bcs.MintCap,
+		MintCap: bind.Object{Id: fmt.Sprintf("0x%x", bcs.MintCap)},
 		Minter:  fmt.Sprintf("0x%x", bcs.Minter),
 		To:      fmt.Sprintf("0x%x", bcs.To),
 		Amount:  bcs.Amount,
@@ -278,7 +294,7 @@ func convertMintedFromBCS(bcs bcsMinted) (Minted, error) {
 }
 
 type bcsBurnt struct {
-	MintCap bind.Object
+	MintCap [32]byte
 	Burner  [32]byte
 	From    [32]byte
 	Amount  uint64
@@ -287,7 +303,7 @@ type bcsBurnt struct {
 func convertBurntFromBCS(bcs bcsBurnt) (Burnt, error) {
 
 	return Burnt{
-		MintCap: bcs.MintCap,
+		MintCap: bind.Object{Id: fmt.Sprintf("0x%x", bcs.MintCap)},
 		Burner:  fmt.Sprintf("0x%x", bcs.Burner),
 		From:    fmt.Sprintf("0x%x", bcs.From),
 		Amount:  bcs.Amount,
@@ -316,6 +332,32 @@ func convertUnblocklistedFromBCS(bcs bcsUnblocklisted) (Unblocklisted, error) {
 	}, nil
 }
 
+type bcsMinterAllowanceIncremented struct {
+	MintCap            [32]byte
+	AllowanceIncrement uint64
+	NewAllowance       uint64
+}
+
+func convertMinterAllowanceIncrementedFromBCS(bcs bcsMinterAllowanceIncremented) (MinterAllowanceIncremented, error) {
+
+	return MinterAllowanceIncremented{
+		MintCap:            bind.Object{Id: fmt.Sprintf("0x%x", bcs.MintCap)},
+		AllowanceIncrement: bcs.AllowanceIncrement,
+		NewAllowance:       bcs.NewAllowance,
+	}, nil
+}
+
+type bcsMinterUnlimitedAllowanceSet struct {
+	MintCap [32]byte
+}
+
+func convertMinterUnlimitedAllowanceSetFromBCS(bcs bcsMinterUnlimitedAllowanceSet) (MinterUnlimitedAllowanceSet, error) {
+
+	return MinterUnlimitedAllowanceSet{
+		MintCap: bind.Object{Id: fmt.Sprintf("0x%x", bcs.MintCap)},
+	}, nil
+}
+
 func init() {
 	bind.RegisterStructDecoder("managed_token::managed_token::TokenState", func(data []byte) (interface{}, error) {
 		var result TokenState
@@ -334,8 +376,13 @@ func init() {
 		return result, nil
 	})
 	bind.RegisterStructDecoder("managed_token::managed_token::MintCapCreated", func(data []byte) (interface{}, error) {
-		var result MintCapCreated
-		_, err := mystenbcs.Unmarshal(data, &result)
+		var temp bcsMintCapCreated
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertMintCapCreatedFromBCS(temp)
 		if err != nil {
 			return nil, err
 		}
@@ -423,16 +470,26 @@ func init() {
 		return result, nil
 	})
 	bind.RegisterStructDecoder("managed_token::managed_token::MinterAllowanceIncremented", func(data []byte) (interface{}, error) {
-		var result MinterAllowanceIncremented
-		_, err := mystenbcs.Unmarshal(data, &result)
+		var temp bcsMinterAllowanceIncremented
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertMinterAllowanceIncrementedFromBCS(temp)
 		if err != nil {
 			return nil, err
 		}
 		return result, nil
 	})
 	bind.RegisterStructDecoder("managed_token::managed_token::MinterUnlimitedAllowanceSet", func(data []byte) (interface{}, error) {
-		var result MinterUnlimitedAllowanceSet
-		_, err := mystenbcs.Unmarshal(data, &result)
+		var temp bcsMinterUnlimitedAllowanceSet
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertMinterUnlimitedAllowanceSetFromBCS(temp)
 		if err != nil {
 			return nil, err
 		}
@@ -448,6 +505,48 @@ func init() {
 	})
 }
 
+// DecodeMinted decodes the BCS of a Minted event.
+func DecodeMinted(data []byte) (Minted, error) {
+	var temp bcsMinted
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return Minted{}, err
+	}
+
+	return convertMintedFromBCS(temp)
+}
+
+// FilterMinted returns a page of the Minted events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *ManagedTokenContract) FilterMinted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[Minted], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "Minted", cursor, limit, DecodeMinted)
+}
+
+// WatchMinted polls the Minted events and sends them to ch until the subscription is stopped.
+func (c *ManagedTokenContract) WatchMinted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[Minted]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "Minted", opts, ch, DecodeMinted)
+}
+
+// DecodeBurnt decodes the BCS of a Burnt event.
+func DecodeBurnt(data []byte) (Burnt, error) {
+	var temp bcsBurnt
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return Burnt{}, err
+	}
+
+	return convertBurntFromBCS(temp)
+}
+
+// FilterBurnt returns a page of the Burnt events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *ManagedTokenContract) FilterBurnt(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[Burnt], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "Burnt", cursor, limit, DecodeBurnt)
+}
+
+// WatchBurnt polls the Burnt events and sends them to ch until the subscription is stopped.
+func (c *ManagedTokenContract) WatchBurnt(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[Burnt]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "Burnt", opts, ch, DecodeBurnt)
+}
+
 // TypeAndVersion executes the type_and_version Move function.
 func (c *ManagedTokenContract) TypeAndVersion(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.managedTokenEncoder.TypeAndVersion()
@@ -0,0 +1,1180 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package module_bcs_stream
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+)
+
+var (
+	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
+)
+
+type IBcsStream interface {
+	AssertIsConsumed(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error)
+	DeserializeUleb128(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error)
+	DeserializeBool(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error)
+	DeserializeAddress(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error)
+	DeserializeU8(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error)
+	DeserializeU16(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error)
+	DeserializeU32(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error)
+	DeserializeU64(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error)
+	DeserializeU128(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error)
+	DeserializeU256(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error)
+	DeserializeU256Entry(ctx context.Context, opts *bind.CallOpts, data []byte, cursor uint64) (*models.SuiTransactionBlockResponse, error)
+	New(ctx context.Context, opts *bind.CallOpts, data []byte) (*models.SuiTransactionBlockResponse, error)
+	DeserializeFixedVectorU8(ctx context.Context, opts *bind.CallOpts, stream BCSStream, len_ uint64) (*models.SuiTransactionBlockResponse, error)
+	DeserializeString(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error)
+	DeserializeVector(ctx context.Context, opts *bind.CallOpts, typeArgs []string, stream BCSStream, elemDeserializer bind.Object) (*models.SuiTransactionBlockResponse, error)
+	DeserializeVectorU8(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error)
+	DeserializeOption(ctx context.Context, opts *bind.CallOpts, typeArgs []string, stream BCSStream, f bind.Object) (*models.SuiTransactionBlockResponse, error)
+	DevInspect() IBcsStreamDevInspect
+	Encoder() BcsStreamEncoder
+	Bound() bind.IBoundContract
+}
+
+type IBcsStreamDevInspect interface {
+	DeserializeUleb128(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (uint64, error)
+	DeserializeBool(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (bool, error)
+	DeserializeAddress(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (string, error)
+	DeserializeU8(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (byte, error)
+	DeserializeU16(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (uint16, error)
+	DeserializeU32(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (uint32, error)
+	DeserializeU64(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (uint64, error)
+	DeserializeU128(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*big.Int, error)
+	DeserializeU256(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*big.Int, error)
+	New(ctx context.Context, opts *bind.CallOpts, data []byte) (BCSStream, error)
+	DeserializeFixedVectorU8(ctx context.Context, opts *bind.CallOpts, stream BCSStream, len_ uint64) ([]byte, error)
+	DeserializeString(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (string, error)
+	DeserializeVector(ctx context.Context, opts *bind.CallOpts, typeArgs []string, stream BCSStream, elemDeserializer bind.Object) ([]bind.Object, error)
+	DeserializeVectorU8(ctx context.Context, opts *bind.CallOpts, stream BCSStream) ([]byte, error)
+	DeserializeOption(ctx context.Context, opts *bind.CallOpts, typeArgs []string, stream BCSStream, f bind.Object) (*bind.Object, error)
+}
+
+type BcsStreamEncoder interface {
+	AssertIsConsumed(stream BCSStream) (*bind.EncodedCall, error)
+	AssertIsConsumedWithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeUleb128(stream BCSStream) (*bind.EncodedCall, error)
+	DeserializeUleb128WithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeBool(stream BCSStream) (*bind.EncodedCall, error)
+	DeserializeBoolWithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeAddress(stream BCSStream) (*bind.EncodedCall, error)
+	DeserializeAddressWithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeU8(stream BCSStream) (*bind.EncodedCall, error)
+	DeserializeU8WithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeU16(stream BCSStream) (*bind.EncodedCall, error)
+	DeserializeU16WithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeU32(stream BCSStream) (*bind.EncodedCall, error)
+	DeserializeU32WithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeU64(stream BCSStream) (*bind.EncodedCall, error)
+	DeserializeU64WithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeU128(stream BCSStream) (*bind.EncodedCall, error)
+	DeserializeU128WithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeU256(stream BCSStream) (*bind.EncodedCall, error)
+	DeserializeU256WithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeU256Entry(data []byte, cursor uint64) (*bind.EncodedCall, error)
+	DeserializeU256EntryWithArgs(args ...any) (*bind.EncodedCall, error)
+	New(data []byte) (*bind.EncodedCall, error)
+	NewWithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeFixedVectorU8(stream BCSStream, len_ uint64) (*bind.EncodedCall, error)
+	DeserializeFixedVectorU8WithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeString(stream BCSStream) (*bind.EncodedCall, error)
+	DeserializeStringWithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeVector(typeArgs []string, stream BCSStream, elemDeserializer bind.Object) (*bind.EncodedCall, error)
+	DeserializeVectorWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error)
+	DeserializeVectorU8(stream BCSStream) (*bind.EncodedCall, error)
+	DeserializeVectorU8WithArgs(args ...any) (*bind.EncodedCall, error)
+	DeserializeOption(typeArgs []string, stream BCSStream, f bind.Object) (*bind.EncodedCall, error)
+	DeserializeOptionWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error)
+}
+
+type BcsStreamContract struct {
+	*bind.BoundContract
+	bcsStreamEncoder
+	devInspect *BcsStreamDevInspect
+}
+
+type BcsStreamDevInspect struct {
+	contract *BcsStreamContract
+}
+
+var _ IBcsStream = (*BcsStreamContract)(nil)
+var _ IBcsStreamDevInspect = (*BcsStreamDevInspect)(nil)
+
+func NewBcsStream(packageID string, client sui.ISuiAPI) (IBcsStream, error) {
+	contract, err := bind.NewBoundContract(packageID, "mcms", "bcs_stream", client)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &BcsStreamContract{
+		BoundContract:    contract,
+		bcsStreamEncoder: bcsStreamEncoder{BoundContract: contract},
+	}
+	c.devInspect = &BcsStreamDevInspect{contract: c}
+	return c, nil
+}
+
+func (c *BcsStreamContract) Bound() bind.IBoundContract {
+	return c.BoundContract
+}
+
+func (c *BcsStreamContract) Encoder() BcsStreamEncoder {
+	return c.bcsStreamEncoder
+}
+
+func (c *BcsStreamContract) DevInspect() IBcsStreamDevInspect {
+	return c.devInspect
+}
+
+type BCSStream struct {
+	Data []byte `move:"vector<u8>"`
+	Cur  uint64 `move:"u64"`
+}
+
+func init() {
+	bind.RegisterStructDecoder("mcms::bcs_stream::BCSStream", func(data []byte) (interface{}, error) {
+		var result BCSStream
+		_, err := mystenbcs.Unmarshal(data, &result)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
+// AssertIsConsumed executes the assert_is_consumed Move function.
+func (c *BcsStreamContract) AssertIsConsumed(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.AssertIsConsumed(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeUleb128 executes the deserialize_uleb128 Move function.
+func (c *BcsStreamContract) DeserializeUleb128(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeUleb128(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeBool executes the deserialize_bool Move function.
+func (c *BcsStreamContract) DeserializeBool(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeBool(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeAddress executes the deserialize_address Move function.
+func (c *BcsStreamContract) DeserializeAddress(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeAddress(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeU8 executes the deserialize_u8 Move function.
+func (c *BcsStreamContract) DeserializeU8(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeU8(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeU16 executes the deserialize_u16 Move function.
+func (c *BcsStreamContract) DeserializeU16(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeU16(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeU32 executes the deserialize_u32 Move function.
+func (c *BcsStreamContract) DeserializeU32(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeU32(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeU64 executes the deserialize_u64 Move function.
+func (c *BcsStreamContract) DeserializeU64(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeU64(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeU128 executes the deserialize_u128 Move function.
+func (c *BcsStreamContract) DeserializeU128(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeU128(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeU256 executes the deserialize_u256 Move function.
+func (c *BcsStreamContract) DeserializeU256(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeU256(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeU256Entry executes the deserialize_u256_entry Move function.
+func (c *BcsStreamContract) DeserializeU256Entry(ctx context.Context, opts *bind.CallOpts, data []byte, cursor uint64) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeU256Entry(data, cursor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// New executes the new Move function.
+func (c *BcsStreamContract) New(ctx context.Context, opts *bind.CallOpts, data []byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.New(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeFixedVectorU8 executes the deserialize_fixed_vector_u8 Move function.
+func (c *BcsStreamContract) DeserializeFixedVectorU8(ctx context.Context, opts *bind.CallOpts, stream BCSStream, len_ uint64) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeFixedVectorU8(stream, len_)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeString executes the deserialize_string Move function.
+func (c *BcsStreamContract) DeserializeString(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeString(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeVector executes the deserialize_vector Move function.
+func (c *BcsStreamContract) DeserializeVector(ctx context.Context, opts *bind.CallOpts, typeArgs []string, stream BCSStream, elemDeserializer bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeVector(typeArgs, stream, elemDeserializer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeVectorU8 executes the deserialize_vector_u8 Move function.
+func (c *BcsStreamContract) DeserializeVectorU8(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeVectorU8(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeOption executes the deserialize_option Move function.
+func (c *BcsStreamContract) DeserializeOption(ctx context.Context, opts *bind.CallOpts, typeArgs []string, stream BCSStream, f bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.bcsStreamEncoder.DeserializeOption(typeArgs, stream, f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// DeserializeUleb128 executes the deserialize_uleb128 Move function using DevInspect to get return values.
+//
+// Returns: u64
+func (d *BcsStreamDevInspect) DeserializeUleb128(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (uint64, error) {
+	encoded, err := d.contract.bcsStreamEncoder.DeserializeUleb128(stream)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(uint64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected return type: expected uint64, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DeserializeBool executes the deserialize_bool Move function using DevInspect to get return values.
+//
+// Returns: bool
+func (d *BcsStreamDevInspect) DeserializeBool(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (bool, error) {
+	encoded, err := d.contract.bcsStreamEncoder.DeserializeBool(stream)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return false, err
+	}
+	if len(results) == 0 {
+		return false, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected return type: expected bool, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DeserializeAddress executes the deserialize_address Move function using DevInspect to get return values.
+//
+// Returns: address
+func (d *BcsStreamDevInspect) DeserializeAddress(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (string, error) {
+	encoded, err := d.contract.bcsStreamEncoder.DeserializeAddress(stream)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return "", err
+	}
+	if len(results) == 0 {
+		return "", fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected return type: expected string, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DeserializeU8 executes the deserialize_u8 Move function using DevInspect to get return values.
+//
+// Returns: u8
+func (d *BcsStreamDevInspect) DeserializeU8(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (byte, error) {
+	encoded, err := d.contract.bcsStreamEncoder.DeserializeU8(stream)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(byte)
+	if !ok {
+		return 0, fmt.Errorf("unexpected return type: expected byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DeserializeU16 executes the deserialize_u16 Move function using DevInspect to get return values.
+//
+// Returns: u16
+func (d *BcsStreamDevInspect) DeserializeU16(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (uint16, error) {
+	encoded, err := d.contract.bcsStreamEncoder.DeserializeU16(stream)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(uint16)
+	if !ok {
+		return 0, fmt.Errorf("unexpected return type: expected uint16, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DeserializeU32 executes the deserialize_u32 Move function using DevInspect to get return values.
+//
+// Returns: u32
+func (d *BcsStreamDevInspect) DeserializeU32(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (uint32, error) {
+	encoded, err := d.contract.bcsStreamEncoder.DeserializeU32(stream)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(uint32)
+	if !ok {
+		return 0, fmt.Errorf("unexpected return type: expected uint32, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DeserializeU64 executes the deserialize_u64 Move function using DevInspect to get return values.
+//
+// Returns: u64
+func (d *BcsStreamDevInspect) DeserializeU64(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (uint64, error) {
+	encoded, err := d.contract.bcsStreamEncoder.DeserializeU64(stream)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(uint64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected return type: expected uint64, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DeserializeU128 executes the deserialize_u128 Move function using DevInspect to get return values.
+//
+// Returns: u128
+func (d *BcsStreamDevInspect) DeserializeU128(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*big.Int, error) {
+	encoded, err := d.contract.bcsStreamEncoder.DeserializeU128(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected *big.Int, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DeserializeU256 executes the deserialize_u256 Move function using DevInspect to get return values.
+//
+// Returns: u256
+func (d *BcsStreamDevInspect) DeserializeU256(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (*big.Int, error) {
+	encoded, err := d.contract.bcsStreamEncoder.DeserializeU256(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected *big.Int, got %T", results[0])
+	}
+	return result, nil
+}
+
+// New executes the new Move function using DevInspect to get return values.
+//
+// Returns: BCSStream
+func (d *BcsStreamDevInspect) New(ctx context.Context, opts *bind.CallOpts, data []byte) (BCSStream, error) {
+	encoded, err := d.contract.bcsStreamEncoder.New(data)
+	if err != nil {
+		return BCSStream{}, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return BCSStream{}, err
+	}
+	if len(results) == 0 {
+		return BCSStream{}, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(BCSStream)
+	if !ok {
+		return BCSStream{}, fmt.Errorf("unexpected return type: expected BCSStream, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DeserializeFixedVectorU8 executes the deserialize_fixed_vector_u8 Move function using DevInspect to get return values.
+//
+// Returns: vector<u8>
+func (d *BcsStreamDevInspect) DeserializeFixedVectorU8(ctx context.Context, opts *bind.CallOpts, stream BCSStream, len_ uint64) ([]byte, error) {
+	encoded, err := d.contract.bcsStreamEncoder.DeserializeFixedVectorU8(stream, len_)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DeserializeString executes the deserialize_string Move function using DevInspect to get return values.
+//
+// Returns: 0x1::string::String
+func (d *BcsStreamDevInspect) DeserializeString(ctx context.Context, opts *bind.CallOpts, stream BCSStream) (string, error) {
+	encoded, err := d.contract.bcsStreamEncoder.DeserializeString(stream)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return "", err
+	}
+	if len(results) == 0 {
+		return "", fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected return type: expected string, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DeserializeVector executes the deserialize_vector Move function using DevInspect to get return values.
+//
+// Returns: vector<$E>
+func (d *BcsStreamDevInspect) DeserializeVector(ctx context.Context, opts *bind.CallOpts, typeArgs []string, stream BCSStream, elemDeserializer bind.Object) ([]bind.Object, error) {
+	encoded, err := d.contract.bcsStreamEncoder.DeserializeVector(typeArgs, stream, elemDeserializer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]bind.Object)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []bind.Object, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DeserializeVectorU8 executes the deserialize_vector_u8 Move function using DevInspect to get return values.
+//
+// Returns: vector<u8>
+func (d *BcsStreamDevInspect) DeserializeVectorU8(ctx context.Context, opts *bind.CallOpts, stream BCSStream) ([]byte, error) {
+	encoded, err := d.contract.bcsStreamEncoder.DeserializeVectorU8(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// DeserializeOption executes the deserialize_option Move function using DevInspect to get return values.
+//
+// Returns: 0x1::option::Option<$E>
+func (d *BcsStreamDevInspect) DeserializeOption(ctx context.Context, opts *bind.CallOpts, typeArgs []string, stream BCSStream, f bind.Object) (*bind.Object, error) {
+	encoded, err := d.contract.bcsStreamEncoder.DeserializeOption(typeArgs, stream, f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(*bind.Object)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected *bind.Object, got %T", results[0])
+	}
+	return result, nil
+}
+
+type bcsStreamEncoder struct {
+	*bind.BoundContract
+}
+
+// AssertIsConsumed encodes a call to the assert_is_consumed Move function.
+func (c bcsStreamEncoder) AssertIsConsumed(stream BCSStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("assert_is_consumed", typeArgsList, typeParamsList, []string{
+		"&BCSStream",
+	}, []any{
+		stream,
+	}, nil)
+}
+
+// AssertIsConsumedWithArgs encodes a call to the assert_is_consumed Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) AssertIsConsumedWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&BCSStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("assert_is_consumed", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// DeserializeUleb128 encodes a call to the deserialize_uleb128 Move function.
+func (c bcsStreamEncoder) DeserializeUleb128(stream BCSStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_uleb128", typeArgsList, typeParamsList, []string{
+		"&mut BCSStream",
+	}, []any{
+		stream,
+	}, []string{
+		"u64",
+	})
+}
+
+// DeserializeUleb128WithArgs encodes a call to the deserialize_uleb128 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeUleb128WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut BCSStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_uleb128", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u64",
+	})
+}
+
+// DeserializeBool encodes a call to the deserialize_bool Move function.
+func (c bcsStreamEncoder) DeserializeBool(stream BCSStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_bool", typeArgsList, typeParamsList, []string{
+		"&mut BCSStream",
+	}, []any{
+		stream,
+	}, []string{
+		"bool",
+	})
+}
+
+// DeserializeBoolWithArgs encodes a call to the deserialize_bool Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeBoolWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut BCSStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_bool", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"bool",
+	})
+}
+
+// DeserializeAddress encodes a call to the deserialize_address Move function.
+func (c bcsStreamEncoder) DeserializeAddress(stream BCSStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_address", typeArgsList, typeParamsList, []string{
+		"&mut BCSStream",
+	}, []any{
+		stream,
+	}, []string{
+		"address",
+	})
+}
+
+// DeserializeAddressWithArgs encodes a call to the deserialize_address Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeAddressWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut BCSStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_address", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"address",
+	})
+}
+
+// DeserializeU8 encodes a call to the deserialize_u8 Move function.
+func (c bcsStreamEncoder) DeserializeU8(stream BCSStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_u8", typeArgsList, typeParamsList, []string{
+		"&mut BCSStream",
+	}, []any{
+		stream,
+	}, []string{
+		"u8",
+	})
+}
+
+// DeserializeU8WithArgs encodes a call to the deserialize_u8 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeU8WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut BCSStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_u8", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u8",
+	})
+}
+
+// DeserializeU16 encodes a call to the deserialize_u16 Move function.
+func (c bcsStreamEncoder) DeserializeU16(stream BCSStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_u16", typeArgsList, typeParamsList, []string{
+		"&mut BCSStream",
+	}, []any{
+		stream,
+	}, []string{
+		"u16",
+	})
+}
+
+// DeserializeU16WithArgs encodes a call to the deserialize_u16 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeU16WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut BCSStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_u16", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u16",
+	})
+}
+
+// DeserializeU32 encodes a call to the deserialize_u32 Move function.
+func (c bcsStreamEncoder) DeserializeU32(stream BCSStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_u32", typeArgsList, typeParamsList, []string{
+		"&mut BCSStream",
+	}, []any{
+		stream,
+	}, []string{
+		"u32",
+	})
+}
+
+// DeserializeU32WithArgs encodes a call to the deserialize_u32 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeU32WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut BCSStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_u32", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u32",
+	})
+}
+
+// DeserializeU64 encodes a call to the deserialize_u64 Move function.
+func (c bcsStreamEncoder) DeserializeU64(stream BCSStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_u64", typeArgsList, typeParamsList, []string{
+		"&mut BCSStream",
+	}, []any{
+		stream,
+	}, []string{
+		"u64",
+	})
+}
+
+// DeserializeU64WithArgs encodes a call to the deserialize_u64 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeU64WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut BCSStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_u64", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u64",
+	})
+}
+
+// DeserializeU128 encodes a call to the deserialize_u128 Move function.
+func (c bcsStreamEncoder) DeserializeU128(stream BCSStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_u128", typeArgsList, typeParamsList, []string{
+		"&mut BCSStream",
+	}, []any{
+		stream,
+	}, []string{
+		"u128",
+	})
+}
+
+// DeserializeU128WithArgs encodes a call to the deserialize_u128 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeU128WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut BCSStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_u128", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u128",
+	})
+}
+
+// DeserializeU256 encodes a call to the deserialize_u256 Move function.
+func (c bcsStreamEncoder) DeserializeU256(stream BCSStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_u256", typeArgsList, typeParamsList, []string{
+		"&mut BCSStream",
+	}, []any{
+		stream,
+	}, []string{
+		"u256",
+	})
+}
+
+// DeserializeU256WithArgs encodes a call to the deserialize_u256 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeU256WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut BCSStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_u256", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"u256",
+	})
+}
+
+// DeserializeU256Entry encodes a call to the deserialize_u256_entry Move function.
+func (c bcsStreamEncoder) DeserializeU256Entry(data []byte, cursor uint64) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_u256_entry", typeArgsList, typeParamsList, []string{
+		"vector<u8>",
+		"u64",
+	}, []any{
+		data,
+		cursor,
+	}, nil)
+}
+
+// DeserializeU256EntryWithArgs encodes a call to the deserialize_u256_entry Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeU256EntryWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"vector<u8>",
+		"u64",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_u256_entry", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// New encodes a call to the new Move function.
+func (c bcsStreamEncoder) New(data []byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("new", typeArgsList, typeParamsList, []string{
+		"vector<u8>",
+	}, []any{
+		data,
+	}, []string{
+		"mcms::bcs_stream::BCSStream",
+	})
+}
+
+// NewWithArgs encodes a call to the new Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) NewWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"vector<u8>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("new", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"mcms::bcs_stream::BCSStream",
+	})
+}
+
+// DeserializeFixedVectorU8 encodes a call to the deserialize_fixed_vector_u8 Move function.
+func (c bcsStreamEncoder) DeserializeFixedVectorU8(stream BCSStream, len_ uint64) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_fixed_vector_u8", typeArgsList, typeParamsList, []string{
+		"&mut BCSStream",
+		"u64",
+	}, []any{
+		stream,
+		len_,
+	}, []string{
+		"vector<u8>",
+	})
+}
+
+// DeserializeFixedVectorU8WithArgs encodes a call to the deserialize_fixed_vector_u8 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeFixedVectorU8WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut BCSStream",
+		"u64",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_fixed_vector_u8", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<u8>",
+	})
+}
+
+// DeserializeString encodes a call to the deserialize_string Move function.
+func (c bcsStreamEncoder) DeserializeString(stream BCSStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_string", typeArgsList, typeParamsList, []string{
+		"&mut BCSStream",
+	}, []any{
+		stream,
+	}, []string{
+		"0x1::string::String",
+	})
+}
+
+// DeserializeStringWithArgs encodes a call to the deserialize_string Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeStringWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut BCSStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_string", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"0x1::string::String",
+	})
+}
+
+// DeserializeVector encodes a call to the deserialize_vector Move function.
+func (c bcsStreamEncoder) DeserializeVector(typeArgs []string, stream BCSStream, elemDeserializer bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"E",
+	}
+	return c.EncodeCallArgsWithGenerics("deserialize_vector", typeArgsList, typeParamsList, []string{
+		"&mut BCSStream",
+		"|&mut BCSStream| -> $E",
+	}, []any{
+		stream,
+		elemDeserializer,
+	}, []string{
+		"vector<$E>",
+	})
+}
+
+// DeserializeVectorWithArgs encodes a call to the deserialize_vector Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeVectorWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut BCSStream",
+		"|&mut BCSStream| -> $E",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"E",
+	}
+	return c.EncodeCallArgsWithGenerics("deserialize_vector", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<$E>",
+	})
+}
+
+// DeserializeVectorU8 encodes a call to the deserialize_vector_u8 Move function.
+func (c bcsStreamEncoder) DeserializeVectorU8(stream BCSStream) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_vector_u8", typeArgsList, typeParamsList, []string{
+		"&mut BCSStream",
+	}, []any{
+		stream,
+	}, []string{
+		"vector<u8>",
+	})
+}
+
+// DeserializeVectorU8WithArgs encodes a call to the deserialize_vector_u8 Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeVectorU8WithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut BCSStream",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("deserialize_vector_u8", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<u8>",
+	})
+}
+
+// DeserializeOption encodes a call to the deserialize_option Move function.
+func (c bcsStreamEncoder) DeserializeOption(typeArgs []string, stream BCSStream, f bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"E",
+	}
+	return c.EncodeCallArgsWithGenerics("deserialize_option", typeArgsList, typeParamsList, []string{
+		"&mut BCSStream",
+		"|&mut BCSStream| -> $E",
+	}, []any{
+		stream,
+		f,
+	}, []string{
+		"0x1::option::Option<$E>",
+	})
+}
+
+// DeserializeOptionWithArgs encodes a call to the deserialize_option Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c bcsStreamEncoder) DeserializeOptionWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut BCSStream",
+		"|&mut BCSStream| -> $E",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"E",
+	}
+	return c.EncodeCallArgsWithGenerics("deserialize_option", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"0x1::option::Option<$E>",
+	})
+}
@@ -13,10 +13,12 @@ import (
 	"github.com/block-vision/sui-go-sdk/sui"
 
 	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	module_mcms_registry "github.com/smartcontractkit/chainlink-sui/bindings/generated/mcms/mcms_registry"
 )
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type IMcms interface {
@@ -29,16 +31,16 @@ type IMcms interface {
 	DispatchTimelockUpdateMinDelay(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, timelockCallbackParams TimelockCallbackParams) (*models.SuiTransactionBlockResponse, error)
 	DispatchTimelockBlockFunction(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, timelockCallbackParams TimelockCallbackParams) (*models.SuiTransactionBlockResponse, error)
 	DispatchTimelockUnblockFunction(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, timelockCallbackParams TimelockCallbackParams) (*models.SuiTransactionBlockResponse, error)
-	ExecuteDispatchToAccount(ctx context.Context, opts *bind.CallOpts, registry bind.Object, accountState bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error)
-	ExecuteDispatchToDeployer(ctx context.Context, opts *bind.CallOpts, registry bind.Object, deployerState bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error)
-	ExecuteTimelockScheduleBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error)
-	ExecuteTimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error)
-	ExecuteTimelockBypasserExecuteBatch(ctx context.Context, opts *bind.CallOpts, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error)
-	ExecuteTimelockCancel(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error)
-	ExecuteTimelockUpdateMinDelay(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error)
-	ExecuteTimelockBlockFunction(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error)
-	ExecuteTimelockUnblockFunction(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error)
-	ExecuteSetConfig(ctx context.Context, opts *bind.CallOpts, registry bind.Object, state bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error)
+	ExecuteDispatchToAccount(ctx context.Context, opts *bind.CallOpts, registry bind.Object, accountState bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	ExecuteDispatchToDeployer(ctx context.Context, opts *bind.CallOpts, registry bind.Object, deployerState bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	ExecuteTimelockScheduleBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	ExecuteTimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	ExecuteTimelockBypasserExecuteBatch(ctx context.Context, opts *bind.CallOpts, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	ExecuteTimelockCancel(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	ExecuteTimelockUpdateMinDelay(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	ExecuteTimelockBlockFunction(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	ExecuteTimelockUnblockFunction(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
+	ExecuteSetConfig(ctx context.Context, opts *bind.CallOpts, registry bind.Object, state bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
 	SetConfig(ctx context.Context, opts *bind.CallOpts, param bind.Object, state bind.Object, role byte, chainId *big.Int, signerAddresses [][]byte, signerGroups []byte, groupQuorums []byte, groupParents []byte, clearRoot bool) (*models.SuiTransactionBlockResponse, error)
 	VerifyMerkleProof(ctx context.Context, opts *bind.CallOpts, proof [][]byte, root []byte, leaf []byte) (*models.SuiTransactionBlockResponse, error)
 	ComputeEthMessageHash(ctx context.Context, opts *bind.CallOpts, root []byte, validUntil uint64) (*models.SuiTransactionBlockResponse, error)
@@ -84,6 +86,30 @@ type IMcms interface {
 	ModuleName(ctx context.Context, opts *bind.CallOpts, function Function) (*models.SuiTransactionBlockResponse, error)
 	Target(ctx context.Context, opts *bind.CallOpts, function Function) (*models.SuiTransactionBlockResponse, error)
 	Data(ctx context.Context, opts *bind.CallOpts, call Call) (*models.SuiTransactionBlockResponse, error)
+	FilterMultisigStateInitialized(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[MultisigStateInitialized], error)
+	WatchMultisigStateInitialized(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[MultisigStateInitialized]) (*bind.EventSubscription, error)
+	FilterConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ConfigSet], error)
+	WatchConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ConfigSet]) (*bind.EventSubscription, error)
+	FilterNewRoot(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[NewRoot], error)
+	WatchNewRoot(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[NewRoot]) (*bind.EventSubscription, error)
+	FilterOpExecuted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OpExecuted], error)
+	WatchOpExecuted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OpExecuted]) (*bind.EventSubscription, error)
+	FilterTimelockInitialized(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[TimelockInitialized], error)
+	WatchTimelockInitialized(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[TimelockInitialized]) (*bind.EventSubscription, error)
+	FilterBypasserCallInitiated(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[BypasserCallInitiated], error)
+	WatchBypasserCallInitiated(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[BypasserCallInitiated]) (*bind.EventSubscription, error)
+	FilterCancelled(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[Cancelled], error)
+	WatchCancelled(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[Cancelled]) (*bind.EventSubscription, error)
+	FilterCallScheduled(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[CallScheduled], error)
+	WatchCallScheduled(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[CallScheduled]) (*bind.EventSubscription, error)
+	FilterCallInitiated(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[CallInitiated], error)
+	WatchCallInitiated(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[CallInitiated]) (*bind.EventSubscription, error)
+	FilterUpdateMinDelay(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[UpdateMinDelay], error)
+	WatchUpdateMinDelay(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[UpdateMinDelay]) (*bind.EventSubscription, error)
+	FilterFunctionBlocked(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[FunctionBlocked], error)
+	WatchFunctionBlocked(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[FunctionBlocked]) (*bind.EventSubscription, error)
+	FilterFunctionUnblocked(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[FunctionUnblocked], error)
+	WatchFunctionUnblocked(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[FunctionUnblocked]) (*bind.EventSubscription, error)
 	DevInspect() IMcmsDevInspect
 	Encoder() McmsEncoder
 	Bound() bind.IBoundContract
@@ -91,11 +117,11 @@ type IMcms interface {
 
 type IMcmsDevInspect interface {
 	Execute(ctx context.Context, opts *bind.CallOpts, state bind.Object, clock bind.Object, role byte, chainId *big.Int, multisigAddr string, nonce uint64, to string, moduleName string, functionName string, data []byte, proof [][]byte) (TimelockCallbackParams, error)
-	DispatchTimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, timelockCallbackParams TimelockCallbackParams) ([]bind.Object, error)
-	DispatchTimelockBypasserExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelockCallbackParams TimelockCallbackParams) ([]bind.Object, error)
-	ExecuteDispatchToDeployer(ctx context.Context, opts *bind.CallOpts, registry bind.Object, deployerState bind.Object, executingCallbackParams bind.Object) (bind.Object, error)
-	ExecuteTimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, executingCallbackParams bind.Object) ([]bind.Object, error)
-	ExecuteTimelockBypasserExecuteBatch(ctx context.Context, opts *bind.CallOpts, executingCallbackParams bind.Object) ([]bind.Object, error)
+	DispatchTimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, timelockCallbackParams TimelockCallbackParams) ([]module_mcms_registry.ExecutingCallbackParams, error)
+	DispatchTimelockBypasserExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelockCallbackParams TimelockCallbackParams) ([]module_mcms_registry.ExecutingCallbackParams, error)
+	ExecuteDispatchToDeployer(ctx context.Context, opts *bind.CallOpts, registry bind.Object, deployerState bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (bind.Object, error)
+	ExecuteTimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) ([]module_mcms_registry.ExecutingCallbackParams, error)
+	ExecuteTimelockBypasserExecuteBatch(ctx context.Context, opts *bind.CallOpts, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) ([]module_mcms_registry.ExecutingCallbackParams, error)
 	VerifyMerkleProof(ctx context.Context, opts *bind.CallOpts, proof [][]byte, root []byte, leaf []byte) (bool, error)
 	ComputeEthMessageHash(ctx context.Context, opts *bind.CallOpts, root []byte, validUntil uint64) ([]byte, error)
 	HashOpLeaf(ctx context.Context, opts *bind.CallOpts, domainSeparator []byte, op Op) ([]byte, error)
@@ -123,7 +149,7 @@ type IMcmsDevInspect interface {
 	ConfigSigners(ctx context.Context, opts *bind.CallOpts, config Config) ([]Signer, error)
 	ConfigGroupQuorums(ctx context.Context, opts *bind.CallOpts, config Config) ([]byte, error)
 	ConfigGroupParents(ctx context.Context, opts *bind.CallOpts, config Config) ([]byte, error)
-	TimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, targets []string, moduleNames []string, functionNames []string, datas [][]byte, predecessor []byte, salt []byte) ([]bind.Object, error)
+	TimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, targets []string, moduleNames []string, functionNames []string, datas [][]byte, predecessor []byte, salt []byte) ([]module_mcms_registry.ExecutingCallbackParams, error)
 	TimelockGetBlockedFunction(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, index uint64) (Function, error)
 	TimelockIsOperation(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, id []byte) (bool, error)
 	TimelockIsOperationPending(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, id []byte) (bool, error)
@@ -161,25 +187,25 @@ type McmsEncoder interface {
 	DispatchTimelockBlockFunctionWithArgs(args ...any) (*bind.EncodedCall, error)
 	DispatchTimelockUnblockFunction(timelock bind.Object, timelockCallbackParams TimelockCallbackParams) (*bind.EncodedCall, error)
 	DispatchTimelockUnblockFunctionWithArgs(args ...any) (*bind.EncodedCall, error)
-	ExecuteDispatchToAccount(registry bind.Object, accountState bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error)
+	ExecuteDispatchToAccount(registry bind.Object, accountState bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	ExecuteDispatchToAccountWithArgs(args ...any) (*bind.EncodedCall, error)
-	ExecuteDispatchToDeployer(registry bind.Object, deployerState bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error)
+	ExecuteDispatchToDeployer(registry bind.Object, deployerState bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	ExecuteDispatchToDeployerWithArgs(args ...any) (*bind.EncodedCall, error)
-	ExecuteTimelockScheduleBatch(timelock bind.Object, clock bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error)
+	ExecuteTimelockScheduleBatch(timelock bind.Object, clock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	ExecuteTimelockScheduleBatchWithArgs(args ...any) (*bind.EncodedCall, error)
-	ExecuteTimelockExecuteBatch(timelock bind.Object, clock bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error)
+	ExecuteTimelockExecuteBatch(timelock bind.Object, clock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	ExecuteTimelockExecuteBatchWithArgs(args ...any) (*bind.EncodedCall, error)
-	ExecuteTimelockBypasserExecuteBatch(executingCallbackParams bind.Object) (*bind.EncodedCall, error)
+	ExecuteTimelockBypasserExecuteBatch(executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	ExecuteTimelockBypasserExecuteBatchWithArgs(args ...any) (*bind.EncodedCall, error)
-	ExecuteTimelockCancel(timelock bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error)
+	ExecuteTimelockCancel(timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	ExecuteTimelockCancelWithArgs(args ...any) (*bind.EncodedCall, error)
-	ExecuteTimelockUpdateMinDelay(timelock bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error)
+	ExecuteTimelockUpdateMinDelay(timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	ExecuteTimelockUpdateMinDelayWithArgs(args ...any) (*bind.EncodedCall, error)
-	ExecuteTimelockBlockFunction(timelock bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error)
+	ExecuteTimelockBlockFunction(timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	ExecuteTimelockBlockFunctionWithArgs(args ...any) (*bind.EncodedCall, error)
-	ExecuteTimelockUnblockFunction(timelock bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error)
+	ExecuteTimelockUnblockFunction(timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	ExecuteTimelockUnblockFunctionWithArgs(args ...any) (*bind.EncodedCall, error)
-	ExecuteSetConfig(registry bind.Object, state bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error)
+	ExecuteSetConfig(registry bind.Object, state bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error)
 	ExecuteSetConfigWithArgs(args ...any) (*bind.EncodedCall, error)
 	SetConfig(param bind.Object, state bind.Object, role byte, chainId *big.Int, signerAddresses [][]byte, signerGroups []byte, groupQuorums []byte, groupParents []byte, clearRoot bool) (*bind.EncodedCall, error)
 	SetConfigWithArgs(args ...any) (*bind.EncodedCall, error)
@@ -1036,6 +1062,258 @@ func init() {
 	})
 }
 
+// DecodeMultisigStateInitialized decodes the BCS of a MultisigStateInitialized event.
+func DecodeMultisigStateInitialized(data []byte) (MultisigStateInitialized, error) {
+	var result MultisigStateInitialized
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return MultisigStateInitialized{}, err
+	}
+
+	return result, nil
+}
+
+// FilterMultisigStateInitialized returns a page of the MultisigStateInitialized events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsContract) FilterMultisigStateInitialized(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[MultisigStateInitialized], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "MultisigStateInitialized", cursor, limit, DecodeMultisigStateInitialized)
+}
+
+// WatchMultisigStateInitialized polls the MultisigStateInitialized events and sends them to ch until the subscription is stopped.
+func (c *McmsContract) WatchMultisigStateInitialized(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[MultisigStateInitialized]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "MultisigStateInitialized", opts, ch, DecodeMultisigStateInitialized)
+}
+
+// DecodeConfigSet decodes the BCS of a ConfigSet event.
+func DecodeConfigSet(data []byte) (ConfigSet, error) {
+	var result ConfigSet
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return ConfigSet{}, err
+	}
+
+	return result, nil
+}
+
+// FilterConfigSet returns a page of the ConfigSet events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsContract) FilterConfigSet(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[ConfigSet], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "ConfigSet", cursor, limit, DecodeConfigSet)
+}
+
+// WatchConfigSet polls the ConfigSet events and sends them to ch until the subscription is stopped.
+func (c *McmsContract) WatchConfigSet(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[ConfigSet]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "ConfigSet", opts, ch, DecodeConfigSet)
+}
+
+// DecodeNewRoot decodes the BCS of a NewRoot event.
+func DecodeNewRoot(data []byte) (NewRoot, error) {
+	var temp bcsNewRoot
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return NewRoot{}, err
+	}
+
+	return convertNewRootFromBCS(temp)
+}
+
+// FilterNewRoot returns a page of the NewRoot events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsContract) FilterNewRoot(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[NewRoot], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "NewRoot", cursor, limit, DecodeNewRoot)
+}
+
+// WatchNewRoot polls the NewRoot events and sends them to ch until the subscription is stopped.
+func (c *McmsContract) WatchNewRoot(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[NewRoot]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "NewRoot", opts, ch, DecodeNewRoot)
+}
+
+// DecodeOpExecuted decodes the BCS of a OpExecuted event.
+func DecodeOpExecuted(data []byte) (OpExecuted, error) {
+	var temp bcsOpExecuted
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return OpExecuted{}, err
+	}
+
+	return convertOpExecutedFromBCS(temp)
+}
+
+// FilterOpExecuted returns a page of the OpExecuted events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsContract) FilterOpExecuted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OpExecuted], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "OpExecuted", cursor, limit, DecodeOpExecuted)
+}
+
+// WatchOpExecuted polls the OpExecuted events and sends them to ch until the subscription is stopped.
+func (c *McmsContract) WatchOpExecuted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OpExecuted]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "OpExecuted", opts, ch, DecodeOpExecuted)
+}
+
+// DecodeTimelockInitialized decodes the BCS of a TimelockInitialized event.
+func DecodeTimelockInitialized(data []byte) (TimelockInitialized, error) {
+	var result TimelockInitialized
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return TimelockInitialized{}, err
+	}
+
+	return result, nil
+}
+
+// FilterTimelockInitialized returns a page of the TimelockInitialized events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsContract) FilterTimelockInitialized(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[TimelockInitialized], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "TimelockInitialized", cursor, limit, DecodeTimelockInitialized)
+}
+
+// WatchTimelockInitialized polls the TimelockInitialized events and sends them to ch until the subscription is stopped.
+func (c *McmsContract) WatchTimelockInitialized(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[TimelockInitialized]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "TimelockInitialized", opts, ch, DecodeTimelockInitialized)
+}
+
+// DecodeBypasserCallInitiated decodes the BCS of a BypasserCallInitiated event.
+func DecodeBypasserCallInitiated(data []byte) (BypasserCallInitiated, error) {
+	var temp bcsBypasserCallInitiated
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return BypasserCallInitiated{}, err
+	}
+
+	return convertBypasserCallInitiatedFromBCS(temp)
+}
+
+// FilterBypasserCallInitiated returns a page of the BypasserCallInitiated events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsContract) FilterBypasserCallInitiated(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[BypasserCallInitiated], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "BypasserCallInitiated", cursor, limit, DecodeBypasserCallInitiated)
+}
+
+// WatchBypasserCallInitiated polls the BypasserCallInitiated events and sends them to ch until the subscription is stopped.
+func (c *McmsContract) WatchBypasserCallInitiated(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[BypasserCallInitiated]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "BypasserCallInitiated", opts, ch, DecodeBypasserCallInitiated)
+}
+
+// DecodeCancelled decodes the BCS of a Cancelled event.
+func DecodeCancelled(data []byte) (Cancelled, error) {
+	var result Cancelled
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return Cancelled{}, err
+	}
+
+	return result, nil
+}
+
+// FilterCancelled returns a page of the Cancelled events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsContract) FilterCancelled(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[Cancelled], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "Cancelled", cursor, limit, DecodeCancelled)
+}
+
+// WatchCancelled polls the Cancelled events and sends them to ch until the subscription is stopped.
+func (c *McmsContract) WatchCancelled(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[Cancelled]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "Cancelled", opts, ch, DecodeCancelled)
+}
+
+// DecodeCallScheduled decodes the BCS of a CallScheduled event.
+func DecodeCallScheduled(data []byte) (CallScheduled, error) {
+	var temp bcsCallScheduled
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return CallScheduled{}, err
+	}
+
+	return convertCallScheduledFromBCS(temp)
+}
+
+// FilterCallScheduled returns a page of the CallScheduled events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsContract) FilterCallScheduled(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[CallScheduled], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "CallScheduled", cursor, limit, DecodeCallScheduled)
+}
+
+// WatchCallScheduled polls the CallScheduled events and sends them to ch until the subscription is stopped.
+func (c *McmsContract) WatchCallScheduled(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[CallScheduled]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "CallScheduled", opts, ch, DecodeCallScheduled)
+}
+
+// DecodeCallInitiated decodes the BCS of a CallInitiated event.
+func DecodeCallInitiated(data []byte) (CallInitiated, error) {
+	var temp bcsCallInitiated
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return CallInitiated{}, err
+	}
+
+	return convertCallInitiatedFromBCS(temp)
+}
+
+// FilterCallInitiated returns a page of the CallInitiated events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsContract) FilterCallInitiated(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[CallInitiated], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "CallInitiated", cursor, limit, DecodeCallInitiated)
+}
+
+// WatchCallInitiated polls the CallInitiated events and sends them to ch until the subscription is stopped.
+func (c *McmsContract) WatchCallInitiated(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[CallInitiated]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "CallInitiated", opts, ch, DecodeCallInitiated)
+}
+
+// DecodeUpdateMinDelay decodes the BCS of a UpdateMinDelay event.
+func DecodeUpdateMinDelay(data []byte) (UpdateMinDelay, error) {
+	var result UpdateMinDelay
+	if _, err := mystenbcs.Unmarshal(data, &result); err != nil {
+		return UpdateMinDelay{}, err
+	}
+
+	return result, nil
+}
+
+// FilterUpdateMinDelay returns a page of the UpdateMinDelay events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsContract) FilterUpdateMinDelay(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[UpdateMinDelay], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "UpdateMinDelay", cursor, limit, DecodeUpdateMinDelay)
+}
+
+// WatchUpdateMinDelay polls the UpdateMinDelay events and sends them to ch until the subscription is stopped.
+func (c *McmsContract) WatchUpdateMinDelay(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[UpdateMinDelay]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "UpdateMinDelay", opts, ch, DecodeUpdateMinDelay)
+}
+
+// DecodeFunctionBlocked decodes the BCS of a FunctionBlocked event.
+func DecodeFunctionBlocked(data []byte) (FunctionBlocked, error) {
+	var temp bcsFunctionBlocked
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return FunctionBlocked{}, err
+	}
+
+	return convertFunctionBlockedFromBCS(temp)
+}
+
+// FilterFunctionBlocked returns a page of the FunctionBlocked events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsContract) FilterFunctionBlocked(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[FunctionBlocked], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "FunctionBlocked", cursor, limit, DecodeFunctionBlocked)
+}
+
+// WatchFunctionBlocked polls the FunctionBlocked events and sends them to ch until the subscription is stopped.
+func (c *McmsContract) WatchFunctionBlocked(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[FunctionBlocked]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "FunctionBlocked", opts, ch, DecodeFunctionBlocked)
+}
+
+// DecodeFunctionUnblocked decodes the BCS of a FunctionUnblocked event.
+func DecodeFunctionUnblocked(data []byte) (FunctionUnblocked, error) {
+	var temp bcsFunctionUnblocked
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return FunctionUnblocked{}, err
+	}
+
+	return convertFunctionUnblockedFromBCS(temp)
+}
+
+// FilterFunctionUnblocked returns a page of the FunctionUnblocked events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsContract) FilterFunctionUnblocked(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[FunctionUnblocked], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "FunctionUnblocked", cursor, limit, DecodeFunctionUnblocked)
+}
+
+// WatchFunctionUnblocked polls the FunctionUnblocked events and sends them to ch until the subscription is stopped.
+func (c *McmsContract) WatchFunctionUnblocked(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[FunctionUnblocked]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "FunctionUnblocked", opts, ch, DecodeFunctionUnblocked)
+}
+
 // SetRoot executes the set_root Move function.
 func (c *McmsContract) SetRoot(ctx context.Context, opts *bind.CallOpts, state bind.Object, clock bind.Object, role byte, root []byte, validUntil uint64, chainId *big.Int, multisigAddr string, preOpCount uint64, postOpCount uint64, overridePreviousRoot bool, metadataProof [][]byte, signatures [][]byte) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.mcmsEncoder.SetRoot(state, clock, role, root, validUntil, chainId, multisigAddr, preOpCount, postOpCount, overridePreviousRoot, metadataProof, signatures)
@@ -1127,7 +1405,7 @@ func (c *McmsContract) DispatchTimelockUnblockFunction(ctx context.Context, opts
 }
 
 // ExecuteDispatchToAccount executes the execute_dispatch_to_account Move function.
-func (c *McmsContract) ExecuteDispatchToAccount(ctx context.Context, opts *bind.CallOpts, registry bind.Object, accountState bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *McmsContract) ExecuteDispatchToAccount(ctx context.Context, opts *bind.CallOpts, registry bind.Object, accountState bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.mcmsEncoder.ExecuteDispatchToAccount(registry, accountState, executingCallbackParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1137,7 +1415,7 @@ func (c *McmsContract) ExecuteDispatchToAccount(ctx context.Context, opts *bind.
 }
 
 // ExecuteDispatchToDeployer executes the execute_dispatch_to_deployer Move function.
-func (c *McmsContract) ExecuteDispatchToDeployer(ctx context.Context, opts *bind.CallOpts, registry bind.Object, deployerState bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *McmsContract) ExecuteDispatchToDeployer(ctx context.Context, opts *bind.CallOpts, registry bind.Object, deployerState bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.mcmsEncoder.ExecuteDispatchToDeployer(registry, deployerState, executingCallbackParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1147,7 +1425,7 @@ func (c *McmsContract) ExecuteDispatchToDeployer(ctx context.Context, opts *bind
 }
 
 // ExecuteTimelockScheduleBatch executes the execute_timelock_schedule_batch Move function.
-func (c *McmsContract) ExecuteTimelockScheduleBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *McmsContract) ExecuteTimelockScheduleBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.mcmsEncoder.ExecuteTimelockScheduleBatch(timelock, clock, executingCallbackParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1157,7 +1435,7 @@ func (c *McmsContract) ExecuteTimelockScheduleBatch(ctx context.Context, opts *b
 }
 
 // ExecuteTimelockExecuteBatch executes the execute_timelock_execute_batch Move function.
-func (c *McmsContract) ExecuteTimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *McmsContract) ExecuteTimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.mcmsEncoder.ExecuteTimelockExecuteBatch(timelock, clock, executingCallbackParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1167,7 +1445,7 @@ func (c *McmsContract) ExecuteTimelockExecuteBatch(ctx context.Context, opts *bi
 }
 
 // ExecuteTimelockBypasserExecuteBatch executes the execute_timelock_bypasser_execute_batch Move function.
-func (c *McmsContract) ExecuteTimelockBypasserExecuteBatch(ctx context.Context, opts *bind.CallOpts, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *McmsContract) ExecuteTimelockBypasserExecuteBatch(ctx context.Context, opts *bind.CallOpts, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.mcmsEncoder.ExecuteTimelockBypasserExecuteBatch(executingCallbackParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1177,7 +1455,7 @@ func (c *McmsContract) ExecuteTimelockBypasserExecuteBatch(ctx context.Context,
 }
 
 // ExecuteTimelockCancel executes the execute_timelock_cancel Move function.
-func (c *McmsContract) ExecuteTimelockCancel(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *McmsContract) ExecuteTimelockCancel(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.mcmsEncoder.ExecuteTimelockCancel(timelock, executingCallbackParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1187,7 +1465,7 @@ func (c *McmsContract) ExecuteTimelockCancel(ctx context.Context, opts *bind.Cal
 }
 
 // ExecuteTimelockUpdateMinDelay executes the execute_timelock_update_min_delay Move function.
-func (c *McmsContract) ExecuteTimelockUpdateMinDelay(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *McmsContract) ExecuteTimelockUpdateMinDelay(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.mcmsEncoder.ExecuteTimelockUpdateMinDelay(timelock, executingCallbackParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1197,7 +1475,7 @@ func (c *McmsContract) ExecuteTimelockUpdateMinDelay(ctx context.Context, opts *
 }
 
 // ExecuteTimelockBlockFunction executes the execute_timelock_block_function Move function.
-func (c *McmsContract) ExecuteTimelockBlockFunction(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *McmsContract) ExecuteTimelockBlockFunction(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.mcmsEncoder.ExecuteTimelockBlockFunction(timelock, executingCallbackParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1207,7 +1485,7 @@ func (c *McmsContract) ExecuteTimelockBlockFunction(ctx context.Context, opts *b
 }
 
 // ExecuteTimelockUnblockFunction executes the execute_timelock_unblock_function Move function.
-func (c *McmsContract) ExecuteTimelockUnblockFunction(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *McmsContract) ExecuteTimelockUnblockFunction(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.mcmsEncoder.ExecuteTimelockUnblockFunction(timelock, executingCallbackParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1217,7 +1495,7 @@ func (c *McmsContract) ExecuteTimelockUnblockFunction(ctx context.Context, opts
 }
 
 // ExecuteSetConfig executes the execute_set_config Move function.
-func (c *McmsContract) ExecuteSetConfig(ctx context.Context, opts *bind.CallOpts, registry bind.Object, state bind.Object, executingCallbackParams bind.Object) (*models.SuiTransactionBlockResponse, error) {
+func (c *McmsContract) ExecuteSetConfig(ctx context.Context, opts *bind.CallOpts, registry bind.Object, state bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.mcmsEncoder.ExecuteSetConfig(registry, state, executingCallbackParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1700,8 +1978,8 @@ func (d *McmsDevInspect) Execute(ctx context.Context, opts *bind.CallOpts, state
 
 // DispatchTimelockExecuteBatch executes the dispatch_timelock_execute_batch Move function using DevInspect to get return values.
 //
-// Returns: vector<ExecutingCallbackParams>
-func (d *McmsDevInspect) DispatchTimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, timelockCallbackParams TimelockCallbackParams) ([]bind.Object, error) {
+// Returns: vector<mcms::mcms_registry::ExecutingCallbackParams>
+func (d *McmsDevInspect) DispatchTimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, timelockCallbackParams TimelockCallbackParams) ([]module_mcms_registry.ExecutingCallbackParams, error) {
 	encoded, err := d.contract.mcmsEncoder.DispatchTimelockExecuteBatch(timelock, clock, timelockCallbackParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1713,17 +1991,17 @@ func (d *McmsDevInspect) DispatchTimelockExecuteBatch(ctx context.Context, opts
 	if len(results) == 0 {
 		return nil, fmt.Errorf("no return value")
 	}
-	result, ok := results[0].([]bind.Object)
+	result, ok := results[0].([]module_mcms_registry.ExecutingCallbackParams)
 	if !ok {
-		return nil, fmt.Errorf("unexpected return type: expected []bind.Object, got %T", results[0])
+		return nil, fmt.Errorf("unexpected return type: expected []module_mcms_registry.ExecutingCallbackParams, got %T", results[0])
 	}
 	return result, nil
 }
 
 // DispatchTimelockBypasserExecuteBatch executes the dispatch_timelock_bypasser_execute_batch Move function using DevInspect to get return values.
 //
-// Returns: vector<ExecutingCallbackParams>
-func (d *McmsDevInspect) DispatchTimelockBypasserExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelockCallbackParams TimelockCallbackParams) ([]bind.Object, error) {
+// Returns: vector<mcms::mcms_registry::ExecutingCallbackParams>
+func (d *McmsDevInspect) DispatchTimelockBypasserExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelockCallbackParams TimelockCallbackParams) ([]module_mcms_registry.ExecutingCallbackParams, error) {
 	encoded, err := d.contract.mcmsEncoder.DispatchTimelockBypasserExecuteBatch(timelockCallbackParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1735,9 +2013,9 @@ func (d *McmsDevInspect) DispatchTimelockBypasserExecuteBatch(ctx context.Contex
 	if len(results) == 0 {
 		return nil, fmt.Errorf("no return value")
 	}
-	result, ok := results[0].([]bind.Object)
+	result, ok := results[0].([]module_mcms_registry.ExecutingCallbackParams)
 	if !ok {
-		return nil, fmt.Errorf("unexpected return type: expected []bind.Object, got %T", results[0])
+		return nil, fmt.Errorf("unexpected return type: expected []module_mcms_registry.ExecutingCallbackParams, got %T", results[0])
 	}
 	return result, nil
 }
@@ -1745,7 +2023,7 @@ func (d *McmsDevInspect) DispatchTimelockBypasserExecuteBatch(ctx context.Contex
 // ExecuteDispatchToDeployer executes the execute_dispatch_to_deployer Move function using DevInspect to get return values.
 //
 // Returns: UpgradeTicket
-func (d *McmsDevInspect) ExecuteDispatchToDeployer(ctx context.Context, opts *bind.CallOpts, registry bind.Object, deployerState bind.Object, executingCallbackParams bind.Object) (bind.Object, error) {
+func (d *McmsDevInspect) ExecuteDispatchToDeployer(ctx context.Context, opts *bind.CallOpts, registry bind.Object, deployerState bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (bind.Object, error) {
 	encoded, err := d.contract.mcmsEncoder.ExecuteDispatchToDeployer(registry, deployerState, executingCallbackParams)
 	if err != nil {
 		return bind.Object{}, fmt.Errorf("failed to encode function call: %w", err)
@@ -1766,8 +2044,8 @@ func (d *McmsDevInspect) ExecuteDispatchToDeployer(ctx context.Context, opts *bi
 
 // ExecuteTimelockExecuteBatch executes the execute_timelock_execute_batch Move function using DevInspect to get return values.
 //
-// Returns: vector<ExecutingCallbackParams>
-func (d *McmsDevInspect) ExecuteTimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, executingCallbackParams bind.Object) ([]bind.Object, error) {
+// Returns: vector<mcms::mcms_registry::ExecutingCallbackParams>
+func (d *McmsDevInspect) ExecuteTimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) ([]module_mcms_registry.ExecutingCallbackParams, error) {
 	encoded, err := d.contract.mcmsEncoder.ExecuteTimelockExecuteBatch(timelock, clock, executingCallbackParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1779,17 +2057,17 @@ func (d *McmsDevInspect) ExecuteTimelockExecuteBatch(ctx context.Context, opts *
 	if len(results) == 0 {
 		return nil, fmt.Errorf("no return value")
 	}
-	result, ok := results[0].([]bind.Object)
+	result, ok := results[0].([]module_mcms_registry.ExecutingCallbackParams)
 	if !ok {
-		return nil, fmt.Errorf("unexpected return type: expected []bind.Object, got %T", results[0])
+		return nil, fmt.Errorf("unexpected return type: expected []module_mcms_registry.ExecutingCallbackParams, got %T", results[0])
 	}
 	return result, nil
 }
 
 // ExecuteTimelockBypasserExecuteBatch executes the execute_timelock_bypasser_execute_batch Move function using DevInspect to get return values.
 //
-// Returns: vector<ExecutingCallbackParams>
-func (d *McmsDevInspect) ExecuteTimelockBypasserExecuteBatch(ctx context.Context, opts *bind.CallOpts, executingCallbackParams bind.Object) ([]bind.Object, error) {
+// Returns: vector<mcms::mcms_registry::ExecutingCallbackParams>
+func (d *McmsDevInspect) ExecuteTimelockBypasserExecuteBatch(ctx context.Context, opts *bind.CallOpts, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) ([]module_mcms_registry.ExecutingCallbackParams, error) {
 	encoded, err := d.contract.mcmsEncoder.ExecuteTimelockBypasserExecuteBatch(executingCallbackParams)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -1801,9 +2079,9 @@ func (d *McmsDevInspect) ExecuteTimelockBypasserExecuteBatch(ctx context.Context
 	if len(results) == 0 {
 		return nil, fmt.Errorf("no return value")
 	}
-	result, ok := results[0].([]bind.Object)
+	result, ok := results[0].([]module_mcms_registry.ExecutingCallbackParams)
 	if !ok {
-		return nil, fmt.Errorf("unexpected return type: expected []bind.Object, got %T", results[0])
+		return nil, fmt.Errorf("unexpected return type: expected []module_mcms_registry.ExecutingCallbackParams, got %T", results[0])
 	}
 	return result, nil
 }
@@ -2389,8 +2667,8 @@ func (d *McmsDevInspect) ConfigGroupParents(ctx context.Context, opts *bind.Call
 
 // TimelockExecuteBatch executes the timelock_execute_batch Move function using DevInspect to get return values.
 //
-// Returns: vector<ExecutingCallbackParams>
-func (d *McmsDevInspect) TimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, targets []string, moduleNames []string, functionNames []string, datas [][]byte, predecessor []byte, salt []byte) ([]bind.Object, error) {
+// Returns: vector<mcms::mcms_registry::ExecutingCallbackParams>
+func (d *McmsDevInspect) TimelockExecuteBatch(ctx context.Context, opts *bind.CallOpts, timelock bind.Object, clock bind.Object, targets []string, moduleNames []string, functionNames []string, datas [][]byte, predecessor []byte, salt []byte) ([]module_mcms_registry.ExecutingCallbackParams, error) {
 	encoded, err := d.contract.mcmsEncoder.TimelockExecuteBatch(timelock, clock, targets, moduleNames, functionNames, datas, predecessor, salt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function call: %w", err)
@@ -2402,9 +2680,9 @@ func (d *McmsDevInspect) TimelockExecuteBatch(ctx context.Context, opts *bind.Ca
 	if len(results) == 0 {
 		return nil, fmt.Errorf("no return value")
 	}
-	result, ok := results[0].([]bind.Object)
+	result, ok := results[0].([]module_mcms_registry.ExecutingCallbackParams)
 	if !ok {
-		return nil, fmt.Errorf("unexpected return type: expected []bind.Object, got %T", results[0])
+		return nil, fmt.Errorf("unexpected return type: expected []module_mcms_registry.ExecutingCallbackParams, got %T", results[0])
 	}
 	return result, nil
 }
@@ -2922,7 +3200,7 @@ func (c mcmsEncoder) DispatchTimelockExecuteBatch(timelock bind.Object, clock bi
 		clock,
 		timelockCallbackParams,
 	}, []string{
-		"vector<ExecutingCallbackParams>",
+		"vector<mcms::mcms_registry::ExecutingCallbackParams>",
 	})
 }
 
@@ -2941,7 +3219,7 @@ func (c mcmsEncoder) DispatchTimelockExecuteBatchWithArgs(args ...any) (*bind.En
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("dispatch_timelock_execute_batch", typeArgsList, typeParamsList, expectedParams, args, []string{
-		"vector<ExecutingCallbackParams>",
+		"vector<mcms::mcms_registry::ExecutingCallbackParams>",
 	})
 }
 
@@ -2954,7 +3232,7 @@ func (c mcmsEncoder) DispatchTimelockBypasserExecuteBatch(timelockCallbackParams
 	}, []any{
 		timelockCallbackParams,
 	}, []string{
-		"vector<ExecutingCallbackParams>",
+		"vector<mcms::mcms_registry::ExecutingCallbackParams>",
 	})
 }
 
@@ -2971,7 +3249,7 @@ func (c mcmsEncoder) DispatchTimelockBypasserExecuteBatchWithArgs(args ...any) (
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("dispatch_timelock_bypasser_execute_batch", typeArgsList, typeParamsList, expectedParams, args, []string{
-		"vector<ExecutingCallbackParams>",
+		"vector<mcms::mcms_registry::ExecutingCallbackParams>",
 	})
 }
 
@@ -3092,7 +3370,7 @@ func (c mcmsEncoder) DispatchTimelockUnblockFunctionWithArgs(args ...any) (*bind
 }
 
 // ExecuteDispatchToAccount encodes a call to the execute_dispatch_to_account Move function.
-func (c mcmsEncoder) ExecuteDispatchToAccount(registry bind.Object, accountState bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error) {
+func (c mcmsEncoder) ExecuteDispatchToAccount(registry bind.Object, accountState bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("execute_dispatch_to_account", typeArgsList, typeParamsList, []string{
@@ -3124,7 +3402,7 @@ func (c mcmsEncoder) ExecuteDispatchToAccountWithArgs(args ...any) (*bind.Encode
 }
 
 // ExecuteDispatchToDeployer encodes a call to the execute_dispatch_to_deployer Move function.
-func (c mcmsEncoder) ExecuteDispatchToDeployer(registry bind.Object, deployerState bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error) {
+func (c mcmsEncoder) ExecuteDispatchToDeployer(registry bind.Object, deployerState bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("execute_dispatch_to_deployer", typeArgsList, typeParamsList, []string{
@@ -3160,7 +3438,7 @@ func (c mcmsEncoder) ExecuteDispatchToDeployerWithArgs(args ...any) (*bind.Encod
 }
 
 // ExecuteTimelockScheduleBatch encodes a call to the execute_timelock_schedule_batch Move function.
-func (c mcmsEncoder) ExecuteTimelockScheduleBatch(timelock bind.Object, clock bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error) {
+func (c mcmsEncoder) ExecuteTimelockScheduleBatch(timelock bind.Object, clock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("execute_timelock_schedule_batch", typeArgsList, typeParamsList, []string{
@@ -3192,7 +3470,7 @@ func (c mcmsEncoder) ExecuteTimelockScheduleBatchWithArgs(args ...any) (*bind.En
 }
 
 // ExecuteTimelockExecuteBatch encodes a call to the execute_timelock_execute_batch Move function.
-func (c mcmsEncoder) ExecuteTimelockExecuteBatch(timelock bind.Object, clock bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error) {
+func (c mcmsEncoder) ExecuteTimelockExecuteBatch(timelock bind.Object, clock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("execute_timelock_execute_batch", typeArgsList, typeParamsList, []string{
@@ -3204,7 +3482,7 @@ func (c mcmsEncoder) ExecuteTimelockExecuteBatch(timelock bind.Object, clock bin
 		clock,
 		executingCallbackParams,
 	}, []string{
-		"vector<ExecutingCallbackParams>",
+		"vector<mcms::mcms_registry::ExecutingCallbackParams>",
 	})
 }
 
@@ -3223,12 +3501,12 @@ func (c mcmsEncoder) ExecuteTimelockExecuteBatchWithArgs(args ...any) (*bind.Enc
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("execute_timelock_execute_batch", typeArgsList, typeParamsList, expectedParams, args, []string{
-		"vector<ExecutingCallbackParams>",
+		"vector<mcms::mcms_registry::ExecutingCallbackParams>",
 	})
 }
 
 // ExecuteTimelockBypasserExecuteBatch encodes a call to the execute_timelock_bypasser_execute_batch Move function.
-func (c mcmsEncoder) ExecuteTimelockBypasserExecuteBatch(executingCallbackParams bind.Object) (*bind.EncodedCall, error) {
+func (c mcmsEncoder) ExecuteTimelockBypasserExecuteBatch(executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("execute_timelock_bypasser_execute_batch", typeArgsList, typeParamsList, []string{
@@ -3236,7 +3514,7 @@ func (c mcmsEncoder) ExecuteTimelockBypasserExecuteBatch(executingCallbackParams
 	}, []any{
 		executingCallbackParams,
 	}, []string{
-		"vector<ExecutingCallbackParams>",
+		"vector<mcms::mcms_registry::ExecutingCallbackParams>",
 	})
 }
 
@@ -3253,12 +3531,12 @@ func (c mcmsEncoder) ExecuteTimelockBypasserExecuteBatchWithArgs(args ...any) (*
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("execute_timelock_bypasser_execute_batch", typeArgsList, typeParamsList, expectedParams, args, []string{
-		"vector<ExecutingCallbackParams>",
+		"vector<mcms::mcms_registry::ExecutingCallbackParams>",
 	})
 }
 
 // ExecuteTimelockCancel encodes a call to the execute_timelock_cancel Move function.
-func (c mcmsEncoder) ExecuteTimelockCancel(timelock bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error) {
+func (c mcmsEncoder) ExecuteTimelockCancel(timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("execute_timelock_cancel", typeArgsList, typeParamsList, []string{
@@ -3287,7 +3565,7 @@ func (c mcmsEncoder) ExecuteTimelockCancelWithArgs(args ...any) (*bind.EncodedCa
 }
 
 // ExecuteTimelockUpdateMinDelay encodes a call to the execute_timelock_update_min_delay Move function.
-func (c mcmsEncoder) ExecuteTimelockUpdateMinDelay(timelock bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error) {
+func (c mcmsEncoder) ExecuteTimelockUpdateMinDelay(timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("execute_timelock_update_min_delay", typeArgsList, typeParamsList, []string{
@@ -3316,7 +3594,7 @@ func (c mcmsEncoder) ExecuteTimelockUpdateMinDelayWithArgs(args ...any) (*bind.E
 }
 
 // ExecuteTimelockBlockFunction encodes a call to the execute_timelock_block_function Move function.
-func (c mcmsEncoder) ExecuteTimelockBlockFunction(timelock bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error) {
+func (c mcmsEncoder) ExecuteTimelockBlockFunction(timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("execute_timelock_block_function", typeArgsList, typeParamsList, []string{
@@ -3345,7 +3623,7 @@ func (c mcmsEncoder) ExecuteTimelockBlockFunctionWithArgs(args ...any) (*bind.En
 }
 
 // ExecuteTimelockUnblockFunction encodes a call to the execute_timelock_unblock_function Move function.
-func (c mcmsEncoder) ExecuteTimelockUnblockFunction(timelock bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error) {
+func (c mcmsEncoder) ExecuteTimelockUnblockFunction(timelock bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("execute_timelock_unblock_function", typeArgsList, typeParamsList, []string{
@@ -3374,7 +3652,7 @@ func (c mcmsEncoder) ExecuteTimelockUnblockFunctionWithArgs(args ...any) (*bind.
 }
 
 // ExecuteSetConfig encodes a call to the execute_set_config Move function.
-func (c mcmsEncoder) ExecuteSetConfig(registry bind.Object, state bind.Object, executingCallbackParams bind.Object) (*bind.EncodedCall, error) {
+func (c mcmsEncoder) ExecuteSetConfig(registry bind.Object, state bind.Object, executingCallbackParams module_mcms_registry.ExecutingCallbackParams) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("execute_set_config", typeArgsList, typeParamsList, []string{
@@ -4282,7 +4560,7 @@ func (c mcmsEncoder) TimelockExecuteBatch(timelock bind.Object, clock bind.Objec
 		predecessor,
 		salt,
 	}, []string{
-		"vector<ExecutingCallbackParams>",
+		"vector<mcms::mcms_registry::ExecutingCallbackParams>",
 	})
 }
 
@@ -4306,7 +4584,7 @@ func (c mcmsEncoder) TimelockExecuteBatchWithArgs(args ...any) (*bind.EncodedCal
 	typeArgsList := []string{}
 	typeParamsList := []string{}
 	return c.EncodeCallArgsWithGenerics("timelock_execute_batch", typeArgsList, typeParamsList, expectedParams, args, []string{
-		"vector<ExecutingCallbackParams>",
+		"vector<mcms::mcms_registry::ExecutingCallbackParams>",
 	})
 }
 
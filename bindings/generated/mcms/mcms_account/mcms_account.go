@@ -17,6 +17,7 @@ import (
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type IMcmsAccount interface {
@@ -29,6 +30,12 @@ type IMcmsAccount interface {
 	PendingTransferFrom(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
 	PendingTransferTo(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
 	PendingTransferAccepted(ctx context.Context, opts *bind.CallOpts, state bind.Object) (*models.SuiTransactionBlockResponse, error)
+	FilterOwnershipTransferRequested(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OwnershipTransferRequested], error)
+	WatchOwnershipTransferRequested(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OwnershipTransferRequested]) (*bind.EventSubscription, error)
+	FilterOwnershipTransferAccepted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OwnershipTransferAccepted], error)
+	WatchOwnershipTransferAccepted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OwnershipTransferAccepted]) (*bind.EventSubscription, error)
+	FilterOwnershipTransferred(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OwnershipTransferred], error)
+	WatchOwnershipTransferred(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OwnershipTransferred]) (*bind.EventSubscription, error)
 	DevInspect() IMcmsAccountDevInspect
 	Encoder() McmsAccountEncoder
 	Bound() bind.IBoundContract
@@ -287,6 +294,69 @@ func init() {
 	})
 }
 
+// DecodeOwnershipTransferRequested decodes the BCS of a OwnershipTransferRequested event.
+func DecodeOwnershipTransferRequested(data []byte) (OwnershipTransferRequested, error) {
+	var temp bcsOwnershipTransferRequested
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return OwnershipTransferRequested{}, err
+	}
+
+	return convertOwnershipTransferRequestedFromBCS(temp)
+}
+
+// FilterOwnershipTransferRequested returns a page of the OwnershipTransferRequested events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsAccountContract) FilterOwnershipTransferRequested(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OwnershipTransferRequested], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "OwnershipTransferRequested", cursor, limit, DecodeOwnershipTransferRequested)
+}
+
+// WatchOwnershipTransferRequested polls the OwnershipTransferRequested events and sends them to ch until the subscription is stopped.
+func (c *McmsAccountContract) WatchOwnershipTransferRequested(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OwnershipTransferRequested]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "OwnershipTransferRequested", opts, ch, DecodeOwnershipTransferRequested)
+}
+
+// DecodeOwnershipTransferAccepted decodes the BCS of a OwnershipTransferAccepted event.
+func DecodeOwnershipTransferAccepted(data []byte) (OwnershipTransferAccepted, error) {
+	var temp bcsOwnershipTransferAccepted
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return OwnershipTransferAccepted{}, err
+	}
+
+	return convertOwnershipTransferAcceptedFromBCS(temp)
+}
+
+// FilterOwnershipTransferAccepted returns a page of the OwnershipTransferAccepted events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsAccountContract) FilterOwnershipTransferAccepted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OwnershipTransferAccepted], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "OwnershipTransferAccepted", cursor, limit, DecodeOwnershipTransferAccepted)
+}
+
+// WatchOwnershipTransferAccepted polls the OwnershipTransferAccepted events and sends them to ch until the subscription is stopped.
+func (c *McmsAccountContract) WatchOwnershipTransferAccepted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OwnershipTransferAccepted]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "OwnershipTransferAccepted", opts, ch, DecodeOwnershipTransferAccepted)
+}
+
+// DecodeOwnershipTransferred decodes the BCS of a OwnershipTransferred event.
+func DecodeOwnershipTransferred(data []byte) (OwnershipTransferred, error) {
+	var temp bcsOwnershipTransferred
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return OwnershipTransferred{}, err
+	}
+
+	return convertOwnershipTransferredFromBCS(temp)
+}
+
+// FilterOwnershipTransferred returns a page of the OwnershipTransferred events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsAccountContract) FilterOwnershipTransferred(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[OwnershipTransferred], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "OwnershipTransferred", cursor, limit, DecodeOwnershipTransferred)
+}
+
+// WatchOwnershipTransferred polls the OwnershipTransferred events and sends them to ch until the subscription is stopped.
+func (c *McmsAccountContract) WatchOwnershipTransferred(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[OwnershipTransferred]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "OwnershipTransferred", opts, ch, DecodeOwnershipTransferred)
+}
+
 // TransferOwnership executes the transfer_ownership Move function.
 func (c *McmsAccountContract) TransferOwnership(ctx context.Context, opts *bind.CallOpts, param bind.Object, state bind.Object, to string) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.mcmsAccountEncoder.TransferOwnership(param, state, to)
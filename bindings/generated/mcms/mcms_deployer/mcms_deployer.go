@@ -17,12 +17,19 @@ import (
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type IMcmsDeployer interface {
 	RegisterUpgradeCap(ctx context.Context, opts *bind.CallOpts, state bind.Object, registry bind.Object, upgradeCap bind.Object) (*models.SuiTransactionBlockResponse, error)
 	AuthorizeUpgrade(ctx context.Context, opts *bind.CallOpts, param bind.Object, state bind.Object, policy byte, digest []byte, packageAddress string) (*models.SuiTransactionBlockResponse, error)
 	CommitUpgrade(ctx context.Context, opts *bind.CallOpts, state bind.Object, receipt bind.Object) (*models.SuiTransactionBlockResponse, error)
+	FilterUpgradeCapRegistered(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[UpgradeCapRegistered], error)
+	WatchUpgradeCapRegistered(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[UpgradeCapRegistered]) (*bind.EventSubscription, error)
+	FilterUpgradeTicketAuthorized(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[UpgradeTicketAuthorized], error)
+	WatchUpgradeTicketAuthorized(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[UpgradeTicketAuthorized]) (*bind.EventSubscription, error)
+	FilterUpgradeReceiptCommitted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[UpgradeReceiptCommitted], error)
+	WatchUpgradeReceiptCommitted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[UpgradeReceiptCommitted]) (*bind.EventSubscription, error)
 	DevInspect() IMcmsDeployerDevInspect
 	Encoder() McmsDeployerEncoder
 	Bound() bind.IBoundContract
@@ -212,6 +219,69 @@ func init() {
 	})
 }
 
+// DecodeUpgradeCapRegistered decodes the BCS of a UpgradeCapRegistered event.
+func DecodeUpgradeCapRegistered(data []byte) (UpgradeCapRegistered, error) {
+	var temp bcsUpgradeCapRegistered
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return UpgradeCapRegistered{}, err
+	}
+
+	return convertUpgradeCapRegisteredFromBCS(temp)
+}
+
+// FilterUpgradeCapRegistered returns a page of the UpgradeCapRegistered events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsDeployerContract) FilterUpgradeCapRegistered(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[UpgradeCapRegistered], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "UpgradeCapRegistered", cursor, limit, DecodeUpgradeCapRegistered)
+}
+
+// WatchUpgradeCapRegistered polls the UpgradeCapRegistered events and sends them to ch until the subscription is stopped.
+func (c *McmsDeployerContract) WatchUpgradeCapRegistered(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[UpgradeCapRegistered]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "UpgradeCapRegistered", opts, ch, DecodeUpgradeCapRegistered)
+}
+
+// DecodeUpgradeTicketAuthorized decodes the BCS of a UpgradeTicketAuthorized event.
+func DecodeUpgradeTicketAuthorized(data []byte) (UpgradeTicketAuthorized, error) {
+	var temp bcsUpgradeTicketAuthorized
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return UpgradeTicketAuthorized{}, err
+	}
+
+	return convertUpgradeTicketAuthorizedFromBCS(temp)
+}
+
+// FilterUpgradeTicketAuthorized returns a page of the UpgradeTicketAuthorized events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsDeployerContract) FilterUpgradeTicketAuthorized(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[UpgradeTicketAuthorized], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "UpgradeTicketAuthorized", cursor, limit, DecodeUpgradeTicketAuthorized)
+}
+
+// WatchUpgradeTicketAuthorized polls the UpgradeTicketAuthorized events and sends them to ch until the subscription is stopped.
+func (c *McmsDeployerContract) WatchUpgradeTicketAuthorized(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[UpgradeTicketAuthorized]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "UpgradeTicketAuthorized", opts, ch, DecodeUpgradeTicketAuthorized)
+}
+
+// DecodeUpgradeReceiptCommitted decodes the BCS of a UpgradeReceiptCommitted event.
+func DecodeUpgradeReceiptCommitted(data []byte) (UpgradeReceiptCommitted, error) {
+	var temp bcsUpgradeReceiptCommitted
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return UpgradeReceiptCommitted{}, err
+	}
+
+	return convertUpgradeReceiptCommittedFromBCS(temp)
+}
+
+// FilterUpgradeReceiptCommitted returns a page of the UpgradeReceiptCommitted events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsDeployerContract) FilterUpgradeReceiptCommitted(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[UpgradeReceiptCommitted], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "UpgradeReceiptCommitted", cursor, limit, DecodeUpgradeReceiptCommitted)
+}
+
+// WatchUpgradeReceiptCommitted polls the UpgradeReceiptCommitted events and sends them to ch until the subscription is stopped.
+func (c *McmsDeployerContract) WatchUpgradeReceiptCommitted(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[UpgradeReceiptCommitted]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "UpgradeReceiptCommitted", opts, ch, DecodeUpgradeReceiptCommitted)
+}
+
 // RegisterUpgradeCap executes the register_upgrade_cap Move function.
 func (c *McmsDeployerContract) RegisterUpgradeCap(ctx context.Context, opts *bind.CallOpts, state bind.Object, registry bind.Object, upgradeCap bind.Object) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.mcmsDeployerEncoder.RegisterUpgradeCap(state, registry, upgradeCap)
@@ -17,6 +17,7 @@ import (
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type IMcmsRegistry interface {
@@ -34,6 +35,8 @@ type IMcmsRegistry interface {
 	Data(ctx context.Context, opts *bind.CallOpts, params ExecutingCallbackParams) (*models.SuiTransactionBlockResponse, error)
 	GetMultisigAddress(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error)
 	CreateMcmsProof(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error)
+	FilterEntrypointRegistered(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[EntrypointRegistered], error)
+	WatchEntrypointRegistered(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[EntrypointRegistered]) (*bind.EventSubscription, error)
 	DevInspect() IMcmsRegistryDevInspect
 	Encoder() McmsRegistryEncoder
 	Bound() bind.IBoundContract
@@ -167,7 +170,7 @@ func convertExecutingCallbackParamsFromBCS(bcs bcsExecutingCallbackParams) (Exec
 }
 
 type bcsEntrypointRegistered struct {
-	RegistryId     bind.Object
+	RegistryId     [32]byte
 	AccountAddress [32]byte
 	ModuleName     string
 }
@@ -175,7 +178,7 @@ type bcsEntrypointRegistered struct {
 func convertEntrypointRegisteredFromBCS(bcs bcsEntrypointRegistered) (EntrypointRegistered, error) {
 
 	return EntrypointRegistered{
-		RegistryId:     bcs.RegistryId,
+		RegistryId:     bind.Object{Id: fmt.Sprintf("0x%x", bcs.RegistryId)},
 		AccountAddress: fmt.Sprintf("0x%x", bcs.AccountAddress),
 		ModuleName:     bcs.ModuleName,
 	}, nil
@@ -234,6 +237,27 @@ func init() {
 	})
 }
 
+// DecodeEntrypointRegistered decodes the BCS of a EntrypointRegistered event.
+func DecodeEntrypointRegistered(data []byte) (EntrypointRegistered, error) {
+	var temp bcsEntrypointRegistered
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return EntrypointRegistered{}, err
+	}
+
+	return convertEntrypointRegisteredFromBCS(temp)
+}
+
+// FilterEntrypointRegistered returns a page of the EntrypointRegistered events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *McmsRegistryContract) FilterEntrypointRegistered(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[EntrypointRegistered], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "EntrypointRegistered", cursor, limit, DecodeEntrypointRegistered)
+}
+
+// WatchEntrypointRegistered polls the EntrypointRegistered events and sends them to ch until the subscription is stopped.
+func (c *McmsRegistryContract) WatchEntrypointRegistered(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[EntrypointRegistered]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "EntrypointRegistered", opts, ch, DecodeEntrypointRegistered)
+}
+
 // RegisterEntrypoint executes the register_entrypoint Move function.
 func (c *McmsRegistryContract) RegisterEntrypoint(ctx context.Context, opts *bind.CallOpts, typeArgs []string, registry bind.Object, proof bind.Object, packageCap bind.Object) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.mcmsRegistryEncoder.RegisterEntrypoint(typeArgs, registry, proof, packageCap)
@@ -17,6 +17,7 @@ import (
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type IMcmsUser interface {
@@ -136,7 +137,7 @@ type bcsUserData struct {
 	B           []byte
 	C           [32]byte
 	D           [16]byte
-	OwnerCap    bind.Object
+	OwnerCap    [32]byte
 }
 
 func convertUserDataFromBCS(bcs bcsUserData) (UserData, error) {
@@ -152,7 +153,7 @@ func convertUserDataFromBCS(bcs bcsUserData) (UserData, error) {
 		B:           bcs.B,
 		C:           fmt.Sprintf("0x%x", bcs.C),
 		D:           DField,
-		OwnerCap:    bcs.OwnerCap,
+		OwnerCap:    bind.Object{Id: fmt.Sprintf("0x%x", bcs.OwnerCap)},
 	}, nil
 }
 
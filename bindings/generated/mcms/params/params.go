@@ -0,0 +1,395 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package module_params
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+)
+
+var (
+	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
+)
+
+type IParams interface {
+	EncodeUint(ctx context.Context, opts *bind.CallOpts, typeArgs []string, input bind.Object, numBytes uint64) (*models.SuiTransactionBlockResponse, error)
+	RightPadVec(ctx context.Context, opts *bind.CallOpts, v []byte, numBytes uint64) (*models.SuiTransactionBlockResponse, error)
+	VectorU8Gt(ctx context.Context, opts *bind.CallOpts, a []byte, b []byte) (*models.SuiTransactionBlockResponse, error)
+	GetAccountAddressAndModuleName(ctx context.Context, opts *bind.CallOpts, proofType bind.Object) (*models.SuiTransactionBlockResponse, error)
+	Slice(ctx context.Context, opts *bind.CallOpts, typeArgs []string, v []bind.Object, start uint64, len_ uint64) (*models.SuiTransactionBlockResponse, error)
+	DevInspect() IParamsDevInspect
+	Encoder() ParamsEncoder
+	Bound() bind.IBoundContract
+}
+
+type IParamsDevInspect interface {
+	EncodeUint(ctx context.Context, opts *bind.CallOpts, typeArgs []string, input bind.Object, numBytes uint64) ([]byte, error)
+	VectorU8Gt(ctx context.Context, opts *bind.CallOpts, a []byte, b []byte) (bool, error)
+	GetAccountAddressAndModuleName(ctx context.Context, opts *bind.CallOpts, proofType bind.Object) ([]any, error)
+	Slice(ctx context.Context, opts *bind.CallOpts, typeArgs []string, v []bind.Object, start uint64, len_ uint64) (any, error)
+}
+
+type ParamsEncoder interface {
+	EncodeUint(typeArgs []string, input bind.Object, numBytes uint64) (*bind.EncodedCall, error)
+	EncodeUintWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error)
+	RightPadVec(v []byte, numBytes uint64) (*bind.EncodedCall, error)
+	RightPadVecWithArgs(args ...any) (*bind.EncodedCall, error)
+	VectorU8Gt(a []byte, b []byte) (*bind.EncodedCall, error)
+	VectorU8GtWithArgs(args ...any) (*bind.EncodedCall, error)
+	GetAccountAddressAndModuleName(proofType bind.Object) (*bind.EncodedCall, error)
+	GetAccountAddressAndModuleNameWithArgs(args ...any) (*bind.EncodedCall, error)
+	Slice(typeArgs []string, v []bind.Object, start uint64, len_ uint64) (*bind.EncodedCall, error)
+	SliceWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error)
+}
+
+type ParamsContract struct {
+	*bind.BoundContract
+	paramsEncoder
+	devInspect *ParamsDevInspect
+}
+
+type ParamsDevInspect struct {
+	contract *ParamsContract
+}
+
+var _ IParams = (*ParamsContract)(nil)
+var _ IParamsDevInspect = (*ParamsDevInspect)(nil)
+
+func NewParams(packageID string, client sui.ISuiAPI) (IParams, error) {
+	contract, err := bind.NewBoundContract(packageID, "mcms", "params", client)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &ParamsContract{
+		BoundContract: contract,
+		paramsEncoder: paramsEncoder{BoundContract: contract},
+	}
+	c.devInspect = &ParamsDevInspect{contract: c}
+	return c, nil
+}
+
+func (c *ParamsContract) Bound() bind.IBoundContract {
+	return c.BoundContract
+}
+
+func (c *ParamsContract) Encoder() ParamsEncoder {
+	return c.paramsEncoder
+}
+
+func (c *ParamsContract) DevInspect() IParamsDevInspect {
+	return c.devInspect
+}
+
+func init() {
+}
+
+// EncodeUint executes the encode_uint Move function.
+func (c *ParamsContract) EncodeUint(ctx context.Context, opts *bind.CallOpts, typeArgs []string, input bind.Object, numBytes uint64) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.paramsEncoder.EncodeUint(typeArgs, input, numBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// RightPadVec executes the right_pad_vec Move function.
+func (c *ParamsContract) RightPadVec(ctx context.Context, opts *bind.CallOpts, v []byte, numBytes uint64) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.paramsEncoder.RightPadVec(v, numBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// VectorU8Gt executes the vector_u8_gt Move function.
+func (c *ParamsContract) VectorU8Gt(ctx context.Context, opts *bind.CallOpts, a []byte, b []byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.paramsEncoder.VectorU8Gt(a, b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// GetAccountAddressAndModuleName executes the get_account_address_and_module_name Move function.
+func (c *ParamsContract) GetAccountAddressAndModuleName(ctx context.Context, opts *bind.CallOpts, proofType bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.paramsEncoder.GetAccountAddressAndModuleName(proofType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// Slice executes the slice Move function.
+func (c *ParamsContract) Slice(ctx context.Context, opts *bind.CallOpts, typeArgs []string, v []bind.Object, start uint64, len_ uint64) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.paramsEncoder.Slice(typeArgs, v, start, len_)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// EncodeUint executes the encode_uint Move function using DevInspect to get return values.
+//
+// Returns: vector<u8>
+func (d *ParamsDevInspect) EncodeUint(ctx context.Context, opts *bind.CallOpts, typeArgs []string, input bind.Object, numBytes uint64) ([]byte, error) {
+	encoded, err := d.contract.paramsEncoder.EncodeUint(typeArgs, input, numBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected []byte, got %T", results[0])
+	}
+	return result, nil
+}
+
+// VectorU8Gt executes the vector_u8_gt Move function using DevInspect to get return values.
+//
+// Returns: bool
+func (d *ParamsDevInspect) VectorU8Gt(ctx context.Context, opts *bind.CallOpts, a []byte, b []byte) (bool, error) {
+	encoded, err := d.contract.paramsEncoder.VectorU8Gt(a, b)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return false, err
+	}
+	if len(results) == 0 {
+		return false, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected return type: expected bool, got %T", results[0])
+	}
+	return result, nil
+}
+
+// GetAccountAddressAndModuleName executes the get_account_address_and_module_name Move function using DevInspect to get return values.
+//
+// Returns:
+//
+//	[0]: address
+//	[1]: 0x1::string::String
+func (d *ParamsDevInspect) GetAccountAddressAndModuleName(ctx context.Context, opts *bind.CallOpts, proofType bind.Object) ([]any, error) {
+	encoded, err := d.contract.paramsEncoder.GetAccountAddressAndModuleName(proofType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	return d.contract.Call(ctx, opts, encoded)
+}
+
+// Slice executes the slice Move function using DevInspect to get return values.
+//
+// Returns: vector<T>
+func (d *ParamsDevInspect) Slice(ctx context.Context, opts *bind.CallOpts, typeArgs []string, v []bind.Object, start uint64, len_ uint64) (any, error) {
+	encoded, err := d.contract.paramsEncoder.Slice(typeArgs, v, start, len_)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	return results[0], nil
+}
+
+type paramsEncoder struct {
+	*bind.BoundContract
+}
+
+// EncodeUint encodes a call to the encode_uint Move function.
+func (c paramsEncoder) EncodeUint(typeArgs []string, input bind.Object, numBytes uint64) (*bind.EncodedCall, error) {
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"T",
+	}
+	return c.EncodeCallArgsWithGenerics("encode_uint", typeArgsList, typeParamsList, []string{
+		"T",
+		"u64",
+	}, []any{
+		input,
+		numBytes,
+	}, []string{
+		"vector<u8>",
+	})
+}
+
+// EncodeUintWithArgs encodes a call to the encode_uint Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c paramsEncoder) EncodeUintWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"T",
+		"u64",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"T",
+	}
+	return c.EncodeCallArgsWithGenerics("encode_uint", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<u8>",
+	})
+}
+
+// RightPadVec encodes a call to the right_pad_vec Move function.
+func (c paramsEncoder) RightPadVec(v []byte, numBytes uint64) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("right_pad_vec", typeArgsList, typeParamsList, []string{
+		"&mut vector<u8>",
+		"u64",
+	}, []any{
+		v,
+		numBytes,
+	}, nil)
+}
+
+// RightPadVecWithArgs encodes a call to the right_pad_vec Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c paramsEncoder) RightPadVecWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut vector<u8>",
+		"u64",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("right_pad_vec", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// VectorU8Gt encodes a call to the vector_u8_gt Move function.
+func (c paramsEncoder) VectorU8Gt(a []byte, b []byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("vector_u8_gt", typeArgsList, typeParamsList, []string{
+		"&vector<u8>",
+		"&vector<u8>",
+	}, []any{
+		a,
+		b,
+	}, []string{
+		"bool",
+	})
+}
+
+// VectorU8GtWithArgs encodes a call to the vector_u8_gt Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c paramsEncoder) VectorU8GtWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&vector<u8>",
+		"&vector<u8>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("vector_u8_gt", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"bool",
+	})
+}
+
+// GetAccountAddressAndModuleName encodes a call to the get_account_address_and_module_name Move function.
+func (c paramsEncoder) GetAccountAddressAndModuleName(proofType bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_account_address_and_module_name", typeArgsList, typeParamsList, []string{
+		"TypeName",
+	}, []any{
+		proofType,
+	}, []string{
+		"address",
+		"0x1::string::String",
+	})
+}
+
+// GetAccountAddressAndModuleNameWithArgs encodes a call to the get_account_address_and_module_name Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c paramsEncoder) GetAccountAddressAndModuleNameWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"TypeName",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("get_account_address_and_module_name", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"address",
+		"0x1::string::String",
+	})
+}
+
+// Slice encodes a call to the slice Move function.
+func (c paramsEncoder) Slice(typeArgs []string, v []bind.Object, start uint64, len_ uint64) (*bind.EncodedCall, error) {
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"T",
+	}
+	return c.EncodeCallArgsWithGenerics("slice", typeArgsList, typeParamsList, []string{
+		"&vector<T>",
+		"u64",
+		"u64",
+	}, []any{
+		v,
+		start,
+		len_,
+	}, []string{
+		"vector<T>",
+	})
+}
+
+// SliceWithArgs encodes a call to the slice Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c paramsEncoder) SliceWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&vector<T>",
+		"u64",
+		"u64",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := typeArgs
+	typeParamsList := []string{
+		"T",
+	}
+	return c.EncodeCallArgsWithGenerics("slice", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"vector<T>",
+	})
+}
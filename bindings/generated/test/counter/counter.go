@@ -17,6 +17,7 @@ import (
 
 var (
 	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
 )
 
 type ICounter interface {
@@ -25,6 +26,8 @@ type ICounter interface {
 	Increment(ctx context.Context, opts *bind.CallOpts, counter bind.Object) (*models.SuiTransactionBlockResponse, error)
 	Decrement(ctx context.Context, opts *bind.CallOpts, counter bind.Object) (*models.SuiTransactionBlockResponse, error)
 	Create(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error)
+	CreateAndTransfer(ctx context.Context, opts *bind.CallOpts, recipient string) (*models.SuiTransactionBlockResponse, error)
+	ReceiveCounter(ctx context.Context, opts *bind.CallOpts, pointer bind.Object, sent bind.Object) (*models.SuiTransactionBlockResponse, error)
 	IncrementByOne(ctx context.Context, opts *bind.CallOpts, counter bind.Object) (*models.SuiTransactionBlockResponse, error)
 	IncrementByOneNoContext(ctx context.Context, opts *bind.CallOpts, counter bind.Object) (*models.SuiTransactionBlockResponse, error)
 	IncrementByTwo(ctx context.Context, opts *bind.CallOpts, admin bind.Object, counter bind.Object) (*models.SuiTransactionBlockResponse, error)
@@ -46,6 +49,10 @@ type ICounter interface {
 	GetVectorOfU8(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error)
 	GetVectorOfAddresses(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error)
 	GetVectorOfVectorsOfU8(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error)
+	FilterCounterIncremented(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[CounterIncremented], error)
+	WatchCounterIncremented(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[CounterIncremented]) (*bind.EventSubscription, error)
+	FilterCounterDecremented(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[CounterDecremented], error)
+	WatchCounterDecremented(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[CounterDecremented]) (*bind.EventSubscription, error)
 	DevInspect() ICounterDevInspect
 	Encoder() CounterEncoder
 	Bound() bind.IBoundContract
@@ -83,6 +90,10 @@ type CounterEncoder interface {
 	DecrementWithArgs(args ...any) (*bind.EncodedCall, error)
 	Create() (*bind.EncodedCall, error)
 	CreateWithArgs(args ...any) (*bind.EncodedCall, error)
+	CreateAndTransfer(recipient string) (*bind.EncodedCall, error)
+	CreateAndTransferWithArgs(args ...any) (*bind.EncodedCall, error)
+	ReceiveCounter(pointer bind.Object, sent bind.Object) (*bind.EncodedCall, error)
+	ReceiveCounterWithArgs(args ...any) (*bind.EncodedCall, error)
 	IncrementByOne(counter bind.Object) (*bind.EncodedCall, error)
 	IncrementByOneWithArgs(args ...any) (*bind.EncodedCall, error)
 	IncrementByOneNoContext(counter bind.Object) (*bind.EncodedCall, error)
@@ -238,6 +249,34 @@ type OCRConfig struct {
 	Transmitters []string   `move:"vector<address>"`
 }
 
+type bcsCounterIncremented struct {
+	CounterId [32]byte
+	NewValue  uint64
+}
+
+func convertCounterIncrementedFromBCS(bcs bcsCounterIncremented) (CounterIncremented, error) {
+
+	return CounterIncremented{
+		CounterId: bind.Object{Id: fmt.Sprintf("0x%x", bcs.CounterId)},
+		NewValue:  bcs.NewValue,
+	}, nil
+}
+
+type bcsCounterDecremented struct {
+	EventType string
+	CounterId [32]byte
+	NewValue  uint64
+}
+
+func convertCounterDecrementedFromBCS(bcs bcsCounterDecremented) (CounterDecremented, error) {
+
+	return CounterDecremented{
+		EventType: bcs.EventType,
+		CounterId: bind.Object{Id: fmt.Sprintf("0x%x", bcs.CounterId)},
+		NewValue:  bcs.NewValue,
+	}, nil
+}
+
 type bcsCounterPointer struct {
 	Id         string
 	CounterId  [32]byte
@@ -362,16 +401,26 @@ func init() {
 		return result, nil
 	})
 	bind.RegisterStructDecoder("test::counter::CounterIncremented", func(data []byte) (interface{}, error) {
-		var result CounterIncremented
-		_, err := mystenbcs.Unmarshal(data, &result)
+		var temp bcsCounterIncremented
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertCounterIncrementedFromBCS(temp)
 		if err != nil {
 			return nil, err
 		}
 		return result, nil
 	})
 	bind.RegisterStructDecoder("test::counter::CounterDecremented", func(data []byte) (interface{}, error) {
-		var result CounterDecremented
-		_, err := mystenbcs.Unmarshal(data, &result)
+		var temp bcsCounterDecremented
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertCounterDecrementedFromBCS(temp)
 		if err != nil {
 			return nil, err
 		}
@@ -489,6 +538,48 @@ func init() {
 	})
 }
 
+// DecodeCounterIncremented decodes the BCS of a CounterIncremented event.
+func DecodeCounterIncremented(data []byte) (CounterIncremented, error) {
+	var temp bcsCounterIncremented
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return CounterIncremented{}, err
+	}
+
+	return convertCounterIncrementedFromBCS(temp)
+}
+
+// FilterCounterIncremented returns a page of the CounterIncremented events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *CounterContract) FilterCounterIncremented(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[CounterIncremented], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "CounterIncremented", cursor, limit, DecodeCounterIncremented)
+}
+
+// WatchCounterIncremented polls the CounterIncremented events and sends them to ch until the subscription is stopped.
+func (c *CounterContract) WatchCounterIncremented(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[CounterIncremented]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "CounterIncremented", opts, ch, DecodeCounterIncremented)
+}
+
+// DecodeCounterDecremented decodes the BCS of a CounterDecremented event.
+func DecodeCounterDecremented(data []byte) (CounterDecremented, error) {
+	var temp bcsCounterDecremented
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return CounterDecremented{}, err
+	}
+
+	return convertCounterDecrementedFromBCS(temp)
+}
+
+// FilterCounterDecremented returns a page of the CounterDecremented events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *CounterContract) FilterCounterDecremented(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[CounterDecremented], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "CounterDecremented", cursor, limit, DecodeCounterDecremented)
+}
+
+// WatchCounterDecremented polls the CounterDecremented events and sends them to ch until the subscription is stopped.
+func (c *CounterContract) WatchCounterDecremented(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[CounterDecremented]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "CounterDecremented", opts, ch, DecodeCounterDecremented)
+}
+
 // Initialize executes the initialize Move function.
 func (c *CounterContract) Initialize(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.counterEncoder.Initialize()
@@ -539,6 +630,26 @@ func (c *CounterContract) Create(ctx context.Context, opts *bind.CallOpts) (*mod
 	return c.ExecuteTransaction(ctx, opts, encoded)
 }
 
+// CreateAndTransfer executes the create_and_transfer Move function.
+func (c *CounterContract) CreateAndTransfer(ctx context.Context, opts *bind.CallOpts, recipient string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.counterEncoder.CreateAndTransfer(recipient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// ReceiveCounter executes the receive_counter Move function.
+func (c *CounterContract) ReceiveCounter(ctx context.Context, opts *bind.CallOpts, pointer bind.Object, sent bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.counterEncoder.ReceiveCounter(pointer, sent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
 // IncrementByOne executes the increment_by_one Move function.
 func (c *CounterContract) IncrementByOne(ctx context.Context, opts *bind.CallOpts, counter bind.Object) (*models.SuiTransactionBlockResponse, error) {
 	encoded, err := c.counterEncoder.IncrementByOne(counter)
@@ -1263,6 +1374,61 @@ func (c counterEncoder) CreateWithArgs(args ...any) (*bind.EncodedCall, error) {
 	})
 }
 
+// CreateAndTransfer encodes a call to the create_and_transfer Move function.
+func (c counterEncoder) CreateAndTransfer(recipient string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("create_and_transfer", typeArgsList, typeParamsList, []string{
+		"address",
+	}, []any{
+		recipient,
+	}, nil)
+}
+
+// CreateAndTransferWithArgs encodes a call to the create_and_transfer Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c counterEncoder) CreateAndTransferWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"address",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("create_and_transfer", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
+// ReceiveCounter encodes a call to the receive_counter Move function.
+func (c counterEncoder) ReceiveCounter(pointer bind.Object, sent bind.Object) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("receive_counter", typeArgsList, typeParamsList, []string{
+		"&mut CounterPointer",
+		"Receiving<Counter>",
+	}, []any{
+		pointer,
+		sent,
+	}, nil)
+}
+
+// ReceiveCounterWithArgs encodes a call to the receive_counter Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c counterEncoder) ReceiveCounterWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&mut CounterPointer",
+		"Receiving<Counter>",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("receive_counter", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
+
 // IncrementByOne encodes a call to the increment_by_one Move function.
 func (c counterEncoder) IncrementByOne(counter bind.Object) (*bind.EncodedCall, error) {
 	typeArgsList := []string{}
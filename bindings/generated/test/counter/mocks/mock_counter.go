@@ -0,0 +1,1838 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated mock of the bindings and any manual changes will be lost.
+
+package mocks
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"go.uber.org/mock/gomock"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	module_counter "github.com/smartcontractkit/chainlink-sui/bindings/generated/test/counter"
+)
+
+var (
+	_ = big.NewInt
+	_ models.EventId
+)
+
+var _ module_counter.ICounter = (*MockICounter)(nil)
+var _ module_counter.ICounterDevInspect = (*MockICounterDevInspect)(nil)
+var _ module_counter.CounterEncoder = (*MockCounterEncoder)(nil)
+
+// MockICounter is a mock of the ICounter interface.
+type MockICounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockICounterMockRecorder
+}
+
+// MockICounterMockRecorder is the mock recorder for MockICounter.
+type MockICounterMockRecorder struct {
+	mock *MockICounter
+}
+
+// NewMockICounter creates a new mock instance.
+func NewMockICounter(ctrl *gomock.Controller) *MockICounter {
+	mock := &MockICounter{ctrl: ctrl}
+	mock.recorder = &MockICounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICounter) EXPECT() *MockICounterMockRecorder {
+	return m.recorder
+}
+
+// Initialize mocks base method.
+func (m *MockICounter) Initialize(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Initialize", ctx, opts)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Initialize indicates an expected call of Initialize.
+func (mr *MockICounterMockRecorder) Initialize(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockICounter)(nil).Initialize), ctx, opts)
+}
+
+// TypeAndVersion mocks base method.
+func (m *MockICounter) TypeAndVersion(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TypeAndVersion", ctx, opts)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TypeAndVersion indicates an expected call of TypeAndVersion.
+func (mr *MockICounterMockRecorder) TypeAndVersion(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TypeAndVersion", reflect.TypeOf((*MockICounter)(nil).TypeAndVersion), ctx, opts)
+}
+
+// Increment mocks base method.
+func (m *MockICounter) Increment(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Increment", ctx, opts, arg0)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockICounterMockRecorder) Increment(ctx, opts, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockICounter)(nil).Increment), ctx, opts, arg0)
+}
+
+// Decrement mocks base method.
+func (m *MockICounter) Decrement(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrement", ctx, opts, arg0)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrement indicates an expected call of Decrement.
+func (mr *MockICounterMockRecorder) Decrement(ctx, opts, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrement", reflect.TypeOf((*MockICounter)(nil).Decrement), ctx, opts, arg0)
+}
+
+// Create mocks base method.
+func (m *MockICounter) Create(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, opts)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockICounterMockRecorder) Create(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockICounter)(nil).Create), ctx, opts)
+}
+
+// CreateAndTransfer mocks base method.
+func (m *MockICounter) CreateAndTransfer(ctx context.Context, opts *bind.CallOpts, arg0 string) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAndTransfer", ctx, opts, arg0)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAndTransfer indicates an expected call of CreateAndTransfer.
+func (mr *MockICounterMockRecorder) CreateAndTransfer(ctx, opts, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndTransfer", reflect.TypeOf((*MockICounter)(nil).CreateAndTransfer), ctx, opts, arg0)
+}
+
+// ReceiveCounter mocks base method.
+func (m *MockICounter) ReceiveCounter(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object, arg1 bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveCounter", ctx, opts, arg0, arg1)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveCounter indicates an expected call of ReceiveCounter.
+func (mr *MockICounterMockRecorder) ReceiveCounter(ctx, opts, arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveCounter", reflect.TypeOf((*MockICounter)(nil).ReceiveCounter), ctx, opts, arg0, arg1)
+}
+
+// IncrementByOne mocks base method.
+func (m *MockICounter) IncrementByOne(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementByOne", ctx, opts, arg0)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByOne indicates an expected call of IncrementByOne.
+func (mr *MockICounterMockRecorder) IncrementByOne(ctx, opts, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByOne", reflect.TypeOf((*MockICounter)(nil).IncrementByOne), ctx, opts, arg0)
+}
+
+// IncrementByOneNoContext mocks base method.
+func (m *MockICounter) IncrementByOneNoContext(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementByOneNoContext", ctx, opts, arg0)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByOneNoContext indicates an expected call of IncrementByOneNoContext.
+func (mr *MockICounterMockRecorder) IncrementByOneNoContext(ctx, opts, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByOneNoContext", reflect.TypeOf((*MockICounter)(nil).IncrementByOneNoContext), ctx, opts, arg0)
+}
+
+// IncrementByTwo mocks base method.
+func (m *MockICounter) IncrementByTwo(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object, arg1 bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementByTwo", ctx, opts, arg0, arg1)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByTwo indicates an expected call of IncrementByTwo.
+func (mr *MockICounterMockRecorder) IncrementByTwo(ctx, opts, arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByTwo", reflect.TypeOf((*MockICounter)(nil).IncrementByTwo), ctx, opts, arg0, arg1)
+}
+
+// IncrementByTwoNoContext mocks base method.
+func (m *MockICounter) IncrementByTwoNoContext(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object, arg1 bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementByTwoNoContext", ctx, opts, arg0, arg1)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByTwoNoContext indicates an expected call of IncrementByTwoNoContext.
+func (mr *MockICounterMockRecorder) IncrementByTwoNoContext(ctx, opts, arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByTwoNoContext", reflect.TypeOf((*MockICounter)(nil).IncrementByTwoNoContext), ctx, opts, arg0, arg1)
+}
+
+// IncrementBy mocks base method.
+func (m *MockICounter) IncrementBy(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object, arg1 uint64) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementBy", ctx, opts, arg0, arg1)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementBy indicates an expected call of IncrementBy.
+func (mr *MockICounterMockRecorder) IncrementBy(ctx, opts, arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementBy", reflect.TypeOf((*MockICounter)(nil).IncrementBy), ctx, opts, arg0, arg1)
+}
+
+// IncrementMult mocks base method.
+func (m *MockICounter) IncrementMult(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object, arg1 uint64, arg2 uint64) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementMult", ctx, opts, arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementMult indicates an expected call of IncrementMult.
+func (mr *MockICounterMockRecorder) IncrementMult(ctx, opts, arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementMult", reflect.TypeOf((*MockICounter)(nil).IncrementMult), ctx, opts, arg0, arg1, arg2)
+}
+
+// IncrementByBytesLength mocks base method.
+func (m *MockICounter) IncrementByBytesLength(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object, arg1 []byte) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementByBytesLength", ctx, opts, arg0, arg1)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByBytesLength indicates an expected call of IncrementByBytesLength.
+func (mr *MockICounterMockRecorder) IncrementByBytesLength(ctx, opts, arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByBytesLength", reflect.TypeOf((*MockICounter)(nil).IncrementByBytesLength), ctx, opts, arg0, arg1)
+}
+
+// GetCount mocks base method.
+func (m *MockICounter) GetCount(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCount", ctx, opts, arg0)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCount indicates an expected call of GetCount.
+func (mr *MockICounterMockRecorder) GetCount(ctx, opts, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCount", reflect.TypeOf((*MockICounter)(nil).GetCount), ctx, opts, arg0)
+}
+
+// GetCountUsingPointer mocks base method.
+func (m *MockICounter) GetCountUsingPointer(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCountUsingPointer", ctx, opts, arg0)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCountUsingPointer indicates an expected call of GetCountUsingPointer.
+func (mr *MockICounterMockRecorder) GetCountUsingPointer(ctx, opts, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountUsingPointer", reflect.TypeOf((*MockICounter)(nil).GetCountUsingPointer), ctx, opts, arg0)
+}
+
+// GetCountNoEntry mocks base method.
+func (m *MockICounter) GetCountNoEntry(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCountNoEntry", ctx, opts, arg0)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCountNoEntry indicates an expected call of GetCountNoEntry.
+func (mr *MockICounterMockRecorder) GetCountNoEntry(ctx, opts, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountNoEntry", reflect.TypeOf((*MockICounter)(nil).GetCountNoEntry), ctx, opts, arg0)
+}
+
+// GetCoinValue mocks base method.
+func (m *MockICounter) GetCoinValue(ctx context.Context, opts *bind.CallOpts, typeArgs []string, arg0 bind.Object) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoinValue", ctx, opts, typeArgs, arg0)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoinValue indicates an expected call of GetCoinValue.
+func (mr *MockICounterMockRecorder) GetCoinValue(ctx, opts, typeArgs, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoinValue", reflect.TypeOf((*MockICounter)(nil).GetCoinValue), ctx, opts, typeArgs, arg0)
+}
+
+// GetAddressList mocks base method.
+func (m *MockICounter) GetAddressList(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddressList", ctx, opts)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressList indicates an expected call of GetAddressList.
+func (mr *MockICounterMockRecorder) GetAddressList(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressList", reflect.TypeOf((*MockICounter)(nil).GetAddressList), ctx, opts)
+}
+
+// GetSimpleResult mocks base method.
+func (m *MockICounter) GetSimpleResult(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSimpleResult", ctx, opts)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSimpleResult indicates an expected call of GetSimpleResult.
+func (mr *MockICounterMockRecorder) GetSimpleResult(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSimpleResult", reflect.TypeOf((*MockICounter)(nil).GetSimpleResult), ctx, opts)
+}
+
+// GetResultStruct mocks base method.
+func (m *MockICounter) GetResultStruct(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResultStruct", ctx, opts)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResultStruct indicates an expected call of GetResultStruct.
+func (mr *MockICounterMockRecorder) GetResultStruct(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResultStruct", reflect.TypeOf((*MockICounter)(nil).GetResultStruct), ctx, opts)
+}
+
+// GetNestedResultStruct mocks base method.
+func (m *MockICounter) GetNestedResultStruct(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNestedResultStruct", ctx, opts)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNestedResultStruct indicates an expected call of GetNestedResultStruct.
+func (mr *MockICounterMockRecorder) GetNestedResultStruct(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNestedResultStruct", reflect.TypeOf((*MockICounter)(nil).GetNestedResultStruct), ctx, opts)
+}
+
+// GetMultiNestedResultStruct mocks base method.
+func (m *MockICounter) GetMultiNestedResultStruct(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultiNestedResultStruct", ctx, opts)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultiNestedResultStruct indicates an expected call of GetMultiNestedResultStruct.
+func (mr *MockICounterMockRecorder) GetMultiNestedResultStruct(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultiNestedResultStruct", reflect.TypeOf((*MockICounter)(nil).GetMultiNestedResultStruct), ctx, opts)
+}
+
+// GetTupleStruct mocks base method.
+func (m *MockICounter) GetTupleStruct(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTupleStruct", ctx, opts)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTupleStruct indicates an expected call of GetTupleStruct.
+func (mr *MockICounterMockRecorder) GetTupleStruct(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTupleStruct", reflect.TypeOf((*MockICounter)(nil).GetTupleStruct), ctx, opts)
+}
+
+// GetOcrConfig mocks base method.
+func (m *MockICounter) GetOcrConfig(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOcrConfig", ctx, opts)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOcrConfig indicates an expected call of GetOcrConfig.
+func (mr *MockICounterMockRecorder) GetOcrConfig(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOcrConfig", reflect.TypeOf((*MockICounter)(nil).GetOcrConfig), ctx, opts)
+}
+
+// GetVectorOfU8 mocks base method.
+func (m *MockICounter) GetVectorOfU8(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVectorOfU8", ctx, opts)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVectorOfU8 indicates an expected call of GetVectorOfU8.
+func (mr *MockICounterMockRecorder) GetVectorOfU8(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVectorOfU8", reflect.TypeOf((*MockICounter)(nil).GetVectorOfU8), ctx, opts)
+}
+
+// GetVectorOfAddresses mocks base method.
+func (m *MockICounter) GetVectorOfAddresses(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVectorOfAddresses", ctx, opts)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVectorOfAddresses indicates an expected call of GetVectorOfAddresses.
+func (mr *MockICounterMockRecorder) GetVectorOfAddresses(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVectorOfAddresses", reflect.TypeOf((*MockICounter)(nil).GetVectorOfAddresses), ctx, opts)
+}
+
+// GetVectorOfVectorsOfU8 mocks base method.
+func (m *MockICounter) GetVectorOfVectorsOfU8(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVectorOfVectorsOfU8", ctx, opts)
+	ret0, _ := ret[0].(*models.SuiTransactionBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVectorOfVectorsOfU8 indicates an expected call of GetVectorOfVectorsOfU8.
+func (mr *MockICounterMockRecorder) GetVectorOfVectorsOfU8(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVectorOfVectorsOfU8", reflect.TypeOf((*MockICounter)(nil).GetVectorOfVectorsOfU8), ctx, opts)
+}
+
+// FilterCounterIncremented mocks base method.
+func (m *MockICounter) FilterCounterIncremented(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[module_counter.CounterIncremented], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterCounterIncremented", ctx, cursor, limit)
+	ret0, _ := ret[0].(*bind.EventPage[module_counter.CounterIncremented])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterCounterIncremented indicates an expected call of FilterCounterIncremented.
+func (mr *MockICounterMockRecorder) FilterCounterIncremented(ctx, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterCounterIncremented", reflect.TypeOf((*MockICounter)(nil).FilterCounterIncremented), ctx, cursor, limit)
+}
+
+// WatchCounterIncremented mocks base method.
+func (m *MockICounter) WatchCounterIncremented(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[module_counter.CounterIncremented]) (*bind.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchCounterIncremented", ctx, opts, ch)
+	ret0, _ := ret[0].(*bind.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchCounterIncremented indicates an expected call of WatchCounterIncremented.
+func (mr *MockICounterMockRecorder) WatchCounterIncremented(ctx, opts, ch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchCounterIncremented", reflect.TypeOf((*MockICounter)(nil).WatchCounterIncremented), ctx, opts, ch)
+}
+
+// FilterCounterDecremented mocks base method.
+func (m *MockICounter) FilterCounterDecremented(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[module_counter.CounterDecremented], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterCounterDecremented", ctx, cursor, limit)
+	ret0, _ := ret[0].(*bind.EventPage[module_counter.CounterDecremented])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterCounterDecremented indicates an expected call of FilterCounterDecremented.
+func (mr *MockICounterMockRecorder) FilterCounterDecremented(ctx, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterCounterDecremented", reflect.TypeOf((*MockICounter)(nil).FilterCounterDecremented), ctx, cursor, limit)
+}
+
+// WatchCounterDecremented mocks base method.
+func (m *MockICounter) WatchCounterDecremented(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[module_counter.CounterDecremented]) (*bind.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchCounterDecremented", ctx, opts, ch)
+	ret0, _ := ret[0].(*bind.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchCounterDecremented indicates an expected call of WatchCounterDecremented.
+func (mr *MockICounterMockRecorder) WatchCounterDecremented(ctx, opts, ch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchCounterDecremented", reflect.TypeOf((*MockICounter)(nil).WatchCounterDecremented), ctx, opts, ch)
+}
+
+// DevInspect mocks base method.
+func (m *MockICounter) DevInspect() module_counter.ICounterDevInspect {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DevInspect")
+	ret0, _ := ret[0].(module_counter.ICounterDevInspect)
+	return ret0
+}
+
+// DevInspect indicates an expected call of DevInspect.
+func (mr *MockICounterMockRecorder) DevInspect() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DevInspect", reflect.TypeOf((*MockICounter)(nil).DevInspect))
+}
+
+// Encoder mocks base method.
+func (m *MockICounter) Encoder() module_counter.CounterEncoder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encoder")
+	ret0, _ := ret[0].(module_counter.CounterEncoder)
+	return ret0
+}
+
+// Encoder indicates an expected call of Encoder.
+func (mr *MockICounterMockRecorder) Encoder() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encoder", reflect.TypeOf((*MockICounter)(nil).Encoder))
+}
+
+// Bound mocks base method.
+func (m *MockICounter) Bound() bind.IBoundContract {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bound")
+	ret0, _ := ret[0].(bind.IBoundContract)
+	return ret0
+}
+
+// Bound indicates an expected call of Bound.
+func (mr *MockICounterMockRecorder) Bound() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bound", reflect.TypeOf((*MockICounter)(nil).Bound))
+}
+
+// MockICounterDevInspect is a mock of the ICounterDevInspect interface.
+type MockICounterDevInspect struct {
+	ctrl     *gomock.Controller
+	recorder *MockICounterDevInspectMockRecorder
+}
+
+// MockICounterDevInspectMockRecorder is the mock recorder for MockICounterDevInspect.
+type MockICounterDevInspectMockRecorder struct {
+	mock *MockICounterDevInspect
+}
+
+// NewMockICounterDevInspect creates a new mock instance.
+func NewMockICounterDevInspect(ctrl *gomock.Controller) *MockICounterDevInspect {
+	mock := &MockICounterDevInspect{ctrl: ctrl}
+	mock.recorder = &MockICounterDevInspectMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICounterDevInspect) EXPECT() *MockICounterDevInspectMockRecorder {
+	return m.recorder
+}
+
+// TypeAndVersion mocks base method.
+func (m *MockICounterDevInspect) TypeAndVersion(ctx context.Context, opts *bind.CallOpts) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TypeAndVersion", ctx, opts)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TypeAndVersion indicates an expected call of TypeAndVersion.
+func (mr *MockICounterDevInspectMockRecorder) TypeAndVersion(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TypeAndVersion", reflect.TypeOf((*MockICounterDevInspect)(nil).TypeAndVersion), ctx, opts)
+}
+
+// Create mocks base method.
+func (m *MockICounterDevInspect) Create(ctx context.Context, opts *bind.CallOpts) (bind.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, opts)
+	ret0, _ := ret[0].(bind.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockICounterDevInspectMockRecorder) Create(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockICounterDevInspect)(nil).Create), ctx, opts)
+}
+
+// IncrementByOne mocks base method.
+func (m *MockICounterDevInspect) IncrementByOne(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementByOne", ctx, opts, arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByOne indicates an expected call of IncrementByOne.
+func (mr *MockICounterDevInspectMockRecorder) IncrementByOne(ctx, opts, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByOne", reflect.TypeOf((*MockICounterDevInspect)(nil).IncrementByOne), ctx, opts, arg0)
+}
+
+// IncrementByOneNoContext mocks base method.
+func (m *MockICounterDevInspect) IncrementByOneNoContext(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementByOneNoContext", ctx, opts, arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByOneNoContext indicates an expected call of IncrementByOneNoContext.
+func (mr *MockICounterDevInspectMockRecorder) IncrementByOneNoContext(ctx, opts, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByOneNoContext", reflect.TypeOf((*MockICounterDevInspect)(nil).IncrementByOneNoContext), ctx, opts, arg0)
+}
+
+// GetCount mocks base method.
+func (m *MockICounterDevInspect) GetCount(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCount", ctx, opts, arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCount indicates an expected call of GetCount.
+func (mr *MockICounterDevInspectMockRecorder) GetCount(ctx, opts, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCount", reflect.TypeOf((*MockICounterDevInspect)(nil).GetCount), ctx, opts, arg0)
+}
+
+// GetCountUsingPointer mocks base method.
+func (m *MockICounterDevInspect) GetCountUsingPointer(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCountUsingPointer", ctx, opts, arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCountUsingPointer indicates an expected call of GetCountUsingPointer.
+func (mr *MockICounterDevInspectMockRecorder) GetCountUsingPointer(ctx, opts, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountUsingPointer", reflect.TypeOf((*MockICounterDevInspect)(nil).GetCountUsingPointer), ctx, opts, arg0)
+}
+
+// GetCountNoEntry mocks base method.
+func (m *MockICounterDevInspect) GetCountNoEntry(ctx context.Context, opts *bind.CallOpts, arg0 bind.Object) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCountNoEntry", ctx, opts, arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCountNoEntry indicates an expected call of GetCountNoEntry.
+func (mr *MockICounterDevInspectMockRecorder) GetCountNoEntry(ctx, opts, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountNoEntry", reflect.TypeOf((*MockICounterDevInspect)(nil).GetCountNoEntry), ctx, opts, arg0)
+}
+
+// GetCoinValue mocks base method.
+func (m *MockICounterDevInspect) GetCoinValue(ctx context.Context, opts *bind.CallOpts, typeArgs []string, arg0 bind.Object) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoinValue", ctx, opts, typeArgs, arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoinValue indicates an expected call of GetCoinValue.
+func (mr *MockICounterDevInspectMockRecorder) GetCoinValue(ctx, opts, typeArgs, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoinValue", reflect.TypeOf((*MockICounterDevInspect)(nil).GetCoinValue), ctx, opts, typeArgs, arg0)
+}
+
+// GetAddressList mocks base method.
+func (m *MockICounterDevInspect) GetAddressList(ctx context.Context, opts *bind.CallOpts) (module_counter.AddressList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddressList", ctx, opts)
+	ret0, _ := ret[0].(module_counter.AddressList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressList indicates an expected call of GetAddressList.
+func (mr *MockICounterDevInspectMockRecorder) GetAddressList(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressList", reflect.TypeOf((*MockICounterDevInspect)(nil).GetAddressList), ctx, opts)
+}
+
+// GetSimpleResult mocks base method.
+func (m *MockICounterDevInspect) GetSimpleResult(ctx context.Context, opts *bind.CallOpts) (module_counter.SimpleResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSimpleResult", ctx, opts)
+	ret0, _ := ret[0].(module_counter.SimpleResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSimpleResult indicates an expected call of GetSimpleResult.
+func (mr *MockICounterDevInspectMockRecorder) GetSimpleResult(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSimpleResult", reflect.TypeOf((*MockICounterDevInspect)(nil).GetSimpleResult), ctx, opts)
+}
+
+// GetResultStruct mocks base method.
+func (m *MockICounterDevInspect) GetResultStruct(ctx context.Context, opts *bind.CallOpts) (module_counter.ComplexResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResultStruct", ctx, opts)
+	ret0, _ := ret[0].(module_counter.ComplexResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResultStruct indicates an expected call of GetResultStruct.
+func (mr *MockICounterDevInspectMockRecorder) GetResultStruct(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResultStruct", reflect.TypeOf((*MockICounterDevInspect)(nil).GetResultStruct), ctx, opts)
+}
+
+// GetNestedResultStruct mocks base method.
+func (m *MockICounterDevInspect) GetNestedResultStruct(ctx context.Context, opts *bind.CallOpts) (module_counter.NestedStruct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNestedResultStruct", ctx, opts)
+	ret0, _ := ret[0].(module_counter.NestedStruct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNestedResultStruct indicates an expected call of GetNestedResultStruct.
+func (mr *MockICounterDevInspectMockRecorder) GetNestedResultStruct(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNestedResultStruct", reflect.TypeOf((*MockICounterDevInspect)(nil).GetNestedResultStruct), ctx, opts)
+}
+
+// GetMultiNestedResultStruct mocks base method.
+func (m *MockICounterDevInspect) GetMultiNestedResultStruct(ctx context.Context, opts *bind.CallOpts) (module_counter.MultiNestedStruct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultiNestedResultStruct", ctx, opts)
+	ret0, _ := ret[0].(module_counter.MultiNestedStruct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultiNestedResultStruct indicates an expected call of GetMultiNestedResultStruct.
+func (mr *MockICounterDevInspectMockRecorder) GetMultiNestedResultStruct(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultiNestedResultStruct", reflect.TypeOf((*MockICounterDevInspect)(nil).GetMultiNestedResultStruct), ctx, opts)
+}
+
+// GetTupleStruct mocks base method.
+func (m *MockICounterDevInspect) GetTupleStruct(ctx context.Context, opts *bind.CallOpts) ([]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTupleStruct", ctx, opts)
+	ret0, _ := ret[0].([]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTupleStruct indicates an expected call of GetTupleStruct.
+func (mr *MockICounterDevInspectMockRecorder) GetTupleStruct(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTupleStruct", reflect.TypeOf((*MockICounterDevInspect)(nil).GetTupleStruct), ctx, opts)
+}
+
+// GetOcrConfig mocks base method.
+func (m *MockICounterDevInspect) GetOcrConfig(ctx context.Context, opts *bind.CallOpts) (module_counter.OCRConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOcrConfig", ctx, opts)
+	ret0, _ := ret[0].(module_counter.OCRConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOcrConfig indicates an expected call of GetOcrConfig.
+func (mr *MockICounterDevInspectMockRecorder) GetOcrConfig(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOcrConfig", reflect.TypeOf((*MockICounterDevInspect)(nil).GetOcrConfig), ctx, opts)
+}
+
+// GetVectorOfU8 mocks base method.
+func (m *MockICounterDevInspect) GetVectorOfU8(ctx context.Context, opts *bind.CallOpts) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVectorOfU8", ctx, opts)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVectorOfU8 indicates an expected call of GetVectorOfU8.
+func (mr *MockICounterDevInspectMockRecorder) GetVectorOfU8(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVectorOfU8", reflect.TypeOf((*MockICounterDevInspect)(nil).GetVectorOfU8), ctx, opts)
+}
+
+// GetVectorOfAddresses mocks base method.
+func (m *MockICounterDevInspect) GetVectorOfAddresses(ctx context.Context, opts *bind.CallOpts) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVectorOfAddresses", ctx, opts)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVectorOfAddresses indicates an expected call of GetVectorOfAddresses.
+func (mr *MockICounterDevInspectMockRecorder) GetVectorOfAddresses(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVectorOfAddresses", reflect.TypeOf((*MockICounterDevInspect)(nil).GetVectorOfAddresses), ctx, opts)
+}
+
+// GetVectorOfVectorsOfU8 mocks base method.
+func (m *MockICounterDevInspect) GetVectorOfVectorsOfU8(ctx context.Context, opts *bind.CallOpts) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVectorOfVectorsOfU8", ctx, opts)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVectorOfVectorsOfU8 indicates an expected call of GetVectorOfVectorsOfU8.
+func (mr *MockICounterDevInspectMockRecorder) GetVectorOfVectorsOfU8(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVectorOfVectorsOfU8", reflect.TypeOf((*MockICounterDevInspect)(nil).GetVectorOfVectorsOfU8), ctx, opts)
+}
+
+// MockCounterEncoder is a mock of the CounterEncoder interface.
+type MockCounterEncoder struct {
+	ctrl     *gomock.Controller
+	recorder *MockCounterEncoderMockRecorder
+}
+
+// MockCounterEncoderMockRecorder is the mock recorder for MockCounterEncoder.
+type MockCounterEncoderMockRecorder struct {
+	mock *MockCounterEncoder
+}
+
+// NewMockCounterEncoder creates a new mock instance.
+func NewMockCounterEncoder(ctrl *gomock.Controller) *MockCounterEncoder {
+	mock := &MockCounterEncoder{ctrl: ctrl}
+	mock.recorder = &MockCounterEncoderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCounterEncoder) EXPECT() *MockCounterEncoderMockRecorder {
+	return m.recorder
+}
+
+// Initialize mocks base method.
+func (m *MockCounterEncoder) Initialize() (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Initialize")
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Initialize indicates an expected call of Initialize.
+func (mr *MockCounterEncoderMockRecorder) Initialize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockCounterEncoder)(nil).Initialize))
+}
+
+// InitializeWithArgs mocks base method.
+func (m *MockCounterEncoder) InitializeWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "InitializeWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InitializeWithArgs indicates an expected call of InitializeWithArgs.
+func (mr *MockCounterEncoderMockRecorder) InitializeWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitializeWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).InitializeWithArgs), varargs...)
+}
+
+// TypeAndVersion mocks base method.
+func (m *MockCounterEncoder) TypeAndVersion() (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TypeAndVersion")
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TypeAndVersion indicates an expected call of TypeAndVersion.
+func (mr *MockCounterEncoderMockRecorder) TypeAndVersion() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TypeAndVersion", reflect.TypeOf((*MockCounterEncoder)(nil).TypeAndVersion))
+}
+
+// TypeAndVersionWithArgs mocks base method.
+func (m *MockCounterEncoder) TypeAndVersionWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "TypeAndVersionWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TypeAndVersionWithArgs indicates an expected call of TypeAndVersionWithArgs.
+func (mr *MockCounterEncoderMockRecorder) TypeAndVersionWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TypeAndVersionWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).TypeAndVersionWithArgs), varargs...)
+}
+
+// Increment mocks base method.
+func (m *MockCounterEncoder) Increment(arg0 bind.Object) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Increment", arg0)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockCounterEncoderMockRecorder) Increment(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockCounterEncoder)(nil).Increment), arg0)
+}
+
+// IncrementWithArgs mocks base method.
+func (m *MockCounterEncoder) IncrementWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "IncrementWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementWithArgs indicates an expected call of IncrementWithArgs.
+func (mr *MockCounterEncoderMockRecorder) IncrementWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementWithArgs), varargs...)
+}
+
+// Decrement mocks base method.
+func (m *MockCounterEncoder) Decrement(arg0 bind.Object) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrement", arg0)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrement indicates an expected call of Decrement.
+func (mr *MockCounterEncoderMockRecorder) Decrement(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrement", reflect.TypeOf((*MockCounterEncoder)(nil).Decrement), arg0)
+}
+
+// DecrementWithArgs mocks base method.
+func (m *MockCounterEncoder) DecrementWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "DecrementWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecrementWithArgs indicates an expected call of DecrementWithArgs.
+func (mr *MockCounterEncoderMockRecorder) DecrementWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecrementWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).DecrementWithArgs), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockCounterEncoder) Create() (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create")
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCounterEncoderMockRecorder) Create() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCounterEncoder)(nil).Create))
+}
+
+// CreateWithArgs mocks base method.
+func (m *MockCounterEncoder) CreateWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "CreateWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithArgs indicates an expected call of CreateWithArgs.
+func (mr *MockCounterEncoderMockRecorder) CreateWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).CreateWithArgs), varargs...)
+}
+
+// CreateAndTransfer mocks base method.
+func (m *MockCounterEncoder) CreateAndTransfer(arg0 string) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAndTransfer", arg0)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAndTransfer indicates an expected call of CreateAndTransfer.
+func (mr *MockCounterEncoderMockRecorder) CreateAndTransfer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndTransfer", reflect.TypeOf((*MockCounterEncoder)(nil).CreateAndTransfer), arg0)
+}
+
+// CreateAndTransferWithArgs mocks base method.
+func (m *MockCounterEncoder) CreateAndTransferWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "CreateAndTransferWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAndTransferWithArgs indicates an expected call of CreateAndTransferWithArgs.
+func (mr *MockCounterEncoderMockRecorder) CreateAndTransferWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndTransferWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).CreateAndTransferWithArgs), varargs...)
+}
+
+// ReceiveCounter mocks base method.
+func (m *MockCounterEncoder) ReceiveCounter(arg0 bind.Object, arg1 bind.Object) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveCounter", arg0, arg1)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveCounter indicates an expected call of ReceiveCounter.
+func (mr *MockCounterEncoderMockRecorder) ReceiveCounter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveCounter", reflect.TypeOf((*MockCounterEncoder)(nil).ReceiveCounter), arg0, arg1)
+}
+
+// ReceiveCounterWithArgs mocks base method.
+func (m *MockCounterEncoder) ReceiveCounterWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "ReceiveCounterWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveCounterWithArgs indicates an expected call of ReceiveCounterWithArgs.
+func (mr *MockCounterEncoderMockRecorder) ReceiveCounterWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveCounterWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).ReceiveCounterWithArgs), varargs...)
+}
+
+// IncrementByOne mocks base method.
+func (m *MockCounterEncoder) IncrementByOne(arg0 bind.Object) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementByOne", arg0)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByOne indicates an expected call of IncrementByOne.
+func (mr *MockCounterEncoderMockRecorder) IncrementByOne(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByOne", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementByOne), arg0)
+}
+
+// IncrementByOneWithArgs mocks base method.
+func (m *MockCounterEncoder) IncrementByOneWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "IncrementByOneWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByOneWithArgs indicates an expected call of IncrementByOneWithArgs.
+func (mr *MockCounterEncoderMockRecorder) IncrementByOneWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByOneWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementByOneWithArgs), varargs...)
+}
+
+// IncrementByOneNoContext mocks base method.
+func (m *MockCounterEncoder) IncrementByOneNoContext(arg0 bind.Object) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementByOneNoContext", arg0)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByOneNoContext indicates an expected call of IncrementByOneNoContext.
+func (mr *MockCounterEncoderMockRecorder) IncrementByOneNoContext(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByOneNoContext", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementByOneNoContext), arg0)
+}
+
+// IncrementByOneNoContextWithArgs mocks base method.
+func (m *MockCounterEncoder) IncrementByOneNoContextWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "IncrementByOneNoContextWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByOneNoContextWithArgs indicates an expected call of IncrementByOneNoContextWithArgs.
+func (mr *MockCounterEncoderMockRecorder) IncrementByOneNoContextWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByOneNoContextWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementByOneNoContextWithArgs), varargs...)
+}
+
+// IncrementByTwo mocks base method.
+func (m *MockCounterEncoder) IncrementByTwo(arg0 bind.Object, arg1 bind.Object) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementByTwo", arg0, arg1)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByTwo indicates an expected call of IncrementByTwo.
+func (mr *MockCounterEncoderMockRecorder) IncrementByTwo(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByTwo", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementByTwo), arg0, arg1)
+}
+
+// IncrementByTwoWithArgs mocks base method.
+func (m *MockCounterEncoder) IncrementByTwoWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "IncrementByTwoWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByTwoWithArgs indicates an expected call of IncrementByTwoWithArgs.
+func (mr *MockCounterEncoderMockRecorder) IncrementByTwoWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByTwoWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementByTwoWithArgs), varargs...)
+}
+
+// IncrementByTwoNoContext mocks base method.
+func (m *MockCounterEncoder) IncrementByTwoNoContext(arg0 bind.Object, arg1 bind.Object) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementByTwoNoContext", arg0, arg1)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByTwoNoContext indicates an expected call of IncrementByTwoNoContext.
+func (mr *MockCounterEncoderMockRecorder) IncrementByTwoNoContext(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByTwoNoContext", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementByTwoNoContext), arg0, arg1)
+}
+
+// IncrementByTwoNoContextWithArgs mocks base method.
+func (m *MockCounterEncoder) IncrementByTwoNoContextWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "IncrementByTwoNoContextWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByTwoNoContextWithArgs indicates an expected call of IncrementByTwoNoContextWithArgs.
+func (mr *MockCounterEncoderMockRecorder) IncrementByTwoNoContextWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByTwoNoContextWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementByTwoNoContextWithArgs), varargs...)
+}
+
+// IncrementBy mocks base method.
+func (m *MockCounterEncoder) IncrementBy(arg0 bind.Object, arg1 uint64) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementBy", arg0, arg1)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementBy indicates an expected call of IncrementBy.
+func (mr *MockCounterEncoderMockRecorder) IncrementBy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementBy", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementBy), arg0, arg1)
+}
+
+// IncrementByWithArgs mocks base method.
+func (m *MockCounterEncoder) IncrementByWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "IncrementByWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByWithArgs indicates an expected call of IncrementByWithArgs.
+func (mr *MockCounterEncoderMockRecorder) IncrementByWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementByWithArgs), varargs...)
+}
+
+// IncrementMult mocks base method.
+func (m *MockCounterEncoder) IncrementMult(arg0 bind.Object, arg1 uint64, arg2 uint64) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementMult", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementMult indicates an expected call of IncrementMult.
+func (mr *MockCounterEncoderMockRecorder) IncrementMult(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementMult", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementMult), arg0, arg1, arg2)
+}
+
+// IncrementMultWithArgs mocks base method.
+func (m *MockCounterEncoder) IncrementMultWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "IncrementMultWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementMultWithArgs indicates an expected call of IncrementMultWithArgs.
+func (mr *MockCounterEncoderMockRecorder) IncrementMultWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementMultWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementMultWithArgs), varargs...)
+}
+
+// IncrementByBytesLength mocks base method.
+func (m *MockCounterEncoder) IncrementByBytesLength(arg0 bind.Object, arg1 []byte) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementByBytesLength", arg0, arg1)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByBytesLength indicates an expected call of IncrementByBytesLength.
+func (mr *MockCounterEncoderMockRecorder) IncrementByBytesLength(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByBytesLength", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementByBytesLength), arg0, arg1)
+}
+
+// IncrementByBytesLengthWithArgs mocks base method.
+func (m *MockCounterEncoder) IncrementByBytesLengthWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "IncrementByBytesLengthWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementByBytesLengthWithArgs indicates an expected call of IncrementByBytesLengthWithArgs.
+func (mr *MockCounterEncoderMockRecorder) IncrementByBytesLengthWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementByBytesLengthWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).IncrementByBytesLengthWithArgs), varargs...)
+}
+
+// GetCount mocks base method.
+func (m *MockCounterEncoder) GetCount(arg0 bind.Object) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCount", arg0)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCount indicates an expected call of GetCount.
+func (mr *MockCounterEncoderMockRecorder) GetCount(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCount", reflect.TypeOf((*MockCounterEncoder)(nil).GetCount), arg0)
+}
+
+// GetCountWithArgs mocks base method.
+func (m *MockCounterEncoder) GetCountWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "GetCountWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCountWithArgs indicates an expected call of GetCountWithArgs.
+func (mr *MockCounterEncoderMockRecorder) GetCountWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).GetCountWithArgs), varargs...)
+}
+
+// GetCountUsingPointer mocks base method.
+func (m *MockCounterEncoder) GetCountUsingPointer(arg0 bind.Object) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCountUsingPointer", arg0)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCountUsingPointer indicates an expected call of GetCountUsingPointer.
+func (mr *MockCounterEncoderMockRecorder) GetCountUsingPointer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountUsingPointer", reflect.TypeOf((*MockCounterEncoder)(nil).GetCountUsingPointer), arg0)
+}
+
+// GetCountUsingPointerWithArgs mocks base method.
+func (m *MockCounterEncoder) GetCountUsingPointerWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "GetCountUsingPointerWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCountUsingPointerWithArgs indicates an expected call of GetCountUsingPointerWithArgs.
+func (mr *MockCounterEncoderMockRecorder) GetCountUsingPointerWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountUsingPointerWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).GetCountUsingPointerWithArgs), varargs...)
+}
+
+// GetCountNoEntry mocks base method.
+func (m *MockCounterEncoder) GetCountNoEntry(arg0 bind.Object) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCountNoEntry", arg0)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCountNoEntry indicates an expected call of GetCountNoEntry.
+func (mr *MockCounterEncoderMockRecorder) GetCountNoEntry(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountNoEntry", reflect.TypeOf((*MockCounterEncoder)(nil).GetCountNoEntry), arg0)
+}
+
+// GetCountNoEntryWithArgs mocks base method.
+func (m *MockCounterEncoder) GetCountNoEntryWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "GetCountNoEntryWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCountNoEntryWithArgs indicates an expected call of GetCountNoEntryWithArgs.
+func (mr *MockCounterEncoderMockRecorder) GetCountNoEntryWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountNoEntryWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).GetCountNoEntryWithArgs), varargs...)
+}
+
+// GetCoinValue mocks base method.
+func (m *MockCounterEncoder) GetCoinValue(typeArgs []string, arg0 bind.Object) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoinValue", typeArgs, arg0)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoinValue indicates an expected call of GetCoinValue.
+func (mr *MockCounterEncoderMockRecorder) GetCoinValue(typeArgs, arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoinValue", reflect.TypeOf((*MockCounterEncoder)(nil).GetCoinValue), typeArgs, arg0)
+}
+
+// GetCoinValueWithArgs mocks base method.
+func (m *MockCounterEncoder) GetCoinValueWithArgs(typeArgs []string, args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{typeArgs}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "GetCoinValueWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoinValueWithArgs indicates an expected call of GetCoinValueWithArgs.
+func (mr *MockCounterEncoderMockRecorder) GetCoinValueWithArgs(typeArgs any, args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{typeArgs}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoinValueWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).GetCoinValueWithArgs), varargs...)
+}
+
+// GetAddressList mocks base method.
+func (m *MockCounterEncoder) GetAddressList() (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddressList")
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressList indicates an expected call of GetAddressList.
+func (mr *MockCounterEncoderMockRecorder) GetAddressList() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressList", reflect.TypeOf((*MockCounterEncoder)(nil).GetAddressList))
+}
+
+// GetAddressListWithArgs mocks base method.
+func (m *MockCounterEncoder) GetAddressListWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "GetAddressListWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressListWithArgs indicates an expected call of GetAddressListWithArgs.
+func (mr *MockCounterEncoderMockRecorder) GetAddressListWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressListWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).GetAddressListWithArgs), varargs...)
+}
+
+// GetSimpleResult mocks base method.
+func (m *MockCounterEncoder) GetSimpleResult() (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSimpleResult")
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSimpleResult indicates an expected call of GetSimpleResult.
+func (mr *MockCounterEncoderMockRecorder) GetSimpleResult() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSimpleResult", reflect.TypeOf((*MockCounterEncoder)(nil).GetSimpleResult))
+}
+
+// GetSimpleResultWithArgs mocks base method.
+func (m *MockCounterEncoder) GetSimpleResultWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "GetSimpleResultWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSimpleResultWithArgs indicates an expected call of GetSimpleResultWithArgs.
+func (mr *MockCounterEncoderMockRecorder) GetSimpleResultWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSimpleResultWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).GetSimpleResultWithArgs), varargs...)
+}
+
+// GetResultStruct mocks base method.
+func (m *MockCounterEncoder) GetResultStruct() (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResultStruct")
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResultStruct indicates an expected call of GetResultStruct.
+func (mr *MockCounterEncoderMockRecorder) GetResultStruct() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResultStruct", reflect.TypeOf((*MockCounterEncoder)(nil).GetResultStruct))
+}
+
+// GetResultStructWithArgs mocks base method.
+func (m *MockCounterEncoder) GetResultStructWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "GetResultStructWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResultStructWithArgs indicates an expected call of GetResultStructWithArgs.
+func (mr *MockCounterEncoderMockRecorder) GetResultStructWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResultStructWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).GetResultStructWithArgs), varargs...)
+}
+
+// GetNestedResultStruct mocks base method.
+func (m *MockCounterEncoder) GetNestedResultStruct() (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNestedResultStruct")
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNestedResultStruct indicates an expected call of GetNestedResultStruct.
+func (mr *MockCounterEncoderMockRecorder) GetNestedResultStruct() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNestedResultStruct", reflect.TypeOf((*MockCounterEncoder)(nil).GetNestedResultStruct))
+}
+
+// GetNestedResultStructWithArgs mocks base method.
+func (m *MockCounterEncoder) GetNestedResultStructWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "GetNestedResultStructWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNestedResultStructWithArgs indicates an expected call of GetNestedResultStructWithArgs.
+func (mr *MockCounterEncoderMockRecorder) GetNestedResultStructWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNestedResultStructWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).GetNestedResultStructWithArgs), varargs...)
+}
+
+// GetMultiNestedResultStruct mocks base method.
+func (m *MockCounterEncoder) GetMultiNestedResultStruct() (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultiNestedResultStruct")
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultiNestedResultStruct indicates an expected call of GetMultiNestedResultStruct.
+func (mr *MockCounterEncoderMockRecorder) GetMultiNestedResultStruct() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultiNestedResultStruct", reflect.TypeOf((*MockCounterEncoder)(nil).GetMultiNestedResultStruct))
+}
+
+// GetMultiNestedResultStructWithArgs mocks base method.
+func (m *MockCounterEncoder) GetMultiNestedResultStructWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "GetMultiNestedResultStructWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultiNestedResultStructWithArgs indicates an expected call of GetMultiNestedResultStructWithArgs.
+func (mr *MockCounterEncoderMockRecorder) GetMultiNestedResultStructWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultiNestedResultStructWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).GetMultiNestedResultStructWithArgs), varargs...)
+}
+
+// GetTupleStruct mocks base method.
+func (m *MockCounterEncoder) GetTupleStruct() (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTupleStruct")
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTupleStruct indicates an expected call of GetTupleStruct.
+func (mr *MockCounterEncoderMockRecorder) GetTupleStruct() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTupleStruct", reflect.TypeOf((*MockCounterEncoder)(nil).GetTupleStruct))
+}
+
+// GetTupleStructWithArgs mocks base method.
+func (m *MockCounterEncoder) GetTupleStructWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "GetTupleStructWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTupleStructWithArgs indicates an expected call of GetTupleStructWithArgs.
+func (mr *MockCounterEncoderMockRecorder) GetTupleStructWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTupleStructWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).GetTupleStructWithArgs), varargs...)
+}
+
+// GetOcrConfig mocks base method.
+func (m *MockCounterEncoder) GetOcrConfig() (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOcrConfig")
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOcrConfig indicates an expected call of GetOcrConfig.
+func (mr *MockCounterEncoderMockRecorder) GetOcrConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOcrConfig", reflect.TypeOf((*MockCounterEncoder)(nil).GetOcrConfig))
+}
+
+// GetOcrConfigWithArgs mocks base method.
+func (m *MockCounterEncoder) GetOcrConfigWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "GetOcrConfigWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOcrConfigWithArgs indicates an expected call of GetOcrConfigWithArgs.
+func (mr *MockCounterEncoderMockRecorder) GetOcrConfigWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOcrConfigWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).GetOcrConfigWithArgs), varargs...)
+}
+
+// GetVectorOfU8 mocks base method.
+func (m *MockCounterEncoder) GetVectorOfU8() (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVectorOfU8")
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVectorOfU8 indicates an expected call of GetVectorOfU8.
+func (mr *MockCounterEncoderMockRecorder) GetVectorOfU8() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVectorOfU8", reflect.TypeOf((*MockCounterEncoder)(nil).GetVectorOfU8))
+}
+
+// GetVectorOfU8WithArgs mocks base method.
+func (m *MockCounterEncoder) GetVectorOfU8WithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "GetVectorOfU8WithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVectorOfU8WithArgs indicates an expected call of GetVectorOfU8WithArgs.
+func (mr *MockCounterEncoderMockRecorder) GetVectorOfU8WithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVectorOfU8WithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).GetVectorOfU8WithArgs), varargs...)
+}
+
+// GetVectorOfAddresses mocks base method.
+func (m *MockCounterEncoder) GetVectorOfAddresses() (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVectorOfAddresses")
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVectorOfAddresses indicates an expected call of GetVectorOfAddresses.
+func (mr *MockCounterEncoderMockRecorder) GetVectorOfAddresses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVectorOfAddresses", reflect.TypeOf((*MockCounterEncoder)(nil).GetVectorOfAddresses))
+}
+
+// GetVectorOfAddressesWithArgs mocks base method.
+func (m *MockCounterEncoder) GetVectorOfAddressesWithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "GetVectorOfAddressesWithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVectorOfAddressesWithArgs indicates an expected call of GetVectorOfAddressesWithArgs.
+func (mr *MockCounterEncoderMockRecorder) GetVectorOfAddressesWithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVectorOfAddressesWithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).GetVectorOfAddressesWithArgs), varargs...)
+}
+
+// GetVectorOfVectorsOfU8 mocks base method.
+func (m *MockCounterEncoder) GetVectorOfVectorsOfU8() (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVectorOfVectorsOfU8")
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVectorOfVectorsOfU8 indicates an expected call of GetVectorOfVectorsOfU8.
+func (mr *MockCounterEncoderMockRecorder) GetVectorOfVectorsOfU8() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVectorOfVectorsOfU8", reflect.TypeOf((*MockCounterEncoder)(nil).GetVectorOfVectorsOfU8))
+}
+
+// GetVectorOfVectorsOfU8WithArgs mocks base method.
+func (m *MockCounterEncoder) GetVectorOfVectorsOfU8WithArgs(args ...any) (*bind.EncodedCall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	ret := m.ctrl.Call(m, "GetVectorOfVectorsOfU8WithArgs", varargs...)
+	ret0, _ := ret[0].(*bind.EncodedCall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVectorOfVectorsOfU8WithArgs indicates an expected call of GetVectorOfVectorsOfU8WithArgs.
+func (mr *MockCounterEncoderMockRecorder) GetVectorOfVectorsOfU8WithArgs(args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := []any{}
+	varargs = append(varargs, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVectorOfVectorsOfU8WithArgs", reflect.TypeOf((*MockCounterEncoder)(nil).GetVectorOfVectorsOfU8WithArgs), varargs...)
+}
@@ -0,0 +1,672 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package module_enums
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+)
+
+var (
+	_ = big.NewInt
+	_ = mystenbcs.Unmarshal
+)
+
+type IEnums interface {
+	Active(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error)
+	Paused(ctx context.Context, opts *bind.CallOpts, until uint64, reason string) (*models.SuiTransactionBlockResponse, error)
+	Migrated(ctx context.Context, opts *bind.CallOpts, to string, version byte) (*models.SuiTransactionBlockResponse, error)
+	Config(ctx context.Context, opts *bind.CallOpts, owner string, until uint64, reason string) (*models.SuiTransactionBlockResponse, error)
+	IsActive(ctx context.Context, opts *bind.CallOpts, status Status) (*models.SuiTransactionBlockResponse, error)
+	Pause(ctx context.Context, opts *bind.CallOpts, until uint64, reason string) (*models.SuiTransactionBlockResponse, error)
+	FilterStatusChanged(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[StatusChanged], error)
+	WatchStatusChanged(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[StatusChanged]) (*bind.EventSubscription, error)
+	DevInspect() IEnumsDevInspect
+	Encoder() EnumsEncoder
+	Bound() bind.IBoundContract
+}
+
+type IEnumsDevInspect interface {
+	Active(ctx context.Context, opts *bind.CallOpts) (Status, error)
+	Paused(ctx context.Context, opts *bind.CallOpts, until uint64, reason string) (Status, error)
+	Migrated(ctx context.Context, opts *bind.CallOpts, to string, version byte) (Status, error)
+	Config(ctx context.Context, opts *bind.CallOpts, owner string, until uint64, reason string) (Config, error)
+	IsActive(ctx context.Context, opts *bind.CallOpts, status Status) (bool, error)
+}
+
+type EnumsEncoder interface {
+	Active() (*bind.EncodedCall, error)
+	ActiveWithArgs(args ...any) (*bind.EncodedCall, error)
+	Paused(until uint64, reason string) (*bind.EncodedCall, error)
+	PausedWithArgs(args ...any) (*bind.EncodedCall, error)
+	Migrated(to string, version byte) (*bind.EncodedCall, error)
+	MigratedWithArgs(args ...any) (*bind.EncodedCall, error)
+	Config(owner string, until uint64, reason string) (*bind.EncodedCall, error)
+	ConfigWithArgs(args ...any) (*bind.EncodedCall, error)
+	IsActive(status Status) (*bind.EncodedCall, error)
+	IsActiveWithArgs(args ...any) (*bind.EncodedCall, error)
+	Pause(until uint64, reason string) (*bind.EncodedCall, error)
+	PauseWithArgs(args ...any) (*bind.EncodedCall, error)
+}
+
+type EnumsContract struct {
+	*bind.BoundContract
+	enumsEncoder
+	devInspect *EnumsDevInspect
+}
+
+type EnumsDevInspect struct {
+	contract *EnumsContract
+}
+
+var _ IEnums = (*EnumsContract)(nil)
+var _ IEnumsDevInspect = (*EnumsDevInspect)(nil)
+
+func NewEnums(packageID string, client sui.ISuiAPI) (IEnums, error) {
+	contract, err := bind.NewBoundContract(packageID, "test", "enums", client)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &EnumsContract{
+		BoundContract: contract,
+		enumsEncoder:  enumsEncoder{BoundContract: contract},
+	}
+	c.devInspect = &EnumsDevInspect{contract: c}
+	return c, nil
+}
+
+func (c *EnumsContract) Bound() bind.IBoundContract {
+	return c.BoundContract
+}
+
+func (c *EnumsContract) Encoder() EnumsEncoder {
+	return c.enumsEncoder
+}
+
+func (c *EnumsContract) DevInspect() IEnumsDevInspect {
+	return c.devInspect
+}
+
+type Config struct {
+	Owner  string `move:"address"`
+	Status Status `move:"Status"`
+}
+
+type StatusChanged struct {
+	Previous Status `move:"Status"`
+	Current  Status `move:"Status"`
+}
+
+// Status is the test::enums::Status Move enum, one of StatusActive, StatusPaused, StatusMigrated.
+type Status interface {
+	bind.EnumVariant
+	isStatus()
+}
+
+type StatusActive struct {
+}
+
+func (StatusActive) isStatus() {}
+
+// MoveVariant returns the BCS tag and the name of the Active variant of Status.
+func (StatusActive) MoveVariant() (uint32, string) {
+	return 0, "Active"
+}
+
+// MarshalBCS encodes the variant as a Status, its tag followed by its fields.
+func (v StatusActive) MarshalBCS() ([]byte, error) {
+	return bind.EncodeEnumVariant(v)
+}
+
+// MarshalJSON encodes the variant as the chain reader represents enums.
+func (v StatusActive) MarshalJSON() ([]byte, error) {
+	return bind.MarshalEnumVariantJSON(v)
+}
+
+type StatusPaused struct {
+	Until  uint64 `move:"u64" json:"until"`
+	Reason string `move:"0x1::string::String" json:"reason"`
+}
+
+func (StatusPaused) isStatus() {}
+
+// MoveVariant returns the BCS tag and the name of the Paused variant of Status.
+func (StatusPaused) MoveVariant() (uint32, string) {
+	return 1, "Paused"
+}
+
+// MarshalBCS encodes the variant as a Status, its tag followed by its fields.
+func (v StatusPaused) MarshalBCS() ([]byte, error) {
+	return bind.EncodeEnumVariant(v)
+}
+
+// MarshalJSON encodes the variant as the chain reader represents enums.
+func (v StatusPaused) MarshalJSON() ([]byte, error) {
+	return bind.MarshalEnumVariantJSON(v)
+}
+
+type StatusMigrated struct {
+	Pos0 string `move:"address" json:"pos0"`
+	Pos1 byte   `move:"u8" json:"pos1"`
+}
+
+func (StatusMigrated) isStatus() {}
+
+// MoveVariant returns the BCS tag and the name of the Migrated variant of Status.
+func (StatusMigrated) MoveVariant() (uint32, string) {
+	return 2, "Migrated"
+}
+
+// MarshalBCS encodes the variant as a Status, its tag followed by its fields.
+func (v StatusMigrated) MarshalBCS() ([]byte, error) {
+	return bind.EncodeEnumVariant(v)
+}
+
+// MarshalJSON encodes the variant as the chain reader represents enums.
+func (v StatusMigrated) MarshalJSON() ([]byte, error) {
+	return bind.MarshalEnumVariantJSON(v)
+}
+
+type bcsConfig struct {
+	Owner  [32]byte
+	Status bcsStatus
+}
+
+func convertConfigFromBCS(bcs bcsConfig) (Config, error) {
+	StatusField, err := convertStatusFromBCS(bcs.Status)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to convert nested struct Status: %w", err)
+	}
+
+	return Config{
+		Owner:  fmt.Sprintf("0x%x", bcs.Owner),
+		Status: StatusField,
+	}, nil
+}
+
+type bcsStatusChanged struct {
+	Previous bcsStatus
+	Current  bcsStatus
+}
+
+func convertStatusChangedFromBCS(bcs bcsStatusChanged) (StatusChanged, error) {
+	PreviousField, err := convertStatusFromBCS(bcs.Previous)
+	if err != nil {
+		return StatusChanged{}, fmt.Errorf("failed to convert nested struct Previous: %w", err)
+	}
+	CurrentField, err := convertStatusFromBCS(bcs.Current)
+	if err != nil {
+		return StatusChanged{}, fmt.Errorf("failed to convert nested struct Current: %w", err)
+	}
+
+	return StatusChanged{
+		Previous: PreviousField,
+		Current:  CurrentField,
+	}, nil
+}
+
+// bcsStatus decodes a Status, mystenbcs setting the field of the variant at the index of the BCS tag.
+type bcsStatus struct {
+	Active   *StatusActive
+	Paused   *StatusPaused
+	Migrated *bcsStatusMigrated
+}
+
+func (bcsStatus) IsBcsEnum() {}
+
+func convertStatusFromBCS(bcs bcsStatus) (Status, error) {
+	switch {
+	case bcs.Active != nil:
+		return *bcs.Active, nil
+	case bcs.Paused != nil:
+		return *bcs.Paused, nil
+	case bcs.Migrated != nil:
+		return convertStatusMigratedFromBCS(*bcs.Migrated)
+	}
+
+	return nil, fmt.Errorf("no variant of Status decoded")
+}
+
+type bcsStatusMigrated struct {
+	Pos0 [32]byte
+	Pos1 byte
+}
+
+func convertStatusMigratedFromBCS(bcs bcsStatusMigrated) (StatusMigrated, error) {
+
+	return StatusMigrated{
+		Pos0: fmt.Sprintf("0x%x", bcs.Pos0),
+		Pos1: bcs.Pos1,
+	}, nil
+}
+
+func init() {
+	bind.RegisterStructDecoder("test::enums::Config", func(data []byte) (interface{}, error) {
+		var temp bcsConfig
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertConfigFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("test::enums::StatusChanged", func(data []byte) (interface{}, error) {
+		var temp bcsStatusChanged
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertStatusChangedFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+	bind.RegisterStructDecoder("test::enums::Status", func(data []byte) (interface{}, error) {
+		var temp bcsStatus
+		_, err := mystenbcs.Unmarshal(data, &temp)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := convertStatusFromBCS(temp)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
+// DecodeStatusChanged decodes the BCS of a StatusChanged event.
+func DecodeStatusChanged(data []byte) (StatusChanged, error) {
+	var temp bcsStatusChanged
+	if _, err := mystenbcs.Unmarshal(data, &temp); err != nil {
+		return StatusChanged{}, err
+	}
+
+	return convertStatusChangedFromBCS(temp)
+}
+
+// FilterStatusChanged returns a page of the StatusChanged events emitted after cursor, oldest first. A nil cursor starts
+// from the first event, and the NextCursor of the page resumes the query.
+func (c *EnumsContract) FilterStatusChanged(ctx context.Context, cursor *models.EventId, limit uint64) (*bind.EventPage[StatusChanged], error) {
+	return bind.FilterEvents(ctx, c.BoundContract, "StatusChanged", cursor, limit, DecodeStatusChanged)
+}
+
+// WatchStatusChanged polls the StatusChanged events and sends them to ch until the subscription is stopped.
+func (c *EnumsContract) WatchStatusChanged(ctx context.Context, opts *bind.WatchOpts, ch chan<- *bind.Event[StatusChanged]) (*bind.EventSubscription, error) {
+	return bind.WatchEvents(ctx, c.BoundContract, "StatusChanged", opts, ch, DecodeStatusChanged)
+}
+
+// Active executes the active Move function.
+func (c *EnumsContract) Active(ctx context.Context, opts *bind.CallOpts) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.enumsEncoder.Active()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// Paused executes the paused Move function.
+func (c *EnumsContract) Paused(ctx context.Context, opts *bind.CallOpts, until uint64, reason string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.enumsEncoder.Paused(until, reason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// Migrated executes the migrated Move function.
+func (c *EnumsContract) Migrated(ctx context.Context, opts *bind.CallOpts, to string, version byte) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.enumsEncoder.Migrated(to, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// Config executes the config Move function.
+func (c *EnumsContract) Config(ctx context.Context, opts *bind.CallOpts, owner string, until uint64, reason string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.enumsEncoder.Config(owner, until, reason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// IsActive executes the is_active Move function.
+func (c *EnumsContract) IsActive(ctx context.Context, opts *bind.CallOpts, status Status) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.enumsEncoder.IsActive(status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// Pause executes the pause Move function.
+func (c *EnumsContract) Pause(ctx context.Context, opts *bind.CallOpts, until uint64, reason string) (*models.SuiTransactionBlockResponse, error) {
+	encoded, err := c.enumsEncoder.Pause(until, reason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	return c.ExecuteTransaction(ctx, opts, encoded)
+}
+
+// Active executes the active Move function using DevInspect to get return values.
+//
+// Returns: Status
+func (d *EnumsDevInspect) Active(ctx context.Context, opts *bind.CallOpts) (Status, error) {
+	encoded, err := d.contract.enumsEncoder.Active()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(Status)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected Status, got %T", results[0])
+	}
+	return result, nil
+}
+
+// Paused executes the paused Move function using DevInspect to get return values.
+//
+// Returns: Status
+func (d *EnumsDevInspect) Paused(ctx context.Context, opts *bind.CallOpts, until uint64, reason string) (Status, error) {
+	encoded, err := d.contract.enumsEncoder.Paused(until, reason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(Status)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected Status, got %T", results[0])
+	}
+	return result, nil
+}
+
+// Migrated executes the migrated Move function using DevInspect to get return values.
+//
+// Returns: Status
+func (d *EnumsDevInspect) Migrated(ctx context.Context, opts *bind.CallOpts, to string, version byte) (Status, error) {
+	encoded, err := d.contract.enumsEncoder.Migrated(to, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(Status)
+	if !ok {
+		return nil, fmt.Errorf("unexpected return type: expected Status, got %T", results[0])
+	}
+	return result, nil
+}
+
+// Config executes the config Move function using DevInspect to get return values.
+//
+// Returns: Config
+func (d *EnumsDevInspect) Config(ctx context.Context, opts *bind.CallOpts, owner string, until uint64, reason string) (Config, error) {
+	encoded, err := d.contract.enumsEncoder.Config(owner, until, reason)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return Config{}, err
+	}
+	if len(results) == 0 {
+		return Config{}, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(Config)
+	if !ok {
+		return Config{}, fmt.Errorf("unexpected return type: expected Config, got %T", results[0])
+	}
+	return result, nil
+}
+
+// IsActive executes the is_active Move function using DevInspect to get return values.
+//
+// Returns: bool
+func (d *EnumsDevInspect) IsActive(ctx context.Context, opts *bind.CallOpts, status Status) (bool, error) {
+	encoded, err := d.contract.enumsEncoder.IsActive(status)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode function call: %w", err)
+	}
+	results, err := d.contract.Call(ctx, opts, encoded)
+	if err != nil {
+		return false, err
+	}
+	if len(results) == 0 {
+		return false, fmt.Errorf("no return value")
+	}
+	result, ok := results[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected return type: expected bool, got %T", results[0])
+	}
+	return result, nil
+}
+
+type enumsEncoder struct {
+	*bind.BoundContract
+}
+
+// Active encodes a call to the active Move function.
+func (c enumsEncoder) Active() (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("active", typeArgsList, typeParamsList, []string{}, []any{}, []string{
+		"test::enums::Status",
+	})
+}
+
+// ActiveWithArgs encodes a call to the active Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c enumsEncoder) ActiveWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("active", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"test::enums::Status",
+	})
+}
+
+// Paused encodes a call to the paused Move function.
+func (c enumsEncoder) Paused(until uint64, reason string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("paused", typeArgsList, typeParamsList, []string{
+		"u64",
+		"0x1::string::String",
+	}, []any{
+		until,
+		reason,
+	}, []string{
+		"test::enums::Status",
+	})
+}
+
+// PausedWithArgs encodes a call to the paused Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c enumsEncoder) PausedWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"u64",
+		"0x1::string::String",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("paused", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"test::enums::Status",
+	})
+}
+
+// Migrated encodes a call to the migrated Move function.
+func (c enumsEncoder) Migrated(to string, version byte) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("migrated", typeArgsList, typeParamsList, []string{
+		"address",
+		"u8",
+	}, []any{
+		to,
+		version,
+	}, []string{
+		"test::enums::Status",
+	})
+}
+
+// MigratedWithArgs encodes a call to the migrated Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c enumsEncoder) MigratedWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"address",
+		"u8",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("migrated", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"test::enums::Status",
+	})
+}
+
+// Config encodes a call to the config Move function.
+func (c enumsEncoder) Config(owner string, until uint64, reason string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("config", typeArgsList, typeParamsList, []string{
+		"address",
+		"u64",
+		"0x1::string::String",
+	}, []any{
+		owner,
+		until,
+		reason,
+	}, []string{
+		"test::enums::Config",
+	})
+}
+
+// ConfigWithArgs encodes a call to the config Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c enumsEncoder) ConfigWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"address",
+		"u64",
+		"0x1::string::String",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("config", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"test::enums::Config",
+	})
+}
+
+// IsActive encodes a call to the is_active Move function.
+func (c enumsEncoder) IsActive(status Status) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("is_active", typeArgsList, typeParamsList, []string{
+		"&Status",
+	}, []any{
+		status,
+	}, []string{
+		"bool",
+	})
+}
+
+// IsActiveWithArgs encodes a call to the is_active Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c enumsEncoder) IsActiveWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"&Status",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("is_active", typeArgsList, typeParamsList, expectedParams, args, []string{
+		"bool",
+	})
+}
+
+// Pause encodes a call to the pause Move function.
+func (c enumsEncoder) Pause(until uint64, reason string) (*bind.EncodedCall, error) {
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("pause", typeArgsList, typeParamsList, []string{
+		"u64",
+		"0x1::string::String",
+	}, []any{
+		until,
+		reason,
+	}, nil)
+}
+
+// PauseWithArgs encodes a call to the pause Move function using arbitrary arguments.
+// This method allows passing both regular values and transaction.Argument values for PTB chaining.
+func (c enumsEncoder) PauseWithArgs(args ...any) (*bind.EncodedCall, error) {
+	expectedParams := []string{
+		"u64",
+		"0x1::string::String",
+	}
+
+	if len(args) != len(expectedParams) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(expectedParams), len(args))
+	}
+	typeArgsList := []string{}
+	typeParamsList := []string{}
+	return c.EncodeCallArgsWithGenerics("pause", typeArgsList, typeParamsList, expectedParams, args, nil)
+}
@@ -0,0 +1,135 @@
+package module_enums
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
+)
+
+const owner = "0x00000000000000000000000000000000000000000000000000000000000000a1"
+
+// devInspectResults wraps BCS values as the results of a DevInspect call returning them.
+func devInspectResults(t *testing.T, values ...[]byte) json.RawMessage {
+	t.Helper()
+
+	returnValues := make([][]any, len(values))
+	for i, value := range values {
+		// []byte would be marshalled as base64, return values are arrays of numbers
+		numbers := make([]int, len(value))
+		for j, b := range value {
+			numbers[j] = int(b)
+		}
+		returnValues[i] = []any{numbers, "type"}
+	}
+	raw, err := json.Marshal([]bind.DevInspectResult{{ReturnValues: returnValues}})
+	require.NoError(t, err)
+
+	return raw
+}
+
+func TestStatusBCS(t *testing.T) {
+	variants := []Status{
+		StatusActive{},
+		StatusPaused{Until: 1700000000, Reason: "upgrade"},
+		StatusMigrated{Pos0: owner, Pos1: 3},
+	}
+
+	for _, variant := range variants {
+		_, name := variant.MoveVariant()
+		t.Run(name, func(t *testing.T) {
+			encoded, err := mystenbcs.Marshal(variant)
+			require.NoError(t, err)
+			tag, _ := variant.MoveVariant()
+			assert.Equal(t, byte(tag), encoded[0])
+
+			decoded, err := bind.DecodeDevInspectResults(devInspectResults(t, encoded), []string{"test::enums::Status"}, nil)
+			require.NoError(t, err)
+			assert.Equal(t, variant, decoded[0])
+		})
+	}
+
+	t.Run("Variant fields", func(t *testing.T) {
+		encoded, err := mystenbcs.Marshal(StatusMigrated{Pos0: owner, Pos1: 3})
+		require.NoError(t, err)
+
+		expected := append([]byte{2}, make([]byte, 31)...)
+		expected = append(expected, 0xa1, 3)
+		assert.Equal(t, expected, encoded)
+	})
+
+	t.Run("Unknown variant", func(t *testing.T) {
+		_, err := bind.DecodeDevInspectResults(devInspectResults(t, []byte{3}), []string{"test::enums::Status"}, nil)
+		require.ErrorContains(t, err, "malformed BCS data")
+	})
+}
+
+func TestStructsWithEnums(t *testing.T) {
+	paused := StatusPaused{Until: 42, Reason: "maintenance"}
+
+	t.Run("Config", func(t *testing.T) {
+		ownerBytes := append(make([]byte, 31), 0xa1)
+		status, err := mystenbcs.Marshal(paused)
+		require.NoError(t, err)
+
+		decoded, err := bind.DecodeDevInspectResults(devInspectResults(t, append(ownerBytes, status...)), []string{"test::enums::Config"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, Config{Owner: owner, Status: paused}, decoded[0])
+	})
+
+	t.Run("StatusChanged event", func(t *testing.T) {
+		encoded, err := mystenbcs.Marshal(StatusChanged{Previous: StatusActive{}, Current: paused})
+		require.NoError(t, err)
+
+		event, err := DecodeStatusChanged(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, StatusChanged{Previous: StatusActive{}, Current: paused}, event)
+	})
+}
+
+func TestStatusJSON(t *testing.T) {
+	// the JSON of the variants matches the chain reader representation of enums decoded from their layout
+	registry := movebcs.NewRegistry()
+	registry.Add("0xa", "enums", "Status", &movebcs.Layout{Variants: []movebcs.Variant{
+		{Name: "Active"},
+		{Name: "Paused", Fields: []movebcs.Field{
+			{Name: "until", Type: movebcs.Type{Kind: movebcs.KindU64}},
+			{Name: "reason", Type: movebcs.Struct("0x1", "string", "String")},
+		}},
+		{Name: "Migrated", Fields: []movebcs.Field{
+			{Name: "pos0", Type: movebcs.Type{Kind: movebcs.KindAddress}},
+			{Name: "pos1", Type: movebcs.Type{Kind: movebcs.KindU8}},
+		}},
+	}})
+
+	tests := []struct {
+		variant  Status
+		expected string
+	}{
+		{StatusActive{}, `{"fields":{},"variant":"Active"}`},
+		{StatusPaused{Until: 42, Reason: "maintenance"}, `{"fields":{"reason":"maintenance","until":"42"},"variant":"Paused"}`},
+		{StatusMigrated{Pos0: owner, Pos1: 3}, fmt.Sprintf(`{"fields":{"pos0":"%s","pos1":3},"variant":"Migrated"}`, owner)},
+	}
+	for _, tt := range tests {
+		_, name := tt.variant.MoveVariant()
+		t.Run(name, func(t *testing.T) {
+			encoded, err := json.Marshal(tt.variant)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(encoded))
+
+			bcsBytes, err := mystenbcs.Marshal(tt.variant)
+			require.NoError(t, err)
+			value, err := registry.Decode(bcsBytes, movebcs.Struct("0xa", "enums", "Status"))
+			require.NoError(t, err)
+			chainReader, err := json.Marshal(movebcs.ToJSON(value))
+			require.NoError(t, err)
+			assert.JSONEq(t, string(chainReader), string(encoded))
+		})
+	}
+}
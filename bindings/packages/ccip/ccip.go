@@ -111,7 +111,7 @@ func PublishCCIP(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI, m
 		return nil, nil, err
 	}
 
-	packageId, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
+	result, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
 		CompiledModules: artifact.Modules,
 		Dependencies:    artifact.Dependencies,
 	})
@@ -119,7 +119,37 @@ func PublishCCIP(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI, m
 		return nil, nil, err
 	}
 
-	contract, err := NewCCIP(packageId, client)
+	contract, err := NewCCIP(result.PackageId, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return contract, tx, nil
+}
+
+// UpgradeCCIP upgrades the package at packageId with the current sources, authorized by the authorizer,
+// and returns the new version of the package.
+func UpgradeCCIP(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI, authorizer bind.UpgradeAuthorizer, packageId string, policy bind.UpgradePolicy, mcmsAddress string, mcmsOwner string) (CCIP, *models.SuiTransactionBlockResponse, error) {
+	artifact, err := bind.CompilePackage(contracts.CCIP, map[string]string{
+		"mcms":       mcmsAddress,
+		"mcms_owner": mcmsOwner,
+		"ccip":       "0x0",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, tx, err := bind.UpgradePackage(ctx, opts, client, bind.UpgradeRequest{
+		CompiledModules: artifact.Modules,
+		Dependencies:    artifact.Dependencies,
+		PackageId:       packageId,
+		Policy:          policy,
+	}, authorizer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contract, err := NewCCIP(result.PackageId, client)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -63,7 +63,7 @@ func PublishCCIPBurnMintTokenPool(
 		return nil, nil, err
 	}
 
-	packageId, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
+	result, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
 		CompiledModules: artifact.Modules,
 		Dependencies:    artifact.Dependencies,
 	})
@@ -71,7 +71,7 @@ func PublishCCIPBurnMintTokenPool(
 		return nil, nil, err
 	}
 
-	contract, err := NewCCIPBurnMintTokenPool(packageId, client)
+	contract, err := NewCCIPBurnMintTokenPool(result.PackageId, client)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -64,7 +64,7 @@ func PublishCCIPLockReleaseTokenPool(
 		return nil, nil, fmt.Errorf("failed to compile package: %w", err)
 	}
 
-	packageId, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
+	result, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
 		CompiledModules: artifact.Modules,
 		Dependencies:    artifact.Dependencies,
 	})
@@ -72,7 +72,7 @@ func PublishCCIPLockReleaseTokenPool(
 		return nil, nil, fmt.Errorf("failed to publish package: %w", err)
 	}
 
-	contract, err := NewCCIPLockReleaseTokenPool(packageId, client)
+	contract, err := NewCCIPLockReleaseTokenPool(result.PackageId, client)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -65,7 +65,7 @@ func PublishCCIPManagedTokenPool(
 		return nil, nil, err
 	}
 
-	packageId, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
+	result, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
 		CompiledModules: artifact.Modules,
 		Dependencies:    artifact.Dependencies,
 	})
@@ -73,7 +73,7 @@ func PublishCCIPManagedTokenPool(
 		return nil, nil, err
 	}
 
-	contract, err := NewCCIPManagedTokenPool(packageId, client)
+	contract, err := NewCCIPManagedTokenPool(result.PackageId, client)
 	if err != nil {
 		return nil, nil, err
 	}
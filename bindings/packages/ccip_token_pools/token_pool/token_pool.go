@@ -55,7 +55,7 @@ func PublishCCIPTokenPool(ctx context.Context, opts *bind.CallOpts, client sui.I
 		return nil, nil, err
 	}
 
-	packageId, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
+	result, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
 		CompiledModules: artifact.Modules,
 		Dependencies:    artifact.Dependencies,
 	})
@@ -63,7 +63,38 @@ func PublishCCIPTokenPool(ctx context.Context, opts *bind.CallOpts, client sui.I
 		return nil, nil, err
 	}
 
-	contract, err := NewCCIPTokenPool(packageId, client)
+	contract, err := NewCCIPTokenPool(result.PackageId, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return contract, tx, nil
+}
+
+// UpgradeCCIPTokenPool upgrades the package at packageId with the current sources, authorized by the authorizer,
+// and returns the new version of the package.
+func UpgradeCCIPTokenPool(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI, authorizer bind.UpgradeAuthorizer, packageId string, policy bind.UpgradePolicy, ccipAddress, mcmsAddress, mcmsOwner string) (TokenPool, *models.SuiTransactionBlockResponse, error) {
+	artifact, err := bind.CompilePackage(contracts.CCIPTokenPool, map[string]string{
+		"ccip":            ccipAddress,
+		"ccip_token_pool": "0x0",
+		"mcms":            mcmsAddress,
+		"mcms_owner":      mcmsOwner,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, tx, err := bind.UpgradePackage(ctx, opts, client, bind.UpgradeRequest{
+		CompiledModules: artifact.Modules,
+		Dependencies:    artifact.Dependencies,
+		PackageId:       packageId,
+		Policy:          policy,
+	}, authorizer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contract, err := NewCCIPTokenPool(result.PackageId, client)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -57,7 +57,7 @@ func PublishLink(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI) (
 		return nil, nil, err
 	}
 
-	packageId, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
+	result, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
 		CompiledModules: artifact.Modules,
 		Dependencies:    artifact.Dependencies,
 	})
@@ -65,7 +65,7 @@ func PublishLink(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI) (
 		return nil, nil, err
 	}
 
-	contract, err := NewLink(packageId, client)
+	contract, err := NewLink(result.PackageId, client)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -64,7 +64,7 @@ func PublishCCIPManagedToken(
 		return nil, nil, err
 	}
 
-	packageId, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
+	result, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
 		CompiledModules: artifact.Modules,
 		Dependencies:    artifact.Dependencies,
 	})
@@ -72,7 +72,7 @@ func PublishCCIPManagedToken(
 		return nil, nil, err
 	}
 
-	contract, err := NewCCIPManagedToken(packageId, client)
+	contract, err := NewCCIPManagedToken(result.PackageId, client)
 	if err != nil {
 		return nil, nil, err
 	}
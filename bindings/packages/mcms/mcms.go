@@ -58,7 +58,7 @@ func PublishMCMS(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI) (
 		return nil, nil, err
 	}
 
-	packageId, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
+	result, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
 		CompiledModules: artifact.Modules,
 		Dependencies:    artifact.Dependencies,
 	})
@@ -66,7 +66,7 @@ func PublishMCMS(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI) (
 		return nil, nil, err
 	}
 
-	contract, err := NewMCMS(packageId, client)
+	contract, err := NewMCMS(result.PackageId, client)
 	if err != nil {
 		return nil, nil, err
 	}
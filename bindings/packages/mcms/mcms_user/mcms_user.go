@@ -59,7 +59,7 @@ func PublishMCMSUser(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAP
 		return nil, nil, err
 	}
 
-	packageId, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
+	result, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
 		CompiledModules: artifact.Modules,
 		Dependencies:    artifact.Dependencies,
 	})
@@ -67,7 +67,7 @@ func PublishMCMSUser(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAP
 		return nil, nil, err
 	}
 
-	contract, err := NewMCMSUser(packageId, client)
+	contract, err := NewMCMSUser(result.PackageId, client)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,148 @@
+package mcms
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/block-vision/sui-go-sdk/mystenbcs"
+	"github.com/block-vision/sui-go-sdk/sui"
+	"github.com/block-vision/sui-go-sdk/transaction"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	module_mcms "github.com/smartcontractkit/chainlink-sui/bindings/generated/mcms/mcms"
+	module_mcms_deployer "github.com/smartcontractkit/chainlink-sui/bindings/generated/mcms/mcms_deployer"
+	bindutils "github.com/smartcontractkit/chainlink-sui/bindings/utils"
+)
+
+const (
+	// DeployerModule and AuthorizeUpgradeFunction name the timelock call authorizing package upgrades
+	DeployerModule           = "mcms_deployer"
+	AuthorizeUpgradeFunction = "authorize_upgrade"
+
+	clockObjectId = "0x6"
+)
+
+// UpgradeAuthorizerConfig identifies the MCMS objects and the timelock operation authorizing an upgrade.
+type UpgradeAuthorizerConfig struct {
+	McmsPackageId         string
+	TimelockObjectId      string
+	RegistryObjectId      string
+	DeployerStateObjectId string
+	// Predecessor and Salt are those of the scheduled batch whose only call is the authorize_upgrade call
+	Predecessor []byte
+	Salt        []byte
+}
+
+var _ bind.UpgradeAuthorizer = (*UpgradeAuthorizer)(nil)
+
+// UpgradeAuthorizer authorizes upgrades of packages whose UpgradeCap is registered with MCMS. It executes the timelock
+// batch calling mcms_deployer::authorize_upgrade, which must have been scheduled by an MCMS proposal and be ready.
+type UpgradeAuthorizer struct {
+	config   UpgradeAuthorizerConfig
+	mcms     module_mcms.IMcms
+	deployer module_mcms_deployer.IMcmsDeployer
+	vector   *bind.BoundContract
+}
+
+func NewUpgradeAuthorizer(config UpgradeAuthorizerConfig, client sui.ISuiAPI) (*UpgradeAuthorizer, error) {
+	mcmsContract, err := module_mcms.NewMcms(config.McmsPackageId, client)
+	if err != nil {
+		return nil, err
+	}
+	deployerContract, err := module_mcms_deployer.NewMcmsDeployer(config.McmsPackageId, client)
+	if err != nil {
+		return nil, err
+	}
+	vectorContract, err := bind.NewBoundContract("0x1", "std", "vector", client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpgradeAuthorizer{
+		config:   config,
+		mcms:     mcmsContract,
+		deployer: deployerContract,
+		vector:   vectorContract,
+	}, nil
+}
+
+// EncodeAuthorizeUpgradeData returns the data of the mcms_deployer::authorize_upgrade timelock call authorizing the
+// upgrade of a package with the policy and digest, to schedule in an MCMS proposal.
+func EncodeAuthorizeUpgradeData(policy bind.UpgradePolicy, digest []byte, packageId string) ([]byte, error) {
+	packageAddress, err := bindutils.ConvertStringToAddressBytes(packageId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid package ID %s: %w", packageId, err)
+	}
+
+	data := bytes.NewBuffer([]byte{byte(policy)})
+	data.Write(mystenbcs.ULEB128Encode(len(digest)))
+	data.Write(digest)
+	data.Write(packageAddress[:])
+
+	return data.Bytes(), nil
+}
+
+func (a *UpgradeAuthorizer) AuthorizeUpgrade(ctx context.Context, opts *bind.CallOpts, ptb *transaction.Transaction, packageId string, policy bind.UpgradePolicy, digest []byte) (*transaction.Argument, error) {
+	data, err := EncodeAuthorizeUpgradeData(policy, digest, packageId)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := a.mcms.Encoder().TimelockExecuteBatch(
+		bind.Object{Id: a.config.TimelockObjectId},
+		bind.Object{Id: clockObjectId},
+		[]string{a.mcms.Bound().GetPackageID()},
+		[]string{DeployerModule},
+		[]string{AuthorizeUpgradeFunction},
+		[][]byte{data},
+		a.config.Predecessor,
+		a.config.Salt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode timelock_execute_batch: %w", err)
+	}
+	calls, err := a.mcms.Bound().AppendPTB(ctx, opts, ptb, encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	// take the callback params of the only call out of the vector returned by the timelock
+	paramsType := fmt.Sprintf("%s::mcms_registry::ExecutingCallbackParams", a.mcms.Bound().GetPackageID())
+	encoded, err = a.vector.EncodeCallArgsWithGenerics("pop_back", []string{paramsType}, []string{"T"}, []string{"&mut vector<T>"}, []any{calls}, []string{"T"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode vector::pop_back: %w", err)
+	}
+	params, err := a.vector.AppendPTB(ctx, opts, ptb, encoded)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err = a.vector.EncodeCallArgsWithGenerics("destroy_empty", []string{paramsType}, []string{"T"}, []string{"vector<T>"}, []any{calls}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode vector::destroy_empty: %w", err)
+	}
+	if _, err = a.vector.AppendPTB(ctx, opts, ptb, encoded); err != nil {
+		return nil, err
+	}
+
+	encoded, err = a.mcms.Encoder().ExecuteDispatchToDeployerWithArgs(
+		bind.Object{Id: a.config.RegistryObjectId},
+		bind.Object{Id: a.config.DeployerStateObjectId},
+		params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode execute_dispatch_to_deployer: %w", err)
+	}
+
+	return a.mcms.Bound().AppendPTB(ctx, opts, ptb, encoded)
+}
+
+func (a *UpgradeAuthorizer) CommitUpgrade(ctx context.Context, opts *bind.CallOpts, ptb *transaction.Transaction, receipt *transaction.Argument) error {
+	encoded, err := a.deployer.Encoder().CommitUpgradeWithArgs(bind.Object{Id: a.config.DeployerStateObjectId}, receipt)
+	if err != nil {
+		return fmt.Errorf("failed to encode commit_upgrade: %w", err)
+	}
+	_, err = a.deployer.Bound().AppendPTB(ctx, opts, ptb, encoded)
+
+	return err
+}
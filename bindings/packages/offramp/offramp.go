@@ -61,7 +61,7 @@ func PublishOfframp(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI
 		return nil, nil, err
 	}
 
-	packageId, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
+	result, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
 		CompiledModules: artifact.Modules,
 		Dependencies:    artifact.Dependencies,
 	})
@@ -69,7 +69,39 @@ func PublishOfframp(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI
 		return nil, nil, err
 	}
 
-	contract, err := NewOfframp(packageId, client)
+	contract, err := NewOfframp(result.PackageId, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return contract, tx, nil
+}
+
+// UpgradeOfframp upgrades the package at packageId with the current sources, authorized by the authorizer,
+// and returns the new version of the package.
+func UpgradeOfframp(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI, authorizer bind.UpgradeAuthorizer, packageId string, policy bind.UpgradePolicy, ccipAddress string, mcmsAddress string) (Offramp, *models.SuiTransactionBlockResponse, error) {
+	artifact, err := bind.CompilePackage(contracts.CCIPOfframp, map[string]string{
+		"mcms":                      mcmsAddress,
+		"ccip":                      ccipAddress,
+		"ccip_offramp":              "0x0",
+		"mcms_owner":                "0x1",
+		"mcms_register_entrypoints": "0x2",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, tx, err := bind.UpgradePackage(ctx, opts, client, bind.UpgradeRequest{
+		CompiledModules: artifact.Modules,
+		Dependencies:    artifact.Dependencies,
+		PackageId:       packageId,
+		Policy:          policy,
+	}, authorizer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contract, err := NewOfframp(result.PackageId, client)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -55,7 +55,7 @@ func PublishOnramp(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI,
 		return nil, nil, err
 	}
 
-	packageId, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
+	result, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
 		CompiledModules: artifact.Modules,
 		Dependencies:    artifact.Dependencies,
 	})
@@ -63,7 +63,38 @@ func PublishOnramp(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI,
 		return nil, nil, err
 	}
 
-	contract, err := NewOnramp(packageId, client)
+	contract, err := NewOnramp(result.PackageId, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return contract, tx, nil
+}
+
+// UpgradeOnramp upgrades the package at packageId with the current sources, authorized by the authorizer,
+// and returns the new version of the package.
+func UpgradeOnramp(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI, authorizer bind.UpgradeAuthorizer, packageId string, policy bind.UpgradePolicy, ccipAddress, mcmsAddress, mcmsOwnerAddress string) (Onramp, *models.SuiTransactionBlockResponse, error) {
+	artifact, err := bind.CompilePackage(contracts.CCIPOnramp, map[string]string{
+		"ccip":        ccipAddress,
+		"ccip_onramp": "0x0",
+		"mcms":        mcmsAddress,
+		"mcms_owner":  mcmsOwnerAddress,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, tx, err := bind.UpgradePackage(ctx, opts, client, bind.UpgradeRequest{
+		CompiledModules: artifact.Modules,
+		Dependencies:    artifact.Dependencies,
+		PackageId:       packageId,
+		Policy:          policy,
+	}, authorizer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contract, err := NewOnramp(result.PackageId, client)
 	if err != nil {
 		return nil, nil, err
 	}
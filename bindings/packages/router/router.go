@@ -55,7 +55,7 @@ func PublishCCIPRouter(ctx context.Context, opts *bind.CallOpts, client sui.ISui
 		return nil, nil, err
 	}
 
-	packageId, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
+	result, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
 		CompiledModules: artifact.Modules,
 		Dependencies:    artifact.Dependencies,
 	})
@@ -63,7 +63,38 @@ func PublishCCIPRouter(ctx context.Context, opts *bind.CallOpts, client sui.ISui
 		return nil, nil, err
 	}
 
-	contract, err := NewCCIPRouter(packageId, client)
+	contract, err := NewCCIPRouter(result.PackageId, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return contract, tx, nil
+}
+
+// UpgradeCCIPRouter upgrades the package at packageId with the current sources, authorized by the authorizer,
+// and returns the new version of the package.
+func UpgradeCCIPRouter(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI, authorizer bind.UpgradeAuthorizer, packageId string, policy bind.UpgradePolicy, mcmsAddress string, mcmsOwner string) (CCIPRouter, *models.SuiTransactionBlockResponse, error) {
+	artifact, err := bind.CompilePackage(contracts.CCIPRouter, map[string]string{
+		"ccip_router":               "0x0",
+		"mcms":                      mcmsAddress,
+		"mcms_owner":                mcmsOwner,
+		"mcms_register_entrypoints": "0x2",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, tx, err := bind.UpgradePackage(ctx, opts, client, bind.UpgradeRequest{
+		CompiledModules: artifact.Modules,
+		Dependencies:    artifact.Dependencies,
+		PackageId:       packageId,
+		Policy:          policy,
+	}, authorizer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contract, err := NewCCIPRouter(result.PackageId, client)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -84,14 +84,14 @@ func PublishTest(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI) (
 		return nil, nil, err
 	}
 
-	packageId, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
+	result, tx, err := bind.PublishPackage(ctx, opts, client, bind.PublishRequest{
 		CompiledModules: artifact.Modules,
 		Dependencies:    artifact.Dependencies,
 	})
 	if err != nil {
 		return nil, nil, err
 	}
-	contract, err := NewTest(packageId, client)
+	contract, err := NewTest(result.PackageId, client)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -5,6 +5,7 @@ package tests
 import (
 	"context"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/block-vision/sui-go-sdk/models"
@@ -268,6 +269,39 @@ func TestBindingFeatures(t *testing.T) {
 		}
 	})
 
+	t.Run("Receiving objects sent to objects", func(t *testing.T) {
+		signerAddress, err := signer.GetAddress()
+		require.NoError(t, err)
+
+		// the publisher owns one of the CounterPointers created on publish
+		pointerId := ""
+		for _, change := range tx.ObjectChanges {
+			if change.Type == "created" && strings.HasSuffix(change.ObjectType, "::counter::CounterPointer") &&
+				change.GetObjectChangeAddressOwner() == signerAddress {
+				pointerId = change.ObjectId
+			}
+		}
+		require.NotEmpty(t, pointerId)
+
+		sendTx, err := counterContract.CreateAndTransfer(ctx, opts, pointerId)
+		require.NoError(t, err)
+		sentId, _, err := FindCreatedObject(sendTx.ObjectChanges, "::counter::Counter")
+		require.NoError(t, err)
+
+		receiveTx, err := counterContract.ReceiveCounter(ctx, opts, bind.Object{Id: pointerId}, bind.Object{Id: sentId})
+		require.NoError(t, err)
+		require.Equal(t, "success", receiveTx.Effects.Status.Status)
+
+		received := false
+		for _, change := range receiveTx.ObjectChanges {
+			if change.ObjectId == sentId {
+				require.Equal(t, signerAddress, change.GetObjectChangeAddressOwner())
+				received = true
+			}
+		}
+		require.True(t, received, "received counter not in object changes")
+	})
+
 	t.Run("DevInspect simulates modified state", func(t *testing.T) {
 		initTx, err := counterContract.Initialize(ctx, opts)
 		require.NoError(t, err)
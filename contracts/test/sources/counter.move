@@ -1,6 +1,6 @@
 module test::counter {
     use sui::object::{Self, UID, ID};
-    use sui::transfer;
+    use sui::transfer::{Self, Receiving};
     use sui::tx_context::{Self, TxContext};
     use sui::event;
     use sui::address;
@@ -166,6 +166,21 @@ module test::counter {
         }
     }
 
+    /// Create a Counter and send it to an address, e.g. of an object receiving it with receive_counter
+    public fun create_and_transfer(recipient: address, ctx: &mut TxContext) {
+        let counter = Counter {
+            id: object::new(ctx),
+            value: 0
+        };
+        transfer::public_transfer(counter, recipient);
+    }
+
+    /// Receive a Counter sent to the pointer and send it to the sender
+    public fun receive_counter(pointer: &mut CounterPointer, sent: Receiving<Counter>, ctx: &TxContext) {
+        let counter = transfer::public_receive(&mut pointer.id, sent);
+        transfer::public_transfer(counter, tx_context::sender(ctx));
+    }
+
     public fun increment_by_one(counter: &mut Counter, _ctx: &mut TxContext): u64 {
         counter.value = counter.value + 1;
 
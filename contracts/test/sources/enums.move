@@ -0,0 +1,47 @@
+module test::enums {
+    use std::string::String;
+    use sui::event;
+
+    public enum Status has copy, drop, store {
+        Active,
+        Paused { until: u64, reason: String },
+        Migrated(address, u8),
+    }
+
+    public struct Config has copy, drop {
+        owner: address,
+        status: Status,
+    }
+
+    public struct StatusChanged has copy, drop {
+        previous: Status,
+        current: Status,
+    }
+
+    public fun active(): Status {
+        Status::Active
+    }
+
+    public fun paused(until: u64, reason: String): Status {
+        Status::Paused { until, reason }
+    }
+
+    public fun migrated(to: address, version: u8): Status {
+        Status::Migrated(to, version)
+    }
+
+    public fun config(owner: address, until: u64, reason: String): Config {
+        Config { owner, status: paused(until, reason) }
+    }
+
+    public fun is_active(status: &Status): bool {
+        match (status) {
+            Status::Active => true,
+            _ => false,
+        }
+    }
+
+    public fun pause(until: u64, reason: String) {
+        event::emit(StatusChanged { previous: Status::Active, current: paused(until, reason) });
+    }
+}
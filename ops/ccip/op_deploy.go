@@ -42,15 +42,34 @@ var deployHandler = func(b cld_ops.Bundle, deps sui_ops.OpTxDeps, input DeployCC
 		return sui_ops.OpTxResult[DeployCCIPObjects]{}, err
 	}
 
-	// TODO: We should move the object ID finding logic into the binding package
-	obj1, err1 := bind.FindObjectIdFromPublishTx(*tx, "ownable", "OwnerCap")
-	obj2, err2 := bind.FindObjectIdFromPublishTx(*tx, "state_object", "CCIPObjectRefPointer")
-	obj3, err3 := bind.FindObjectIdFromPublishTx(*tx, "state_object", "CCIPObjectRef")
-	obj4, err4 := bind.FindObjectIdFromPublishTx(*tx, "onramp_state_helper", "SourceTransferCap")
-	obj5, err5 := bind.FindObjectIdFromPublishTx(*tx, "offramp_state_helper", "DestTransferCap")
-
-	if err1 != nil || err2 != nil || err3 != nil || err4 != nil || err5 != nil {
-		return sui_ops.OpTxResult[DeployCCIPObjects]{}, fmt.Errorf("failed to find object IDs in publish tx: %w", err)
+	result, err := bind.NewPublishResult(*tx)
+	if err != nil {
+		return sui_ops.OpTxResult[DeployCCIPObjects]{}, err
+	}
+
+	obj1, err := result.ObjectId("ownable", "OwnerCap")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployCCIPObjects]{}, fmt.Errorf("failed to find OwnerCap object ID in publish tx: %w", err)
+	}
+
+	obj2, err := result.ObjectId("state_object", "CCIPObjectRefPointer")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployCCIPObjects]{}, fmt.Errorf("failed to find CCIPObjectRefPointer object ID in publish tx: %w", err)
+	}
+
+	obj3, err := result.ObjectId("state_object", "CCIPObjectRef")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployCCIPObjects]{}, fmt.Errorf("failed to find CCIPObjectRef object ID in publish tx: %w", err)
+	}
+
+	obj4, err := result.ObjectId("onramp_state_helper", "SourceTransferCap")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployCCIPObjects]{}, fmt.Errorf("failed to find SourceTransferCap object ID in publish tx: %w", err)
+	}
+
+	obj5, err := result.ObjectId("offramp_state_helper", "DestTransferCap")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployCCIPObjects]{}, fmt.Errorf("failed to find DestTransferCap object ID in publish tx: %w", err)
 	}
 
 	b.Logger.Infow("CCIP package deployed", "packageId", ccipPackage.Address(), "CCIP Object Ref", obj3)
@@ -65,7 +84,7 @@ var deployHandler = func(b cld_ops.Bundle, deps sui_ops.OpTxDeps, input DeployCC
 			SourceTransferCapObjectId:    obj4,
 			DestTransferCapObjectId:      obj5,
 		},
-	}, err
+	}, nil
 }
 
 var DeployCCIPOp = cld_ops.NewOperation(
@@ -39,7 +39,7 @@ var deployDummyReceiverHandler = func(b cld_ops.Bundle, deps sui_ops.OpTxDeps, i
 	}
 
 	// Publish the package
-	packageId, tx, err := bind.PublishPackage(
+	result, tx, err := bind.PublishPackage(
 		b.GetContext(),
 		opts,
 		deps.Client,
@@ -56,19 +56,19 @@ var deployDummyReceiverHandler = func(b cld_ops.Bundle, deps sui_ops.OpTxDeps, i
 	// The init function creates:
 	// 1. CCIPReceiverState (shared object)
 	// 2. OwnerCap (transferred to sender)
-	ownerCapObjectId, err1 := bind.FindObjectIdFromPublishTx(*tx, "dummy_receiver", "OwnerCap")
-	if err1 != nil {
-		return sui_ops.OpTxResult[DeployDummyReceiverObjects]{}, fmt.Errorf("failed to find OwnerCap object ID in publish tx: %w", err1)
+	ownerCapObjectId, err := result.ObjectId("dummy_receiver", "OwnerCap")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployDummyReceiverObjects]{}, fmt.Errorf("failed to find OwnerCap object ID in publish tx: %w", err)
 	}
 
-	receiverStateObjectId, err2 := bind.FindObjectIdFromPublishTx(*tx, "dummy_receiver", "CCIPReceiverState")
-	if err2 != nil {
-		return sui_ops.OpTxResult[DeployDummyReceiverObjects]{}, fmt.Errorf("failed to find CCIPReceiverState object ID in publish tx: %w", err2)
+	receiverStateObjectId, err := result.ObjectId("dummy_receiver", "CCIPReceiverState")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployDummyReceiverObjects]{}, fmt.Errorf("failed to find CCIPReceiverState object ID in publish tx: %w", err)
 	}
 
 	return sui_ops.OpTxResult[DeployDummyReceiverObjects]{
 		Digest:    tx.Digest,
-		PackageId: packageId,
+		PackageId: result.PackageId,
 		Objects: DeployDummyReceiverObjects{
 			OwnerCapObjectId:          ownerCapObjectId,
 			CCIPReceiverStateObjectId: receiverStateObjectId,
@@ -0,0 +1,46 @@
+package ccipops
+
+import (
+	"context"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	cld_ops "github.com/smartcontractkit/chainlink-deployments-framework/operations"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	"github.com/smartcontractkit/chainlink-sui/bindings/packages/ccip"
+	sui_ops "github.com/smartcontractkit/chainlink-sui/ops"
+)
+
+type UpgradeCCIPInput struct {
+	sui_ops.UpgradePackageInput
+	McmsPackageId string
+	McmsOwner     string
+}
+
+var upgradeHandler = sui_ops.NewUpgradeHandler("CCIP", func(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI, authorizer bind.UpgradeAuthorizer, input UpgradeCCIPInput) (string, *models.SuiTransactionBlockResponse, error) {
+	upgraded, tx, err := ccip.UpgradeCCIP(
+		ctx,
+		opts,
+		client,
+		authorizer,
+		input.PackageId,
+		input.Policy,
+		input.McmsPackageId,
+		input.McmsOwner,
+	)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return upgraded.Address(), tx, nil
+})
+
+var UpgradeCCIPOp = cld_ops.NewOperation(
+	sui_ops.NewSuiOperationName("ccip", "package", "upgrade"),
+	semver.MustParse("0.1.0"),
+	"Upgrades the CCIP package, directly or through MCMS",
+	upgradeHandler,
+)
@@ -38,12 +38,19 @@ var deployHandler = func(b cld_ops.Bundle, deps sui_ops.OpTxDeps, input DeployCC
 		return sui_ops.OpTxResult[DeployCCIPOffRampObjects]{}, err
 	}
 
-	// TODO: We should move the object ID finding logic into the binding package
-	obj1, err1 := bind.FindObjectIdFromPublishTx(*tx, "ownable", "OwnerCap")
-	obj2, err2 := bind.FindObjectIdFromPublishTx(*tx, "offramp", "OffRampState")
+	result, err := bind.NewPublishResult(*tx)
+	if err != nil {
+		return sui_ops.OpTxResult[DeployCCIPOffRampObjects]{}, err
+	}
 
-	if err1 != nil || err2 != nil {
-		return sui_ops.OpTxResult[DeployCCIPOffRampObjects]{}, fmt.Errorf("failed to find object IDs in publish tx: err1=%w, err2=%w", err1, err2)
+	obj1, err := result.ObjectId("ownable", "OwnerCap")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployCCIPOffRampObjects]{}, fmt.Errorf("failed to find OwnerCap object ID in publish tx: %w", err)
+	}
+
+	obj2, err := result.ObjectId("offramp", "OffRampState")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployCCIPOffRampObjects]{}, fmt.Errorf("failed to find OffRampState object ID in publish tx: %w", err)
 	}
 
 	return sui_ops.OpTxResult[DeployCCIPOffRampObjects]{
@@ -0,0 +1,46 @@
+package offrampops
+
+import (
+	"context"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	cld_ops "github.com/smartcontractkit/chainlink-deployments-framework/operations"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	"github.com/smartcontractkit/chainlink-sui/bindings/packages/offramp"
+	sui_ops "github.com/smartcontractkit/chainlink-sui/ops"
+)
+
+type UpgradeCCIPOffRampInput struct {
+	sui_ops.UpgradePackageInput
+	CCIPPackageId string
+	MCMSPackageId string
+}
+
+var upgradeHandler = sui_ops.NewUpgradeHandler("CCIP offramp", func(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI, authorizer bind.UpgradeAuthorizer, input UpgradeCCIPOffRampInput) (string, *models.SuiTransactionBlockResponse, error) {
+	upgraded, tx, err := offramp.UpgradeOfframp(
+		ctx,
+		opts,
+		client,
+		authorizer,
+		input.PackageId,
+		input.Policy,
+		input.CCIPPackageId,
+		input.MCMSPackageId,
+	)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return upgraded.Address(), tx, nil
+})
+
+var UpgradeCCIPOffRampOp = cld_ops.NewOperation(
+	sui_ops.NewSuiOperationName("ccip-off-ramp", "package", "upgrade"),
+	semver.MustParse("0.1.0"),
+	"Upgrades the CCIP offramp package, directly or through MCMS",
+	upgradeHandler,
+)
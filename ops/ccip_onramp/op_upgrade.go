@@ -0,0 +1,48 @@
+package onrampops
+
+import (
+	"context"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	cld_ops "github.com/smartcontractkit/chainlink-deployments-framework/operations"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	"github.com/smartcontractkit/chainlink-sui/bindings/packages/onramp"
+	sui_ops "github.com/smartcontractkit/chainlink-sui/ops"
+)
+
+type UpgradeCCIPOnRampInput struct {
+	sui_ops.UpgradePackageInput
+	CCIPPackageId      string
+	MCMSPackageId      string
+	MCMSOwnerPackageId string
+}
+
+var upgradeHandler = sui_ops.NewUpgradeHandler("CCIP onramp", func(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI, authorizer bind.UpgradeAuthorizer, input UpgradeCCIPOnRampInput) (string, *models.SuiTransactionBlockResponse, error) {
+	upgraded, tx, err := onramp.UpgradeOnramp(
+		ctx,
+		opts,
+		client,
+		authorizer,
+		input.PackageId,
+		input.Policy,
+		input.CCIPPackageId,
+		input.MCMSPackageId,
+		input.MCMSOwnerPackageId,
+	)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return upgraded.Address(), tx, nil
+})
+
+var UpgradeCCIPOnRampOp = cld_ops.NewOperation(
+	sui_ops.NewSuiOperationName("ccip-on-ramp", "package", "upgrade"),
+	semver.MustParse("0.1.0"),
+	"Upgrades the CCIP onramp package, directly or through MCMS",
+	upgradeHandler,
+)
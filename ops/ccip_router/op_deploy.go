@@ -35,10 +35,19 @@ var deployHandler = func(b cld_ops.Bundle, deps sui_ops.OpTxDeps, input DeployCC
 		return sui_ops.OpTxResult[DeployCCIPRouterObjects]{}, err
 	}
 
-	obj1, err1 := bind.FindObjectIdFromPublishTx(*tx, "ownable", "OwnerCap")
-	obj2, err2 := bind.FindObjectIdFromPublishTx(*tx, "router", "RouterState")
-	if err1 != nil || err2 != nil {
-		return sui_ops.OpTxResult[DeployCCIPRouterObjects]{}, fmt.Errorf("failed to find object IDs in publish tx: %w", err)
+	result, err := bind.NewPublishResult(*tx)
+	if err != nil {
+		return sui_ops.OpTxResult[DeployCCIPRouterObjects]{}, err
+	}
+
+	obj1, err := result.ObjectId("ownable", "OwnerCap")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployCCIPRouterObjects]{}, fmt.Errorf("failed to find OwnerCap object ID in publish tx: %w", err)
+	}
+
+	obj2, err := result.ObjectId("router", "RouterState")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployCCIPRouterObjects]{}, fmt.Errorf("failed to find RouterState object ID in publish tx: %w", err)
 	}
 
 	return sui_ops.OpTxResult[DeployCCIPRouterObjects]{
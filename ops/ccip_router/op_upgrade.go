@@ -0,0 +1,46 @@
+package routerops
+
+import (
+	"context"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	cld_ops "github.com/smartcontractkit/chainlink-deployments-framework/operations"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	"github.com/smartcontractkit/chainlink-sui/bindings/packages/router"
+	sui_ops "github.com/smartcontractkit/chainlink-sui/ops"
+)
+
+type UpgradeCCIPRouterInput struct {
+	sui_ops.UpgradePackageInput
+	McmsPackageId string
+	McmsOwner     string
+}
+
+var upgradeHandler = sui_ops.NewUpgradeHandler("CCIP router", func(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI, authorizer bind.UpgradeAuthorizer, input UpgradeCCIPRouterInput) (string, *models.SuiTransactionBlockResponse, error) {
+	upgraded, tx, err := router.UpgradeCCIPRouter(
+		ctx,
+		opts,
+		client,
+		authorizer,
+		input.PackageId,
+		input.Policy,
+		input.McmsPackageId,
+		input.McmsOwner,
+	)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return upgraded.Address(), tx, nil
+})
+
+var UpgradeCCIPRouterOp = cld_ops.NewOperation(
+	sui_ops.NewSuiOperationName("ccip-router", "package", "upgrade"),
+	semver.MustParse("0.1.0"),
+	"Upgrades the CCIP router package, directly or through MCMS",
+	upgradeHandler,
+)
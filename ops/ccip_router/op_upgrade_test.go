@@ -0,0 +1,80 @@
+//go:build integration
+
+package routerops
+
+import (
+	"context"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	cld_ops "github.com/smartcontractkit/chainlink-deployments-framework/operations"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	"github.com/smartcontractkit/chainlink-sui/bindings/tests/testenv"
+	sui_ops "github.com/smartcontractkit/chainlink-sui/ops"
+	mcms_ops "github.com/smartcontractkit/chainlink-sui/ops/mcms"
+)
+
+func TestUpgradeCCIPRouterOp(t *testing.T) {
+	t.Parallel()
+	signer, client := testenv.SetupEnvironment(t)
+
+	deps := sui_ops.OpTxDeps{
+		Client: client,
+		Signer: signer,
+		GetCallOpts: func() *bind.CallOpts {
+			b := uint64(400_000_000)
+			return &bind.CallOpts{
+				WaitForExecution: true,
+				GasBudget:        &b,
+			}
+		},
+	}
+
+	reporter := cld_ops.NewMemoryReporter()
+	bundle := cld_ops.NewBundle(
+		context.Background,
+		logger.Test(t),
+		reporter,
+	)
+
+	signerAddress, err := signer.GetAddress()
+	require.NoError(t, err, "failed to get signer address")
+
+	reportMCMs, err := cld_ops.ExecuteOperation(bundle, mcms_ops.DeployMCMSOp, deps, cld_ops.EmptyInput{})
+	require.NoError(t, err, "failed to deploy MCMS Package")
+
+	reportRouter, err := cld_ops.ExecuteOperation(bundle, DeployCCIPRouterOp, deps, DeployCCIPRouterInput{
+		McmsPackageId: reportMCMs.Output.PackageId,
+		McmsOwner:     signerAddress,
+	})
+	require.NoError(t, err, "failed to deploy CCIP router")
+
+	// the UpgradeCap of the router is sent to the publisher
+	publishTx, err := client.SuiGetTransactionBlock(context.Background(), models.SuiGetTransactionBlockRequest{
+		Digest:  reportRouter.Output.Digest,
+		Options: models.SuiTransactionBlockOptions{ShowObjectChanges: true},
+	})
+	require.NoError(t, err)
+	publishResult, err := bind.NewPublishResult(publishTx)
+	require.NoError(t, err)
+	upgradeCapId, err := publishResult.UpgradeCapId()
+	require.NoError(t, err)
+
+	reportUpgrade, err := cld_ops.ExecuteOperation(bundle, UpgradeCCIPRouterOp, deps, UpgradeCCIPRouterInput{
+		UpgradePackageInput: sui_ops.UpgradePackageInput{
+			PackageId:     reportRouter.Output.PackageId,
+			Policy:        bind.UpgradePolicyCompatible,
+			Authorization: sui_ops.UpgradeAuthorization{UpgradeCapObjectId: upgradeCapId},
+		},
+		McmsPackageId: reportMCMs.Output.PackageId,
+		McmsOwner:     signerAddress,
+	})
+	require.NoError(t, err, "failed to upgrade CCIP router")
+	require.NotEqual(t, reportRouter.Output.PackageId, reportUpgrade.Output.PackageId)
+	require.Equal(t, uint64(2), reportUpgrade.Output.Objects.Version)
+}
@@ -0,0 +1,48 @@
+package tokenpoolops
+
+import (
+	"context"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	cld_ops "github.com/smartcontractkit/chainlink-deployments-framework/operations"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	tokenpool "github.com/smartcontractkit/chainlink-sui/bindings/packages/ccip_token_pools/token_pool"
+	sui_ops "github.com/smartcontractkit/chainlink-sui/ops"
+)
+
+type UpgradeCCIPTokenPoolInput struct {
+	sui_ops.UpgradePackageInput
+	CCIPPackageId    string
+	MCMSAddress      string
+	MCMSOwnerAddress string
+}
+
+var upgradeHandler = sui_ops.NewUpgradeHandler("CCIP token pool", func(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI, authorizer bind.UpgradeAuthorizer, input UpgradeCCIPTokenPoolInput) (string, *models.SuiTransactionBlockResponse, error) {
+	upgraded, tx, err := tokenpool.UpgradeCCIPTokenPool(
+		ctx,
+		opts,
+		client,
+		authorizer,
+		input.PackageId,
+		input.Policy,
+		input.CCIPPackageId,
+		input.MCMSAddress,
+		input.MCMSOwnerAddress,
+	)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return upgraded.Address(), tx, nil
+})
+
+var UpgradeCCIPTokenPoolOp = cld_ops.NewOperation(
+	sui_ops.NewSuiOperationName("ccip-token-pool", "package", "upgrade"),
+	semver.MustParse("0.1.0"),
+	"Upgrades the CCIP token pool package, directly or through MCMS",
+	upgradeHandler,
+)
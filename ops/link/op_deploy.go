@@ -30,7 +30,7 @@ var handler = func(b cld_ops.Bundle, deps sui_ops.OpTxDeps, input cld_ops.EmptyI
 		return sui_ops.OpTxResult[DeployLinkObjects]{}, err
 	}
 
-	packageId, tx, err := bind.PublishPackage(b.GetContext(), opts, deps.Client, bind.PublishRequest{
+	result, tx, err := bind.PublishPackage(b.GetContext(), opts, deps.Client, bind.PublishRequest{
 		CompiledModules: artifact.Modules,
 		Dependencies:    artifact.Dependencies,
 	})
@@ -38,24 +38,24 @@ var handler = func(b cld_ops.Bundle, deps sui_ops.OpTxDeps, input cld_ops.EmptyI
 		return sui_ops.OpTxResult[DeployLinkObjects]{}, err
 	}
 
-	obj1, err1 := bind.FindObjectIdFromPublishTx(*tx, "coin", "CoinMetadata")
-	if err1 != nil {
-		return sui_ops.OpTxResult[DeployLinkObjects]{}, fmt.Errorf("failed to find CoinMetadata object ID in publish tx: %w", err1)
+	obj1, err := result.ObjectId("coin", "CoinMetadata")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployLinkObjects]{}, fmt.Errorf("failed to find CoinMetadata object ID in publish tx: %w", err)
 	}
 
-	obj2, err2 := bind.FindObjectIdFromPublishTx(*tx, "coin", "TreasuryCap")
-	if err2 != nil {
-		return sui_ops.OpTxResult[DeployLinkObjects]{}, fmt.Errorf("failed to find TreasuryCap object ID in publish tx: %w", err2)
+	obj2, err := result.ObjectId("coin", "TreasuryCap")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployLinkObjects]{}, fmt.Errorf("failed to find TreasuryCap object ID in publish tx: %w", err)
 	}
 
-	obj3, err3 := bind.FindObjectIdFromPublishTx(*tx, "package", "UpgradeCap")
-	if err3 != nil {
-		return sui_ops.OpTxResult[DeployLinkObjects]{}, fmt.Errorf("failed to find UpgradeCap object ID in publish tx: %w", err3)
+	obj3, err := result.ObjectId("package", "UpgradeCap")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployLinkObjects]{}, fmt.Errorf("failed to find UpgradeCap object ID in publish tx: %w", err)
 	}
 
 	return sui_ops.OpTxResult[DeployLinkObjects]{
 		Digest:    tx.Digest,
-		PackageId: packageId,
+		PackageId: result.PackageId,
 		Objects: DeployLinkObjects{
 			CoinMetadataObjectId: obj1,
 			TreasuryCapObjectId:  obj2,
@@ -37,16 +37,39 @@ var handler = func(b cld_ops.Bundle, deps sui_ops.OpTxDeps, input cld_ops.EmptyI
 		return sui_ops.OpTxResult[DeployMCMSObjects]{}, err
 	}
 
-	// TODO: We should move the object ID finding logic into the binding package
-	mcmsObject, err1 := bind.FindObjectIdFromPublishTx(*tx, "mcms", "MultisigState")
-	timelockObj, err2 := bind.FindObjectIdFromPublishTx(*tx, "mcms", "Timelock")
-	depState, err3 := bind.FindObjectIdFromPublishTx(*tx, "mcms_deployer", "DeployerState")
-	reg, err4 := bind.FindObjectIdFromPublishTx(*tx, "mcms_registry", "Registry")
-	acc, err5 := bind.FindObjectIdFromPublishTx(*tx, "mcms_account", "AccountState")
-	ownCap, err6 := bind.FindObjectIdFromPublishTx(*tx, "mcms_account", "OwnerCap")
+	result, err := bind.NewPublishResult(*tx)
+	if err != nil {
+		return sui_ops.OpTxResult[DeployMCMSObjects]{}, err
+	}
+
+	mcmsObject, err := result.ObjectId("mcms", "MultisigState")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployMCMSObjects]{}, fmt.Errorf("failed to find MultisigState object ID in publish tx: %w", err)
+	}
 
-	if err1 != nil || err2 != nil || err3 != nil || err4 != nil || err5 != nil || err6 != nil {
-		return sui_ops.OpTxResult[DeployMCMSObjects]{}, fmt.Errorf("failed to find object IDs in publish tx: %w", err)
+	timelockObj, err := result.ObjectId("mcms", "Timelock")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployMCMSObjects]{}, fmt.Errorf("failed to find Timelock object ID in publish tx: %w", err)
+	}
+
+	depState, err := result.ObjectId("mcms_deployer", "DeployerState")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployMCMSObjects]{}, fmt.Errorf("failed to find DeployerState object ID in publish tx: %w", err)
+	}
+
+	reg, err := result.ObjectId("mcms_registry", "Registry")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployMCMSObjects]{}, fmt.Errorf("failed to find Registry object ID in publish tx: %w", err)
+	}
+
+	acc, err := result.ObjectId("mcms_account", "AccountState")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployMCMSObjects]{}, fmt.Errorf("failed to find AccountState object ID in publish tx: %w", err)
+	}
+
+	ownCap, err := result.ObjectId("mcms_account", "OwnerCap")
+	if err != nil {
+		return sui_ops.OpTxResult[DeployMCMSObjects]{}, fmt.Errorf("failed to find OwnerCap object ID in publish tx: %w", err)
 	}
 
 	return sui_ops.OpTxResult[DeployMCMSObjects]{
@@ -60,7 +83,7 @@ var handler = func(b cld_ops.Bundle, deps sui_ops.OpTxDeps, input cld_ops.EmptyI
 			McmsAccountStateObjectId:    acc,
 			McmsAccountOwnerCapObjectId: ownCap,
 		},
-	}, err
+	}, nil
 }
 
 var DeployMCMSOp = cld_ops.NewOperation(
@@ -21,6 +21,8 @@ type OpTxResult[O any] struct {
 
 type OpTxDeps struct {
 	Client sui.ISuiAPI
+	// Signer signs and executes every operation transaction. Use an UnsignedSigner, together with
+	// its Client, to emit the transactions for external signing instead.
 	Signer rel.SuiSigner
 	// We could have some logic to modify the gas based on input
 	GetCallOpts func() *bind.CallOpts
@@ -0,0 +1,465 @@
+package operations
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+	"github.com/block-vision/sui-go-sdk/transaction"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	bindutils "github.com/smartcontractkit/chainlink-sui/bindings/utils"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	rel "github.com/smartcontractkit/chainlink-sui/relayer/signer"
+)
+
+// dryRunTimeout bounds the dry run used to summarize an unsigned transaction.
+const dryRunTimeout = 30 * time.Second
+
+// ErrTransactionNotSigned is returned by UnsignedSigner when an operation tries to execute a transaction
+// for which no external signature has been attached yet.
+var ErrTransactionNotSigned = errors.New("transaction must be signed externally")
+
+// UnsignedTransaction is a transaction emitted by an operation running in unsigned mode.
+// TxBytes are the base64 encoded BCS transaction data to sign, with gas coins and object references pinned.
+type UnsignedTransaction struct {
+	Digest  string             `json:"digest"`
+	TxBytes string             `json:"txBytes"`
+	Summary TransactionSummary `json:"summary"`
+
+	summarized bool
+}
+
+// TransactionSummary is a human-readable description of an unsigned transaction, built from a dry run,
+// for reviewers to check before signing.
+type TransactionSummary struct {
+	Sender        string                `json:"sender"`
+	GasOwner      string                `json:"gasOwner"`
+	GasBudget     string                `json:"gasBudget"`
+	GasPrice      string                `json:"gasPrice"`
+	GasPayment    []models.SuiObjectRef `json:"gasPayment"`
+	Inputs        []models.SuiCallArg   `json:"inputs"`
+	Commands      []string              `json:"commands"`
+	ObjectChanges []string              `json:"objectChanges"`
+	GasUsed       models.GasCostSummary `json:"gasUsed"`
+	DryRunStatus  string                `json:"dryRunStatus"`
+	DryRunError   string                `json:"dryRunError,omitempty"`
+}
+
+func (s TransactionSummary) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "sender: %s\n", s.Sender)
+	fmt.Fprintf(&sb, "gas: owner %s, budget %s, price %s\n", s.GasOwner, s.GasBudget, s.GasPrice)
+	for _, coin := range s.GasPayment {
+		fmt.Fprintf(&sb, "  gas coin %s (version %d, digest %s)\n", coin.ObjectId, coin.Version, coin.Digest)
+	}
+	sb.WriteString("commands:\n")
+	for i, command := range s.Commands {
+		fmt.Fprintf(&sb, "  %d: %s\n", i, command)
+	}
+	sb.WriteString("object changes:\n")
+	for _, change := range s.ObjectChanges {
+		fmt.Fprintf(&sb, "  %s\n", change)
+	}
+	fmt.Fprintf(&sb, "dry run: %s", s.DryRunStatus)
+	if s.DryRunError != "" {
+		fmt.Fprintf(&sb, " (%s)", s.DryRunError)
+	}
+
+	return sb.String()
+}
+
+// SignedTransaction holds signatures produced outside of the deployment environment for an unsigned transaction.
+// TxBytes are only required when the transaction was recorded by another UnsignedSigner, e.g. before a restart.
+type SignedTransaction struct {
+	Digest     string   `json:"digest"`
+	TxBytes    string   `json:"txBytes,omitempty"`
+	Signatures []string `json:"signatures"`
+}
+
+var _ rel.SuiSigner = (*UnsignedSigner)(nil)
+
+// UnsignedSigner allows running operations for an account whose keys are not available, e.g. kept offline.
+// Used as the OpTxDeps signer, it records every transaction an operation tries to execute and fails it with
+// ErrTransactionNotSigned, halting the sequence. The recorded transactions can be exported with
+// PendingTransactions, signed externally and attached back with AttachSignatures, which verifies them.
+//
+// A signed transaction is broadcast either by Submit, which executes the recorded bytes, or by running the same
+// sequence again, with the same reporter so that completed operations are skipped, when the halted operation
+// rebuilds an identical transaction. Pin the gas coin through CallOpts.GasObject for rebuilt transactions to be
+// identical to the signed ones.
+//
+// After Submit, running the sequence again resumes it: the halted operation rebuilds its transaction against the
+// updated chain state, and when the rebuilt transaction makes the same calls with the same inputs, it is given the
+// response of the submitted transaction instead of being executed. This requires the operations to use Client as
+// their OpTxDeps client.
+type UnsignedSigner struct {
+	address string
+	client  sui.ISuiAPI
+
+	mu      sync.Mutex
+	pending map[string]*UnsignedTransaction
+	signed  map[string]SignedTransaction
+	// submitted transactions, awaiting their operation to be resumed
+	submitted []submittedTransaction
+	// responses of submitted transactions, by digest of the transaction rebuilt when resuming
+	resumed map[string]bind.SuiTransactionBlockResponse
+}
+
+type submittedTransaction struct {
+	calls      *transactionCalls
+	signatures []string
+	response   bind.SuiTransactionBlockResponse
+}
+
+func NewUnsignedSigner(address string, client sui.ISuiAPI) *UnsignedSigner {
+	return &UnsignedSigner{
+		address: address,
+		client:  client,
+		pending: make(map[string]*UnsignedTransaction),
+		signed:  make(map[string]SignedTransaction),
+		resumed: make(map[string]bind.SuiTransactionBlockResponse),
+	}
+}
+
+// GetAddress returns the address of the account the transactions are built for
+func (s *UnsignedSigner) GetAddress() (string, error) {
+	return s.address, nil
+}
+
+// Client returns the client operations must use to resume after Submit, see UnsignedSigner.
+func (s *UnsignedSigner) Client() sui.ISuiAPI {
+	return &resumingClient{ISuiAPI: s.client, signer: s}
+}
+
+// Sign returns the attached signatures for the transaction if there are any, or the signatures of the submitted
+// transaction it resumes. Otherwise it records the transaction as pending and returns an error wrapping
+// ErrTransactionNotSigned.
+func (s *UnsignedSigner) Sign(txBytes []byte) ([]string, error) {
+	digest := client.TransactionDigestFromBytes(txBytes)
+	// a transaction which can't be decoded can't resume a submitted one, and is recorded for review as is
+	calls, _ := decodeTransactionCalls(txBytes)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if signed, ok := s.signed[digest]; ok {
+		delete(s.signed, digest)
+		delete(s.pending, digest)
+
+		return signed.Signatures, nil
+	}
+
+	for i, submitted := range s.submitted {
+		if calls != nil && calls.equal(submitted.calls) {
+			s.submitted = slices.Delete(s.submitted, i, i+1)
+			s.resumed[digest] = submitted.response
+
+			return submitted.signatures, nil
+		}
+	}
+
+	s.pending[digest] = &UnsignedTransaction{
+		Digest:  digest,
+		TxBytes: base64.StdEncoding.EncodeToString(txBytes),
+	}
+
+	if len(s.signed) > 0 {
+		return nil, fmt.Errorf("%w: transaction %s does not match any of the %d signed transactions, "+
+			"the chain state may have changed since they were built", ErrTransactionNotSigned, digest, len(s.signed))
+	}
+
+	return nil, fmt.Errorf("%w: transaction %s", ErrTransactionNotSigned, digest)
+}
+
+// PendingTransactions returns the transactions awaiting an external signature, in no particular order.
+// Each transaction is summarized from a dry run the first time it is returned.
+func (s *UnsignedSigner) PendingTransactions(ctx context.Context) []UnsignedTransaction {
+	s.mu.Lock()
+	var unsummarized []*UnsignedTransaction
+	for _, tx := range s.pending {
+		if !tx.summarized {
+			unsummarized = append(unsummarized, tx)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, tx := range unsummarized {
+		txBytes, err := base64.StdEncoding.DecodeString(tx.TxBytes)
+		if err != nil {
+			continue
+		}
+		summary := s.summarize(ctx, txBytes)
+
+		s.mu.Lock()
+		tx.Summary = summary
+		tx.summarized = true
+		s.mu.Unlock()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pending := make([]UnsignedTransaction, 0, len(s.pending))
+	for _, tx := range s.pending {
+		pending = append(pending, *tx)
+	}
+
+	return pending
+}
+
+// AttachSignatures attaches externally produced signatures to a transaction, identified by its digest.
+// Signatures must be serialized Sui signatures (base64 encoded), single key or MultiSig, and are verified against
+// the transaction bytes: each of them must be valid and signed by the signer address.
+func (s *UnsignedSigner) AttachSignatures(signed SignedTransaction) error {
+	if signed.Digest == "" {
+		return errors.New("transaction digest is required")
+	}
+	if len(signed.Signatures) == 0 {
+		return fmt.Errorf("no signatures provided for transaction %s", signed.Digest)
+	}
+
+	s.mu.Lock()
+	if pending, ok := s.pending[signed.Digest]; ok {
+		signed.TxBytes = pending.TxBytes
+	}
+	s.mu.Unlock()
+	if signed.TxBytes == "" {
+		return fmt.Errorf("transaction %s is not pending, its bytes are required", signed.Digest)
+	}
+
+	txBytes, err := base64.StdEncoding.DecodeString(signed.TxBytes)
+	if err != nil {
+		return fmt.Errorf("failed to decode transaction %s: %w", signed.Digest, err)
+	}
+	if digest := client.TransactionDigestFromBytes(txBytes); digest != signed.Digest {
+		return fmt.Errorf("transaction bytes have digest %s, expected %s", digest, signed.Digest)
+	}
+
+	expected, err := bindutils.ConvertAddressToString(s.address)
+	if err != nil {
+		return fmt.Errorf("invalid signer address %s: %w", s.address, err)
+	}
+	for i, signature := range signed.Signatures {
+		address, err := rel.VerifySignature(txBytes, signature)
+		if err != nil {
+			return fmt.Errorf("invalid signature %d for transaction %s: %w", i, signed.Digest, err)
+		}
+		if address != expected {
+			return fmt.Errorf("invalid signature %d for transaction %s: signature is from %s, expected %s",
+				i, signed.Digest, address, s.address)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	signed.Signatures = slices.Clone(signed.Signatures)
+	s.signed[signed.Digest] = signed
+
+	return nil
+}
+
+// Submit executes a signed transaction from its recorded bytes and returns its response. The next operation
+// rebuilding the same calls resumes from this response, see UnsignedSigner.
+func (s *UnsignedSigner) Submit(ctx context.Context, digest string) (*models.SuiTransactionBlockResponse, error) {
+	s.mu.Lock()
+	signed, ok := s.signed[digest]
+	s.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("no signatures attached to transaction %s", digest)
+	}
+
+	txBytes, err := base64.StdEncoding.DecodeString(signed.TxBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode transaction %s: %w", digest, err)
+	}
+	calls, err := decodeTransactionCalls(txBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode transaction %s: %w", digest, err)
+	}
+
+	resp, err := bind.ExecuteTransactionBlock(ctx, s.client, models.SuiExecuteTransactionBlockRequest{
+		TxBytes:   signed.TxBytes,
+		Signature: signed.Signatures,
+		Options: models.SuiTransactionBlockOptions{
+			ShowInput:          true,
+			ShowRawInput:       true,
+			ShowEffects:        true,
+			ShowObjectChanges:  true,
+			ShowBalanceChanges: true,
+			ShowEvents:         true,
+		},
+		RequestType: "WaitForLocalExecution",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute transaction %s: %w", digest, err)
+	}
+	if err := bind.GetFailedTxError(&resp.SuiTransactionBlockResponse); err != nil {
+		return &resp.SuiTransactionBlockResponse, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.signed, digest)
+	delete(s.pending, digest)
+	s.submitted = append(s.submitted, submittedTransaction{
+		calls:      calls,
+		signatures: signed.Signatures,
+		response:   *resp,
+	})
+
+	return &resp.SuiTransactionBlockResponse, nil
+}
+
+// resumingClient returns the response of a submitted transaction when its operation executes the transaction
+// rebuilt to resume it.
+type resumingClient struct {
+	sui.ISuiAPI
+	signer *UnsignedSigner
+}
+
+// SuiCall returns the response of the submitted transaction resumed by an executed transaction, bind executing
+// transactions with sui_executeTransactionBlock through SuiCall.
+func (c *resumingClient) SuiCall(ctx context.Context, method string, params ...interface{}) (interface{}, error) {
+	if method == "sui_executeTransactionBlock" && len(params) > 0 {
+		encodedTx, _ := params[0].(string)
+		txBytes, err := base64.StdEncoding.DecodeString(encodedTx)
+		if err == nil {
+			digest := client.TransactionDigestFromBytes(txBytes)
+
+			c.signer.mu.Lock()
+			resp, ok := c.signer.resumed[digest]
+			delete(c.signer.resumed, digest)
+			c.signer.mu.Unlock()
+			if ok {
+				result, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "result": resp})
+				if err != nil {
+					return nil, err
+				}
+
+				return string(result), nil
+			}
+		}
+	}
+
+	return c.ISuiAPI.SuiCall(ctx, method, params...)
+}
+
+// transactionCalls are the sender, inputs and commands of a transaction, without the versions of its owned objects
+// and its gas data, which change once an earlier version of the transaction is executed.
+type transactionCalls struct {
+	sender   string
+	inputs   []*transaction.CallArg
+	commands [][]byte
+}
+
+func decodeTransactionCalls(txBytes []byte) (*transactionCalls, error) {
+	decoded, err := bind.DecodeTransactionData(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	calls := &transactionCalls{
+		sender:   decoded.Sender,
+		inputs:   make([]*transaction.CallArg, len(decoded.CallArgs)),
+		commands: decoded.RawCommands,
+	}
+	for i, input := range decoded.CallArgs {
+		calls.inputs[i] = input
+		// owned objects are only identified by their ID
+		if input.Object != nil && input.Object.ImmOrOwnedObject != nil {
+			calls.inputs[i] = &transaction.CallArg{Object: &transaction.ObjectArg{
+				ImmOrOwnedObject: &transaction.SuiObjectRef{ObjectId: input.Object.ImmOrOwnedObject.ObjectId},
+			}}
+		}
+		if input.Object != nil && input.Object.Receiving != nil {
+			calls.inputs[i] = &transaction.CallArg{Object: &transaction.ObjectArg{
+				Receiving: &transaction.SuiObjectRef{ObjectId: input.Object.Receiving.ObjectId},
+			}}
+		}
+	}
+
+	return calls, nil
+}
+
+func (c *transactionCalls) equal(other *transactionCalls) bool {
+	return other != nil && c.sender == other.sender &&
+		slices.EqualFunc(c.inputs, other.inputs, func(a, b *transaction.CallArg) bool { return reflect.DeepEqual(a, b) }) &&
+		slices.EqualFunc(c.commands, other.commands, bytes.Equal)
+}
+
+// summarize dry runs the transaction to describe its inputs, commands and effects. A failed dry run is
+// reported in the summary rather than returned, so the transaction can still be inspected.
+func (s *UnsignedSigner) summarize(ctx context.Context, txBytes []byte) TransactionSummary {
+	ctx, cancel := context.WithTimeout(ctx, dryRunTimeout)
+	defer cancel()
+
+	resp, err := s.client.SuiDryRunTransactionBlock(ctx, models.SuiDryRunTransactionBlockRequest{
+		TxBytes: base64.StdEncoding.EncodeToString(txBytes),
+	})
+	if err != nil {
+		return TransactionSummary{
+			Sender:      s.address,
+			DryRunError: err.Error(),
+		}
+	}
+
+	data := resp.Transaction.Data
+	summary := TransactionSummary{
+		Sender:       data.Sender,
+		GasOwner:     data.GasData.Owner,
+		GasBudget:    data.GasData.Budget,
+		GasPrice:     data.GasData.Price,
+		GasPayment:   data.GasData.Payment,
+		Inputs:       data.Transaction.Inputs,
+		GasUsed:      resp.Effects.GasUsed,
+		DryRunStatus: resp.Effects.Status.Status,
+		DryRunError:  resp.Effects.Status.Error,
+	}
+
+	for _, command := range data.Transaction.Transactions {
+		summary.Commands = append(summary.Commands, describeCommand(command))
+	}
+
+	for _, change := range resp.ObjectChanges {
+		switch change.Type {
+		case "published":
+			summary.ObjectChanges = append(summary.ObjectChanges,
+				fmt.Sprintf("published package %s (modules: %s)", change.PackageId, strings.Join(change.Modules, ", ")))
+		default:
+			summary.ObjectChanges = append(summary.ObjectChanges,
+				fmt.Sprintf("%s %s %s", change.Type, change.ObjectType, change.ObjectId))
+		}
+	}
+
+	return summary
+}
+
+// describeCommand renders a command of a dry run transaction, e.g. `MoveCall 0x1::module::function<T>`.
+func describeCommand(command any) string {
+	if moveCall := models.MoveCall(command); moveCall != nil {
+		description := fmt.Sprintf("MoveCall %s::%s::%s", moveCall.Package, moveCall.Module, moveCall.Function)
+		if len(moveCall.TypeArguments) > 0 {
+			description += "<" + strings.Join(moveCall.TypeArguments, ", ") + ">"
+		}
+
+		return description
+	}
+
+	if fields, ok := command.(map[string]any); ok {
+		for name := range fields {
+			return name
+		}
+	}
+
+	return fmt.Sprintf("%v", command)
+}
@@ -0,0 +1,279 @@
+package operations
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind/bindtest"
+	module_counter "github.com/smartcontractkit/chainlink-sui/bindings/generated/test/counter"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	rel "github.com/smartcontractkit/chainlink-sui/relayer/signer"
+)
+
+// dryRunClient only implements the dry run endpoint used to summarize unsigned transactions.
+type dryRunClient struct {
+	sui.ISuiAPI
+	response models.SuiTransactionBlockResponse
+	err      error
+}
+
+func (c *dryRunClient) SuiDryRunTransactionBlock(_ context.Context, _ models.SuiDryRunTransactionBlockRequest) (models.SuiTransactionBlockResponse, error) {
+	return c.response, c.err
+}
+
+func TestUnsignedSigner(t *testing.T) {
+	t.Parallel()
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	offlineSigner := rel.NewPrivateKeySigner(privateKey)
+	address, err := offlineSigner.GetAddress()
+	require.NoError(t, err)
+
+	dryRun := models.SuiTransactionBlockResponse{}
+	dryRun.Transaction.Data.Sender = address
+	dryRun.Transaction.Data.Transaction.Transactions = []any{
+		map[string]any{"MoveCall": map[string]any{
+			"package":        "0x2",
+			"module":         "coin",
+			"function":       "join",
+			"type_arguments": []string{"0x2::sui::SUI"},
+		}},
+		map[string]any{"TransferObjects": []any{}},
+	}
+	dryRun.Effects.Status.Status = "success"
+	dryRun.ObjectChanges = []models.ObjectChange{{Type: "created", ObjectType: "0x1::m::Cap", ObjectId: "0xcap"}}
+
+	txBytes := []byte("transaction data")
+
+	t.Run("records unsigned transactions", func(t *testing.T) {
+		t.Parallel()
+		signer := NewUnsignedSigner(address, &dryRunClient{response: dryRun})
+
+		_, err := signer.Sign(txBytes)
+		require.ErrorIs(t, err, ErrTransactionNotSigned)
+
+		pending := signer.PendingTransactions(context.Background())
+		require.Len(t, pending, 1)
+		assert.Equal(t, "dHJhbnNhY3Rpb24gZGF0YQ==", pending[0].TxBytes)
+		assert.Equal(t, []string{"MoveCall 0x2::coin::join<0x2::sui::SUI>", "TransferObjects"}, pending[0].Summary.Commands)
+		assert.Equal(t, []string{"created 0x1::m::Cap 0xcap"}, pending[0].Summary.ObjectChanges)
+		assert.Equal(t, "success", pending[0].Summary.DryRunStatus)
+	})
+
+	t.Run("keeps transactions when the dry run fails", func(t *testing.T) {
+		t.Parallel()
+		signer := NewUnsignedSigner(address, &dryRunClient{err: errors.New("node unavailable")})
+
+		_, err := signer.Sign(txBytes)
+		require.ErrorIs(t, err, ErrTransactionNotSigned)
+
+		pending := signer.PendingTransactions(context.Background())
+		require.Len(t, pending, 1)
+		assert.Equal(t, "node unavailable", pending[0].Summary.DryRunError)
+	})
+
+	t.Run("uses attached signatures", func(t *testing.T) {
+		t.Parallel()
+		signer := NewUnsignedSigner(address, &dryRunClient{response: dryRun})
+
+		_, err := signer.Sign(txBytes)
+		require.ErrorIs(t, err, ErrTransactionNotSigned)
+		pending := signer.PendingTransactions(context.Background())
+		require.Len(t, pending, 1)
+
+		signatures, err := offlineSigner.Sign(txBytes)
+		require.NoError(t, err)
+		require.NoError(t, signer.AttachSignatures(SignedTransaction{Digest: pending[0].Digest, Signatures: signatures}))
+
+		attached, err := signer.Sign(txBytes)
+		require.NoError(t, err)
+		assert.Equal(t, signatures, attached)
+		assert.Empty(t, signer.PendingTransactions(context.Background()), "signed transaction should no longer be pending")
+	})
+
+	t.Run("reports transactions that do not match the signed ones", func(t *testing.T) {
+		t.Parallel()
+		signer := NewUnsignedSigner(address, &dryRunClient{response: dryRun})
+
+		_, err := signer.Sign(txBytes)
+		require.ErrorIs(t, err, ErrTransactionNotSigned)
+		signatures, err := offlineSigner.Sign(txBytes)
+		require.NoError(t, err)
+		require.NoError(t, signer.AttachSignatures(SignedTransaction{Digest: client.TransactionDigestFromBytes(txBytes), Signatures: signatures}))
+
+		_, err = signer.Sign([]byte("rebuilt transaction data"))
+		require.ErrorIs(t, err, ErrTransactionNotSigned)
+		require.ErrorContains(t, err, "does not match any of the 1 signed transactions")
+	})
+
+	t.Run("rejects signatures from another account", func(t *testing.T) {
+		t.Parallel()
+		signer := NewUnsignedSigner(address, &dryRunClient{response: dryRun})
+		_, err := signer.Sign(txBytes)
+		require.ErrorIs(t, err, ErrTransactionNotSigned)
+
+		_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		signatures, err := rel.NewPrivateKeySigner(otherKey).Sign(txBytes)
+		require.NoError(t, err)
+
+		err = signer.AttachSignatures(SignedTransaction{Digest: client.TransactionDigestFromBytes(txBytes), Signatures: signatures})
+		require.ErrorContains(t, err, "expected "+address)
+	})
+
+	t.Run("rejects signatures of another transaction", func(t *testing.T) {
+		t.Parallel()
+		signer := NewUnsignedSigner(address, &dryRunClient{response: dryRun})
+		_, err := signer.Sign(txBytes)
+		require.ErrorIs(t, err, ErrTransactionNotSigned)
+
+		signatures, err := offlineSigner.Sign([]byte("other transaction data"))
+		require.NoError(t, err)
+		err = signer.AttachSignatures(SignedTransaction{Digest: client.TransactionDigestFromBytes(txBytes), Signatures: signatures})
+		require.ErrorContains(t, err, "invalid signature 0")
+
+		// transactions which are not pending require their bytes, matching the digest
+		err = signer.AttachSignatures(SignedTransaction{Digest: "digest", Signatures: signatures})
+		require.ErrorContains(t, err, "is not pending")
+		err = signer.AttachSignatures(SignedTransaction{Digest: "digest", TxBytes: "dHJhbnNhY3Rpb24gZGF0YQ==", Signatures: signatures})
+		require.ErrorContains(t, err, "expected digest")
+	})
+
+	t.Run("verifies multisig signatures", func(t *testing.T) {
+		t.Parallel()
+		_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		multiSigPublicKey, err := rel.NewMultiSigPublicKey([]rel.MultiSigMember{
+			{Flag: rel.SigFlagEd25519, PublicKey: privateKey.Public().(ed25519.PublicKey), Weight: 1},
+			{Flag: rel.SigFlagEd25519, PublicKey: otherKey.Public().(ed25519.PublicKey), Weight: 1},
+		}, 2)
+		require.NoError(t, err)
+		signer := NewUnsignedSigner(multiSigPublicKey.Address(), &dryRunClient{response: dryRun})
+		_, err = signer.Sign(txBytes)
+		require.ErrorIs(t, err, ErrTransactionNotSigned)
+
+		partialSignatures, err := offlineSigner.Sign(txBytes)
+		require.NoError(t, err)
+		otherSignatures, err := rel.NewPrivateKeySigner(otherKey).Sign(txBytes)
+		require.NoError(t, err)
+		multiSigSignature, err := multiSigPublicKey.CombineSignatures(append(partialSignatures, otherSignatures...))
+		require.NoError(t, err)
+		require.NoError(t, signer.AttachSignatures(SignedTransaction{Digest: client.TransactionDigestFromBytes(txBytes), Signatures: []string{multiSigSignature}}))
+
+		// a signature of a single member doesn't belong to the multisig account
+		err = signer.AttachSignatures(SignedTransaction{Digest: client.TransactionDigestFromBytes(txBytes), Signatures: partialSignatures})
+		require.ErrorContains(t, err, "expected "+multiSigPublicKey.Address())
+	})
+}
+
+func TestUnsignedSignerSubmit(t *testing.T) {
+	t.Parallel()
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	offlineSigner := rel.NewPrivateKeySigner(privateKey)
+	address, err := offlineSigner.GetAddress()
+	require.NoError(t, err)
+
+	const packageId = "0x00000000000000000000000000000000000000000000000000000000000000a1"
+	counterId := fmt.Sprintf("0x%064x", 1)
+	gasCoinId := fmt.Sprintf("0x%064x", 0xc0)
+	suiClient := bindtest.NewSuiClient()
+	suiClient.AddSharedObject(counterId, packageId+"::counter::Counter", 3)
+	suiClient.AddGasCoin(gasCoinId, 4, base58.Encode(make([]byte, 32)), address, 1_000_000_000)
+
+	signer := NewUnsignedSigner(address, suiClient)
+	counter, err := module_counter.NewCounter(packageId, signer.Client())
+	require.NoError(t, err)
+	opts := &bind.CallOpts{Signer: signer, GasObject: gasCoinId, GasBudget: new(uint64)}
+	*opts.GasBudget = 10_000_000
+
+	_, err = counter.Increment(context.Background(), opts, bind.Object{Id: counterId})
+	require.ErrorIs(t, err, ErrTransactionNotSigned)
+	pending := signer.PendingTransactions(context.Background())
+	require.Len(t, pending, 1)
+	assert.Equal(t, "success", pending[0].Summary.DryRunStatus)
+
+	_, err = signer.Submit(context.Background(), pending[0].Digest)
+	require.ErrorContains(t, err, "no signatures attached")
+
+	txBytes, err := base64.StdEncoding.DecodeString(pending[0].TxBytes)
+	require.NoError(t, err)
+	signatures, err := offlineSigner.Sign(txBytes)
+	require.NoError(t, err)
+	require.NoError(t, signer.AttachSignatures(SignedTransaction{Digest: pending[0].Digest, Signatures: signatures}))
+
+	resp, err := signer.Submit(context.Background(), pending[0].Digest)
+	require.NoError(t, err)
+	assert.Equal(t, "tx-0", resp.Digest)
+	assert.Empty(t, signer.PendingTransactions(context.Background()))
+
+	// executing the transaction updated the gas coin, the operation resumes from the submitted transaction
+	// although it rebuilds a different one
+	suiClient.AddGasCoin(gasCoinId, 5, base58.Encode(append(make([]byte, 31), 1)), address, 1_000_000_000)
+	resumed, err := counter.Increment(context.Background(), opts, bind.Object{Id: counterId})
+	require.NoError(t, err)
+	assert.Equal(t, "tx-0", resumed.Digest)
+	assert.Len(t, suiClient.Executed(), 1, "the rebuilt transaction must not be executed")
+
+	// later transactions are recorded again
+	_, err = counter.Increment(context.Background(), opts, bind.Object{Id: counterId})
+	require.ErrorIs(t, err, ErrTransactionNotSigned)
+}
+
+func TestDecodeTransactionCalls(t *testing.T) {
+	t.Parallel()
+
+	sender := "0x" + strings.Repeat("0a", 32)
+	build := func(t *testing.T, objectId byte, version uint64, gasVersion uint64, amount uint64) *transactionCalls {
+		t.Helper()
+
+		ptb := transaction.NewTransaction()
+		object := ptb.Data.V1.AddInput(transaction.CallArg{Object: &transaction.ObjectArg{ImmOrOwnedObject: &transaction.SuiObjectRef{
+			ObjectId: models.SuiAddressBytes{31: objectId},
+			Version:  version,
+			Digest:   models.ObjectDigestBytes(bytes.Repeat([]byte{byte(version)}, 32)),
+		}}})
+		value := ptb.Data.V1.AddInput(transaction.CallArg{Pure: &transaction.Pure{Bytes: binary.LittleEndian.AppendUint64(nil, amount)}})
+		ptb.MoveCall("0xa1", "counter", "increment_by", nil, []transaction.Argument{object, value})
+
+		offline := &bind.OfflineContext{
+			Sender: sender,
+			GasPayment: []models.SuiObjectRef{
+				{ObjectId: "0x" + strings.Repeat("0c", 32), Version: gasVersion, Digest: base58.Encode(make([]byte, 32))},
+			},
+			GasPrice:  1000,
+			GasBudget: 20_000_000,
+		}
+		txBytes, err := offline.BuildTransaction(ptb)
+		require.NoError(t, err)
+		calls, err := decodeTransactionCalls(txBytes)
+		require.NoError(t, err)
+
+		return calls
+	}
+
+	calls := build(t, 1, 3, 5, 10)
+	// executing an earlier version of the transaction changes the versions of its owned objects and gas coins
+	assert.True(t, calls.equal(build(t, 1, 4, 6, 10)))
+	assert.False(t, calls.equal(build(t, 2, 3, 5, 10)), "another owned object")
+	assert.False(t, calls.equal(build(t, 1, 3, 5, 11)), "another pure input")
+	assert.False(t, calls.equal(nil))
+}
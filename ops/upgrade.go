@@ -0,0 +1,103 @@
+package operations
+
+import (
+	"context"
+	"errors"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+
+	cld_ops "github.com/smartcontractkit/chainlink-deployments-framework/operations"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	"github.com/smartcontractkit/chainlink-sui/bindings/packages/mcms"
+)
+
+// UpgradeAuthorization selects how a package upgrade is authorized: with the UpgradeCap owned by the signer,
+// or with the UpgradeCap registered with MCMS once the timelock operation authorizing the upgrade is ready.
+// Exactly one of UpgradeCapObjectId and Mcms must be set.
+type UpgradeAuthorization struct {
+	UpgradeCapObjectId string
+	Mcms               *mcms.UpgradeAuthorizerConfig
+}
+
+func (a UpgradeAuthorization) Authorizer(client sui.ISuiAPI) (bind.UpgradeAuthorizer, error) {
+	switch {
+	case a.UpgradeCapObjectId != "" && a.Mcms != nil:
+		return nil, errors.New("upgrade must be authorized either by an UpgradeCap or by MCMS, not both")
+	case a.UpgradeCapObjectId != "":
+		return bind.NewUpgradeCapAuthorizer(a.UpgradeCapObjectId, client)
+	case a.Mcms != nil:
+		return mcms.NewUpgradeAuthorizer(*a.Mcms, client)
+	default:
+		return nil, errors.New("upgrade must be authorized by an UpgradeCap or by MCMS")
+	}
+}
+
+type UpgradePackageObjects struct {
+	// Version of the upgraded package, the first version of a package being 1
+	Version uint64
+}
+
+// NewUpgradeResult returns the result of an operation upgrading a package to packageId in the transaction.
+func NewUpgradeResult(tx *models.SuiTransactionBlockResponse, packageId string) (OpTxResult[UpgradePackageObjects], error) {
+	result, err := bind.NewPublishResult(*tx)
+	if err != nil {
+		return OpTxResult[UpgradePackageObjects]{}, err
+	}
+
+	return OpTxResult[UpgradePackageObjects]{
+		Digest:    tx.Digest,
+		PackageId: packageId,
+		Objects: UpgradePackageObjects{
+			Version: result.Version,
+		},
+	}, nil
+}
+
+// UpgradePackageInput is the input common to the operations upgrading a package, embedded in their inputs.
+type UpgradePackageInput struct {
+	// PackageId is the ID of the current version of the package
+	PackageId     string
+	Policy        bind.UpgradePolicy
+	Authorization UpgradeAuthorization
+}
+
+func (i UpgradePackageInput) packageUpgrade() UpgradePackageInput {
+	return i
+}
+
+type upgradeOperationInput interface {
+	packageUpgrade() UpgradePackageInput
+}
+
+// UpgradeFunc upgrades the package of an operation input with the authorizer, e.g. with the Upgrade function of
+// its binding, and returns the ID of the upgraded package.
+type UpgradeFunc[I any] func(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI, authorizer bind.UpgradeAuthorizer, input I) (string, *models.SuiTransactionBlockResponse, error)
+
+// NewUpgradeHandler returns the handler of an operation upgrading a package with upgrade, signed by the signer of
+// the deps and authorized by the Authorization of the input. packageName names the package in the logs.
+func NewUpgradeHandler[I upgradeOperationInput](packageName string, upgrade UpgradeFunc[I]) cld_ops.OperationHandler[I, OpTxResult[UpgradePackageObjects], OpTxDeps] {
+	return func(b cld_ops.Bundle, deps OpTxDeps, input I) (output OpTxResult[UpgradePackageObjects], err error) {
+		opts := deps.GetCallOpts()
+		opts.Signer = deps.Signer
+		upgradeInput := input.packageUpgrade()
+		authorizer, err := upgradeInput.Authorization.Authorizer(deps.Client)
+		if err != nil {
+			return OpTxResult[UpgradePackageObjects]{}, err
+		}
+
+		packageId, tx, err := upgrade(b.GetContext(), opts, deps.Client, authorizer, input)
+		if err != nil {
+			return OpTxResult[UpgradePackageObjects]{}, err
+		}
+
+		output, err = NewUpgradeResult(tx, packageId)
+		if err != nil {
+			return OpTxResult[UpgradePackageObjects]{}, err
+		}
+		b.Logger.Infow(packageName+" package upgraded", "previousPackageId", upgradeInput.PackageId, "packageId", output.PackageId, "version", output.Objects.Version)
+
+		return output, nil
+	}
+}
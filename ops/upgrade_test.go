@@ -0,0 +1,108 @@
+package operations
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	cld_ops "github.com/smartcontractkit/chainlink-deployments-framework/operations"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind/bindtest"
+)
+
+type upgradeTestInput struct {
+	UpgradePackageInput
+	Modules []string
+}
+
+var upgradeTestOp = cld_ops.NewOperation(
+	NewSuiOperationName("test", "package", "upgrade"),
+	semver.MustParse("0.1.0"),
+	"Upgrades the test package",
+	NewUpgradeHandler("test", func(ctx context.Context, opts *bind.CallOpts, client sui.ISuiAPI, authorizer bind.UpgradeAuthorizer, input upgradeTestInput) (string, *models.SuiTransactionBlockResponse, error) {
+		result, tx, err := bind.UpgradePackage(ctx, opts, client, bind.UpgradeRequest{
+			CompiledModules: input.Modules,
+			Dependencies:    []string{"0x1", "0x2"},
+			PackageId:       input.PackageId,
+			Policy:          input.Policy,
+		}, authorizer)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return result.PackageId, tx, nil
+	}),
+)
+
+func TestUpgradeHandler(t *testing.T) {
+	t.Parallel()
+
+	objectId := func(b byte) string {
+		return fmt.Sprintf("0x%064x", b)
+	}
+	digest := base58.Encode(make([]byte, 32))
+	sender := objectId(0x5e)
+	packageId := objectId(0xa1)
+	upgradeCapId := objectId(0xca)
+
+	client := bindtest.NewSuiClient()
+	client.AddGasCoin(objectId(0xc0), 4, digest, sender, 1_000_000_000)
+	client.AddOwnedObject(upgradeCapId, "0x2::package::UpgradeCap", 3, digest, sender)
+	client.OnExecute = func(tx *bind.DecodedTransaction) (models.SuiTransactionBlockResponse, error) {
+		return models.SuiTransactionBlockResponse{
+			Digest:        "upgrade",
+			Effects:       models.SuiEffects{Status: models.ExecutionStatus{Status: "success"}},
+			ObjectChanges: []models.ObjectChange{{Type: "published", PackageId: objectId(0xa2), Version: "2"}},
+		}, nil
+	}
+
+	deps := OpTxDeps{
+		Client: client,
+		Signer: bindtest.Signer(sender),
+		GetCallOpts: func() *bind.CallOpts {
+			gasBudget := bind.DefaultGasBudget
+			return &bind.CallOpts{GasBudget: &gasBudget}
+		},
+	}
+	bundle := cld_ops.NewBundle(context.Background, logger.Test(t), cld_ops.NewMemoryReporter())
+	input := upgradeTestInput{
+		UpgradePackageInput: UpgradePackageInput{
+			PackageId: packageId,
+			Policy:    bind.UpgradePolicyAdditive,
+		},
+		Modules: []string{base64.StdEncoding.EncodeToString([]byte{0xa1, 0x1c, 0xeb, 0x0b})},
+	}
+
+	// the authorization is required
+	_, err := cld_ops.ExecuteOperation(bundle, upgradeTestOp, deps, input)
+	require.ErrorContains(t, err, "upgrade must be authorized by an UpgradeCap or by MCMS")
+	assert.Empty(t, client.Executed())
+
+	input.Authorization = UpgradeAuthorization{UpgradeCapObjectId: upgradeCapId}
+	report, err := cld_ops.ExecuteOperation(bundle, upgradeTestOp, deps, input)
+	require.NoError(t, err)
+	assert.Equal(t, OpTxResult[UpgradePackageObjects]{
+		Digest:    "upgrade",
+		PackageId: objectId(0xa2),
+		Objects:   UpgradePackageObjects{Version: 2},
+	}, report.Output)
+
+	executed := client.Executed()
+	require.Len(t, executed, 1)
+	assert.Equal(t, sender, executed[0].Sender)
+	assert.Equal(t, []string{
+		"MoveCall 0x0000000000000000000000000000000000000000000000000000000000000002::package::authorize_upgrade(Input(0), Input(1), Input(2))",
+		fmt.Sprintf("Upgrade(1 modules, dependencies [%s, %s], package %s, ticket Result(0))", objectId(1), objectId(2), packageId),
+		"MoveCall 0x0000000000000000000000000000000000000000000000000000000000000002::package::commit_upgrade(Input(0), Result(1))",
+	}, executed[0].Commands)
+}
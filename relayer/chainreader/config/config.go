@@ -11,6 +11,9 @@ import (
 type ChainReaderConfig struct {
 	IsLoopPlugin bool
 	Modules      map[string]*ChainReaderModule
+	// StrictValidation fails binding packages when the config does not match their modules (optional). Problems
+	// caused by modules that can't be fetched, e.g. of packages not deployed yet, are only logged.
+	StrictValidation bool
 }
 
 type ChainReaderModule struct {
@@ -29,6 +32,9 @@ type ChainReaderFunction struct {
 	Params        []codec.SuiFunctionParam
 	// Defines a way to transform a tuple result into a JSON object
 	ResultTupleToStruct []string
+	// ResultType is a value of the Go struct results are decoded into (optional). The `sui` tags of its fields are
+	// validated against the return types of the function at Bind time.
+	ResultType any `json:"-"`
 }
 
 type ChainReaderEvent struct {
@@ -45,6 +51,10 @@ type ChainReaderEvent struct {
 
 	// Renames provided filters to match the event field names (optional). When not provided, the filters are used as-is.
 	EventFilterRenames map[string]string
+
+	// DataType is a value of the Go struct events are decoded into (optional). The `sui` tags of its fields are
+	// validated against the layout of the event struct at Bind time.
+	DataType any `json:"-"`
 }
 
 type RenamedField struct {
@@ -40,6 +40,18 @@ func (store *DBStore) EnsureSchema(ctx context.Context) error {
 		return fmt.Errorf("failed to create sui.events table: %w", err)
 	}
 
+	// tables created before events were stored as BCS lack the column
+	_, err = store.ds.ExecContext(ctx, AddEventsBcsColumn)
+	if err != nil {
+		return fmt.Errorf("failed to add bcs column to sui.events table: %w", err)
+	}
+
+	// and the ones created before the type of each event was stored
+	_, err = store.ds.ExecContext(ctx, AddEventsTypeColumn)
+	if err != nil {
+		return fmt.Errorf("failed to add event_type column to sui.events table: %w", err)
+	}
+
 	return nil
 }
 
@@ -52,7 +64,23 @@ type EventRecord struct {
 	BlockHeight         string
 	BlockHash           []byte
 	BlockTimestamp      uint64
-	Data                map[string]any
+	// Data is the event in its canonical JSON form, see movebcs.ToJSON, or as parsed by the node when it could not be
+	// decoded from BCS
+	Data map[string]any
+	// Bcs holds the raw BCS bytes of the event, nil when only the node's parsed form is known
+	Bcs []byte
+	// EventType is the full type of the event as emitted, with the package that defines it and its type arguments,
+	// e.g. 0x1::pool::Locked<0x2::sui::SUI>. It is empty for events stored before it was recorded.
+	EventType string
+}
+
+// Type returns the type the data of the event is decoded with: its EventType, or its handle when it is unknown.
+func (record EventRecord) Type() string {
+	if record.EventType != "" {
+		return record.EventType
+	}
+
+	return record.EventHandle
 }
 
 func (store *DBStore) InsertEvents(ctx context.Context, records []EventRecord) error {
@@ -76,6 +104,8 @@ func (store *DBStore) InsertEvents(ctx context.Context, records []EventRecord) e
 			record.BlockHash,
 			record.BlockTimestamp,
 			data,
+			record.Bcs,
+			record.EventType,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to insert event (handle: %s, offset: %d): %w", record.EventHandle, record.EventOffset, err)
@@ -133,7 +163,7 @@ func (store *DBStore) QueryEvents(ctx context.Context, eventAccountAddress, even
 	for rows.Next() {
 		var record EventRecord
 		var dataBytes []byte
-		err := rows.Scan(&record.EventAccountAddress, &record.EventHandle, &record.EventOffset, &record.BlockVersion, &record.BlockHeight, &record.BlockHash, &record.BlockTimestamp, &record.TxDigest, &dataBytes)
+		err := rows.Scan(&record.EventAccountAddress, &record.EventHandle, &record.EventOffset, &record.BlockVersion, &record.BlockHeight, &record.BlockHash, &record.BlockTimestamp, &record.TxDigest, &dataBytes, &record.Bcs, &record.EventType)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan event record: %w", err)
 		}
@@ -179,6 +209,29 @@ func (store *DBStore) GetLatestOffset(ctx context.Context, eventAccountAddress,
 	}, totalCount, nil
 }
 
+// GetLatestEventId returns the ID of the latest event recorded in the DB for any of the given types, or 0 if there
+// is none. IDs only increase, so the result changes whenever a new event of one of the types is indexed.
+func (store *DBStore) GetLatestEventId(ctx context.Context, eventAccountAddress string, eventHandles ...string) (uint64, error) {
+	if len(eventHandles) == 0 {
+		return 0, errors.New("no event handles to get the latest event id of")
+	}
+
+	placeholders := make([]string, len(eventHandles))
+	args := []any{eventAccountAddress}
+	for i, eventHandle := range eventHandles {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args = append(args, eventHandle)
+	}
+
+	var id uint64
+	err := store.ds.QueryRowxContext(ctx, QueryLatestEventId+"("+strings.Join(placeholders, ", ")+")", args...).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest event id for %s: %w", strings.Join(eventHandles, ", "), err)
+	}
+
+	return id, nil
+}
+
 func (store *DBStore) GetTxDigestByEventId(ctx context.Context, eventID uint64) (string, error) {
 	var txDigest string
 	err := store.ds.QueryRowxContext(ctx, GetTxDigestById, eventID).Scan(&txDigest)
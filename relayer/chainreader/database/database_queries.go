@@ -17,10 +17,20 @@ const (
 		block_hash BYTEA NOT NULL,
 		block_timestamp BIGINT NOT NULL,
 		data JSONB NOT NULL,
+		bcs BYTEA,
+		event_type TEXT,
 		UNIQUE (event_account_address, event_handle, tx_digest, event_offset)
 	);
     `
 
+	AddEventsBcsColumn = `
+	ALTER TABLE sui.events ADD COLUMN IF NOT EXISTS bcs BYTEA;
+    `
+
+	AddEventsTypeColumn = `
+	ALTER TABLE sui.events ADD COLUMN IF NOT EXISTS event_type TEXT;
+    `
+
 	InsertEvent = `
 	INSERT INTO sui.events (
 		event_account_address,
@@ -31,13 +41,15 @@ const (
 		block_height,
 		block_hash,
 		block_timestamp,
-		data
-	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		data,
+		bcs,
+		event_type
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, ''))
 	ON CONFLICT DO NOTHING;
     `
 
 	QueryEventsBase = `
-	SELECT event_account_address, event_handle, event_offset, block_version, block_height, block_hash, block_timestamp, tx_digest, data
+	SELECT event_account_address, event_handle, event_offset, block_version, block_height, block_hash, block_timestamp, tx_digest, data, bcs, COALESCE(event_type, '')
 	FROM sui.events
 	WHERE event_account_address = $1 AND event_handle = $2
     `
@@ -56,6 +68,12 @@ const (
 	WHERE event_account_address = $1 AND event_handle = $2 
 	`
 
+	// QueryLatestEventId is completed with the list of event handles, e.g. "($2, $3)"
+	QueryLatestEventId = `
+	SELECT COALESCE(MAX(id), 0)
+	FROM sui.events
+	WHERE event_account_address = $1 AND event_handle IN `
+
 	GetTxDigestById = `
 	SELECT tx_digest
 	FROM sui.events
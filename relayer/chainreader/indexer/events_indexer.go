@@ -13,10 +13,16 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
 	"github.com/smartcontractkit/chainlink-sui/relayer/chainreader/database"
 	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
 )
 
+// ErrEventsWithoutBCS is returned by SyncEvent once it has stored events whose BCS could not be decoded. The events
+// are stored with the JSON parsed by the node, and are read back from it, which loses the precision of large integers.
+var ErrEventsWithoutBCS = errors.New("events stored without BCS")
+
 type EventsIndexer struct {
 	db                  *database.DBStore
 	client              client.SuiPTBClient
@@ -188,6 +194,44 @@ func convertMapKeysToCamelCaseWithPath(input any, path string) any {
 	return input
 }
 
+// decodeEvent decodes the BCS of an event with the layout of its type and returns it in its canonical JSON form,
+// along with the raw BCS bytes.
+func (eIndexer *EventsIndexer) decodeEvent(ctx context.Context, event bind.SuiEvent) (map[string]any, []byte, error) {
+	if event.Bcs == "" {
+		return nil, nil, errors.New("event has no BCS")
+	}
+
+	bcsBytes, err := event.DecodeBCS()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := DecodeEventData(ctx, eIndexer.client, event.Type, bcsBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, bcsBytes, nil
+}
+
+// DecodeEventData decodes the BCS of an event of the given type into the JSON form the indexer stores, with its keys
+// in camel case.
+func DecodeEventData(ctx context.Context, ptbClient client.SuiPTBClient, eventType string, bcsBytes []byte) (map[string]any, error) {
+	value, err := ptbClient.DecodeMoveValue(ctx, eventType, bcsBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := movebcs.ToJSON(value).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("expected event %s to decode to a struct, got %T", eventType, value)
+	}
+
+	normalized, _ := convertMapKeysToCamelCase(data).(map[string]any)
+
+	return normalized, nil
+}
+
 func (eIndexer *EventsIndexer) SyncEvent(ctx context.Context, selector *client.EventSelector) error {
 	if selector == nil {
 		return fmt.Errorf("unspecified selector for SyncEvent call")
@@ -218,6 +262,8 @@ func (eIndexer *EventsIndexer) SyncEvent(ctx context.Context, selector *client.E
 
 	batchSize := uint(batchSizeRecords)
 	var totalProcessed int
+	// the errors of the events stored without their BCS, which are returned once the events are saved
+	var decodeErrs []error
 
 	sortOptions := &client.QuerySortOptions{
 		Descending: false, // Process events in chronological order
@@ -281,8 +327,17 @@ eventLoop:
 				//nolint:gosec
 				offset += uint64(i) + totalCount
 
-				// normalize the data, convert snake case to camel case
-				normalizedData := convertMapKeysToCamelCase(event.ParsedJson)
+				// decode the event from its BCS, falling back to the node's parsed JSON when it cannot be decoded
+				eventData, eventBcs, err := eIndexer.decodeEvent(ctx, event)
+				if err != nil {
+					eIndexer.logger.Errorw("syncEvent: failed to decode event BCS, storing parsed JSON",
+						"type", event.Type, "txDigest", event.Id.TxDigest, "error", err)
+
+					decodeErrs = append(decodeErrs, fmt.Errorf("event %s#%s: %w", event.Id.TxDigest, event.Id.EventSeq, err))
+					// normalize the parsed JSON of the node the same way as the decoded data, with camel case keys
+					eventData, _ = convertMapKeysToCamelCase(event.ParsedJson).(map[string]any)
+					eventBcs = nil
+				}
 
 				// Convert event to database record
 				record := database.EventRecord{
@@ -294,7 +349,9 @@ eventLoop:
 					BlockHeight:         fmt.Sprintf("%d", block.Height),
 					BlockHash:           []byte(block.TxDigest),
 					BlockTimestamp:      block.Timestamp,
-					Data:                normalizedData.(map[string]any),
+					Data:                eventData,
+					Bcs:                 eventBcs,
+					EventType:           event.Type,
 				}
 				batchRecords = append(batchRecords, record)
 			}
@@ -335,6 +392,10 @@ eventLoop:
 		}
 	}
 
+	if len(decodeErrs) > 0 {
+		return fmt.Errorf("syncEvent: %w: %d events of %s: %w", ErrEventsWithoutBCS, len(decodeErrs), eventHandle, errors.Join(decodeErrs...))
+	}
+
 	return nil
 }
 
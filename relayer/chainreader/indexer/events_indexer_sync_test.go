@@ -0,0 +1,131 @@
+//go:build unit
+
+package indexer_test
+
+import (
+	"context"
+	"encoding/base64"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil/sqltest"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	"github.com/smartcontractkit/chainlink-sui/relayer/chainreader/database"
+	"github.com/smartcontractkit/chainlink-sui/relayer/chainreader/indexer"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
+	"github.com/smartcontractkit/chainlink-sui/relayer/testutils"
+)
+
+const counterModuleJSON = `{
+	"address": "0xabc",
+	"name": "counter",
+	"structs": {
+		"CounterIncremented": {
+			"typeParameters": [{"constraints": {"abilities": []}, "isPhantom": false}],
+			"fields": [{"name": "new_value", "type": "U128"}, {"name": "tag", "type": {"TypeParameter": 0}}]
+		}
+	}
+}`
+
+//nolint:paralleltest
+func TestEventsIndexerSyncEventBCS(t *testing.T) {
+	ctx := context.Background()
+	log := logger.Test(t)
+
+	datastoreUrl := os.Getenv("TEST_DB_URL")
+	if datastoreUrl == "" {
+		t.Skip("Skipping persistent tests as TEST_DB_URL is not set in CI")
+	}
+	db := sqltest.NewDB(t, datastoreUrl)
+	dbStore := database.NewDBStore(db, log)
+	require.NoError(t, dbStore.EnsureSchema(ctx))
+
+	registry := movebcs.NewRegistry()
+	require.NoError(t, registry.AddNormalizedModuleJSON([]byte(counterModuleJSON)))
+	eventType, err := movebcs.ParseType("0xabc::counter::CounterIncremented<u8>")
+	require.NoError(t, err)
+
+	// above 2^53, so the value only survives when it is decoded from its BCS
+	large, ok := new(big.Int).SetString("1267650600228229401496703205376", 10)
+	require.True(t, ok)
+	encoded, err := registry.Encode(eventType, map[string]any{"new_value": large, "tag": uint8(1)})
+	require.NoError(t, err)
+
+	event := func(seq string, bcs string, bcsEncoding string) bind.SuiEvent {
+		return bind.SuiEvent{
+			SuiEventResponse: models.SuiEventResponse{
+				Id:         models.EventId{TxDigest: "digest", EventSeq: seq},
+				Type:       "0xabc::counter::CounterIncremented<u8>",
+				ParsedJson: map[string]any{"new_value": float64(1)},
+				Bcs:        bcs,
+			},
+			BcsEncoding: bcsEncoding,
+		}
+	}
+
+	ptbClient := &testutils.FakeSuiPTBClient{
+		MoveTypes: registry,
+		Events: []bind.SuiEvent{
+			event("0", base64.StdEncoding.EncodeToString(encoded), bind.BcsEncodingBase64),
+			event("1", base58.Encode(encoded), bind.BcsEncodingBase58),
+			event("2", "not base64!", bind.BcsEncodingBase64),
+		},
+	}
+	selector := &client.EventSelector{Package: "0xabc", Module: "counter", Event: "CounterIncremented"}
+	eventsIndexer := indexer.NewEventIndexer(db, log, ptbClient, []*client.EventSelector{selector}, time.Second, 10*time.Second)
+
+	// the event without BCS is stored from its parsed JSON, and reported
+	err = eventsIndexer.SyncEvent(ctx, selector)
+	require.ErrorIs(t, err, indexer.ErrEventsWithoutBCS)
+
+	records, err := dbStore.QueryEvents(ctx, "0xabc", "0xabc::counter::CounterIncremented", nil, query.LimitAndSort{
+		Limit: query.Limit{Count: 10},
+	})
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	for _, record := range records[:2] {
+		assert.Equal(t, encoded, record.Bcs)
+		assert.Equal(t, map[string]any{"newValue": large.String(), "tag": float64(1)}, record.Data)
+	}
+	for _, record := range records {
+		// the handle lacks the type arguments the event is decoded with
+		assert.Equal(t, "0xabc::counter::CounterIncremented<u8>", record.Type())
+	}
+	assert.Nil(t, records[2].Bcs)
+	assert.Equal(t, map[string]any{"newValue": float64(1)}, records[2].Data)
+}
+
+func TestDecodeEventData(t *testing.T) {
+	t.Parallel()
+
+	registry := movebcs.NewRegistry()
+	require.NoError(t, registry.AddNormalizedModuleJSON([]byte(counterModuleJSON)))
+	eventType, err := movebcs.ParseType("0xabc::counter::CounterIncremented<u8>")
+	require.NoError(t, err)
+	encoded, err := registry.Encode(eventType, map[string]any{"new_value": big.NewInt(7), "tag": uint8(1)})
+	require.NoError(t, err)
+	ptbClient := &testutils.FakeSuiPTBClient{MoveTypes: registry}
+
+	data, err := indexer.DecodeEventData(context.Background(), ptbClient, "0xabc::counter::CounterIncremented<u8>", encoded)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"newValue": "7", "tag": uint8(1)}, data)
+
+	_, err = indexer.DecodeEventData(context.Background(), ptbClient, "0xabc::counter::CounterIncremented<u8>", encoded[:3])
+	require.Error(t, err)
+
+	// the generic event cannot be decoded without its type arguments
+	_, err = indexer.DecodeEventData(context.Background(), ptbClient, "0xabc::counter::CounterIncremented", encoded)
+	require.Error(t, err)
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"regexp"
 	"slices"
 	"strconv"
 	"sync"
@@ -21,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink-sui/relayer/chainreader/database"
 	"github.com/smartcontractkit/chainlink-sui/relayer/chainreader/util"
 	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client/suierrors"
 	"github.com/smartcontractkit/chainlink-sui/relayer/codec"
 )
 
@@ -327,16 +327,9 @@ func (tIndexer *TransactionsIndexer) syncTransmitterTransactions(ctx context.Con
 				continue
 			}
 
-			if moveAbort.Location.Module.Name != moduleKey {
-				tIndexer.logger.Debugw("Skipping transaction with different module",
-					"transmitter", transmitter, "module", moveAbort.Location.Module.Name)
-
-				continue
-			}
-
-			if moveAbort.Location.FunctionName == nil || !slices.Contains(tIndexer.executeFunctions, *moveAbort.Location.FunctionName) {
-				tIndexer.logger.Debugw("Skipping transaction for non-execute function",
-					"transmitter", transmitter, "function", *moveAbort.Location.FunctionName)
+			if !tIndexer.isMessageExecutionFailure(&transactionRecord, moveAbort) {
+				tIndexer.logger.Debugw("Skipping transaction not failing a message execution",
+					"transmitter", transmitter, "module", moveAbort.Location.Module.Name, "commandIndex", moveAbort.CommandIndex)
 
 				continue
 			}
@@ -602,94 +595,39 @@ func (t *TransactionsIndexer) getEventPackageIdFromConfig(_, _ string) (string,
 	return "", fmt.Errorf("offramp package not set yet")
 }
 
-// ModuleId represents Move’s ModuleId { address, name }
-type ModuleId struct {
-	Address string
-	Name    string
-}
-
-// MoveLocation corresponds to MoveLocation { module, function, instruction, function_name }
-type MoveLocation struct {
-	Module       ModuleId
-	Function     uint64
-	Instruction  uint64
-	FunctionName *string // nil if None
-}
-
-// MoveAbort wraps a MoveLocation plus abort code and PTB command index
-type MoveAbort struct {
-	Location     MoveLocation
-	AbortCode    uint64
-	CommandIndex uint64
-}
-
-// regex to capture:
-//
-//	1: address (hex)
-//	2: module name
-//	3: function (decimal)
-//	4: instruction (decimal)
-//	5: either Some("X") or None
-//	6: inner X from Some("X") (empty if None)
-//	7: abort code
-//	8: command index
-var abortRe = regexp.MustCompile(
-	`^MoveAbort\(` +
-		`MoveLocation \{ module: ModuleId \{ address: ([0-9a-f]+), name: Identifier\("([^"]+)"\) \}, ` +
-		`function: (\d+), instruction: (\d+), function_name: (Some\("([^"]+)"\)|None) \}, ` +
-		`(\d+)\) in command (\d+)$`,
-)
-
-// ParseMoveAbort parses the error string into a MoveAbort struct.
-func (tIndexer *TransactionsIndexer) parseMoveAbort(s string) (*MoveAbort, error) {
-	m := abortRe.FindStringSubmatch(s)
-	if m == nil {
-		return nil, fmt.Errorf("input does not match MoveAbort pattern")
+// isMessageExecutionFailure reports whether a Move abort failed the execution of the message of an execute PTB,
+// i.e. the abort happened in one of the offramp execute functions, or in any command following `init_execute`
+// (token pool and receiver calls). Aborts in `init_execute` itself are report level failures, e.g. a root not
+// committed yet, and the message can be executed later.
+func (tIndexer *TransactionsIndexer) isMessageExecutionFailure(transactionRecord *models.SuiTransactionBlockResponse, moveAbort *MoveAbort) bool {
+	if moveAbort.Location.Module.Name == tIndexer.executionEventModuleKey &&
+		moveAbort.Location.FunctionName != nil &&
+		slices.Contains(tIndexer.executeFunctions, *moveAbort.Location.FunctionName) {
+		return true
 	}
-	// m[1]=address, m[2]=modName, m[3]=func, m[4]=instr,
-	// m[5]=full (Some("…")|None), m[6]=inner name or "",
-	// m[7]=abortCode, m[8]=cmdIndex
 
-	// parse integers
-	fn, err := strconv.ParseUint(m[3], 10, 64)
-	if err != nil {
-		return nil, fmt.Errorf("bad function number: %w", err)
-	}
-	instr, err := strconv.ParseUint(m[4], 10, 64)
-	if err != nil {
-		return nil, fmt.Errorf("bad instruction number: %w", err)
-	}
-	abortCode, err := strconv.ParseUint(m[7], 10, 64)
-	if err != nil {
-		return nil, fmt.Errorf("bad abort code: %w", err)
-	}
-	cmdIdx, err := strconv.ParseUint(m[8], 10, 64)
-	if err != nil {
-		return nil, fmt.Errorf("bad command index: %w", err)
+	if moveAbort.CommandIndex == 0 {
+		return false
 	}
 
-	// optional function name
-	var fname *string
-	if m[5] != "None" {
-		fname = new(string)
-		*fname = m[6]
+	// the first command of an execute PTB is always `offramp::init_execute`
+	commands := transactionRecord.Transaction.Data.Transaction.Transactions
+	if len(commands) == 0 {
+		return false
 	}
+	moveCall := models.MoveCall(commands[0])
 
-	loc := MoveLocation{
-		Module: ModuleId{
-			Address: m[1],
-			Name:    m[2],
-		},
-		Function:     fn,
-		Instruction:  instr,
-		FunctionName: fname,
-	}
+	return moveCall != nil && moveCall.Module == tIndexer.executionEventModuleKey && moveCall.Function == "init_execute"
+}
+
+// ModuleId, MoveLocation and MoveAbort describe the location of a Move abort, see suierrors.MoveAbort
+type ModuleId = suierrors.ModuleId
+type MoveLocation = suierrors.MoveLocation
+type MoveAbort = suierrors.MoveAbort
 
-	return &MoveAbort{
-		Location:     loc,
-		AbortCode:    abortCode,
-		CommandIndex: cmdIdx,
-	}, nil
+// parseMoveAbort parses the error string into a MoveAbort struct.
+func (tIndexer *TransactionsIndexer) parseMoveAbort(s string) (*MoveAbort, error) {
+	return suierrors.ParseMoveAbort(s)
 }
 
 // extractCommandCallArgs zips the input indices with the input call args to output a slice of call arg details
@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink-aptos/relayer/chainreader/loop"
 
 	"github.com/smartcontractkit/chainlink-sui/relayer/codec"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
 )
 
 const (
@@ -23,7 +24,7 @@ const (
 )
 
 func NewLoopChainReader(log logger.Logger, reader types.ContractReader) types.ContractReader {
-	return &loopChainReader{logger: log, reader: reader, moduleAddresses: map[string]string{}}
+	return &loopChainReader{logger: log, reader: reader, moduleAddresses: map[string]string{}, moveTypes: movebcs.NewRegistry()}
 }
 
 type loopChainReader struct {
@@ -32,6 +33,9 @@ type loopChainReader struct {
 	logger          logger.Logger
 	reader          types.ContractReader
 	moduleAddresses map[string]string
+	// moveTypes converts event data into `sui` tagged structs. It holds no layouts, so the tagged fields must
+	// declare their Move type.
+	moveTypes *movebcs.Registry
 }
 
 func (s *loopChainReader) Name() string {
@@ -178,7 +182,11 @@ func (s *loopChainReader) QueryKey(ctx context.Context, contract types.BoundCont
 		}
 
 		eventData := reflect.New(reflect.TypeOf(sequenceDataType).Elem()).Interface()
-		err = codec.DecodeSuiJsonValue(jsonData, eventData)
+		if movebcs.HasTags(reflect.TypeOf(sequenceDataType)) {
+			err = s.moveTypes.UnmarshalFields(jsonData, eventData)
+		} else {
+			err = codec.DecodeSuiJsonValue(jsonData, eventData)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("failed to decode LOOP sourced event data (`%s`) into a Sui value: %+w", string(*jsonBytes), err)
 		}
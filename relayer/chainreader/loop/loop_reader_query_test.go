@@ -0,0 +1,71 @@
+//go:build unit
+
+package loop
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+)
+
+// jsonEventsReader serves events as the JSON bytes a chain reader running as a LOOP plugin returns.
+type jsonEventsReader struct {
+	types.UnimplementedContractReader
+	events []string
+}
+
+func (r *jsonEventsReader) Bind(ctx context.Context, bindings []types.BoundContract) error {
+	return nil
+}
+
+func (r *jsonEventsReader) QueryKey(ctx context.Context, contract types.BoundContract, filter query.KeyFilter, limitAndSort query.LimitAndSort, sequenceDataType any) ([]types.Sequence, error) {
+	sequences := make([]types.Sequence, len(r.events))
+	for i, event := range r.events {
+		data := []byte(event)
+		sequences[i] = types.Sequence{Data: &data}
+	}
+
+	return sequences, nil
+}
+
+func TestLoopChainReaderQueryKeyTaggedEvents(t *testing.T) {
+	t.Parallel()
+
+	type poolLocked struct {
+		Sender   string   `sui:"sender,type=address"`
+		Amount   *big.Int `sui:"amount,type=u128"`
+		Nonce    uint64   `sui:"nonce,type=u64"`
+		Receiver []byte   `sui:"receiver,type=vector<u8>"`
+	}
+
+	reader := NewLoopChainReader(logger.Test(t), &jsonEventsReader{events: []string{
+		`{"sender": "0x2", "amount": "1267650600228229401496703205376", "nonce": "7", "receiver": [1, 2]}`,
+	}})
+
+	sequences, err := reader.QueryKey(context.Background(), types.BoundContract{Name: "Pool"}, query.KeyFilter{Key: "PoolLocked"}, query.LimitAndSort{}, &poolLocked{})
+	require.NoError(t, err)
+	require.Len(t, sequences, 1)
+
+	amount, ok := new(big.Int).SetString("1267650600228229401496703205376", 10)
+	require.True(t, ok)
+	assert.Equal(t, &poolLocked{
+		Sender:   "0x0000000000000000000000000000000000000000000000000000000000000002",
+		Amount:   amount,
+		Nonce:    7,
+		Receiver: []byte{1, 2},
+	}, sequences[0].Data)
+
+	// tagged fields must declare their Move type, as there is no layout to read it from
+	type undeclared struct {
+		Nonce uint64 `sui:"nonce"`
+	}
+	_, err = reader.QueryKey(context.Background(), types.BoundContract{Name: "Pool"}, query.KeyFilter{Key: "PoolLocked"}, query.LimitAndSort{}, &undeclared{})
+	require.ErrorContains(t, err, "does not declare its Move type")
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
 	"reflect"
@@ -25,6 +26,8 @@ import (
 	"github.com/smartcontractkit/chainlink-sui/relayer/chainreader/indexer"
 	"github.com/smartcontractkit/chainlink-sui/relayer/client"
 	"github.com/smartcontractkit/chainlink-sui/relayer/codec"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
+	"github.com/smartcontractkit/chainlink-sui/relayer/configvalidator"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	pkgtypes "github.com/smartcontractkit/chainlink-common/pkg/types"
@@ -123,6 +126,20 @@ func (s *suiChainReader) Bind(ctx context.Context, bindings []pkgtypes.BoundCont
 		newBindings[binding.Name] = binding.Address
 	}
 
+	report := configvalidator.NewValidator(s.client).ValidateChainReaderConfig(ctx, s.config, newBindings)
+	if err := report.Err(); err != nil {
+		s.logger.Errorw("chain reader config does not match the bound packages", "err", err)
+	}
+	if s.config.StrictValidation {
+		if err := report.ErrIgnoringUnavailableModules(); err != nil {
+			return fmt.Errorf("failed to bind packages: %w", err)
+		}
+	}
+	// the `sui` tags of the configured types must match the bound packages whatever the validation mode
+	if err := report.TagsErr(); err != nil {
+		return fmt.Errorf("failed to bind packages: %w", err)
+	}
+
 	maps.Copy(s.packageAddresses, newBindings)
 
 	// Only need the OffRamp package for the tx indexer
@@ -184,6 +201,11 @@ func (s *suiChainReader) GetLatestValue(ctx context.Context, readIdentifier stri
 		"function", parsed.readName,
 	)
 
+	// `sui` tagged structs are decoded exactly from the BCS bytes of the results
+	if !s.config.IsLoopPlugin && movebcs.HasTags(reflect.TypeOf(returnVal)) {
+		return s.readTypedValue(ctx, parsed, params, functionConfig, returnVal)
+	}
+
 	results, err := s.callFunction(ctx, parsed, params, functionConfig)
 	if err != nil {
 		return err
@@ -228,7 +250,7 @@ func (s *suiChainReader) QueryKey(ctx context.Context, contract pkgtypes.BoundCo
 	}
 
 	// Transform events to sequences
-	sequences, err := s.transformEventsToSequences(eventRecords, sequenceDataType, false)
+	sequences, err := s.transformEventsToSequences(ctx, eventRecords, sequenceDataType, false)
 	if err != nil {
 		return nil, err
 	}
@@ -258,7 +280,7 @@ func (s *suiChainReader) QueryKeyWithMetadata(ctx context.Context, contract pkgt
 	}
 
 	// Transform events to sequences
-	sequences, err := s.transformEventsToSequences(eventRecords, sequenceDataType, true)
+	sequences, err := s.transformEventsToSequences(ctx, eventRecords, sequenceDataType, true)
 	if err != nil {
 		return nil, err
 	}
@@ -379,7 +401,10 @@ func (s *suiChainReader) updateEventConfigs(ctx context.Context, contract pkgtyp
 
 	// Sync the event in case it's not already in the database
 	err = s.indexer.GetEventIndexer().SyncEvent(ctx, &eventConfig.EventSelector)
-	if err != nil {
+	if errors.Is(err, indexer.ErrEventsWithoutBCS) {
+		// the events are stored, the indexer has already logged each of them
+		s.logger.Warnw("events were indexed without their BCS", "handle", eventConfig.EventSelector, "error", err)
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -446,6 +471,34 @@ func (s *suiChainReader) callFunction(ctx context.Context, parsed *readIdentifie
 	return responseValues, nil
 }
 
+// readTypedValue calls a contract function and decodes its results into a `sui` tagged struct: a tuple result into
+// the fields named by ResultTupleToStruct, a single result into the struct itself.
+func (s *suiChainReader) readTypedValue(ctx context.Context, parsed *readIdentifier, params any, functionConfig *config.ChainReaderFunction, returnVal any) error {
+	argMap, err := s.parseParams(params, functionConfig)
+	if err != nil {
+		return fmt.Errorf("failed to parse parameters: %w", err)
+	}
+	args, argTypes, err := s.prepareArguments(ctx, argMap, functionConfig, parsed)
+	if err != nil {
+		return fmt.Errorf("failed to prepare arguments: %w", err)
+	}
+
+	values, err := s.client.ReadFunctionBCS(ctx, functionConfig.SignerAddress, parsed.address, parsed.contractName, parsed.readName, args, argTypes)
+	if err != nil {
+		return fmt.Errorf("failed to call function %s: %w", parsed.readName, err)
+	}
+
+	if functionConfig.ResultTupleToStruct != nil {
+		return s.client.DecodeMoveValuesInto(ctx, functionConfig.ResultTupleToStruct, values, returnVal)
+	}
+
+	if len(values) != 1 {
+		return fmt.Errorf("function %s returns %d values, use ResultTupleToStruct to decode them", parsed.readName, len(values))
+	}
+
+	return s.client.DecodeMoveValueInto(ctx, values[0].Type, values[0].Bcs, returnVal)
+}
+
 // parseParams parses input parameters based on whether we're running as a LOOP plugin
 func (s *suiChainReader) parseParams(params any, functionConfig *config.ChainReaderFunction) (map[string]any, error) {
 	argMap := make(map[string]any)
@@ -724,7 +777,7 @@ type SequenceWithRecord struct {
 }
 
 // transformEventsToSequences converts database event records to sequence format
-func (s *suiChainReader) transformEventsToSequences(eventRecords []database.EventRecord, sequenceDataType any, includeRecord bool) ([]SequenceWithRecord, error) {
+func (s *suiChainReader) transformEventsToSequences(ctx context.Context, eventRecords []database.EventRecord, sequenceDataType any, includeRecord bool) ([]SequenceWithRecord, error) {
 	sequences := make([]SequenceWithRecord, 0, len(eventRecords))
 
 	s.logger.Debugw("Transforming events to sequences", "eventRecords", eventRecords, "sequenceDataType", sequenceDataType)
@@ -742,7 +795,11 @@ func (s *suiChainReader) transformEventsToSequences(eventRecords []database.Even
 				return nil, fmt.Errorf("failed to marshal data for LOOP: %w", err)
 			}
 			eventData = &jsonData
-		} else if err := codec.DecodeSuiJsonValue(record.Data, eventData); err != nil {
+		} else if movebcs.HasTags(reflect.TypeOf(sequenceDataType)) {
+			if err := s.decodeTypedEvent(ctx, record, eventData); err != nil {
+				return nil, fmt.Errorf("failed to decode event data: %w", err)
+			}
+		} else if err := s.decodeEventData(ctx, record, eventData); err != nil {
 			return nil, fmt.Errorf("failed to decode event data: %w", err)
 		}
 
@@ -780,3 +837,28 @@ func (s *suiChainReader) transformEventsToSequences(eventRecords []database.Even
 
 	return sequences, nil
 }
+
+// decodeEventData decodes an event record into an untagged target, from the JSON form of its BCS bytes when they were
+// indexed and otherwise from its JSON data, which holds the node's parsed JSON for events indexed without BCS.
+func (s *suiChainReader) decodeEventData(ctx context.Context, record database.EventRecord, target any) error {
+	data := record.Data
+	if record.Bcs != nil {
+		decoded, err := indexer.DecodeEventData(ctx, s.client, record.Type(), record.Bcs)
+		if err != nil {
+			return err
+		}
+		data = decoded
+	}
+
+	return codec.DecodeSuiJsonValue(data, target)
+}
+
+// decodeTypedEvent decodes an event record into a `sui` tagged struct, exactly from its BCS bytes when they were
+// indexed and otherwise from its JSON data.
+func (s *suiChainReader) decodeTypedEvent(ctx context.Context, record database.EventRecord, target any) error {
+	if record.Bcs != nil {
+		return s.client.DecodeMoveValueInto(ctx, record.Type(), record.Bcs, target)
+	}
+
+	return s.client.UnmarshalMoveValue(ctx, record.Type(), record.Data, target)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -14,11 +15,17 @@ import (
 	cwConfig "github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/config"
 	"github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/ptb"
 	"github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/ptb/offramp"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
 	"github.com/smartcontractkit/chainlink-sui/relayer/txm"
 )
 
 const ServiceName = "SuiChainWriter"
 
+// argTypes holds the framework layouts the declared types of `sui` tagged args are checked against, args of user
+// struct types are checked when the PTB constructor encodes them.
+var argTypes = movebcs.NewRegistry()
+
 type SuiChainWriter struct {
 	lggr       logger.Logger
 	txm        txm.TxManager
@@ -30,15 +37,24 @@ type SuiChainWriter struct {
 
 func NewSuiChainWriter(lggr logger.Logger, txManager txm.TxManager, config cwConfig.ChainWriterConfig, simulate bool) (*SuiChainWriter, error) {
 	suiClient := txManager.GetClient()
+	// objects are resolved with the resolver of the TXM, which tracks the versions of the objects it changes
+	ptbFactory := ptb.NewPTBConstructor(config, suiClient, lggr)
+	ptbFactory.SetObjectResolver(txManager.GetObjectResolver())
+
 	return &SuiChainWriter{
 		lggr:       logger.Named(lggr, ServiceName),
 		txm:        txManager,
 		config:     config,
 		simulate:   simulate,
-		ptbFactory: ptb.NewPTBConstructor(config, suiClient, lggr),
+		ptbFactory: ptbFactory,
 	}, nil
 }
 
+// SetOffRampCache sets the cache used for the offramp configuration read when building CCIP execute and commit PTBs.
+func (s *SuiChainWriter) SetOffRampCache(cache *offramp.ExecutionCache) {
+	s.ptbFactory.SetOffRampCache(cache)
+}
+
 // SubmitTransaction is the primary entry point for submitting transactions via the SuiChainWriter.
 // It acts as a router, determining whether to enqueue a standard smart contract call or a
 // Programmable Transaction Block (PTB) based on the provided contractName.
@@ -50,15 +66,23 @@ func NewSuiChainWriter(lggr logger.Logger, txManager txm.TxManager, config cwCon
 //   - method: The specific function name within the module (for standard calls) or the virtual function
 //     name defined in the PTB configuration.
 //   - args: The arguments required by the function or PTB commands. For PTB submissions, these are automatically
-//     mapped to commands based on the configuration using the builder pattern internally.
+//     mapped to commands based on the configuration using the builder pattern internally. Args may be a Go struct
+//     whose fields are named after the params with `sui:"param_name,type=u64"` tags (see movebcs.TagName).
 //   - transactionID: A unique identifier for this transaction attempt.
 //   - toAddress: The target address for the transaction (Note: Often implicitly handled by the module/function config in Sui).
 //   - meta: Transaction metadata, primarily used for specifying gas limits (*commontypes.TxMeta).
 //   - _ *big.Int: An unused parameter, present for interface compatibility.
 //
+// Functions listing several PublicKeys, or using AllTransmitterKeys, are submitted by the candidate with the fewest
+// in-flight transactions and then the highest balance, the PTB being built for that signer.
+//
+// When the writer is in simulate mode, the PTB is built exactly as in production but dry-run instead of broadcast:
+// the simulated effects and gas usage are recorded as the result of the transaction, which is finalized if the
+// dry-run succeeded and fatal otherwise, and can be read back with GetSimulationResult.
+//
 // Returns:
 //   - error: An error if the configuration is missing, argument processing fails, or the underlying
-//     transaction enqueue operation in the TxManager fails.
+//     transaction enqueue (or simulation) operation in the TxManager fails.
 func (s *SuiChainWriter) SubmitTransaction(ctx context.Context, contractName string, method string, args any, transactionID string, toAddress string, meta *commonTypes.TxMeta, _ *big.Int) error {
 	ptbName := contractName
 
@@ -75,8 +99,8 @@ func (s *SuiChainWriter) SubmitTransaction(ctx context.Context, contractName str
 	}
 
 	var arguments cwConfig.Arguments
-	if err := mapstructure.Decode(args, &arguments.Args); err != nil {
-		return fmt.Errorf("failed to decode args: %w", err)
+	if err := decodeArgs(args, &arguments); err != nil {
+		return err
 	}
 	arguments.ArgTypes = map[string]string{}
 
@@ -112,7 +136,19 @@ func (s *SuiChainWriter) SubmitTransaction(ctx context.Context, contractName str
 		s.lggr.Infow("CCIPCommit not implemented", "transactionID", transactionID)
 	}
 
-	ptbService, err := s.ptbFactory.BuildPTBCommands(ctx, ptbName, method, arguments, toAddress, functionConfig)
+	signerPublicKey, releaseSigner, err := s.selectSigner(ctx, functionConfig)
+	if err != nil {
+		s.lggr.Errorw("Error selecting signer", "error", err)
+		return err
+	}
+	// once enqueued, or failed to be, the transaction no longer needs the selection of its signer to be counted
+	defer releaseSigner()
+
+	// the PTB is built for the selected signer, which owns the coins and the pre-requisite objects it uses
+	txnConfig := *functionConfig
+	txnConfig.PublicKey = signerPublicKey
+
+	ptbService, err := s.ptbFactory.BuildPTBCommands(ctx, ptbName, method, arguments, toAddress, &txnConfig)
 
 	if err != nil {
 		s.lggr.Errorw("Error building PTB commands", "error", err)
@@ -121,7 +157,19 @@ func (s *SuiChainWriter) SubmitTransaction(ctx context.Context, contractName str
 
 	s.lggr.Infow("PTB commands", "ptb", ptbService, "functionConfig", functionConfig)
 
-	tx, err := s.txm.EnqueuePTB(ctx, transactionID, meta, functionConfig.PublicKey, ptbService)
+	if s.simulate {
+		tx, simulateErr := s.txm.SimulatePTB(ctx, transactionID, meta, signerPublicKey, ptbService)
+		if simulateErr != nil {
+			s.lggr.Errorw("Error simulating PTB", "error", simulateErr)
+			return simulateErr
+		}
+		s.lggr.Infow("Transaction simulated", "transactionID", tx.TransactionID, "functionName", method,
+			"status", tx.Simulation.Status, "error", tx.Simulation.Error, "gasUsed", tx.Simulation.GasUsed)
+
+		return nil
+	}
+
+	tx, err := s.txm.EnqueuePTB(ctx, transactionID, meta, signerPublicKey, ptbService)
 	if err != nil {
 		s.lggr.Errorw("Error enqueuing PTB", "error", err)
 		return err
@@ -131,6 +179,45 @@ func (s *SuiChainWriter) SubmitTransaction(ctx context.Context, contractName str
 	return nil
 }
 
+// selectSigner returns the public key a submission of a function is signed with: the configured PublicKey, or the
+// candidate picked by the TxManager when the function lists several PublicKeys or uses all the transmitter keys,
+// along with the func releasing the selection.
+func (s *SuiChainWriter) selectSigner(ctx context.Context, functionConfig *cwConfig.ChainWriterFunction) ([]byte, func(), error) {
+	switch {
+	case functionConfig.AllTransmitterKeys:
+		return s.txm.SelectSigner(ctx, nil)
+	case len(functionConfig.PublicKeys) > 0:
+		return s.txm.SelectSigner(ctx, functionConfig.PublicKeys)
+	default:
+		return functionConfig.PublicKey, func() {}, nil
+	}
+}
+
+// decodeArgs decodes the args of SubmitTransaction into a map keyed by param name. Args given as a Go struct with
+// `sui` tags are keyed by the names of the tags, and fields declaring a Move type must hold a value of that type.
+func decodeArgs(args any, arguments *cwConfig.Arguments) error {
+	if movebcs.HasTags(reflect.TypeOf(args)) {
+		values, err := argTypes.MarshalFields(args)
+		if err != nil {
+			return fmt.Errorf("failed to decode args: %w", err)
+		}
+		arguments.Args = values
+
+		return nil
+	}
+
+	if err := mapstructure.Decode(args, &arguments.Args); err != nil {
+		return fmt.Errorf("failed to decode args: %w", err)
+	}
+
+	return nil
+}
+
+// GetSimulationResult returns the simulated effects and gas usage of a transaction submitted in simulate mode.
+func (s *SuiChainWriter) GetSimulationResult(ctx context.Context, transactionID string) (*client.DryRunResult, error) {
+	return s.txm.GetSimulationResult(ctx, transactionID)
+}
+
 // GetFeeComponents implements types.ContractWriter.
 func (s *SuiChainWriter) GetFeeComponents(ctx context.Context) (*commonTypes.ChainFeeComponents, error) {
 	return nil, errors.New("GetFeeComponents not implemented")
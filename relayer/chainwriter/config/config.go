@@ -10,10 +10,17 @@ var (
 	PTBChainWriterModuleName = "cll://component=cw/type=ptb_builder"
 	CCIPExecute              = "execute"
 	CCIPCommit               = "commit"
+	CCIPSend                 = "ccip_send"
 )
 
 type ChainWriterConfig struct {
 	Modules map[string]*ChainWriterModule
+	// Simulate dry-runs the transactions instead of broadcasting them (optional). Used to run shadow lanes and to
+	// validate configs without spending gas.
+	Simulate bool
+	// StrictValidation fails creating the chain writer when the config does not match the modules it calls
+	// (optional). Problems caused by modules that can't be fetched, e.g. of packages not deployed yet, are only logged.
+	StrictValidation bool
 }
 
 type ChainWriterModule struct {
@@ -50,7 +57,8 @@ func (c ChainWriterPTBCommand) GetParamKey(paramName string) string {
 // into the arguments map provided for PTB construction.
 //
 // The usage flow is that a request is made to get all the owned objects by "OwnerId" and then picking the one
-// that matches the Tag
+// that matches the Tag. Without an OwnerId, the objects are looked up among the objects of the signer the
+// transaction is submitted with.
 type PrerequisiteObject struct {
 	OwnerId *string
 	Name    string // the key under which the value is inserted in the args, must match one of the arg names used in the PTB commands
@@ -64,6 +72,12 @@ type ChainWriterFunction struct {
 	Name string
 	// The public key of the account that will sign and submit the transaction.
 	PublicKey []byte
+	// The public keys of the accounts that may sign and submit the transaction (optional). When set, the chain writer
+	// picks the signer of each submission among them, preferring the account with the fewest in-flight transactions
+	// and then the highest balance. PublicKey is ignored.
+	PublicKeys [][]byte
+	// AllTransmitterKeys makes every account of the keystore a candidate signer, as PublicKeys does (optional).
+	AllTransmitterKeys bool
 	// The values that need to be loaded into the args by making SuiX_GetOwnedObjects calls
 	PrerequisiteObjects []PrerequisiteObject
 	// Mapping of logical names to package/module addresses
@@ -75,11 +89,17 @@ type ChainWriterFunction struct {
 	// The set of PTB commands to run as part of this function call.
 	// This field is used in replacement of `Params` above.
 	PTBCommands []ChainWriterPTBCommand
+	// ArgsType is a value of the Go struct args are passed as (optional). The `sui` tags of its fields are validated
+	// against the params of the PTB commands when the config is validated.
+	ArgsType any `json:"-"`
 }
 
 type Arguments struct {
 	Args     map[string]any
 	ArgTypes map[string]string // Maps argument name to its generic type
+	// SignerAddress is the address of the transaction signer, set by the PTB constructor. It owns the coins
+	// used for Coin params.
+	SignerAddress string
 }
 
 type ChainWriterSignal struct{}
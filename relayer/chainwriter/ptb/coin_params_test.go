@@ -0,0 +1,88 @@
+//go:build unit
+
+package ptb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cwConfig "github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/config"
+	"github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/ptb"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec"
+	"github.com/smartcontractkit/chainlink-sui/relayer/testutils"
+)
+
+func TestProcessArgsForCommand_CoinParams(t *testing.T) {
+	t.Parallel()
+
+	mockClient := &testutils.FakeSuiPTBClient{
+		CoinsData: []models.CoinData{
+			{CoinType: "0xabc::link::LINK", CoinObjectId: "0x11", Version: "1", Digest: "9WzSXdwbky8tNbH7juvyaui4QzMUYEjdCEKMrMgLhXHT", Balance: "40"},
+			{CoinType: "0xabc::link::LINK", CoinObjectId: "0x12", Version: "1", Digest: "9WzSXdwbky8tNbH7juvyaui4QzMUYEjdCEKMrMgLhXHT", Balance: "30"},
+		},
+	}
+	constructor := ptb.NewPTBConstructor(cwConfig.ChainWriterConfig{}, mockClient, logger.Test(t))
+
+	tests := []struct {
+		name             string
+		param            codec.SuiFunctionParam
+		args             map[string]any
+		expectError      string
+		expectedCommands int
+	}{
+		{
+			name:             "SUI is split from gas",
+			param:            codec.SuiFunctionParam{Name: "payment", Coin: &codec.CoinParam{CoinType: "0x2::sui::SUI", Amount: 10}},
+			expectedCommands: 1,
+		},
+		{
+			name:             "coins are merged and split",
+			param:            codec.SuiFunctionParam{Name: "payment", Coin: &codec.CoinParam{CoinType: "0xabc::link::LINK"}},
+			args:             map[string]any{"payment": uint64(50)},
+			expectedCommands: 2,
+		},
+		{
+			name:             "balance conversion",
+			param:            codec.SuiFunctionParam{Name: "payment", Coin: &codec.CoinParam{CoinType: "0xabc::link::LINK", Amount: 5, IntoBalance: true}},
+			expectedCommands: 2,
+		},
+		{
+			name:        "insufficient balance",
+			param:       codec.SuiFunctionParam{Name: "payment", Coin: &codec.CoinParam{CoinType: "0xabc::link::LINK"}},
+			args:        map[string]any{"payment": "71"},
+			expectError: "insufficient balance",
+		},
+		{
+			name:        "invalid amount",
+			param:       codec.SuiFunctionParam{Name: "payment", Coin: &codec.CoinParam{CoinType: "0xabc::link::LINK"}},
+			args:        map[string]any{"payment": -1},
+			expectError: "negative coin amount",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := transaction.NewTransaction()
+			arguments := &cwConfig.Arguments{Args: tt.args, SignerAddress: "0x1"}
+			cachedArgs := map[string]transaction.Argument{}
+
+			processed, err := constructor.ProcessArgsForCommand(context.Background(), builder, []codec.SuiFunctionParam{tt.param}, arguments, &cachedArgs)
+			if tt.expectError != "" {
+				require.ErrorContains(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, processed, 1)
+			assert.NotNil(t, processed[0].Result, "coin argument should be the result of a PTB command")
+			assert.Len(t, builder.Data.V1.Kind.ProgrammableTransaction.Commands, tt.expectedCommands)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+// Package coins builds the PTB commands producing `Coin<T>` arguments of a given amount from the coins owned by
+// the signer.
+package coins
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/transaction"
+)
+
+const SuiCoinType = "0x0000000000000000000000000000000000000000000000000000000000000002::sui::SUI"
+
+// NormalizeCoinType returns the coin type with its package address zero-padded and 0x-prefixed, so that coin
+// types reported in different forms (e.g. `0x2::sui::SUI` and `0x00..02::sui::SUI`) can be compared.
+func NormalizeCoinType(coinType string) (string, error) {
+	address, rest, ok := strings.Cut(coinType, "::")
+	if !ok {
+		return "", fmt.Errorf("invalid coin type: %s", coinType)
+	}
+
+	addressBytes, err := transaction.ConvertSuiAddressStringToBytes(models.SuiAddress(address))
+	if err != nil {
+		return "", fmt.Errorf("invalid address in coin type %s: %w", coinType, err)
+	}
+
+	return "0x" + hex.EncodeToString(addressBytes[:]) + "::" + rest, nil
+}
+
+// CoinTypeFromMetadataType extracts the coin type T from a `0x2::coin::CoinMetadata<T>` object type.
+func CoinTypeFromMetadataType(metadataType string) (string, error) {
+	start := strings.Index(metadataType, "::coin::CoinMetadata<")
+	if start < 0 || !strings.HasSuffix(metadataType, ">") {
+		return "", fmt.Errorf("not a coin metadata object type: %s", metadataType)
+	}
+	start += len("::coin::CoinMetadata<")
+
+	return NormalizeCoinType(metadataType[start : len(metadataType)-1])
+}
+
+// SelectCoins picks the coins of the given type to cover amount, largest balances first.
+func SelectCoins(coins []models.CoinData, coinType string, amount uint64) ([]models.CoinData, error) {
+	type balancedCoin struct {
+		coin    models.CoinData
+		balance uint64
+	}
+
+	candidates := make([]balancedCoin, 0, len(coins))
+	for _, coin := range coins {
+		normalized, err := NormalizeCoinType(coin.CoinType)
+		if err != nil || normalized != coinType {
+			continue
+		}
+
+		balance, err := strconv.ParseUint(coin.Balance, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse balance of coin %s: %w", coin.CoinObjectId, err)
+		}
+		candidates = append(candidates, balancedCoin{coin: coin, balance: balance})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].balance > candidates[j].balance
+	})
+
+	selected := make([]models.CoinData, 0)
+	var total uint64
+	for _, candidate := range candidates {
+		if total >= amount {
+			break
+		}
+		selected = append(selected, candidate.coin)
+		total += candidate.balance
+	}
+
+	if total < amount || len(selected) == 0 {
+		return nil, fmt.Errorf("insufficient balance of %s: need %d, have %d", coinType, amount, total)
+	}
+
+	return selected, nil
+}
+
+// AppendCoinWithBalance adds the commands producing a `Coin<T>` of exactly amount to the PTB. SUI is split
+// from the gas coin, so that it never conflicts with the gas payment selected by the TXM. Other coin types
+// are selected from ownedCoins, skipping the coins that already are inputs of the PTB, merged into the
+// largest selected coin, and the amount is split from it.
+func AppendCoinWithBalance(ptb *transaction.Transaction, ownedCoins []models.CoinData, coinType string, amount uint64) (transaction.Argument, error) {
+	coinArguments, err := AppendCoinsWithBalances(ptb, ownedCoins, coinType, []uint64{amount})
+	if err != nil {
+		return transaction.Argument{}, err
+	}
+
+	return coinArguments[0], nil
+}
+
+// AppendCoinsWithBalances adds the commands producing a `Coin<T>` of exactly each of the amounts to the PTB, like
+// AppendCoinWithBalance. The coins of a type other than SUI are selected and merged once for the total of the
+// amounts, and every amount is split from the merged coin, so that the amounts share the selected coins.
+func AppendCoinsWithBalances(ptb *transaction.Transaction, ownedCoins []models.CoinData, coinType string, amounts []uint64) ([]transaction.Argument, error) {
+	coinType, err := NormalizeCoinType(coinType)
+	if err != nil {
+		return nil, err
+	}
+
+	source := ptb.Gas()
+	if coinType != SuiCoinType {
+		var total uint64
+		for _, amount := range amounts {
+			if total+amount < total {
+				return nil, fmt.Errorf("total amount of %s overflows", coinType)
+			}
+			total += amount
+		}
+
+		selected, err := SelectCoins(unusedCoins(ptb, ownedCoins), coinType, total)
+		if err != nil {
+			return nil, err
+		}
+
+		coinArguments := make([]transaction.Argument, 0, len(selected))
+		for _, coin := range selected {
+			coinArgument, err := coinObjectArgument(ptb, coin)
+			if err != nil {
+				return nil, err
+			}
+			coinArguments = append(coinArguments, coinArgument)
+		}
+
+		if len(coinArguments) > 1 {
+			ptb.MergeCoins(coinArguments[0], coinArguments[1:])
+		}
+		source = coinArguments[0]
+	}
+
+	split := make([]transaction.Argument, 0, len(amounts))
+	for _, amount := range amounts {
+		split = append(split, ptb.SplitCoins(source, []transaction.Argument{ptb.Pure(amount)}))
+	}
+
+	return split, nil
+}
+
+// unusedCoins filters out the coins that are already inputs of the PTB, as an owned object can only be used
+// once as an input.
+func unusedCoins(ptb *transaction.Transaction, coins []models.CoinData) []models.CoinData {
+	if ptb.Data.V1 == nil || ptb.Data.V1.Kind == nil || ptb.Data.V1.Kind.ProgrammableTransaction == nil {
+		return coins
+	}
+
+	inputs := ptb.Data.V1.Kind.ProgrammableTransaction.Inputs
+	unused := make([]models.CoinData, 0, len(coins))
+	for _, coin := range coins {
+		objectIdBytes, err := transaction.ConvertSuiAddressStringToBytes(models.SuiAddress(coin.CoinObjectId))
+		if err != nil {
+			continue
+		}
+
+		used := false
+		for _, input := range inputs {
+			if input != nil && input.Object != nil && input.Object.ImmOrOwnedObject != nil &&
+				bytes.Equal(input.Object.ImmOrOwnedObject.ObjectId[:], objectIdBytes[:]) {
+				used = true
+				break
+			}
+		}
+		if !used {
+			unused = append(unused, coin)
+		}
+	}
+
+	return unused
+}
+
+func coinObjectArgument(ptb *transaction.Transaction, coin models.CoinData) (transaction.Argument, error) {
+	objectIdBytes, err := transaction.ConvertSuiAddressStringToBytes(models.SuiAddress(coin.CoinObjectId))
+	if err != nil {
+		return transaction.Argument{}, fmt.Errorf("failed to convert coin object ID %s: %w", coin.CoinObjectId, err)
+	}
+	version, err := strconv.ParseUint(coin.Version, 10, 64)
+	if err != nil {
+		return transaction.Argument{}, fmt.Errorf("failed to parse version of coin %s: %w", coin.CoinObjectId, err)
+	}
+	digestBytes, err := transaction.ConvertObjectDigestStringToBytes(models.ObjectDigest(coin.Digest))
+	if err != nil {
+		return transaction.Argument{}, fmt.Errorf("failed to convert digest of coin %s: %w", coin.CoinObjectId, err)
+	}
+
+	return ptb.Object(transaction.CallArg{
+		Object: &transaction.ObjectArg{
+			ImmOrOwnedObject: &transaction.SuiObjectRef{
+				ObjectId: *objectIdBytes,
+				Version:  version,
+				Digest:   *digestBytes,
+			},
+		},
+	}), nil
+}
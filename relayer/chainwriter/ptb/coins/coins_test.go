@@ -0,0 +1,137 @@
+//go:build unit
+
+package coins
+
+import (
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLinkCoinType = "0x0000000000000000000000000000000000000000000000000000000000000abc::link::LINK"
+
+func testCoin(id string, coinType string, balance string) models.CoinData {
+	return models.CoinData{
+		CoinType:     coinType,
+		CoinObjectId: id,
+		Version:      "1",
+		Digest:       "HT8yjG5mGmE4kWyW7Mba3Dfka5Ecin2hmsSX5nqqd8VL",
+		Balance:      balance,
+	}
+}
+
+func TestNormalizeCoinType(t *testing.T) {
+	t.Parallel()
+
+	normalized, err := NormalizeCoinType("0x2::sui::SUI")
+	require.NoError(t, err)
+	assert.Equal(t, SuiCoinType, normalized)
+
+	normalized, err = NormalizeCoinType("abc::link::LINK")
+	require.NoError(t, err)
+	assert.Equal(t, testLinkCoinType, normalized)
+
+	_, err = NormalizeCoinType("0x2")
+	require.Error(t, err)
+}
+
+func TestCoinTypeFromMetadataType(t *testing.T) {
+	t.Parallel()
+
+	coinType, err := CoinTypeFromMetadataType("0x2::coin::CoinMetadata<0xabc::link::LINK>")
+	require.NoError(t, err)
+	assert.Equal(t, testLinkCoinType, coinType)
+
+	_, err = CoinTypeFromMetadataType("0x2::coin::Coin<0xabc::link::LINK>")
+	require.Error(t, err)
+}
+
+func TestSelectCoins(t *testing.T) {
+	t.Parallel()
+
+	coins := []models.CoinData{
+		testCoin("0x1", "0xabc::link::LINK", "10"),
+		testCoin("0x2", "0x2::sui::SUI", "1000"),
+		testCoin("0x3", "0xabc::link::LINK", "30"),
+		testCoin("0x4", "0xabc::link::LINK", "20"),
+	}
+
+	selected, err := SelectCoins(coins, testLinkCoinType, 45)
+	require.NoError(t, err)
+	require.Len(t, selected, 2)
+	assert.Equal(t, "0x3", selected[0].CoinObjectId, "largest coin first")
+	assert.Equal(t, "0x4", selected[1].CoinObjectId)
+
+	_, err = SelectCoins(coins, testLinkCoinType, 61)
+	require.ErrorContains(t, err, "insufficient balance")
+}
+
+func TestAppendCoinWithBalance(t *testing.T) {
+	t.Parallel()
+
+	coins := []models.CoinData{
+		testCoin("0x1", "0xabc::link::LINK", "10"),
+		testCoin("0x3", "0xabc::link::LINK", "30"),
+	}
+
+	t.Run("SUI is split from gas", func(t *testing.T) {
+		t.Parallel()
+
+		ptb := transaction.NewTransaction()
+		_, err := AppendCoinWithBalance(ptb, coins, SuiCoinType, 5)
+		require.NoError(t, err)
+
+		commands := ptb.Data.V1.Kind.ProgrammableTransaction.Commands
+		require.Len(t, commands, 1)
+		require.NotNil(t, commands[0].SplitCoins)
+		assert.NotNil(t, commands[0].SplitCoins.Coin.GasCoin)
+	})
+
+	t.Run("coins are merged before splitting", func(t *testing.T) {
+		t.Parallel()
+
+		ptb := transaction.NewTransaction()
+		_, err := AppendCoinWithBalance(ptb, coins, testLinkCoinType, 35)
+		require.NoError(t, err)
+
+		commands := ptb.Data.V1.Kind.ProgrammableTransaction.Commands
+		require.Len(t, commands, 2)
+		assert.NotNil(t, commands[0].MergeCoins)
+		assert.NotNil(t, commands[1].SplitCoins)
+	})
+
+	t.Run("amounts are split from coins merged once", func(t *testing.T) {
+		t.Parallel()
+
+		ptb := transaction.NewTransaction()
+		split, err := AppendCoinsWithBalances(ptb, coins, testLinkCoinType, []uint64{25, 15})
+		require.NoError(t, err)
+		require.Len(t, split, 2)
+
+		commands := ptb.Data.V1.Kind.ProgrammableTransaction.Commands
+		require.Len(t, commands, 3)
+		require.NotNil(t, commands[0].MergeCoins)
+		assert.Equal(t, *commands[0].MergeCoins.Destination, *commands[1].SplitCoins.Coin)
+		assert.Equal(t, *commands[0].MergeCoins.Destination, *commands[2].SplitCoins.Coin)
+
+		_, err = AppendCoinsWithBalances(transaction.NewTransaction(), coins, testLinkCoinType, []uint64{25, 16})
+		require.ErrorContains(t, err, "insufficient balance")
+	})
+
+	t.Run("coins already used by the PTB are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		ptb := transaction.NewTransaction()
+		_, err := AppendCoinWithBalance(ptb, coins, testLinkCoinType, 25)
+		require.NoError(t, err)
+		_, err = AppendCoinWithBalance(ptb, coins, testLinkCoinType, 5)
+		require.NoError(t, err)
+		assert.Len(t, ptb.Data.V1.Kind.ProgrammableTransaction.Inputs, 4, "two coins and two amounts")
+
+		_, err = AppendCoinWithBalance(ptb, coins, testLinkCoinType, 1)
+		require.ErrorContains(t, err, "insufficient balance")
+	})
+}
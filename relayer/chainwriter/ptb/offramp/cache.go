@@ -0,0 +1,379 @@
+package offramp
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/block-vision/sui-go-sdk/models"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	receiver_registry "github.com/smartcontractkit/chainlink-sui/bindings/generated/ccip/ccip/receiver_registry"
+	module_token_admin_registry "github.com/smartcontractkit/chainlink-sui/bindings/generated/ccip/ccip/token_admin_registry"
+	"github.com/smartcontractkit/chainlink-sui/relayer/chainreader/database"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+)
+
+// DefaultExecutionCacheTTL bounds how long cached offramp configuration is used without being re-read.
+const DefaultExecutionCacheTTL = 10 * time.Minute
+
+// configEvents are the CCIP events emitted when the token pool or receiver configuration changes.
+var configEvents = []struct {
+	module string
+	event  string
+}{
+	{"token_admin_registry", "PoolSet"},
+	{"token_admin_registry", "PoolRegistered"},
+	{"token_admin_registry", "PoolUnregistered"},
+	{"receiver_registry", "ReceiverRegistered"},
+	{"receiver_registry", "ReceiverUnregistered"},
+}
+
+// ConfigVersionSource reports a version of the CCIP token pool and receiver configuration.
+// The version must change whenever the configuration changes.
+type ConfigVersionSource interface {
+	ConfigVersion(ctx context.Context, ccipPackageId string) (uint64, error)
+}
+
+// EventsConfigVersionSource derives the configuration version from the CCIP config events indexed in `sui.events`.
+// Events are only available if the chain reader is configured to index them, otherwise the version never
+// changes and cached configuration is only refreshed once its TTL expires.
+type EventsConfigVersionSource struct {
+	db *database.DBStore
+}
+
+func NewEventsConfigVersionSource(db *database.DBStore) *EventsConfigVersionSource {
+	return &EventsConfigVersionSource{db: db}
+}
+
+// ConfigVersion returns the ID of the latest indexed config event, which increases with each new config event.
+func (s *EventsConfigVersionSource) ConfigVersion(ctx context.Context, ccipPackageId string) (uint64, error) {
+	eventHandles := make([]string, len(configEvents))
+	for i, configEvent := range configEvents {
+		eventHandles[i] = fmt.Sprintf("%s::%s::%s", ccipPackageId, configEvent.module, configEvent.event)
+	}
+
+	return s.db.GetLatestEventId(ctx, ccipPackageId, eventHandles...)
+}
+
+// offRampCacheEntry holds the configuration of a single offramp package, valid for a given config version.
+type offRampCacheEntry struct {
+	addressMappings OffRampAddressMappings
+	loadedAt        time.Time
+	configVersion   uint64
+	tokenConfigs    map[string]module_token_admin_registry.TokenConfig
+	receiverConfigs map[string]receiver_registry.ReceiverConfig
+	// simulated is set once an execute PTB built from these configs simulated successfully
+	simulated bool
+}
+
+// ExecutionCache caches the values read when building offramp execute PTBs: the offramp address mappings,
+// the token pool configs and the receiver configs, per offramp package ID. They only change on admin
+// actions, so the entries of an offramp are dropped when the config version reported by the
+// ConfigVersionSource changes, or when they are older than the TTL. Normalized modules are immutable for
+// a given package ID and are kept for the lifetime of the cache.
+//
+// Entries are not keyed by the versions of the CCIP and offramp state objects: the offramp state is mutated by
+// every execution and the CCIP object by every commit and onramp send, so their versions change far more often
+// than the configs and would leave nothing cached. The state objects themselves are created once when the packages
+// are initialized and are never replaced, so the package ID identifies them, and the token pool and receiver configs
+// they hold are only changed by the admin functions emitting the config events behind the config version.
+//
+// The cache also tracks when execute PTBs need to be simulated before they are submitted: see NeedsSimulation.
+//
+// A nil *ExecutionCache is valid and reads everything from the chain on every call.
+type ExecutionCache struct {
+	ttl      time.Duration
+	versions ConfigVersionSource // optional
+	now      func() time.Time    // overridable for testing
+
+	mu                sync.Mutex
+	offRamps          map[string]*offRampCacheEntry
+	normalizedModules map[string]models.GetNormalizedMoveModuleResponse
+	attempts          map[ccipocr3.Bytes32]time.Time
+}
+
+// NewExecutionCache creates an ExecutionCache. versions may be nil, in which case entries are only
+// refreshed once they are older than ttl.
+func NewExecutionCache(ttl time.Duration, versions ConfigVersionSource) *ExecutionCache {
+	if ttl <= 0 {
+		ttl = DefaultExecutionCacheTTL
+	}
+
+	return &ExecutionCache{
+		ttl:               ttl,
+		versions:          versions,
+		now:               time.Now,
+		offRamps:          make(map[string]*offRampCacheEntry),
+		normalizedModules: make(map[string]models.GetNormalizedMoveModuleResponse),
+		attempts:          make(map[ccipocr3.Bytes32]time.Time),
+	}
+}
+
+// GetOfframpAddressMappings returns the cached address mappings of the offramp package, resolving them with
+// GetOfframpAddressMappings if they are missing or stale.
+func (c *ExecutionCache) GetOfframpAddressMappings(
+	ctx context.Context,
+	lggr logger.Logger,
+	ptbClient client.SuiPTBClient,
+	offRampPackageId string,
+	publicKey []byte,
+) (OffRampAddressMappings, error) {
+	if c == nil {
+		return GetOfframpAddressMappings(ctx, lggr, ptbClient, offRampPackageId, publicKey)
+	}
+
+	c.mu.Lock()
+	entry, ok := c.offRamps[offRampPackageId]
+	if ok && c.now().Sub(entry.loadedAt) >= c.ttl {
+		delete(c.offRamps, offRampPackageId)
+		ok = false
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		addressMappings, err := GetOfframpAddressMappings(ctx, lggr, ptbClient, offRampPackageId, publicKey)
+		if err != nil {
+			return OffRampAddressMappings{}, err
+		}
+
+		version, err := c.configVersion(ctx, addressMappings.CcipPackageId)
+		if err != nil {
+			return OffRampAddressMappings{}, err
+		}
+
+		entry = &offRampCacheEntry{
+			addressMappings: addressMappings,
+			loadedAt:        c.now(),
+			configVersion:   version,
+			tokenConfigs:    make(map[string]module_token_admin_registry.TokenConfig),
+			receiverConfigs: make(map[string]receiver_registry.ReceiverConfig),
+		}
+
+		c.mu.Lock()
+		c.offRamps[offRampPackageId] = entry
+		c.mu.Unlock()
+
+		return addressMappings, nil
+	}
+
+	// the address mappings themselves only change on redeployment, but a config change invalidates the
+	// token pool and receiver configs read for this offramp
+	version, err := c.configVersion(ctx, entry.addressMappings.CcipPackageId)
+	if err != nil {
+		return OffRampAddressMappings{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if version != entry.configVersion {
+		lggr.Debugw("CCIP config changed, dropping cached offramp configs",
+			"offRampPackageId", offRampPackageId, "previousVersion", entry.configVersion, "version", version)
+		entry.configVersion = version
+		entry.tokenConfigs = make(map[string]module_token_admin_registry.TokenConfig)
+		entry.receiverConfigs = make(map[string]receiver_registry.ReceiverConfig)
+		entry.simulated = false
+	}
+
+	return entry.addressMappings, nil
+}
+
+// GetTokenConfig returns the token admin registry config of a token, reading it with a dev inspect call if
+// it is not cached.
+func (c *ExecutionCache) GetTokenConfig(
+	ctx context.Context,
+	devInspect module_token_admin_registry.ITokenAdminRegistryDevInspect,
+	callOpts *bind.CallOpts,
+	addressMappings *OffRampAddressMappings,
+	coinMetadataAddress string,
+) (module_token_admin_registry.TokenConfig, error) {
+	entry := c.offRampEntry(addressMappings.OffRampPackageId)
+	if entry != nil {
+		c.mu.Lock()
+		tokenConfig, ok := entry.tokenConfigs[coinMetadataAddress]
+		c.mu.Unlock()
+		if ok {
+			return tokenConfig, nil
+		}
+	}
+
+	tokenConfig, err := devInspect.GetTokenConfig(ctx, callOpts, bind.Object{Id: addressMappings.CcipObjectRef}, coinMetadataAddress)
+	if err != nil {
+		return module_token_admin_registry.TokenConfig{}, err
+	}
+
+	if entry != nil {
+		c.mu.Lock()
+		entry.tokenConfigs[coinMetadataAddress] = tokenConfig
+		c.mu.Unlock()
+	}
+
+	return tokenConfig, nil
+}
+
+// GetReceiverConfig returns the config of a registered receiver, checking the registration and reading the
+// config with dev inspect calls if it is not cached. Unregistered receivers are not cached, so that a
+// receiver registered afterwards is picked up immediately.
+func (c *ExecutionCache) GetReceiverConfig(
+	ctx context.Context,
+	devInspect receiver_registry.IReceiverRegistryDevInspect,
+	callOpts *bind.CallOpts,
+	addressMappings *OffRampAddressMappings,
+	receiverPackageId string,
+) (receiver_registry.ReceiverConfig, error) {
+	entry := c.offRampEntry(addressMappings.OffRampPackageId)
+	if entry != nil {
+		c.mu.Lock()
+		receiverConfig, ok := entry.receiverConfigs[receiverPackageId]
+		c.mu.Unlock()
+		if ok {
+			return receiverConfig, nil
+		}
+	}
+
+	ref := bind.Object{Id: addressMappings.CcipObjectRef}
+	isRegistered, err := devInspect.IsRegisteredReceiver(ctx, callOpts, ref, receiverPackageId)
+	if err != nil {
+		return receiver_registry.ReceiverConfig{}, fmt.Errorf("failed to check if receiver is registered in offramp execution: %w", err)
+	}
+	if !isRegistered {
+		return receiver_registry.ReceiverConfig{}, fmt.Errorf("receiver is not registered in offramp execution: %s", receiverPackageId)
+	}
+
+	receiverConfig, err := devInspect.GetReceiverConfig(ctx, callOpts, ref, receiverPackageId)
+	if err != nil {
+		return receiver_registry.ReceiverConfig{}, fmt.Errorf("failed to get receiver config in offramp execution: %w", err)
+	}
+
+	if entry != nil {
+		c.mu.Lock()
+		entry.receiverConfigs[receiverPackageId] = receiverConfig
+		c.mu.Unlock()
+	}
+
+	return receiverConfig, nil
+}
+
+// GetNormalizedModule returns the normalized module, fetching it from the node if it is not cached.
+func (c *ExecutionCache) GetNormalizedModule(
+	ctx context.Context,
+	ptbClient client.SuiPTBClient,
+	packageId string,
+	module string,
+) (models.GetNormalizedMoveModuleResponse, error) {
+	if c == nil {
+		return ptbClient.GetNormalizedModule(ctx, packageId, module)
+	}
+
+	key := packageId + "::" + module
+	c.mu.Lock()
+	normalizedModule, ok := c.normalizedModules[key]
+	c.mu.Unlock()
+	if ok {
+		return normalizedModule, nil
+	}
+
+	normalizedModule, err := ptbClient.GetNormalizedModule(ctx, packageId, module)
+	if err != nil {
+		return models.GetNormalizedMoveModuleResponse{}, err
+	}
+
+	c.mu.Lock()
+	c.normalizedModules[key] = normalizedModule
+	c.mu.Unlock()
+
+	return normalizedModule, nil
+}
+
+// Invalidate drops the cached configuration of an offramp package, e.g. after an execution failed in a way
+// that suggests the cached values are outdated.
+func (c *ExecutionCache) Invalidate(offRampPackageId string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.offRamps, offRampPackageId)
+}
+
+// NeedsSimulation reports whether an execute PTB of the offramp package for the message must be simulated before
+// it is submitted. Simulating costs a dev inspect call per execution, so it is only done when the cached configs
+// are cold (first use, expired, invalidated or changed since the last successful simulation), when the last
+// simulation failed, or when the message was already attempted, as a retry suggests the previous PTB failed.
+func (c *ExecutionCache) NeedsSimulation(offRampPackageId string, messageID ccipocr3.Bytes32) bool {
+	if c == nil {
+		return true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.offRamps[offRampPackageId]
+	if !ok || !entry.simulated {
+		return true
+	}
+	attemptedAt, attempted := c.attempts[messageID]
+
+	return attempted && c.now().Sub(attemptedAt) < c.ttl
+}
+
+// RecordSimulation records the outcome of the simulation of an execute PTB of the offramp package, built from
+// its current cached configs.
+func (c *ExecutionCache) RecordSimulation(offRampPackageId string, succeeded bool) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.offRamps[offRampPackageId]; ok {
+		entry.simulated = succeeded
+	}
+}
+
+// RecordAttempt records that an execute PTB was built for the message. Attempts older than the TTL are dropped.
+func (c *ExecutionCache) RecordAttempt(messageID ccipocr3.Bytes32) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for id, attemptedAt := range c.attempts {
+		if now.Sub(attemptedAt) >= c.ttl {
+			delete(c.attempts, id)
+		}
+	}
+	c.attempts[messageID] = now
+}
+
+func (c *ExecutionCache) offRampEntry(offRampPackageId string) *offRampCacheEntry {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.offRamps[offRampPackageId]
+}
+
+func (c *ExecutionCache) configVersion(ctx context.Context, ccipPackageId string) (uint64, error) {
+	if c.versions == nil {
+		return 0, nil
+	}
+
+	version, err := c.versions.ConfigVersion(ctx, ccipPackageId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get CCIP config version: %w", err)
+	}
+
+	return version, nil
+}
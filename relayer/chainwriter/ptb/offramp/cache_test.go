@@ -0,0 +1,243 @@
+//go:build unit
+
+package offramp
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	module_token_admin_registry "github.com/smartcontractkit/chainlink-sui/bindings/generated/ccip/ccip/token_admin_registry"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client/mocks"
+)
+
+const (
+	testOffRampPackageId = "0x0000000000000000000000000000000000000000000000000000000000000abc"
+	testCcipPackageId    = "0x0000000000000000000000000000000000000000000000000000000000000def"
+)
+
+type staticConfigVersions struct {
+	version uint64
+}
+
+func (s *staticConfigVersions) ConfigVersion(_ context.Context, _ string) (uint64, error) {
+	return s.version, nil
+}
+
+// fakeTokenAdminRegistryDevInspect counts the token config dev inspect calls
+type fakeTokenAdminRegistryDevInspect struct {
+	module_token_admin_registry.ITokenAdminRegistryDevInspect
+	calls int
+}
+
+func (f *fakeTokenAdminRegistryDevInspect) GetTokenConfig(_ context.Context, _ *bind.CallOpts, _ bind.Object, coinMetadataAddress string) (module_token_admin_registry.TokenConfig, error) {
+	f.calls++
+	return module_token_admin_registry.TokenConfig{TokenPoolPackageId: "0x1", TokenPoolModule: "pool", TokenType: coinMetadataAddress}, nil
+}
+
+// expectAddressMappingsDiscovery sets up the reads performed by GetOfframpAddressMappings, the given number of times
+func expectAddressMappingsDiscovery(ptbClient *mocks.MockSuiPTBClient, times int) {
+	ccipPackageIdBytes := make([]byte, 32)
+	ccipPackageIdBytes[30], ccipPackageIdBytes[31] = 0x0d, 0xef
+
+	ptbClient.EXPECT().
+		ReadFunction(gomock.Any(), gomock.Any(), testOffRampPackageId, "offramp", "get_ccip_package_id", gomock.Any(), gomock.Any()).
+		Return([]any{ccipPackageIdBytes}, nil).
+		Times(times)
+	ptbClient.EXPECT().
+		ReadOwnedObjects(gomock.Any(), testOffRampPackageId, gomock.Any()).
+		Return([]models.SuiObjectResponse{ownedObject("0x1::offramp::OffRampStatePointer", map[string]any{"off_ramp_state_id": "0x2"})}, nil).
+		Times(times)
+	ptbClient.EXPECT().
+		ReadOwnedObjects(gomock.Any(), testCcipPackageId, gomock.Any()).
+		Return([]models.SuiObjectResponse{ownedObject("0x1::state_object::CCIPObjectRefPointer", map[string]any{"object_ref_id": "0x3", "owner_cap_id": "0x4"})}, nil).
+		Times(times)
+}
+
+func ownedObject(objectType string, fields map[string]any) models.SuiObjectResponse {
+	content := &models.SuiParsedData{}
+	content.Fields = fields
+
+	return models.SuiObjectResponse{Data: &models.SuiObjectData{Type: objectType, Content: content}}
+}
+
+func testPublicKey(t *testing.T) []byte {
+	t.Helper()
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return publicKey
+}
+
+func TestExecutionCacheAddressMappings(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	lggr := logger.Test(t)
+	publicKey := testPublicKey(t)
+
+	ptbClient := mocks.NewMockSuiPTBClient(gomock.NewController(t))
+	// once on the first call and once after the TTL expires
+	expectAddressMappingsDiscovery(ptbClient, 2)
+
+	now := time.Now()
+	cache := NewExecutionCache(time.Minute, nil)
+	cache.now = func() time.Time { return now }
+
+	first, err := cache.GetOfframpAddressMappings(ctx, lggr, ptbClient, testOffRampPackageId, publicKey)
+	require.NoError(t, err)
+	assert.Equal(t, testCcipPackageId, first.CcipPackageId)
+	assert.Equal(t, "0x2", first.OffRampState)
+	assert.Equal(t, "0x3", first.CcipObjectRef)
+	assert.Equal(t, "0x4", first.CcipOwnerCap)
+
+	second, err := cache.GetOfframpAddressMappings(ctx, lggr, ptbClient, testOffRampPackageId, publicKey)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	now = now.Add(time.Minute)
+	_, err = cache.GetOfframpAddressMappings(ctx, lggr, ptbClient, testOffRampPackageId, publicKey)
+	require.NoError(t, err)
+}
+
+func TestExecutionCacheTokenConfigs(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	lggr := logger.Test(t)
+	publicKey := testPublicKey(t)
+
+	ptbClient := mocks.NewMockSuiPTBClient(gomock.NewController(t))
+	// the address mappings survive config changes
+	expectAddressMappingsDiscovery(ptbClient, 1)
+
+	versions := &staticConfigVersions{version: 1}
+	cache := NewExecutionCache(time.Minute, versions)
+	devInspect := &fakeTokenAdminRegistryDevInspect{}
+
+	addressMappings, err := cache.GetOfframpAddressMappings(ctx, lggr, ptbClient, testOffRampPackageId, publicKey)
+	require.NoError(t, err)
+
+	for range 3 {
+		tokenConfig, err := cache.GetTokenConfig(ctx, devInspect, &bind.CallOpts{}, &addressMappings, "0xcoin")
+		require.NoError(t, err)
+		assert.Equal(t, "0xcoin", tokenConfig.TokenType)
+	}
+	assert.Equal(t, 1, devInspect.calls, "token config should be read once")
+
+	// a new config event drops the cached configs
+	versions.version = 2
+	_, err = cache.GetOfframpAddressMappings(ctx, lggr, ptbClient, testOffRampPackageId, publicKey)
+	require.NoError(t, err)
+	_, err = cache.GetTokenConfig(ctx, devInspect, &bind.CallOpts{}, &addressMappings, "0xcoin")
+	require.NoError(t, err)
+	assert.Equal(t, 2, devInspect.calls, "token config should be read again after a config change")
+
+	// invalidating the offramp drops everything
+	cache.Invalidate(testOffRampPackageId)
+	_, err = cache.GetTokenConfig(ctx, devInspect, &bind.CallOpts{}, &addressMappings, "0xcoin")
+	require.NoError(t, err)
+	assert.Equal(t, 3, devInspect.calls)
+}
+
+func TestExecutionCacheNil(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	lggr := logger.Test(t)
+	publicKey := testPublicKey(t)
+
+	ptbClient := mocks.NewMockSuiPTBClient(gomock.NewController(t))
+	expectAddressMappingsDiscovery(ptbClient, 2)
+	ptbClient.EXPECT().GetNormalizedModule(gomock.Any(), "0x1", "pool").Return(models.GetNormalizedMoveModuleResponse{}, nil).Times(2)
+
+	var cache *ExecutionCache
+	devInspect := &fakeTokenAdminRegistryDevInspect{}
+	for range 2 {
+		addressMappings, err := cache.GetOfframpAddressMappings(ctx, lggr, ptbClient, testOffRampPackageId, publicKey)
+		require.NoError(t, err)
+		_, err = cache.GetTokenConfig(ctx, devInspect, &bind.CallOpts{}, &addressMappings, "0xcoin")
+		require.NoError(t, err)
+		_, err = cache.GetNormalizedModule(ctx, ptbClient, "0x1", "pool")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, devInspect.calls)
+	cache.Invalidate(testOffRampPackageId)
+}
+
+func TestExecutionCacheNormalizedModules(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	ptbClient := mocks.NewMockSuiPTBClient(gomock.NewController(t))
+	ptbClient.EXPECT().GetNormalizedModule(gomock.Any(), "0x1", "pool").Return(models.GetNormalizedMoveModuleResponse{Name: "pool"}, nil).Times(1)
+
+	cache := NewExecutionCache(0, nil)
+	for range 2 {
+		normalizedModule, err := cache.GetNormalizedModule(ctx, ptbClient, "0x1", "pool")
+		require.NoError(t, err)
+		assert.Equal(t, "pool", normalizedModule.Name)
+	}
+}
+
+func TestExecutionCacheNeedsSimulation(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	lggr := logger.Test(t)
+	publicKey := testPublicKey(t)
+
+	ptbClient := mocks.NewMockSuiPTBClient(gomock.NewController(t))
+	// once on the first call and once after invalidation
+	expectAddressMappingsDiscovery(ptbClient, 2)
+
+	now := time.Now()
+	versions := &staticConfigVersions{version: 1}
+	cache := NewExecutionCache(time.Minute, versions)
+	cache.now = func() time.Time { return now }
+	first, second := ccipocr3.Bytes32{1}, ccipocr3.Bytes32{2}
+
+	assert.True(t, cache.NeedsSimulation(testOffRampPackageId, first), "no cached configs")
+	_, err := cache.GetOfframpAddressMappings(ctx, lggr, ptbClient, testOffRampPackageId, publicKey)
+	require.NoError(t, err)
+	assert.True(t, cache.NeedsSimulation(testOffRampPackageId, first), "configs not simulated yet")
+
+	cache.RecordSimulation(testOffRampPackageId, false)
+	assert.True(t, cache.NeedsSimulation(testOffRampPackageId, first), "last simulation failed")
+
+	cache.RecordSimulation(testOffRampPackageId, true)
+	cache.RecordAttempt(first)
+	assert.False(t, cache.NeedsSimulation(testOffRampPackageId, second), "warm configs")
+	assert.True(t, cache.NeedsSimulation(testOffRampPackageId, first), "message already attempted")
+
+	now = now.Add(time.Minute)
+	cache.RecordAttempt(second)
+	assert.False(t, cache.NeedsSimulation(testOffRampPackageId, first), "attempt expired")
+	assert.True(t, cache.NeedsSimulation(testOffRampPackageId, second))
+
+	// a config change makes the configs cold again
+	cache.now = time.Now
+	versions.version = 2
+	_, err = cache.GetOfframpAddressMappings(ctx, lggr, ptbClient, testOffRampPackageId, publicKey)
+	require.NoError(t, err)
+	assert.True(t, cache.NeedsSimulation(testOffRampPackageId, first), "configs changed")
+
+	cache.RecordSimulation(testOffRampPackageId, true)
+	cache.Invalidate(testOffRampPackageId)
+	assert.True(t, cache.NeedsSimulation(testOffRampPackageId, first), "configs invalidated")
+	_, err = cache.GetOfframpAddressMappings(ctx, lggr, ptbClient, testOffRampPackageId, publicKey)
+	require.NoError(t, err)
+	assert.True(t, cache.NeedsSimulation(testOffRampPackageId, first), "configs read again")
+
+	var nilCache *ExecutionCache
+	nilCache.RecordSimulation(testOffRampPackageId, true)
+	nilCache.RecordAttempt(first)
+	assert.True(t, nilCache.NeedsSimulation(testOffRampPackageId, first))
+}
@@ -53,7 +53,10 @@ func DecodeOffRampExecCallArgs(args map[string]any) (*SuiOffRampExecCallArgs, er
 	return offrampArgs, nil
 }
 
-// BuildOffRampExecutePTB builds the PTB for the OffRampExecute operation
+// BuildOffRampExecutePTB builds the PTB for the OffRampExecute operation and returns which of its commands
+// belong to which message, to attribute failures to messages.
+// Token pool and receiver configs are read through the cache, which may be nil to always read them from the chain.
+// Objects are resolved with the objectResolver, which may be nil to resolve them from the chain.
 func BuildOffRampExecutePTB(
 	ctx context.Context,
 	lggr logger.Logger,
@@ -62,29 +65,42 @@ func BuildOffRampExecutePTB(
 	args config.Arguments,
 	signerAddress string,
 	addressMappings OffRampAddressMappings,
-) (err error) {
+	cache *ExecutionCache,
+	objectResolver *bind.ObjectResolver,
+) (*ExecutePTBLayout, error) {
 	sdkClient := ptbClient.GetClient()
 	offrampArgs, err := DecodeOffRampExecCallArgs(args.Args)
 	if err != nil {
-		return fmt.Errorf("failed to decode args for offramp execute PTB: %w", err)
+		return nil, fmt.Errorf("failed to decode args for offramp execute PTB: %w", err)
 	}
 
-	coinMetadataAddresses := make([]string, 0)
-	messages := make([]ccipocr3.Message, 0)
+	// `init_execute` executes the single message of the report bytes, so the PTB only carries the commands of
+	// that message. The report info may list more messages: they are executed by their own execute PTBs, so a
+	// failing message can't block the others.
+	executionReport, err := DecodeExecutionReport(offrampArgs.Report)
+	if err != nil {
+		return nil, err
+	}
 
-	// Prepare some data from the execution report for easy access when constructing the PTB commands.
+	layout := &ExecutePTBLayout{}
+	skipped := 0
 	for _, report := range offrampArgs.Info.AbstractReports {
 		for _, message := range report.Messages {
-			messages = append(messages, message)
-			for _, tokenAmount := range message.TokenAmounts {
-				destTokenAddress := "0x" + hex.EncodeToString(tokenAmount.DestTokenAddress)
-
-				lggr.Debugw("found token metadata address", "address", destTokenAddress)
-
-				coinMetadataAddresses = append(coinMetadataAddresses, destTokenAddress)
+			if message.Header.MessageID != executionReport.MessageID {
+				skipped++
+				continue
 			}
+			layout.Messages = append(layout.Messages, ExecuteMessageCommands{Message: message})
 		}
 	}
+	if len(layout.Messages) != 1 {
+		return nil, fmt.Errorf("execute report info holds %d messages with the ID %s of the execution report, expected 1",
+			len(layout.Messages), executionReport.MessageID.String())
+	}
+	if skipped > 0 {
+		lggr.Warnw("Execute report info holds messages not in the execution report, they are not executed by this PTB",
+			"messageID", executionReport.MessageID.String(), "skippedMessages", skipped)
+	}
 
 	// An interface used to make dev inspect calls in bindings, actual signing does not happen here.
 	devInspectSigner := signer.NewDevInspectSigner(signerAddress)
@@ -93,19 +109,20 @@ func BuildOffRampExecutePTB(
 	callOpts := &bind.CallOpts{
 		Signer:           devInspectSigner,
 		WaitForExecution: true,
+		ObjectResolver:   objectResolver,
 	}
 
 	// Set the offramp package interface from bindings
 	offrampPkg, err := offramp.NewOfframp(addressMappings.OffRampPackageId, sdkClient)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	offrampContract := offrampPkg.Offramp().(*module_offramp.OfframpContract)
 	offrampEncoder := offrampContract.Encoder()
 
 	tokenReceiverBytes, ok := offrampArgs.ExtraData.ExtraArgsDecoded["token_receiver"]
 	if !ok {
-		return fmt.Errorf("missing token receiver in extra args")
+		return nil, fmt.Errorf("missing token receiver in extra args")
 	}
 	tokenReceiver := "0x" + hex.EncodeToString(tokenReceiverBytes.([]byte))
 
@@ -123,60 +140,80 @@ func BuildOffRampExecutePTB(
 		tokenReceiver,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to encode move call (init_execute) using bindings: %w", err)
+		return nil, fmt.Errorf("failed to encode move call (init_execute) using bindings: %w", err)
 	}
 
 	initExecuteResult, err := offrampContract.AppendPTB(ctx, callOpts, ptb, encodedInitExecute)
 	if err != nil {
-		return fmt.Errorf("failed to build PTB (init_execute) using bindings: %w", err)
+		return nil, fmt.Errorf("failed to build PTB (init_execute) using bindings: %w", err)
 	}
 
 	// Process each token pool from this offramp execution after getting their configs
 	// from the registry. Attach the commands to the PTB and return their argument results.
-	tokenPoolCommandsResults, err := ProcessTokenPools(
-		ctx,
-		lggr,
-		ptbClient,
-		ptb,
-		&addressMappings,
-		callOpts,
-		coinMetadataAddresses,
-		initExecuteResult,
-	)
-	if err != nil {
-		return err
-	}
+	// Messages are processed one at a time to record which commands belong to which message.
+	for i, messageCommands := range layout.Messages {
+		coinMetadataAddresses := make([]string, 0, len(messageCommands.Message.TokenAmounts))
+		for _, tokenAmount := range messageCommands.Message.TokenAmounts {
+			destTokenAddress := "0x" + hex.EncodeToString(tokenAmount.DestTokenAddress)
+
+			lggr.Debugw("found token metadata address", "address", destTokenAddress)
+
+			coinMetadataAddresses = append(coinMetadataAddresses, destTokenAddress)
+		}
+
+		firstCommand := commandCount(ptb)
+		tokenPoolCommandsResults, err := ProcessTokenPools(
+			ctx,
+			lggr,
+			ptbClient,
+			ptb,
+			&addressMappings,
+			callOpts,
+			coinMetadataAddresses,
+			initExecuteResult,
+			cache,
+		)
+		if err != nil {
+			return nil, err
+		}
+		layout.recordCommands(ptb, i, firstCommand)
 
-	lggr.Debugw("finished processing token pool calls", "tokenPoolCalls", tokenPoolCommandsResults)
+		lggr.Debugw("finished processing token pool calls", "tokenPoolCalls", tokenPoolCommandsResults)
+	}
 
 	// Process each message and create PTB commands for each (valid) receiver.
-	_, err = ProcessReceivers(
-		ctx,
-		lggr,
-		ptbClient,
-		ptb,
-		messages,
-		&addressMappings,
-		callOpts,
-		initExecuteResult,
-		offrampArgs.ExtraData.ExtraArgsDecoded,
-	)
-	if err != nil {
-		return err
+	for i, messageCommands := range layout.Messages {
+		firstCommand := commandCount(ptb)
+		_, err = ProcessReceivers(
+			ctx,
+			lggr,
+			ptbClient,
+			ptb,
+			[]ccipocr3.Message{messageCommands.Message},
+			&addressMappings,
+			callOpts,
+			initExecuteResult,
+			offrampArgs.ExtraData.ExtraArgsDecoded,
+			cache,
+		)
+		if err != nil {
+			return nil, err
+		}
+		layout.recordCommands(ptb, i, firstCommand)
 	}
 
 	// add the final PTB command (finish_execute) to the PTB using the interface from bindings
 	encodedFinishExecute, err := offrampEncoder.FinishExecuteWithArgs(bind.Object{Id: addressMappings.OffRampState}, initExecuteResult)
 	if err != nil {
-		return fmt.Errorf("failed to encode move call (finish_execute) using bindings: %w", err)
+		return nil, fmt.Errorf("failed to encode move call (finish_execute) using bindings: %w", err)
 	}
 
 	_, err = offrampContract.AppendPTB(ctx, callOpts, ptb, encodedFinishExecute)
 	if err != nil {
-		return fmt.Errorf("failed to build PTB (finish_execute) using bindings: %w", err)
+		return nil, fmt.Errorf("failed to build PTB (finish_execute) using bindings: %w", err)
 	}
 
-	return nil
+	return layout, nil
 }
 
 func ProcessTokenPools(
@@ -188,6 +225,7 @@ func ProcessTokenPools(
 	callOpts *bind.CallOpts,
 	coinMetadataAddresses []string,
 	receiverParams *transaction.Argument,
+	cache *ExecutionCache,
 ) ([]transaction.Argument, error) {
 	sdkClient := ptbClient.GetClient()
 
@@ -209,15 +247,15 @@ func ProcessTokenPools(
 	// as they are provided in the form of an array from the core node. We can alternatively simply read
 	// the first index but we do this to allow for simplified future updates.
 	for _, coinMetadataAddress := range coinMetadataAddresses {
-		tokenConfig, err := tokenAdminRegistryDevInspect.GetTokenConfig(ctx, callOpts, bind.Object{Id: addressMappings.CcipObjectRef}, coinMetadataAddress)
+		tokenConfig, err := cache.GetTokenConfig(ctx, tokenAdminRegistryDevInspect, callOpts, addressMappings, coinMetadataAddress)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get token configs for offramp execution: %w", err)
 		}
 
-		lggr.Debugw("fetched token configs via dev inspect call", "tokenConfig", tokenConfig)
+		lggr.Debugw("fetched token configs", "tokenConfig", tokenConfig)
 
 		// Get the move normalized module to dynamically construct the parameters for the token pool call
-		tokenPoolNormalizedModule, err := ptbClient.GetNormalizedModule(ctx, tokenConfig.TokenPoolPackageId, tokenConfig.TokenPoolModule)
+		tokenPoolNormalizedModule, err := cache.GetNormalizedModule(ctx, ptbClient, tokenConfig.TokenPoolPackageId, tokenConfig.TokenPoolModule)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get normalized module for token pool: %w", err)
 		}
@@ -327,6 +365,7 @@ func ProcessReceivers(
 	callOpts *bind.CallOpts,
 	receiverParams *transaction.Argument,
 	extraArgs map[string]any,
+	cache *ExecutionCache,
 ) ([]transaction.Argument, error) {
 	sdkClient := ptbClient.GetClient()
 
@@ -349,21 +388,13 @@ func ProcessReceivers(
 		// Parse the receiver address into a hex string
 		receiverPackageId := "0x" + hex.EncodeToString(message.Receiver)
 
-		isRegistered, err := receiverRegistryDevInspect.IsRegisteredReceiver(ctx, callOpts, bind.Object{Id: addressMappings.CcipObjectRef}, receiverPackageId)
-		if err != nil {
-			return nil, fmt.Errorf("failed to check if receiver is registered in offramp execution: %w", err)
-		}
 		// If the receiver is not registered, fail the entire execution
-		if !isRegistered {
-			return nil, fmt.Errorf("receiver is not registered in offramp execution: %s", message.Receiver)
-		}
-
-		receiverConfig, err := receiverRegistryDevInspect.GetReceiverConfig(ctx, callOpts, bind.Object{Id: addressMappings.CcipObjectRef}, receiverPackageId)
+		receiverConfig, err := cache.GetReceiverConfig(ctx, receiverRegistryDevInspect, callOpts, addressMappings, receiverPackageId)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get receiver config in offramp execution: %w", err)
+			return nil, err
 		}
 
-		receiverNormalizedModule, err := ptbClient.GetNormalizedModule(ctx, receiverPackageId, receiverConfig.ModuleName)
+		receiverNormalizedModule, err := cache.GetNormalizedModule(ctx, ptbClient, receiverPackageId, receiverConfig.ModuleName)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get normalized module for token pool: %w", err)
 		}
@@ -656,7 +656,7 @@ func TestExecuteOffRamp(t *testing.T) {
 			},
 		}
 
-		err = offramp.BuildOffRampExecutePTB(
+		_, err = offramp.BuildOffRampExecutePTB(
 			ctx,
 			lggr,
 			ptbClient,
@@ -664,6 +664,8 @@ func TestExecuteOffRamp(t *testing.T) {
 			args,
 			accountAddress,
 			addressMappings,
+			nil,
+			nil,
 		)
 		require.NoError(t, err, "failed to build offramp execute PTB")
 		lggr.Infow("Offramp execute PTB", "ptb", ptb)
@@ -0,0 +1,118 @@
+package offramp
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/block-vision/sui-go-sdk/sui"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client/suierrors"
+)
+
+// ExecuteMessageCommands lists the indices of the PTB commands appended for a message of an execute report,
+// i.e. its token pool and receiver calls.
+type ExecuteMessageCommands struct {
+	Message  ccipocr3.Message
+	Commands []uint64
+}
+
+// ExecutePTBLayout maps the commands of an execute PTB to the messages they execute. Commands not belonging
+// to a message (`init_execute`, `finish_execute`) apply to the whole report.
+type ExecutePTBLayout struct {
+	Messages []ExecuteMessageCommands
+}
+
+// MessageForCommand returns the message the command at the given index was appended for, if any.
+func (l *ExecutePTBLayout) MessageForCommand(commandIndex uint64) (*ExecuteMessageCommands, bool) {
+	if l == nil {
+		return nil, false
+	}
+
+	for i := range l.Messages {
+		if slices.Contains(l.Messages[i].Commands, commandIndex) {
+			return &l.Messages[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// recordCommands assigns the commands appended to the PTB since `from` to the message.
+func (l *ExecutePTBLayout) recordCommands(ptb *transaction.Transaction, messageIndex int, from uint64) {
+	for index := from; index < commandCount(ptb); index++ {
+		l.Messages[messageIndex].Commands = append(l.Messages[messageIndex].Commands, index)
+	}
+}
+
+func commandCount(ptb *transaction.Transaction) uint64 {
+	if ptb.Data.V1 == nil || ptb.Data.V1.Kind == nil || ptb.Data.V1.Kind.ProgrammableTransaction == nil {
+		return 0
+	}
+
+	return uint64(len(ptb.Data.V1.Kind.ProgrammableTransaction.Commands))
+}
+
+// ExecuteSimulationFailure describes an execute PTB whose simulation failed.
+type ExecuteSimulationFailure struct {
+	// Error is the execution error reported by the node
+	Error string
+	// Abort is the parsed Move abort, nil if the failure is not a Move abort
+	Abort *suierrors.MoveAbort
+	// Message is the message whose token pool or receiver call aborted, nil for report level failures
+	Message *ExecuteMessageCommands
+}
+
+// IsMessageFailure reports whether the failure is isolated to a message, as opposed to the report itself
+// (e.g. a root not committed yet or a cursed source chain), which aborts in `init_execute`.
+func (f *ExecuteSimulationFailure) IsMessageFailure() bool {
+	return f.Message != nil
+}
+
+func (f *ExecuteSimulationFailure) String() string {
+	if f.Message != nil {
+		return fmt.Sprintf("message %s (sequence number %d) failed: %s",
+			f.Message.Message.Header.MessageID.String(), f.Message.Message.Header.SequenceNumber, f.Error)
+	}
+
+	return fmt.Sprintf("report failed: %s", f.Error)
+}
+
+// SimulateExecutePTB dev inspects an execute PTB and, if it fails, identifies the message it failed for from
+// the location of the abort. It returns a nil failure if the simulation succeeds, and an error only if the
+// simulation could not be run.
+func SimulateExecutePTB(
+	ctx context.Context,
+	sdkClient sui.ISuiAPI,
+	ptb *transaction.Transaction,
+	signerAddress string,
+	layout *ExecutePTBLayout,
+) (*ExecuteSimulationFailure, error) {
+	response, err := bind.DevInspectPTB(ctx, signerAddress, sdkClient, ptb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate execute PTB: %w", err)
+	}
+
+	if response.Effects.Status.Status == "success" {
+		return nil, nil //nolint:nilnil
+	}
+
+	failure := &ExecuteSimulationFailure{Error: response.Effects.Status.Error}
+	abort, err := suierrors.ParseMoveAbort(failure.Error)
+	if err != nil {
+		return failure, nil
+	}
+	failure.Abort = abort
+
+	if message, ok := layout.MessageForCommand(abort.CommandIndex); ok {
+		failure.Message = message
+	} else if abort.CommandIndex > 0 && len(layout.Messages) == 1 {
+		// `finish_execute` aborts when the receiver did not consume the message
+		failure.Message = &layout.Messages[0]
+	}
+
+	return failure, nil
+}
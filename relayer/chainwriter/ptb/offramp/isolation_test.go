@@ -0,0 +1,119 @@
+//go:build unit
+
+package offramp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// devInspectClient returns a fixed execution status for dev inspect calls
+type devInspectClient struct {
+	sui.ISuiAPI
+	status models.ExecutionStatus
+}
+
+func (c *devInspectClient) SuiDevInspectTransactionBlock(_ context.Context, _ models.SuiDevInspectTransactionBlockRequest) (models.SuiTransactionBlockResponse, error) {
+	return models.SuiTransactionBlockResponse{Effects: models.SuiEffects{Status: c.status}}, nil
+}
+
+func moveAbortError(module string, function string, commandIndex string) string {
+	return `MoveAbort(MoveLocation { module: ModuleId { address: 0000000000000000000000000000000000000000000000000000000000000abc, name: Identifier("` +
+		module + `") }, function: 3, instruction: 12, function_name: Some("` + function + `") }, 7) in command ` + commandIndex
+}
+
+func testLayout() *ExecutePTBLayout {
+	return &ExecutePTBLayout{
+		Messages: []ExecuteMessageCommands{
+			{
+				Message:  ccipocr3.Message{Header: ccipocr3.RampMessageHeader{MessageID: ccipocr3.Bytes32{1}, SequenceNumber: 10}},
+				Commands: []uint64{1, 3},
+			},
+		},
+	}
+}
+
+func TestExecutePTBLayoutMessageForCommand(t *testing.T) {
+	t.Parallel()
+
+	layout := testLayout()
+	message, ok := layout.MessageForCommand(3)
+	require.True(t, ok)
+	assert.Equal(t, ccipocr3.SeqNum(10), message.Message.Header.SequenceNumber)
+
+	_, ok = layout.MessageForCommand(0)
+	assert.False(t, ok, "init_execute does not belong to a message")
+
+	var nilLayout *ExecutePTBLayout
+	_, ok = nilLayout.MessageForCommand(1)
+	assert.False(t, ok)
+}
+
+func TestSimulateExecutePTB(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		status         models.ExecutionStatus
+		expectFailure  bool
+		messageFailure bool
+		expectAbort    bool
+	}{
+		{
+			name:   "success",
+			status: models.ExecutionStatus{Status: "success"},
+		},
+		{
+			name:           "receiver abort",
+			status:         models.ExecutionStatus{Status: "failure", Error: moveAbortError("receiver", "ccip_receive", "3")},
+			expectFailure:  true,
+			messageFailure: true,
+			expectAbort:    true,
+		},
+		{
+			name:           "finish execute abort",
+			status:         models.ExecutionStatus{Status: "failure", Error: moveAbortError("offramp", "finish_execute", "4")},
+			expectFailure:  true,
+			messageFailure: true,
+			expectAbort:    true,
+		},
+		{
+			name:          "init execute abort",
+			status:        models.ExecutionStatus{Status: "failure", Error: moveAbortError("offramp", "init_execute", "0")},
+			expectFailure: true,
+			expectAbort:   true,
+		},
+		{
+			name:          "non abort failure",
+			status:        models.ExecutionStatus{Status: "failure", Error: "InsufficientGas"},
+			expectFailure: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &devInspectClient{status: tt.status}
+			failure, err := SimulateExecutePTB(context.Background(), client, transaction.NewTransaction(), "0x1", testLayout())
+			require.NoError(t, err)
+
+			if !tt.expectFailure {
+				assert.Nil(t, failure)
+				return
+			}
+
+			require.NotNil(t, failure)
+			assert.Equal(t, tt.status.Error, failure.Error)
+			assert.Equal(t, tt.messageFailure, failure.IsMessageFailure())
+			assert.Equal(t, tt.expectAbort, failure.Abort != nil)
+		})
+	}
+}
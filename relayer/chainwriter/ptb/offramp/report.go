@@ -0,0 +1,66 @@
+package offramp
+
+import (
+	"fmt"
+
+	aptosBCS "github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+)
+
+// ExecutionReport is the part of a BCS encoded execution report, as deserialized by `init_execute`, needed to
+// match the report to the messages of the execute report info. A Sui execution report carries a single message.
+type ExecutionReport struct {
+	SourceChainSelector uint64
+	MessageID           ccipocr3.Bytes32
+	SequenceNumber      uint64
+	Receiver            [32]byte
+}
+
+// DecodeExecutionReport decodes the BCS encoded execution report passed to `init_execute`.
+func DecodeExecutionReport(report []byte) (ExecutionReport, error) {
+	var executionReport ExecutionReport
+
+	des := aptosBCS.NewDeserializer(report)
+	executionReport.SourceChainSelector = des.U64()
+	des.ReadFixedBytesInto(executionReport.MessageID[:])
+	headerSourceChainSelector := des.U64()
+	des.U64() // dest chain selector
+	executionReport.SequenceNumber = des.U64()
+	des.U64()       // nonce
+	des.ReadBytes() // sender
+	des.ReadBytes() // data
+	des.ReadFixedBytesInto(executionReport.Receiver[:])
+	des.U256() // gas limit
+
+	tokenAmounts := des.Uleb128()
+	for i := uint32(0); i < tokenAmounts && des.Error() == nil; i++ {
+		des.ReadBytes()        // source pool address
+		des.ReadFixedBytes(32) // dest token address
+		des.U32()              // dest gas amount
+		des.ReadBytes()        // extra data
+		des.U256()             // amount
+	}
+
+	offchainTokenData := des.Uleb128()
+	for i := uint32(0); i < offchainTokenData && des.Error() == nil; i++ {
+		des.ReadBytes()
+	}
+
+	proofs := des.Uleb128()
+	for i := uint32(0); i < proofs && des.Error() == nil; i++ {
+		des.ReadFixedBytes(32)
+	}
+
+	if err := des.Error(); err != nil {
+		return ExecutionReport{}, fmt.Errorf("failed to decode execution report: %w", err)
+	}
+	if des.Remaining() > 0 {
+		return ExecutionReport{}, fmt.Errorf("failed to decode execution report: %d unexpected trailing bytes", des.Remaining())
+	}
+	if headerSourceChainSelector != executionReport.SourceChainSelector {
+		return ExecutionReport{}, fmt.Errorf("execution report source chain selector %d does not match its message header (%d)",
+			executionReport.SourceChainSelector, headerSourceChainSelector)
+	}
+
+	return executionReport, nil
+}
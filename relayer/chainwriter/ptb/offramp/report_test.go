@@ -0,0 +1,63 @@
+//go:build unit
+
+package offramp
+
+import (
+	"math/big"
+	"testing"
+
+	aptosBCS "github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/smartcontractkit/chainlink-ccip/pkg/types/ccipocr3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serializeExecutionReport serializes an execution report with a token transfer, as deserialized by `init_execute`
+func serializeExecutionReport(sourceChainSelector uint64, headerSourceChainSelector uint64, messageID ccipocr3.Bytes32) []byte {
+	ser := &aptosBCS.Serializer{}
+	ser.U64(sourceChainSelector)
+	ser.FixedBytes(messageID[:])
+	ser.U64(headerSourceChainSelector)
+	ser.U64(2)  // dest chain selector
+	ser.U64(42) // sequence number
+	ser.U64(0)  // nonce
+	ser.WriteBytes([]byte{0xaa})
+	ser.WriteBytes([]byte("data"))
+	ser.FixedBytes(append(make([]byte, 31), 0x07))
+	ser.U256(*big.NewInt(200_000))
+
+	ser.Uleb128(1)
+	ser.WriteBytes([]byte{0xbb})
+	ser.FixedBytes(make([]byte, 32))
+	ser.U32(90_000)
+	ser.WriteBytes(nil)
+	ser.U256(*big.NewInt(100))
+
+	ser.Uleb128(1)
+	ser.WriteBytes(nil)
+	ser.Uleb128(1)
+	ser.FixedBytes(make([]byte, 32))
+
+	return ser.ToBytes()
+}
+
+func TestDecodeExecutionReport(t *testing.T) {
+	t.Parallel()
+
+	messageID := ccipocr3.Bytes32{9, 8, 7}
+	report, err := DecodeExecutionReport(serializeExecutionReport(1, 1, messageID))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), report.SourceChainSelector)
+	assert.Equal(t, messageID, report.MessageID)
+	assert.Equal(t, uint64(42), report.SequenceNumber)
+	assert.Equal(t, byte(0x07), report.Receiver[31])
+
+	_, err = DecodeExecutionReport(serializeExecutionReport(1, 3, messageID))
+	require.ErrorContains(t, err, "does not match its message header")
+
+	valid := serializeExecutionReport(1, 1, messageID)
+	_, err = DecodeExecutionReport(valid[:len(valid)-1])
+	require.ErrorContains(t, err, "failed to decode execution report")
+	_, err = DecodeExecutionReport(append(valid, 0))
+	require.ErrorContains(t, err, "trailing bytes")
+}
@@ -0,0 +1,102 @@
+package onramp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+)
+
+type OnRampAddressMappings struct {
+	CcipPackageId   string `json:"ccipPackageId"`
+	CcipObjectRef   string `json:"ccipObjectRef"`
+	ClockObject     string `json:"clockObject"`
+	OnRampPackageId string `json:"onRampPackageId"`
+	OnRampState     string `json:"onRampState"`
+}
+
+// GetOnRampAddressMappings resolves the addresses required to build a CCIP send PTB from the onramp package ID:
+// the CCIP package ID is read from `onramp::get_ccip_package_id`, the onramp state from the OnRampStatePointer
+// owned by the onramp package and the CCIP object ref from the CCIPObjectRefPointer owned by the CCIP package.
+func GetOnRampAddressMappings(
+	ctx context.Context,
+	lggr logger.Logger,
+	ptbClient client.SuiPTBClient,
+	onRampPackageId string,
+	publicKey []byte,
+) (OnRampAddressMappings, error) {
+	addressMappings := OnRampAddressMappings{
+		ClockObject:     "0x6",
+		OnRampPackageId: onRampPackageId,
+	}
+
+	signerAddress, err := client.GetAddressFromPublicKey(publicKey)
+	if err != nil {
+		return OnRampAddressMappings{}, fmt.Errorf("failed to get signer address: %w", err)
+	}
+
+	getCCIPPackageIdResponse, err := ptbClient.ReadFunction(ctx, signerAddress, onRampPackageId, "onramp", "get_ccip_package_id", []any{}, []string{})
+	if err != nil {
+		return OnRampAddressMappings{}, fmt.Errorf("failed to read ccip package id: %w", err)
+	}
+	if len(getCCIPPackageIdResponse) == 0 {
+		return OnRampAddressMappings{}, fmt.Errorf("empty response when reading ccip package id")
+	}
+
+	// Handle both byte slice and base64 string responses
+	var addressBytes []byte
+	switch v := getCCIPPackageIdResponse[0].(type) {
+	case []byte:
+		addressBytes = v
+	case string:
+		addressBytes, err = base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return OnRampAddressMappings{}, fmt.Errorf("failed to decode ccip package id: %w", err)
+		}
+	default:
+		return OnRampAddressMappings{}, fmt.Errorf("unexpected type for ccip package id response, got %T", getCCIPPackageIdResponse[0])
+	}
+	addressMappings.CcipPackageId = "0x" + hex.EncodeToString(addressBytes)
+
+	addressMappings.OnRampState, err = readPointerField(ctx, ptbClient, onRampPackageId, "onramp::OnRampStatePointer", "on_ramp_state_id")
+	if err != nil {
+		return OnRampAddressMappings{}, err
+	}
+
+	addressMappings.CcipObjectRef, err = readPointerField(ctx, ptbClient, addressMappings.CcipPackageId, "state_object::CCIPObjectRefPointer", "object_ref_id")
+	if err != nil {
+		return OnRampAddressMappings{}, err
+	}
+
+	lggr.Debugw("Address mappings for onramp", "addressMappings", addressMappings)
+
+	return addressMappings, nil
+}
+
+// readPointerField reads an address field of the pointer object of the given type owned by a package.
+func readPointerField(ctx context.Context, ptbClient client.SuiPTBClient, packageId string, pointerType string, field string) (string, error) {
+	ownedObjects, err := ptbClient.ReadOwnedObjects(ctx, packageId, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to read objects owned by %s: %w", packageId, err)
+	}
+
+	for _, ownedObject := range ownedObjects {
+		if ownedObject.Data == nil || ownedObject.Data.Content == nil || !strings.Contains(ownedObject.Data.Type, pointerType) {
+			continue
+		}
+
+		value, ok := ownedObject.Data.Content.Fields[field].(string)
+		if !ok || value == "" {
+			return "", fmt.Errorf("missing %s in %s", field, pointerType)
+		}
+
+		return value, nil
+	}
+
+	return "", fmt.Errorf("%s not found in objects owned by %s", pointerType, packageId)
+}
@@ -0,0 +1,408 @@
+// / A package to build the PTB for the CCIP send operation (`onramp::ccip_send`) along with the coin selection and
+// / token pool calls it depends on. Like the offramp package, it builds the PTB directly instead of generating CW configs.
+package onramp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/sui"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	module_token_admin_registry "github.com/smartcontractkit/chainlink-sui/bindings/generated/ccip/ccip/token_admin_registry"
+	module_onramp "github.com/smartcontractkit/chainlink-sui/bindings/generated/ccip/ccip_onramp/onramp"
+	"github.com/smartcontractkit/chainlink-sui/bindings/packages/ccip"
+	"github.com/smartcontractkit/chainlink-sui/bindings/packages/onramp"
+	"github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/config"
+	"github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/ptb/coins"
+	"github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/ptb/offramp"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/signer"
+)
+
+const (
+	OnrampTokenPoolFunctionName = "lock_or_burn"
+
+	// defaultTokenReceiver is used when no token receiver is given, the token receiver is only used for
+	// Sui destinations
+	defaultTokenReceiver = "0x0"
+)
+
+// TokenAmount is a token to transfer along with a CCIP message. Token is the address of the token's CoinMetadata object.
+type TokenAmount struct {
+	Token  string `mapstructure:"Token"`
+	Amount uint64 `mapstructure:"Amount"`
+}
+
+type SuiOnRampSendCallArgs struct {
+	DestChainSelector uint64        `mapstructure:"DestChainSelector"`
+	Receiver          []byte        `mapstructure:"Receiver"`
+	Data              []byte        `mapstructure:"Data"`
+	TokenAmounts      []TokenAmount `mapstructure:"TokenAmounts"`
+	// FeeToken is the address of the fee token's CoinMetadata object
+	FeeToken  string `mapstructure:"FeeToken"`
+	ExtraArgs []byte `mapstructure:"ExtraArgs"`
+	// TokenReceiver is the Sui address receiving the tokens, only used for Sui destinations (optional)
+	TokenReceiver string `mapstructure:"TokenReceiver"`
+}
+
+func DecodeOnRampSendCallArgs(args map[string]any) (*SuiOnRampSendCallArgs, error) {
+	onrampArgs := &SuiOnRampSendCallArgs{}
+	err := mapstructure.Decode(args, onrampArgs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode args for CCIP send PTB: %w", err)
+	}
+
+	if onrampArgs.FeeToken == "" {
+		return nil, fmt.Errorf("missing fee token for CCIP send PTB")
+	}
+	// TokenTransferParams holds a single token transfer
+	if len(onrampArgs.TokenAmounts) > 1 {
+		return nil, fmt.Errorf("at most one token transfer is supported per CCIP message, got %d", len(onrampArgs.TokenAmounts))
+	}
+	if onrampArgs.TokenReceiver == "" {
+		onrampArgs.TokenReceiver = defaultTokenReceiver
+	}
+
+	return onrampArgs, nil
+}
+
+// CCIPSendQuote is the fee quoted for a CCIP send PTB.
+type CCIPSendQuote struct {
+	FeeCoinType string
+	Fee         uint64
+}
+
+// BuildCCIPSendPTB builds the PTB for the CCIP send operation:
+//  1. the coins for the token amounts and the fee are split from the signer's coins (SUI is split from gas)
+//  2. `onramp_state_helper::create_token_transfer_params` creates the token transfer params
+//  3. the `lock_or_burn` function of the token pool registered for each token in the token admin registry
+//     locks or burns the tokens and records the transfer in the params
+//  4. `onramp::ccip_send` sends the message, charging the fee quoted with `onramp::get_fee`
+//  5. the remainder of the fee coin is returned to the signer
+//
+// Objects are resolved with the objectResolver, which may be nil to resolve them from the chain.
+func BuildCCIPSendPTB(
+	ctx context.Context,
+	lggr logger.Logger,
+	ptbClient client.SuiPTBClient,
+	ptb *transaction.Transaction,
+	args config.Arguments,
+	signerAddress string,
+	addressMappings OnRampAddressMappings,
+	objectResolver *bind.ObjectResolver,
+) (*CCIPSendQuote, error) {
+	sdkClient := ptbClient.GetClient()
+	sendArgs, err := DecodeOnRampSendCallArgs(args.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	callOpts := &bind.CallOpts{
+		Signer:           signer.NewDevInspectSigner(signerAddress),
+		WaitForExecution: true,
+		ObjectResolver:   objectResolver,
+	}
+
+	ccipPkg, err := ccip.NewCCIP(addressMappings.CcipPackageId, sdkClient)
+	if err != nil {
+		return nil, err
+	}
+	tokenAdminRegistryDevInspect := ccipPkg.TokenAdminRegistry().(*module_token_admin_registry.TokenAdminRegistryContract).DevInspect()
+
+	onrampPkg, err := onramp.NewOnramp(addressMappings.OnRampPackageId, sdkClient)
+	if err != nil {
+		return nil, err
+	}
+	onrampContract := onrampPkg.Onramp().(*module_onramp.OnrampContract)
+
+	feeTokenMetadata, err := ptbClient.ReadObjectId(ctx, sendArgs.FeeToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fee token metadata %s: %w", sendArgs.FeeToken, err)
+	}
+	feeCoinType, err := coins.CoinTypeFromMetadataType(feeTokenMetadata.Type)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fee token: %w", err)
+	}
+
+	tokenConfigs := make([]module_token_admin_registry.TokenConfig, 0, len(sendArgs.TokenAmounts))
+	tokenAddresses := make([]string, 0, len(sendArgs.TokenAmounts))
+	tokenAmounts := make([]uint64, 0, len(sendArgs.TokenAmounts))
+	for _, tokenAmount := range sendArgs.TokenAmounts {
+		tokenConfig, err := tokenAdminRegistryDevInspect.GetTokenConfig(ctx, callOpts, bind.Object{Id: addressMappings.CcipObjectRef}, tokenAmount.Token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get token config for %s: %w", tokenAmount.Token, err)
+		}
+		if isZeroAddress(tokenConfig.TokenPoolPackageId) {
+			return nil, fmt.Errorf("no token pool registered for token %s", tokenAmount.Token)
+		}
+
+		tokenConfigs = append(tokenConfigs, tokenConfig)
+		tokenAddresses = append(tokenAddresses, tokenAmount.Token)
+		tokenAmounts = append(tokenAmounts, tokenAmount.Amount)
+	}
+
+	fee, err := onrampContract.DevInspect().GetFee(
+		ctx,
+		callOpts,
+		[]string{feeCoinType},
+		bind.Object{Id: addressMappings.CcipObjectRef},
+		bind.Object{Id: addressMappings.ClockObject},
+		sendArgs.DestChainSelector,
+		sendArgs.Receiver,
+		sendArgs.Data,
+		tokenAddresses,
+		tokenAmounts,
+		bind.Object{Id: sendArgs.FeeToken},
+		sendArgs.ExtraArgs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to quote CCIP send fee: %w", err)
+	}
+	lggr.Debugw("quoted CCIP send fee", "fee", fee, "feeCoinType", feeCoinType)
+
+	ownedCoins, err := ptbClient.GetCoinsByAddress(ctx, signerAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get coins of %s: %w", signerAddress, err)
+	}
+
+	tokenCoinTypes := make([]string, 0, len(tokenConfigs))
+	for i, tokenConfig := range tokenConfigs {
+		tokenCoinType, err := coins.NormalizeCoinType(withHexPrefix(tokenConfig.TokenType))
+		if err != nil {
+			return nil, fmt.Errorf("invalid token type for %s: %w", tokenAddresses[i], err)
+		}
+		tokenCoinTypes = append(tokenCoinTypes, tokenCoinType)
+	}
+
+	// Split the coins first, so that a token and the fee can be paid in the same coin type
+	tokenCoins, feeCoin, err := appendSendCoins(ptb, ownedCoins, tokenAddresses, tokenCoinTypes, tokenAmounts, feeCoinType, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	createParams, err := encodeCreateTokenTransferParams(ctx, callOpts, ptb, sdkClient, addressMappings.CcipPackageId, sendArgs.TokenReceiver)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, tokenConfig := range tokenConfigs {
+		normalizedModule, err := ptbClient.GetNormalizedModule(ctx, tokenConfig.TokenPoolPackageId, tokenConfig.TokenPoolModule)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get normalized module for token pool: %w", err)
+		}
+
+		err = AppendPTBCommandForTokenPool(ctx, lggr, ptbClient, ptb, callOpts, &addressMappings, &tokenConfig, &normalizedModule,
+			createParams, tokenCoins[i], sendArgs.DestChainSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append token pool command to PTB: %w", err)
+		}
+	}
+
+	encodedCCIPSend, err := onrampContract.Encoder().CcipSendWithArgs(
+		[]string{feeCoinType},
+		bind.Object{Id: addressMappings.CcipObjectRef},
+		bind.Object{Id: addressMappings.OnRampState},
+		bind.Object{Id: addressMappings.ClockObject},
+		sendArgs.DestChainSelector,
+		sendArgs.Receiver,
+		sendArgs.Data,
+		createParams,
+		bind.Object{Id: sendArgs.FeeToken},
+		feeCoin,
+		sendArgs.ExtraArgs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode move call (ccip_send) using bindings: %w", err)
+	}
+
+	_, err = onrampContract.AppendPTB(ctx, callOpts, ptb, encodedCCIPSend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build PTB (ccip_send) using bindings: %w", err)
+	}
+
+	// `ccip_send` only takes the fee it needs from the fee coin, return what is left to the signer
+	ptb.TransferObjects([]transaction.Argument{feeCoin}, ptb.Pure(signerAddress))
+
+	return &CCIPSendQuote{FeeCoinType: feeCoinType, Fee: fee}, nil
+}
+
+// appendSendCoins splits the coins of the token amounts and of the fee from the signer's coins. The coins of each
+// coin type are selected and merged once, so that tokens and the fee paid in the same coin type share them, and
+// every token coin holds exactly its amount.
+func appendSendCoins(
+	ptb *transaction.Transaction,
+	ownedCoins []models.CoinData,
+	tokenAddresses []string,
+	tokenCoinTypes []string,
+	tokenAmounts []uint64,
+	feeCoinType string,
+	fee uint64,
+) ([]transaction.Argument, transaction.Argument, error) {
+	feeCoinType, err := coins.NormalizeCoinType(feeCoinType)
+	if err != nil {
+		return nil, transaction.Argument{}, fmt.Errorf("invalid fee coin type: %w", err)
+	}
+
+	// the fee is the last of the amounts
+	coinTypes := append(append([]string{}, tokenCoinTypes...), feeCoinType)
+	amounts := append(append([]uint64{}, tokenAmounts...), fee)
+	coinArguments := make([]transaction.Argument, len(amounts))
+	appended := map[string]bool{}
+	for i, coinType := range coinTypes {
+		if appended[coinType] {
+			continue
+		}
+		appended[coinType] = true
+
+		var indexes []int
+		var typeAmounts []uint64
+		for j := i; j < len(coinTypes); j++ {
+			if coinTypes[j] == coinType {
+				indexes = append(indexes, j)
+				typeAmounts = append(typeAmounts, amounts[j])
+			}
+		}
+
+		typeCoins, err := coins.AppendCoinsWithBalances(ptb, ownedCoins, coinType, typeAmounts)
+		if err != nil {
+			if i < len(tokenAddresses) {
+				return nil, transaction.Argument{}, fmt.Errorf("failed to select coins for token %s: %w", tokenAddresses[i], err)
+			}
+
+			return nil, transaction.Argument{}, fmt.Errorf("failed to select coins for fee: %w", err)
+		}
+		for j, index := range indexes {
+			coinArguments[index] = typeCoins[j]
+		}
+	}
+
+	return coinArguments[:len(tokenAmounts)], coinArguments[len(tokenAmounts)], nil
+}
+
+func encodeCreateTokenTransferParams(
+	ctx context.Context,
+	callOpts *bind.CallOpts,
+	ptb *transaction.Transaction,
+	sdkClient sui.ISuiAPI,
+	ccipPackageId string,
+	tokenReceiver string,
+) (*transaction.Argument, error) {
+	helperContract, err := bind.NewBoundContract(ccipPackageId, ccipPackageId, "onramp_state_helper", sdkClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create onramp state helper bound contract: %w", err)
+	}
+
+	encoded, err := helperContract.EncodeCallArgsWithGenerics(
+		"create_token_transfer_params",
+		[]string{},
+		[]string{},
+		[]string{"address"},
+		[]any{tokenReceiver},
+		[]string{"TokenTransferParams"},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode move call (create_token_transfer_params): %w", err)
+	}
+
+	result, err := helperContract.AppendPTB(ctx, callOpts, ptb, encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build PTB (create_token_transfer_params) using bindings: %w", err)
+	}
+
+	return result, nil
+}
+
+// AppendPTBCommandForTokenPool appends the `lock_or_burn` call of a token pool. The fixed parameters are followed
+// by the pool specific objects listed in the token config's lock_or_burn_params.
+func AppendPTBCommandForTokenPool(
+	ctx context.Context,
+	lggr logger.Logger,
+	ptbClient client.SuiPTBClient,
+	ptb *transaction.Transaction,
+	callOpts *bind.CallOpts,
+	addressMappings *OnRampAddressMappings,
+	tokenPoolConfigs *module_token_admin_registry.TokenConfig,
+	normalizedModule *models.GetNormalizedMoveModuleResponse,
+	tokenTransferParams *transaction.Argument,
+	tokenCoin transaction.Argument,
+	destChainSelector uint64,
+) error {
+	poolBoundContract, err := bind.NewBoundContract(
+		tokenPoolConfigs.TokenPoolPackageId,
+		tokenPoolConfigs.TokenPoolPackageId,
+		tokenPoolConfigs.TokenPoolModule,
+		ptbClient.GetClient(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create token pool bound contract when appending PTB command: %w", err)
+	}
+
+	// the fixed parameters include values passed by value (the coin and the chain selector), their types are
+	// given here as DecodeParameters only decodes object parameters
+	paramTypes := []string{"&object", "&mut object", "object", "u64", "&object"}
+	paramValues := []any{
+		bind.Object{Id: addressMappings.CcipObjectRef},
+		tokenTransferParams,
+		tokenCoin,
+		destChainSelector,
+		bind.Object{Id: addressMappings.ClockObject},
+	}
+	for _, value := range tokenPoolConfigs.LockOrBurnParams {
+		paramValues = append(paramValues, value)
+	}
+
+	functionSignature, ok := normalizedModule.ExposedFunctions[OnrampTokenPoolFunctionName]
+	if !ok {
+		return fmt.Errorf("missing function signature for token pool function not found in module (%s)", OnrampTokenPoolFunctionName)
+	}
+
+	decodedParamTypes, err := offramp.DecodeParameters(lggr, functionSignature.(map[string]any), "parameters")
+	if err != nil {
+		return fmt.Errorf("failed to decode parameters for token pool function: %w", err)
+	}
+	if len(decodedParamTypes) != len(paramValues) {
+		return fmt.Errorf("token pool function %s expects %d parameters, token config provides %d",
+			OnrampTokenPoolFunctionName, len(decodedParamTypes), len(paramValues))
+	}
+	paramTypes = append(paramTypes, decodedParamTypes[len(paramTypes):]...)
+
+	lggr.Debugw("calling token pool", "paramTypes", paramTypes, "paramValues", paramValues)
+
+	encodedTokenPoolCall, err := poolBoundContract.EncodeCallArgsWithGenerics(
+		OnrampTokenPoolFunctionName,
+		[]string{withHexPrefix(tokenPoolConfigs.TokenType)},
+		[]string{},
+		paramTypes,
+		paramValues,
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to encode token pool call: %w", err)
+	}
+
+	_, err = poolBoundContract.AppendPTB(ctx, callOpts, ptb, encodedTokenPoolCall)
+	if err != nil {
+		return fmt.Errorf("failed to build PTB (token pool call) using bindings: %w", err)
+	}
+
+	return nil
+}
+
+func isZeroAddress(address string) bool {
+	return strings.Trim(strings.TrimPrefix(address, "0x"), "0") == ""
+}
+
+func withHexPrefix(value string) string {
+	if strings.HasPrefix(value, "0x") {
+		return value
+	}
+
+	return "0x" + value
+}
@@ -0,0 +1,381 @@
+//go:build unit
+
+package onramp
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	aptosBCS "github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind/bindtest"
+	bindutils "github.com/smartcontractkit/chainlink-sui/bindings/utils"
+	"github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/config"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client/mocks"
+)
+
+func TestDecodeOnRampSendCallArgs(t *testing.T) {
+	t.Parallel()
+
+	args, err := DecodeOnRampSendCallArgs(map[string]any{
+		"DestChainSelector": uint64(1),
+		"Receiver":          []byte{1, 2},
+		"FeeToken":          "0xfee",
+		"TokenAmounts":      []TokenAmount{{Token: "0xlink", Amount: 10}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, defaultTokenReceiver, args.TokenReceiver)
+	assert.Equal(t, uint64(10), args.TokenAmounts[0].Amount)
+
+	_, err = DecodeOnRampSendCallArgs(map[string]any{"DestChainSelector": uint64(1)})
+	require.ErrorContains(t, err, "missing fee token")
+
+	_, err = DecodeOnRampSendCallArgs(map[string]any{
+		"FeeToken":     "0xfee",
+		"TokenAmounts": []TokenAmount{{Token: "0x1", Amount: 1}, {Token: "0x2", Amount: 1}},
+	})
+	require.ErrorContains(t, err, "at most one token transfer")
+}
+
+const (
+	testSignerAddress    = "0x0000000000000000000000000000000000000000000000000000000000005151"
+	testCcipPackageId    = "0x0000000000000000000000000000000000000000000000000000000000000c1c"
+	testOnRampPackageId  = "0x0000000000000000000000000000000000000000000000000000000000000011"
+	testPoolPackageId    = "0x0000000000000000000000000000000000000000000000000000000000000b01"
+	testLinkMetadata     = "0x0000000000000000000000000000000000000000000000000000000000001111"
+	testSuiMetadata      = "0x0000000000000000000000000000000000000000000000000000000000002222"
+	testPoolState        = "0x0000000000000000000000000000000000000000000000000000000000003333"
+	testLinkCoinType     = "0x0000000000000000000000000000000000000000000000000000000000000abc::link::LINK"
+	testCoinObjectDigest = "HT8yjG5mGmE4kWyW7Mba3Dfka5Ecin2hmsSX5nqqd8VL"
+)
+
+var testAddressMappings = OnRampAddressMappings{
+	CcipPackageId:   testCcipPackageId,
+	CcipObjectRef:   "0x0000000000000000000000000000000000000000000000000000000000000aaa",
+	ClockObject:     "0x6",
+	OnRampPackageId: testOnRampPackageId,
+	OnRampState:     "0x0000000000000000000000000000000000000000000000000000000000000bbb",
+}
+
+// sendTestEnv serves the reads of BuildCCIPSendPTB: dev inspects of `get_token_config` and `get_fee` are answered
+// with the registered token pool and the fee, and objects are resolved from the in-memory client.
+type sendTestEnv struct {
+	suiClient *bindtest.SuiClient
+	ptbClient *mocks.MockSuiPTBClient
+	// tokenPoolPackageId is returned by get_token_config, the zero address if no pool is registered
+	tokenPoolPackageId string
+	fee                uint64
+}
+
+func newSendTestEnv(t *testing.T, ownedCoins []models.CoinData) *sendTestEnv {
+	t.Helper()
+
+	env := &sendTestEnv{
+		suiClient:          bindtest.NewSuiClient(),
+		ptbClient:          mocks.NewMockSuiPTBClient(gomock.NewController(t)),
+		tokenPoolPackageId: testPoolPackageId,
+		fee:                7,
+	}
+	env.suiClient.AddSharedObject(testAddressMappings.CcipObjectRef, testCcipPackageId+"::state_object::CCIPObjectRef", 1)
+	env.suiClient.AddSharedObject(testAddressMappings.OnRampState, testOnRampPackageId+"::onramp::OnRampState", 1)
+	env.suiClient.AddSharedObject("0x6", "0x2::clock::Clock", 1)
+	env.suiClient.AddSharedObject(testPoolState, testPoolPackageId+"::pool::PoolState", 1)
+	for _, metadata := range []struct{ id, coinType string }{{testLinkMetadata, testLinkCoinType}, {testSuiMetadata, "0x2::sui::SUI"}} {
+		env.suiClient.AddObject(models.SuiObjectData{
+			ObjectId: metadata.id,
+			Type:     "0x2::coin::CoinMetadata<" + metadata.coinType + ">",
+			Version:  "1",
+			Digest:   testCoinObjectDigest,
+			Owner:    "Immutable",
+		})
+	}
+	env.suiClient.OnDevInspect = env.devInspect
+
+	env.ptbClient.EXPECT().GetClient().Return(env.suiClient).AnyTimes()
+	env.ptbClient.EXPECT().ReadObjectId(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, objectId string) (models.SuiObjectData, error) {
+			response, err := env.suiClient.SuiGetObject(ctx, models.SuiGetObjectRequest{ObjectId: objectId})
+			if err != nil {
+				return models.SuiObjectData{}, err
+			}
+			if response.Data == nil {
+				return models.SuiObjectData{}, fmt.Errorf("object %s not found", objectId)
+			}
+
+			return *response.Data, nil
+		}).AnyTimes()
+	env.ptbClient.EXPECT().GetCoinsByAddress(gomock.Any(), testSignerAddress).Return(ownedCoins, nil).AnyTimes()
+	env.ptbClient.EXPECT().GetNormalizedModule(gomock.Any(), testPoolPackageId, "pool").Return(lockOrBurnModule(), nil).AnyTimes()
+
+	return env
+}
+
+func (e *sendTestEnv) devInspect(req models.SuiDevInspectTransactionBlockRequest) (models.SuiTransactionBlockResponse, error) {
+	kind, err := bindutils.DecodeBase64(req.TxBytes)
+	if err != nil {
+		return models.SuiTransactionBlockResponse{}, err
+	}
+
+	switch {
+	case bytes.Contains(kind, []byte("get_token_config")):
+		return bindtest.DevInspectResponse(serializeTokenConfig(e.tokenPoolPackageId)), nil
+	case bytes.Contains(kind, []byte("get_fee")):
+		ser := &aptosBCS.Serializer{}
+		ser.U64(e.fee)
+
+		return bindtest.DevInspectResponse(ser.ToBytes()), nil
+	default:
+		return models.SuiTransactionBlockResponse{}, fmt.Errorf("unexpected dev inspect")
+	}
+}
+
+// serializeTokenConfig serializes the token_admin_registry::TokenConfig of LINK, using the pool state as its only
+// lock_or_burn param
+func serializeTokenConfig(tokenPoolPackageId string) []byte {
+	ser := &aptosBCS.Serializer{}
+	ser.FixedBytes(addressBytes(tokenPoolPackageId))
+	ser.WriteString("pool")
+	ser.WriteString(strings.TrimPrefix(testLinkCoinType, "0x"))
+	ser.FixedBytes(addressBytes(testSignerAddress))
+	ser.FixedBytes(addressBytes("0x0"))
+	ser.WriteString("pool::TypeProof")
+	ser.Uleb128(1)
+	ser.FixedBytes(addressBytes(testPoolState))
+	ser.Uleb128(0)
+
+	return ser.ToBytes()
+}
+
+func addressBytes(address string) []byte {
+	decoded, _ := hex.DecodeString(fmt.Sprintf("%064s", strings.TrimPrefix(address, "0x")))
+
+	return decoded
+}
+
+func structType(address string, module string, name string, typeArguments ...any) map[string]any {
+	if typeArguments == nil {
+		typeArguments = []any{}
+	}
+
+	return map[string]any{"Struct": map[string]any{"address": address, "module": module, "name": name, "typeArguments": typeArguments}}
+}
+
+// lockOrBurnModule is the normalized token pool module exposing
+// `lock_or_burn<T>(&CCIPObjectRef, &mut TokenTransferParams, Coin<T>, u64, &Clock, &mut PoolState, &mut TxContext)`
+func lockOrBurnModule() models.GetNormalizedMoveModuleResponse {
+	return models.GetNormalizedMoveModuleResponse{
+		Name: "pool",
+		ExposedFunctions: map[string]any{
+			OnrampTokenPoolFunctionName: map[string]any{
+				"visibility":     "Public",
+				"isEntry":        false,
+				"typeParameters": []any{map[string]any{"abilities": []any{}}},
+				"parameters": []any{
+					map[string]any{"Reference": structType(testCcipPackageId, "state_object", "CCIPObjectRef")},
+					map[string]any{"MutableReference": structType(testCcipPackageId, "onramp_state_helper", "TokenTransferParams")},
+					structType("0x2", "coin", "Coin", map[string]any{"TypeParameter": float64(0)}),
+					"U64",
+					map[string]any{"Reference": structType("0x2", "clock", "Clock")},
+					map[string]any{"MutableReference": structType(testPoolPackageId, "pool", "PoolState")},
+					map[string]any{"MutableReference": structType("0x2", "tx_context", "TxContext")},
+				},
+				"return": []any{},
+			},
+		},
+	}
+}
+
+func testOwnedCoin(id string, coinType string, balance string) models.CoinData {
+	return models.CoinData{
+		CoinType:     coinType,
+		CoinObjectId: id,
+		Version:      "1",
+		Digest:       testCoinObjectDigest,
+		Balance:      balance,
+	}
+}
+
+// moveCalls returns the `module::function` of the move calls of the PTB, and "SplitCoins", "MergeCoins" or
+// "TransferObjects" for the other commands
+func moveCalls(ptb *transaction.Transaction) []string {
+	var calls []string
+	for _, command := range ptb.Data.V1.Kind.ProgrammableTransaction.Commands {
+		switch {
+		case command.MoveCall != nil:
+			calls = append(calls, command.MoveCall.Module+"::"+command.MoveCall.Function)
+		case command.SplitCoins != nil:
+			calls = append(calls, "SplitCoins")
+		case command.MergeCoins != nil:
+			calls = append(calls, "MergeCoins")
+		case command.TransferObjects != nil:
+			calls = append(calls, "TransferObjects")
+		}
+	}
+
+	return calls
+}
+
+func TestBuildCCIPSendPTB(t *testing.T) {
+	t.Parallel()
+
+	ownedCoins := []models.CoinData{
+		testOwnedCoin("0x0000000000000000000000000000000000000000000000000000000000000c01", testLinkCoinType, "10"),
+		testOwnedCoin("0x0000000000000000000000000000000000000000000000000000000000000c02", testLinkCoinType, "30"),
+	}
+
+	tests := []struct {
+		name          string
+		tokenAmounts  []TokenAmount
+		feeToken      string
+		poolPackageId string
+		expectedCalls []string
+		expectedErr   string
+	}{
+		{
+			name:     "message paid in SUI",
+			feeToken: testSuiMetadata,
+			expectedCalls: []string{
+				"SplitCoins", // fee from gas
+				"onramp_state_helper::create_token_transfer_params",
+				"onramp::ccip_send",
+				"TransferObjects",
+			},
+		},
+		{
+			name:         "token transfer paid in SUI",
+			tokenAmounts: []TokenAmount{{Token: testLinkMetadata, Amount: 25}},
+			feeToken:     testSuiMetadata,
+			expectedCalls: []string{
+				"SplitCoins", // token coin, from the largest coin
+				"SplitCoins", // fee from gas
+				"onramp_state_helper::create_token_transfer_params",
+				"pool::lock_or_burn",
+				"onramp::ccip_send",
+				"TransferObjects",
+			},
+		},
+		{
+			name:         "token transfer paid in the same token",
+			tokenAmounts: []TokenAmount{{Token: testLinkMetadata, Amount: 25}},
+			feeToken:     testLinkMetadata,
+			expectedCalls: []string{
+				"MergeCoins", // token and fee coins, merged once
+				"SplitCoins", // token coin
+				"SplitCoins", // fee coin
+				"onramp_state_helper::create_token_transfer_params",
+				"pool::lock_or_burn",
+				"onramp::ccip_send",
+				"TransferObjects",
+			},
+		},
+		{
+			name:          "token without pool",
+			tokenAmounts:  []TokenAmount{{Token: testLinkMetadata, Amount: 1}},
+			feeToken:      testSuiMetadata,
+			poolPackageId: "0x0",
+			expectedErr:   "no token pool registered for token",
+		},
+		{
+			name:         "insufficient token balance",
+			tokenAmounts: []TokenAmount{{Token: testLinkMetadata, Amount: 35}},
+			feeToken:     testLinkMetadata,
+			expectedErr:  "failed to select coins for token",
+		},
+		{
+			name:        "fee token is not a coin metadata",
+			feeToken:    testPoolState,
+			expectedErr: "invalid fee token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newSendTestEnv(t, ownedCoins)
+			if tt.poolPackageId != "" {
+				env.tokenPoolPackageId = tt.poolPackageId
+			}
+
+			ptb := transaction.NewTransaction()
+			args := config.Arguments{Args: map[string]any{
+				"DestChainSelector": uint64(5009297550715157269),
+				"Receiver":          []byte{0x01, 0x02},
+				"Data":              []byte("hello"),
+				"TokenAmounts":      tt.tokenAmounts,
+				"FeeToken":          tt.feeToken,
+				"ExtraArgs":         []byte{},
+			}}
+
+			quote, err := BuildCCIPSendPTB(context.Background(), logger.Test(t), env.ptbClient, ptb, args, testSignerAddress, testAddressMappings, nil)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, env.fee, quote.Fee)
+			assert.Equal(t, tt.expectedCalls, moveCalls(ptb))
+			if tt.feeToken == testLinkMetadata {
+				commands := ptb.Data.V1.Kind.ProgrammableTransaction.Commands
+				assert.Equal(t, *commands[0].MergeCoins.Destination, *commands[1].SplitCoins.Coin)
+				assert.Equal(t, *commands[0].MergeCoins.Destination, *commands[2].SplitCoins.Coin, "the fee is split from the merged coin")
+			}
+		})
+	}
+}
+
+func TestAppendSendCoins(t *testing.T) {
+	t.Parallel()
+
+	ownedCoins := []models.CoinData{
+		testOwnedCoin("0x0000000000000000000000000000000000000000000000000000000000000c01", testLinkCoinType, "10"),
+		testOwnedCoin("0x0000000000000000000000000000000000000000000000000000000000000c02", testLinkCoinType, "30"),
+	}
+	tokens := []string{testLinkMetadata, testLinkMetadata}
+	linkCoinTypes := []string{testLinkCoinType, testLinkCoinType}
+
+	// two token amounts and the fee in the same coin type, only covered by both coins together
+	ptb := transaction.NewTransaction()
+	tokenCoins, feeCoin, err := appendSendCoins(ptb, ownedCoins, tokens, linkCoinTypes, []uint64{25, 10}, testLinkCoinType, 3)
+	require.NoError(t, err)
+	require.Len(t, tokenCoins, 2)
+
+	programmable := ptb.Data.V1.Kind.ProgrammableTransaction
+	assert.Equal(t, []string{"MergeCoins", "SplitCoins", "SplitCoins", "SplitCoins"}, moveCalls(ptb))
+	merged := *programmable.Commands[0].MergeCoins.Destination
+	splitAmount := func(command int) uint64 {
+		split := programmable.Commands[command].SplitCoins
+		assert.Equal(t, merged, *split.Coin, "every amount is split from the merged coin")
+		amount := programmable.Inputs[*split.Amount[0].Input].Pure.Bytes
+
+		return binary.LittleEndian.Uint64(amount)
+	}
+	assert.Equal(t, uint64(25), splitAmount(1))
+	assert.Equal(t, uint64(10), splitAmount(2))
+	assert.Equal(t, uint64(3), splitAmount(3), "the fee is only split once")
+	assert.Equal(t, []uint16{1, 2}, []uint16{*tokenCoins[0].Result, *tokenCoins[1].Result})
+	assert.Equal(t, uint16(3), *feeCoin.Result)
+
+	_, _, err = appendSendCoins(transaction.NewTransaction(), ownedCoins, tokens, linkCoinTypes, []uint64{25, 10}, testLinkCoinType, 6)
+	require.ErrorContains(t, err, "failed to select coins for token")
+
+	// SUI fees are split from gas, separately from the token coins
+	ptb = transaction.NewTransaction()
+	_, feeCoin, err = appendSendCoins(ptb, ownedCoins, tokens[:1], linkCoinTypes[:1], []uint64{25}, "0x2::sui::SUI", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"SplitCoins", "SplitCoins"}, moveCalls(ptb))
+	assert.NotNil(t, ptb.Data.V1.Kind.ProgrammableTransaction.Commands[*feeCoin.Result].SplitCoins.Coin.GasCoin)
+}
@@ -3,6 +3,8 @@ package ptb
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"strconv"
 	"strings"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -11,8 +13,11 @@ import (
 	"github.com/block-vision/sui-go-sdk/sui"
 	"github.com/block-vision/sui-go-sdk/transaction"
 
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
 	cwConfig "github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/config"
+	"github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/ptb/coins"
 	"github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/ptb/offramp"
+	"github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/ptb/onramp"
 	"github.com/smartcontractkit/chainlink-sui/relayer/client"
 	"github.com/smartcontractkit/chainlink-sui/relayer/codec"
 )
@@ -21,13 +26,17 @@ import (
 // It provides methods to construct PTBs by mapping arguments to their respective commands
 // and handling dependencies between commands.
 type PTBConstructor struct {
-	config cwConfig.ChainWriterConfig // Configuration for building PTBs
-	client client.SuiPTBClient        // Client for interacting with Sui PTB functionality
-	log    logger.Logger              // Logger for debugging and error reporting
+	config       cwConfig.ChainWriterConfig // Configuration for building PTBs
+	client       client.SuiPTBClient        // Client for interacting with Sui PTB functionality
+	log          logger.Logger              // Logger for debugging and error reporting
+	offRampCache *offramp.ExecutionCache    // Cache for the offramp configuration read when building CCIP PTBs
+	// Resolver of the objects of CCIP PTBs, nil to resolve them from the chain for each PTB
+	objectResolver *bind.ObjectResolver
 }
 
 // NewPTBConstructor creates a new PTB constructor with the given configuration.
 // It initializes a PTBConstructor with the provided config, client, and logger.
+// The offramp configuration is cached for offramp.DefaultExecutionCacheTTL, use SetOffRampCache to change it.
 //
 // Parameters:
 //   - config: The ChainWriterConfig containing module and function definitions
@@ -38,12 +47,24 @@ type PTBConstructor struct {
 //   - *PTBConstructor: A new instance of PTBConstructor
 func NewPTBConstructor(config cwConfig.ChainWriterConfig, ptbClient client.SuiPTBClient, log logger.Logger) *PTBConstructor {
 	return &PTBConstructor{
-		config: config,
-		client: ptbClient,
-		log:    log,
+		config:       config,
+		client:       ptbClient,
+		log:          log,
+		offRampCache: offramp.NewExecutionCache(offramp.DefaultExecutionCacheTTL, nil),
 	}
 }
 
+// SetOffRampCache replaces the cache used for the offramp configuration. A nil cache disables caching.
+func (p *PTBConstructor) SetOffRampCache(cache *offramp.ExecutionCache) {
+	p.offRampCache = cache
+}
+
+// SetObjectResolver sets the resolver of the objects of CCIP PTBs, e.g. the one of the TXM which is kept current
+// with the effects of the transactions it executes. A nil resolver resolves objects from the chain for each PTB.
+func (p *PTBConstructor) SetObjectResolver(resolver *bind.ObjectResolver) {
+	p.objectResolver = resolver
+}
+
 /*
 BuildPTBCommands builds a set of PTB commands based on a signal specified in the ChainWriter configuration.
 The function first builds all PTB arguments (both object and scalar) before constructing the commands.
@@ -155,25 +176,54 @@ func (p *PTBConstructor) BuildPTBCommands(ctx context.Context, moduleName string
 	// Handle special functions that require custom PTB building
 	switch function {
 	case cwConfig.CCIPExecute:
-		addressMappings, err := offramp.GetOfframpAddressMappings(ctx, p.log, p.client, toAddress, txnConfig.PublicKey)
+		addressMappings, err := p.offRampCache.GetOfframpAddressMappings(ctx, p.log, p.client, toAddress, txnConfig.PublicKey)
 		if err != nil {
 			p.log.Errorw("Error setting up address mappings", "error", err)
 			return nil, err
 		}
 
 		// Construct the entire PTB transaction for offramp execute without CW configs
-		err = offramp.BuildOffRampExecutePTB(ctx, p.log, p.client, ptb, arguments, signerAddress, addressMappings)
+		layout, err := offramp.BuildOffRampExecutePTB(ctx, p.log, p.client, ptb, arguments, signerAddress, addressMappings, p.offRampCache, p.objectResolver)
 		if err != nil {
 			p.log.Errorw("Error building OffRamp execute PTB", "error", err)
+			// the failure may come from outdated cached configs, read them again on the next attempt
+			p.offRampCache.Invalidate(toAddress)
+
+			return nil, err
+		}
+
+		messageID := layout.Messages[0].Message.Header.MessageID
+		if p.offRampCache.NeedsSimulation(toAddress, messageID) {
+			err = p.checkExecutePTB(ctx, ptb, signerAddress, toAddress, layout)
+			if err != nil {
+				return nil, err
+			}
+		}
+		p.offRampCache.RecordAttempt(messageID)
+
+		return ptb, nil
+
+	case cwConfig.CCIPSend:
+		addressMappings, err := onramp.GetOnRampAddressMappings(ctx, p.log, p.client, toAddress, txnConfig.PublicKey)
+		if err != nil {
+			p.log.Errorw("Error setting up onramp address mappings", "error", err)
+			return nil, err
+		}
+
+		// Construct the entire PTB transaction for CCIP send without CW configs
+		quote, err := onramp.BuildCCIPSendPTB(ctx, p.log, p.client, ptb, arguments, signerAddress, addressMappings, p.objectResolver)
+		if err != nil {
+			p.log.Errorw("Error building CCIP send PTB", "error", err)
 			return nil, err
 		}
+		p.log.Infow("Built CCIP send PTB", "fee", quote.Fee, "feeCoinType", quote.FeeCoinType)
 
 		return ptb, nil
 
 	case cwConfig.CCIPCommit:
 		// If it's just a commit, then we just need to get the address mappings and use the regular
 		// PTB builder to build the PTB.
-		am, err := offramp.GetOfframpAddressMappings(ctx, p.log, p.client, toAddress, txnConfig.PublicKey)
+		am, err := p.offRampCache.GetOfframpAddressMappings(ctx, p.log, p.client, toAddress, txnConfig.PublicKey)
 		if err != nil {
 			return nil, err
 		}
@@ -194,6 +244,21 @@ func (p *PTBConstructor) BuildPTBCommands(ctx context.Context, moduleName string
 
 	}
 
+	// Coin params are taken from the signer's coins
+	arguments.SignerAddress = signerAddress
+
+	// Pre-requisite objects without an owner are owned by the signer
+	if len(txnConfig.PrerequisiteObjects) > 0 {
+		if arguments.Args == nil {
+			arguments.Args = map[string]any{}
+		}
+		err = p.FetchPrereqObjects(ctx, txnConfig.PrerequisiteObjects, &arguments.Args, &signerAddress)
+		if err != nil {
+			p.log.Errorw("Error fetching pre-requisite objects", "error", err)
+			return nil, err
+		}
+	}
+
 	// Create a map for caching objects
 	cachedArgs := make(map[string]transaction.Argument)
 
@@ -219,6 +284,52 @@ func (p *PTBConstructor) BuildPTBCommands(ctx context.Context, moduleName string
 	return ptb, nil
 }
 
+// checkExecutePTB simulates an execute PTB before it is submitted. It is only called when the offramp cache
+// reports that a simulation is needed (cold configs, a failed simulation or a retried message), so executions
+// with warm configs don't make any dev inspect call.
+//
+// A Sui execution report carries a single message and BuildOffRampExecutePTB only executes that message, so
+// each message already has its own PTB and a failing message can't block others. When the simulation shows
+// that a token pool or receiver call of the message aborts, the PTB is still submitted: the failed transaction
+// is picked up by the transactions indexer and reported as a failed ExecutionStateChanged event, and the TXM
+// does not retry Move aborts. Report level failures (aborts in `init_execute`, e.g. a root not committed yet)
+// are returned instead, as the report can be executed later. If the simulation cannot be run, the PTB is
+// submitted as is.
+func (p *PTBConstructor) checkExecutePTB(
+	ctx context.Context,
+	ptb *transaction.Transaction,
+	signerAddress string,
+	offRampPackageId string,
+	layout *offramp.ExecutePTBLayout,
+) error {
+	failure, err := offramp.SimulateExecutePTB(ctx, p.client.GetClient(), ptb, signerAddress, layout)
+	if err != nil {
+		p.log.Warnw("Failed to simulate OffRamp execute PTB, submitting it without simulation", "error", err)
+		return nil
+	}
+	p.offRampCache.RecordSimulation(offRampPackageId, failure == nil)
+	if failure == nil {
+		return nil
+	}
+
+	if failure.IsMessageFailure() {
+		p.log.Errorw("OffRamp execute PTB fails for a message, submitting it to record the failure",
+			"messageID", failure.Message.Message.Header.MessageID.String(),
+			"sequenceNumber", failure.Message.Message.Header.SequenceNumber,
+			"receiver", failure.Message.Message.Receiver.String(),
+			"error", failure.Error)
+
+		return nil
+	}
+
+	if failure.Abort == nil {
+		p.log.Warnw("OffRamp execute PTB simulation failed, submitting it anyway", "error", failure.Error)
+		return nil
+	}
+
+	return fmt.Errorf("offramp execute PTB simulation failed: %s", failure)
+}
+
 // ProcessMoveCall handles constructing move call commands and adds it to the PTB `builder` instance.
 func (p *PTBConstructor) ProcessMoveCall(
 	ctx context.Context,
@@ -304,6 +415,23 @@ func (p *PTBConstructor) ProcessArgsForCommand(
 			continue
 		}
 
+		// build Coin<T> and Balance<T> params from the signer's coins
+		if param.Coin != nil {
+			if cachedArg, exists := (*cachedArgs)[param.Name]; exists {
+				processedArgs = append(processedArgs, cachedArg)
+				continue
+			}
+
+			coinArg, err := p.ProcessCoinParam(ctx, builder, param, arguments)
+			if err != nil {
+				return nil, fmt.Errorf("failed to build coin argument for %s: %w", param.Name, err)
+			}
+			processedArgs = append(processedArgs, coinArg)
+			(*cachedArgs)[param.Name] = coinArg
+
+			continue
+		}
+
 		// otherwise, check if the parameter is in the provided args
 		if argRawValue, exists := arguments.Args[param.Name]; exists {
 			// check if the param has already been converted and cached
@@ -366,6 +494,102 @@ func (p *PTBConstructor) ProcessArgsForCommand(
 	return processedArgs, nil
 }
 
+// ProcessCoinParam appends the commands producing the `Coin<T>` (or `Balance<T>`) described by a Coin param to the
+// PTB: the signer's coins of type T are merged and the amount is split from them, SUI is split from the gas coin.
+// The amount is read from the argument named after the param, falling back to the amount of the Coin param.
+func (p *PTBConstructor) ProcessCoinParam(
+	ctx context.Context,
+	builder *transaction.Transaction,
+	param codec.SuiFunctionParam,
+	arguments *cwConfig.Arguments,
+) (transaction.Argument, error) {
+	amount := param.Coin.Amount
+	if rawAmount, exists := arguments.Args[param.Name]; exists {
+		var err error
+		amount, err = coinAmount(rawAmount)
+		if err != nil {
+			return transaction.Argument{}, err
+		}
+	}
+
+	coinType, err := coins.NormalizeCoinType(param.Coin.CoinType)
+	if err != nil {
+		return transaction.Argument{}, err
+	}
+
+	var ownedCoins []models.CoinData
+	if coinType != coins.SuiCoinType {
+		if arguments.SignerAddress == "" {
+			return transaction.Argument{}, fmt.Errorf("signer address is required to select coins of type %s", coinType)
+		}
+
+		ownedCoins, err = p.client.GetCoinsByAddress(ctx, arguments.SignerAddress)
+		if err != nil {
+			return transaction.Argument{}, fmt.Errorf("failed to get coins of %s: %w", arguments.SignerAddress, err)
+		}
+	}
+
+	coinArg, err := coins.AppendCoinWithBalance(builder, ownedCoins, coinType, amount)
+	if err != nil {
+		return transaction.Argument{}, err
+	}
+	p.log.Debugw("Built coin argument", "param", param.Name, "coinType", coinType, "amount", amount)
+
+	if !param.Coin.IntoBalance {
+		return coinArg, nil
+	}
+
+	typeTag, err := NewTypeTagBuilder().createTypeTag(coinType)
+	if err != nil {
+		return transaction.Argument{}, fmt.Errorf("failed to create type tag for coin type %s: %w", coinType, err)
+	}
+
+	return builder.MoveCall("0x2", "coin", "into_balance", []transaction.TypeTag{typeTag}, []transaction.Argument{coinArg}), nil
+}
+
+// coinAmount converts the raw value of a Coin param argument to an amount.
+func coinAmount(value any) (uint64, error) {
+	switch v := value.(type) {
+	case uint64:
+		return v, nil
+	case uint32:
+		return uint64(v), nil
+	case int:
+		if v < 0 {
+			return 0, fmt.Errorf("negative coin amount %d", v)
+		}
+
+		return uint64(v), nil
+	case int64:
+		if v < 0 {
+			return 0, fmt.Errorf("negative coin amount %d", v)
+		}
+
+		return uint64(v), nil
+	case float64:
+		if v < 0 || v != float64(uint64(v)) {
+			return 0, fmt.Errorf("invalid coin amount %v", v)
+		}
+
+		return uint64(v), nil
+	case string:
+		amount, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid coin amount %q: %w", v, err)
+		}
+
+		return amount, nil
+	case *big.Int:
+		if v == nil || !v.IsUint64() {
+			return 0, fmt.Errorf("invalid coin amount %v", v)
+		}
+
+		return v.Uint64(), nil
+	default:
+		return 0, fmt.Errorf("unsupported coin amount type %T", value)
+	}
+}
+
 // FetchPrereqObjects fetches each pre-requisite object and its details, then populates the args map with its values
 func (p *PTBConstructor) FetchPrereqObjects(ctx context.Context, prereqObjects []cwConfig.PrerequisiteObject, args *map[string]any, ownerFallback *string) error {
 	for _, prereq := range prereqObjects {
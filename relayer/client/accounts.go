@@ -1,22 +1,23 @@
 package client
 
 import (
-	"crypto/ed25519"
 	"encoding/hex"
-	"fmt"
 
 	"golang.org/x/crypto/blake2b"
 )
 
+// GetAddressFromPublicKey derives the Sui address of a keystore public key.
+// The signature scheme is resolved with ParsePublicKey.
 func GetAddressFromPublicKey(pubKey []byte) (string, error) {
-	if len(pubKey) != ed25519.PublicKeySize {
-		return "", fmt.Errorf("invalid public key length, expected %d got %d", ed25519.PublicKeySize, len(pubKey))
+	flag, rawPubKey, err := ParsePublicKey(pubKey)
+	if err != nil {
+		return "", err
 	}
 
-	// Prepend the Ed25519 flag byte to the public key
-	flaggedPubKey := make([]byte, 1+len(pubKey))
-	flaggedPubKey[0] = byte(SigFlagEd25519)
-	copy(flaggedPubKey[1:], pubKey)
+	// Prepend the scheme flag byte to the public key
+	flaggedPubKey := make([]byte, 1+len(rawPubKey))
+	flaggedPubKey[0] = byte(flag)
+	copy(flaggedPubKey[1:], rawPubKey)
 
 	// Hash the flagged public key
 	digest := blake2b.Sum256(flaggedPubKey)
@@ -0,0 +1,93 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dryRunAbortError = `MoveAbort(MoveLocation { module: ModuleId { address: 0000000000000000000000000000000000000000000000000000000000000abc, name: Identifier("counter") }, function: 3, instruction: 12, function_name: Some("increment") }, 7) in command 1`
+
+// newDryRunGateway serves sui_dryRunTransactionBlock with the given execution status.
+func newDryRunGateway(t *testing.T, status string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"effects":{"status":` + status + `,` +
+			`"gasUsed":{"computationCost":"1000","storageCost":"2000","storageRebate":"500","nonRefundableStorageFee":"0"}}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestPTBClientEstimateGas(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		status      string
+		expectedGas uint64
+		expectAbort bool
+		expectedErr string
+	}{
+		{
+			name:        "success",
+			status:      `{"status":"success"}`,
+			expectedGas: 3000,
+		},
+		{
+			name:        "move abort",
+			status:      `{"status":"failure","error":` + jsonString(dryRunAbortError) + `}`,
+			expectAbort: true,
+			expectedErr: "transaction failed in dry run: MoveAbort",
+		},
+		{
+			name:        "other failure",
+			status:      `{"status":"failure","error":"InsufficientGas"}`,
+			expectedErr: "transaction failed in dry run: InsufficientGas",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newDryRunGateway(t, tt.status)
+			ptbClient, err := NewPTBClient(logger.Test(t), server.URL, nil, time.Second, nil, 1, WaitForEffectsCert)
+			require.NoError(t, err)
+
+			gas, err := ptbClient.EstimateGas(context.Background(), "AA==")
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedGas, gas)
+
+				return
+			}
+
+			require.ErrorContains(t, err, tt.expectedErr)
+			var dryRunErr *DryRunFailedError
+			require.ErrorAs(t, err, &dryRunErr)
+			if !tt.expectAbort {
+				assert.Nil(t, dryRunErr.Abort)
+				return
+			}
+			require.NotNil(t, dryRunErr.Abort)
+			assert.Equal(t, uint64(7), dryRunErr.Abort.AbortCode)
+			assert.Equal(t, uint64(1), dryRunErr.Abort.CommandIndex)
+		})
+	}
+}
+
+func jsonString(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+}
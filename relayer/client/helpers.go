@@ -10,6 +10,9 @@ import (
 
 	"github.com/block-vision/sui-go-sdk/models"
 	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/mr-tron/base58"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
 )
 
 func (c *PTBClient) TransformTransactionArg(
@@ -137,6 +140,16 @@ func (c *PTBClient) TransformTransactionArg(
 
 		return nil, fmt.Errorf("expected []string for vector<address>, got %T", arg)
 	default:
+		if requiresMoveCodec(argType) {
+			bcsBytes, err := c.EncodeMoveValue(ctx, argType, arg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode argument of type %s: %w", argType, err)
+			}
+			pureArg := tx.Data.V1.AddInput(transaction.CallArg{Pure: &transaction.Pure{Bytes: bcsBytes}})
+
+			return &pureArg, nil
+		}
+
 		pureArg := tx.Pure(arg)
 		return &pureArg, nil
 	}
@@ -202,7 +215,7 @@ func (c *PTBClient) convertBlockvisionResponse(resp *models.SuiTransactionBlockR
 		},
 		ObjectChanges: resp.ObjectChanges,
 		Events:        resp.Events,
-		Effects:       resp.Effects,
+		Effects:       bind.SuiEffects{SuiEffects: resp.Effects},
 	}
 
 	// Note: Full conversion of effects, events, and object changes would require
@@ -232,3 +245,36 @@ func (c *PTBClient) HashTxBytes(txBytes []byte) []byte {
 	digest := blake2b.Sum256(intentMessage)
 	return digest[:]
 }
+
+// transactionDataIntentPrefix is the BCS type name prepended to transaction data when computing its digest.
+const transactionDataIntentPrefix = "TransactionData::"
+
+// TransactionDigestFromBytes computes the Sui transaction digest of BCS encoded transaction data, so that a
+// transaction is tracked without relying on the node returning its digest.
+func TransactionDigestFromBytes(txBytes []byte) string {
+	digestInput := append([]byte(transactionDataIntentPrefix), txBytes...)
+	digest := blake2b.Sum256(digestInput)
+
+	return base58.Encode(digest[:])
+}
+
+// netGasUsed returns the net gas cost of a transaction in MIST, i.e. computation and storage costs minus the
+// storage rebate. Costs that cannot be parsed are ignored.
+func netGasUsed(gasUsed models.GasCostSummary) uint64 {
+	parse := func(value string) uint64 {
+		parsed, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return 0
+		}
+
+		return parsed
+	}
+
+	cost := parse(gasUsed.ComputationCost) + parse(gasUsed.StorageCost)
+	rebate := parse(gasUsed.StorageRebate)
+	if rebate > cost {
+		return 0
+	}
+
+	return cost - rebate
+}
@@ -13,6 +13,7 @@ import (
 	signer "github.com/block-vision/sui-go-sdk/signer"
 	sui "github.com/block-vision/sui-go-sdk/sui"
 	transaction "github.com/block-vision/sui-go-sdk/transaction"
+	bind "github.com/smartcontractkit/chainlink-sui/bindings/bind"
 	client "github.com/smartcontractkit/chainlink-sui/relayer/client"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -56,6 +57,36 @@ func (mr *MockSuiPTBClientMockRecorder) BlockByDigest(ctx, txDigest interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockByDigest", reflect.TypeOf((*MockSuiPTBClient)(nil).BlockByDigest), ctx, txDigest)
 }
 
+// DecodeMoveValue mocks base method.
+func (m *MockSuiPTBClient) DecodeMoveValue(ctx context.Context, moveType string, bcsBytes []byte) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecodeMoveValue", ctx, moveType, bcsBytes)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecodeMoveValue indicates an expected call of DecodeMoveValue.
+func (mr *MockSuiPTBClientMockRecorder) DecodeMoveValue(ctx, moveType, bcsBytes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodeMoveValue", reflect.TypeOf((*MockSuiPTBClient)(nil).DecodeMoveValue), ctx, moveType, bcsBytes)
+}
+
+// DryRunTransaction mocks base method.
+func (m *MockSuiPTBClient) DryRunTransaction(ctx context.Context, txBytes string) (client.DryRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunTransaction", ctx, txBytes)
+	ret0, _ := ret[0].(client.DryRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunTransaction indicates an expected call of DryRunTransaction.
+func (mr *MockSuiPTBClientMockRecorder) DryRunTransaction(ctx, txBytes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunTransaction", reflect.TypeOf((*MockSuiPTBClient)(nil).DryRunTransaction), ctx, txBytes)
+}
+
 // EstimateGas mocks base method.
 func (m *MockSuiPTBClient) EstimateGas(ctx context.Context, txBytes string) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +146,21 @@ func (mr *MockSuiPTBClientMockRecorder) GetCoinsByAddress(ctx, address interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoinsByAddress", reflect.TypeOf((*MockSuiPTBClient)(nil).GetCoinsByAddress), ctx, address)
 }
 
+// GetCoinBalance mocks base method.
+func (m *MockSuiPTBClient) GetCoinBalance(ctx context.Context, address, coinType string) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCoinBalance", ctx, address, coinType)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCoinBalance indicates an expected call of GetCoinBalance.
+func (mr *MockSuiPTBClientMockRecorder) GetCoinBalance(ctx, address, coinType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoinBalance", reflect.TypeOf((*MockSuiPTBClient)(nil).GetCoinBalance), ctx, address, coinType)
+}
+
 // GetSUIBalance mocks base method.
 func (m *MockSuiPTBClient) GetSUIBalance(ctx context.Context, address string) (*big.Int, error) {
 	m.ctrl.T.Helper()
@@ -161,10 +207,10 @@ func (mr *MockSuiPTBClientMockRecorder) MoveCall(ctx, req interface{}) *gomock.C
 }
 
 // QueryEvents mocks base method.
-func (m *MockSuiPTBClient) QueryEvents(ctx context.Context, filter client.EventFilterByMoveEventModule, limit *uint, cursor *client.EventId, sortOptions *client.QuerySortOptions) (*models.PaginatedEventsResponse, error) {
+func (m *MockSuiPTBClient) QueryEvents(ctx context.Context, filter client.EventFilterByMoveEventModule, limit *uint, cursor *client.EventId, sortOptions *client.QuerySortOptions) (*bind.EventsPage, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QueryEvents", ctx, filter, limit, cursor, sortOptions)
-	ret0, _ := ret[0].(*models.PaginatedEventsResponse)
+	ret0, _ := ret[0].(*bind.EventsPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
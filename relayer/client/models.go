@@ -2,6 +2,9 @@ package client
 
 import (
 	"github.com/block-vision/sui-go-sdk/models"
+
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client/suierrors"
 )
 
 type TransactionBlockOptions struct {
@@ -62,7 +65,7 @@ type TxnMetaData struct {
 type SuiTransactionBlockResponse struct {
 	TxDigest      string                    `json:"txDigest"`
 	Status        SuiExecutionStatus        `json:"status"`
-	Effects       models.SuiEffects         `json:"effects"`
+	Effects       bind.SuiEffects           `json:"effects"`
 	Events        []models.SuiEventResponse `json:"events,omitempty"`
 	Timestamp     uint64                    `json:"timestamp"`
 	Height        uint64                    `json:"height"`
@@ -117,10 +120,45 @@ type QuerySortOptions struct {
 }
 
 type TransactionResult struct {
-	Status string `json:"status"`
-	Error  string `json:"error"`
+	Status  string `json:"status"`
+	Error   string `json:"error"`
+	GasUsed uint64 `json:"gasUsed"` // net gas cost in MIST: computation + storage - storage rebate
+}
+
+// DryRunResult is the outcome of a transaction executed against the latest state without being committed.
+type DryRunResult struct {
+	TransactionResult
+	Effects        models.SuiEffects         `json:"effects"`
+	Events         []models.SuiEventResponse `json:"events,omitempty"`
+	ObjectChanges  []models.ObjectChange     `json:"objectChanges,omitempty"`
+	BalanceChanges []models.BalanceChanges   `json:"balanceChanges,omitempty"`
+}
+
+// DryRunFailedError is returned when a transaction fails in a dry run, e.g. when it aborts.
+type DryRunFailedError struct {
+	// Reason is the execution error reported by the node
+	Reason string
+	// Abort is the parsed Move abort, nil if the failure is not a Move abort
+	Abort *suierrors.MoveAbort
+}
+
+func newDryRunFailedError(status models.ExecutionStatus) *DryRunFailedError {
+	// the abort is nil for other failures
+	abort, _ := suierrors.ParseMoveAbort(status.Error)
+
+	return &DryRunFailedError{Reason: status.Error, Abort: abort}
+}
+
+func (e *DryRunFailedError) Error() string {
+	return "transaction failed in dry run: " + e.Reason
 }
 
 type FunctionReadResponse struct {
 	ReturnValues []any `json:"returnValues"`
 }
+
+// MoveValue is a BCS encoded value of a Move type, e.g. a value returned by a function.
+type MoveValue struct {
+	Type string
+	Bcs  []byte
+}
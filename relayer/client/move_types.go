@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
+)
+
+// DecodeMoveValue decodes the BCS bytes of a value of the given Move type (e.g. `0x1::option::Option<u64>`) into its
+// generic Go form, fetching the layouts of the structs and enums involved from the node as needed.
+func (c *PTBClient) DecodeMoveValue(ctx context.Context, moveType string, bcsBytes []byte) (any, error) {
+	parsed, err := c.resolveMoveType(ctx, moveType)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.moveTypes.Decode(bcsBytes, parsed)
+}
+
+// EncodeMoveValue encodes a Go value as the BCS bytes of a value of the given Move type, fetching the layouts of the
+// structs and enums involved from the node as needed.
+func (c *PTBClient) EncodeMoveValue(ctx context.Context, moveType string, value any) ([]byte, error) {
+	parsed, err := c.resolveMoveType(ctx, moveType)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.moveTypes.Encode(parsed, value)
+}
+
+// DecodeMoveValueInto decodes the BCS bytes of a value of the given Move type into target, e.g. a Go struct whose fields
+// are annotated with `sui` tags (see movebcs.TagName).
+func (c *PTBClient) DecodeMoveValueInto(ctx context.Context, moveType string, bcsBytes []byte, target any) error {
+	parsed, err := c.resolveMoveType(ctx, moveType)
+	if err != nil {
+		return err
+	}
+
+	return c.moveTypes.DecodeInto(bcsBytes, parsed, target)
+}
+
+// UnmarshalMoveValue converts a loosely typed value of the given Move type, such as the parsed JSON of an event, into
+// target.
+func (c *PTBClient) UnmarshalMoveValue(ctx context.Context, moveType string, value any, target any) error {
+	parsed, err := c.resolveMoveType(ctx, moveType)
+	if err != nil {
+		return err
+	}
+
+	return c.moveTypes.Unmarshal(value, parsed, target)
+}
+
+// DecodeMoveValuesInto decodes values, such as the values returned by ReadFunctionBCS, into the `sui` tagged fields of
+// the Go struct target points to, names[i] being the name of values[i].
+func (c *PTBClient) DecodeMoveValuesInto(ctx context.Context, names []string, values []MoveValue, target any) error {
+	if len(names) != len(values) {
+		return fmt.Errorf("got %d values for %d names", len(values), len(names))
+	}
+
+	fields := make([]movebcs.Field, len(values))
+	data := make([][]byte, len(values))
+	for i, value := range values {
+		parsed, err := c.resolveMoveType(ctx, value.Type)
+		if err != nil {
+			return err
+		}
+		fields[i] = movebcs.Field{Name: names[i], Type: parsed}
+		data[i] = value.Bcs
+	}
+
+	return c.moveTypes.DecodeFieldsInto(fields, data, target)
+}
+
+// resolveMoveType parses a Move type and registers the layouts of all the structs and enums it references.
+func (c *PTBClient) resolveMoveType(ctx context.Context, moveType string) (movebcs.Type, error) {
+	parsed, err := movebcs.ParseType(moveType)
+	if err != nil {
+		return movebcs.Type{}, err
+	}
+
+	// registering a layout may reveal the types of its fields, so repeat until every type is known
+	for missing := c.moveTypes.Missing(parsed); len(missing) > 0; missing = c.moveTypes.Missing(parsed) {
+		for _, tag := range missing {
+			if err := c.registerNormalizedModule(ctx, tag.Address, tag.Module); err != nil {
+				return movebcs.Type{}, err
+			}
+			if _, ok := c.moveTypes.Layout(&tag); !ok {
+				return movebcs.Type{}, fmt.Errorf("type %s not found in its module", tag.String())
+			}
+		}
+	}
+
+	return parsed, nil
+}
+
+// registerNormalizedModule fetches a normalized module and registers its structs and enums.
+func (c *PTBClient) registerNormalizedModule(ctx context.Context, packageId string, module string) error {
+	normalizedModule, err := c.GetNormalizedModuleJSON(ctx, packageId, module)
+	if err != nil {
+		return err
+	}
+
+	return c.moveTypes.AddNormalizedModuleJSON(normalizedModule)
+}
+
+// GetNormalizedModuleJSON returns the raw JSON of a normalized module. Unlike GetNormalizedModule, it holds the enums
+// of Move 2024 modules, which the SDK model of the response drops.
+func (c *PTBClient) GetNormalizedModuleJSON(ctx context.Context, packageId string, module string) ([]byte, error) {
+	var result json.RawMessage
+	err := c.WithRateLimit(ctx, func(ctx context.Context) error {
+		response, err := c.client.SuiCall(ctx, "sui_getNormalizedMoveModule", packageId, module)
+		if err != nil {
+			return fmt.Errorf("failed to get normalized module %s::%s: %w", packageId, module, err)
+		}
+
+		rawResponse, ok := response.(string)
+		if !ok {
+			return fmt.Errorf("unexpected normalized module response type %T", response)
+		}
+
+		var normalizedModule struct {
+			Result json.RawMessage `json:"result"`
+		}
+		if err := json.Unmarshal([]byte(rawResponse), &normalizedModule); err != nil {
+			return fmt.Errorf("failed to parse normalized module %s::%s: %w", packageId, module, err)
+		}
+		result = normalizedModule.Result
+
+		return nil
+	})
+
+	return result, err
+}
+
+// requiresMoveCodec reports whether values of a Move type need layout-driven encoding, i.e. whether the type involves
+// structs or enums, which the SDK's pure value encoding cannot represent in general.
+func requiresMoveCodec(moveType string) bool {
+	parsed, err := movebcs.ParseType(moveType)
+	if err != nil {
+		return false
+	}
+
+	for parsed.Kind == movebcs.KindVector {
+		parsed = *parsed.Elem
+	}
+
+	return parsed.Kind == movebcs.KindStruct
+}
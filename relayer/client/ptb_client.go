@@ -6,10 +6,8 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
-	"strings"
 	"time"
 
-	"github.com/aptos-labs/aptos-go-sdk/bcs"
 	"github.com/block-vision/sui-go-sdk/models"
 	"github.com/block-vision/sui-go-sdk/mystenbcs"
 	"github.com/block-vision/sui-go-sdk/signer"
@@ -19,7 +17,9 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 	"golang.org/x/sync/semaphore"
 
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
 	"github.com/smartcontractkit/chainlink-sui/relayer/codec"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
 	"github.com/smartcontractkit/chainlink-sui/relayer/common"
 	"github.com/smartcontractkit/chainlink-sui/shared"
 )
@@ -29,6 +29,7 @@ const (
 	Base10           int    = 10
 	DefaultGasPrice  uint64 = 10_000
 	DefaultGasBudget uint64 = 1_000_000_000
+	SuiCoinType      string = "0x2::sui::SUI"
 )
 
 // var since it's passed via pointer
@@ -42,16 +43,19 @@ type SuiPTBClient interface {
 	ReadObjectId(ctx context.Context, objectId string) (models.SuiObjectData, error)
 	ReadFunction(ctx context.Context, signerAddress string, packageId string, module string, function string, args []any, argTypes []string) ([]any, error)
 	SignAndSendTransaction(ctx context.Context, txBytesRaw string, signerPublicKey []byte, executionRequestType TransactionRequestType) (SuiTransactionBlockResponse, error)
-	QueryEvents(ctx context.Context, filter EventFilterByMoveEventModule, limit *uint, cursor *EventId, sortOptions *QuerySortOptions) (*models.PaginatedEventsResponse, error)
+	QueryEvents(ctx context.Context, filter EventFilterByMoveEventModule, limit *uint, cursor *EventId, sortOptions *QuerySortOptions) (*bind.EventsPage, error)
 	QueryTransactions(ctx context.Context, fromAddress string, cursor *string, limit *uint64) (models.SuiXQueryTransactionBlocksResponse, error)
 	GetTransactionStatus(ctx context.Context, digest string) (TransactionResult, error)
 	GetCoinsByAddress(ctx context.Context, address string) ([]models.CoinData, error)
 	EstimateGas(ctx context.Context, txBytes string) (uint64, error)
+	DryRunTransaction(ctx context.Context, txBytes string) (DryRunResult, error)
 	FinishPTBAndSend(ctx context.Context, txnSigner *signer.Signer, tx *transaction.Transaction, requestType TransactionRequestType) (SuiTransactionBlockResponse, error)
 	BlockByDigest(ctx context.Context, txDigest string) (*SuiTransactionBlockResponse, error)
 	GetBlockById(ctx context.Context, checkpointId string) (models.CheckpointResponse, error)
 	GetNormalizedModule(ctx context.Context, packageId string, moduleId string) (models.GetNormalizedMoveModuleResponse, error)
+	DecodeMoveValue(ctx context.Context, moveType string, bcsBytes []byte) (any, error)
 	GetSUIBalance(ctx context.Context, address string) (*big.Int, error)
+	GetCoinBalance(ctx context.Context, address string, coinType string) (*big.Int, error)
 	GetClient() sui.ISuiAPI
 	HashTxBytes(txBytes []byte) []byte
 }
@@ -66,6 +70,7 @@ type PTBClient struct {
 	rateLimiter        *semaphore.Weighted
 	defaultRequestType TransactionRequestType
 	normalizedModules  map[string]map[string]models.GetNormalizedMoveModuleResponse
+	moveTypes          *movebcs.Registry
 }
 
 var _ SuiPTBClient = (*PTBClient)(nil)
@@ -96,6 +101,7 @@ func NewPTBClient(
 		rateLimiter:        semaphore.NewWeighted(maxConcurrentRequests),
 		defaultRequestType: defaultRequestType,
 		normalizedModules:  make(map[string]map[string]models.GetNormalizedMoveModuleResponse),
+		moveTypes:          movebcs.NewRegistry(),
 	}, nil
 }
 
@@ -149,7 +155,6 @@ func (c *PTBClient) MoveCall(ctx context.Context, req MoveCallRequest) (TxnMetaD
 func (c *PTBClient) SendTransaction(ctx context.Context, payload TransactionBlockRequest) (SuiTransactionBlockResponse, error) {
 	var result SuiTransactionBlockResponse
 	err := c.WithRateLimit(ctx, func(ctx context.Context) error {
-		// Use blockvision SDK's execute transaction
 		executeReq := models.SuiExecuteTransactionBlockRequest{
 			TxBytes:   payload.TxBytes,
 			Signature: payload.Signatures,
@@ -166,13 +171,14 @@ func (c *PTBClient) SendTransaction(ctx context.Context, payload TransactionBloc
 
 		c.log.Debugw("Executing transaction", "request", executeReq)
 
-		response, err := c.client.SuiExecuteTransactionBlock(ctx, executeReq)
+		response, err := bind.ExecuteTransactionBlock(ctx, c.client, executeReq)
 		if err != nil {
 			return fmt.Errorf("failed to execute transaction: %w", err)
 		}
 
-		// Convert blockvision response to models response
-		result = c.convertBlockvisionResponse(&response)
+		// Convert blockvision response to models response, keeping the wrapped and unwrapped objects of its effects
+		result = c.convertBlockvisionResponse(&response.SuiTransactionBlockResponse)
+		result.Effects = response.Effects
 
 		return nil
 	})
@@ -282,6 +288,8 @@ func (c *PTBClient) ReadOwnedObjects(ctx context.Context, ownerAddress string, c
 	return result, err
 }
 
+// EstimateGas dry runs the BCS encoded transaction and returns the gas it uses. A transaction failing in the dry
+// run returns a *DryRunFailedError.
 func (c *PTBClient) EstimateGas(ctx context.Context, txBytes string) (uint64, error) {
 	var result uint64
 	err := c.WithRateLimit(ctx, func(ctx context.Context) error {
@@ -294,6 +302,10 @@ func (c *PTBClient) EstimateGas(ctx context.Context, txBytes string) (uint64, er
 		if err != nil {
 			return fmt.Errorf("failed to estimate gas: %w", err)
 		}
+		// the gas used by a failed transaction doesn't estimate the gas it needs to succeed
+		if response.Effects.Status.Status != "success" {
+			return newDryRunFailedError(response.Effects.Status)
+		}
 
 		// Extract gas used from response
 		if response.Effects.GasUsed.ComputationCost != "" {
@@ -314,8 +326,39 @@ func (c *PTBClient) EstimateGas(ctx context.Context, txBytes string) (uint64, er
 	return result, err
 }
 
-func (c *PTBClient) ReadFunction(ctx context.Context, signerAddress string, packageId string, module string, function string, args []any, argTypes []string) ([]any, error) {
-	var results []any
+// DryRunTransaction executes the BCS encoded transaction against the latest state without committing it, and
+// returns its simulated effects. A transaction aborting during execution is not an error, its status is "failure".
+func (c *PTBClient) DryRunTransaction(ctx context.Context, txBytes string) (DryRunResult, error) {
+	var result DryRunResult
+	err := c.WithRateLimit(ctx, func(ctx context.Context) error {
+		response, err := c.client.SuiDryRunTransactionBlock(ctx, models.SuiDryRunTransactionBlockRequest{
+			TxBytes: txBytes,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to dry run transaction: %w", err)
+		}
+
+		result = DryRunResult{
+			TransactionResult: TransactionResult{
+				Status:  response.Effects.Status.Status,
+				Error:   response.Effects.Status.Error,
+				GasUsed: netGasUsed(response.Effects.GasUsed),
+			},
+			Effects:        response.Effects,
+			Events:         response.Events,
+			ObjectChanges:  response.ObjectChanges,
+			BalanceChanges: response.BalanceChanges,
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// ReadFunctionBCS calls a function with dev inspect and returns its return values undecoded, along with their types.
+func (c *PTBClient) ReadFunctionBCS(ctx context.Context, signerAddress string, packageId string, module string, function string, args []any, argTypes []string) ([]MoveValue, error) {
+	var values []MoveValue
 	err := c.WithRateLimit(ctx, func(ctx context.Context) error {
 		txn := transaction.NewTransaction()
 
@@ -374,62 +417,50 @@ func (c *PTBClient) ReadFunction(ctx context.Context, signerAddress string, pack
 			return fmt.Errorf("failed to unmarshal results: %w", err)
 		}
 
-		results = make([]any, len(functionReadResponse[0].ReturnValues))
-
-		// parse one or more results
+		values = make([]MoveValue, len(functionReadResponse[0].ReturnValues))
 		for i, returnedValue := range functionReadResponse[0].ReturnValues {
 			returnedValue := returnedValue.([]any)
-			structTag := returnedValue[1].(string)
-			structParts := strings.Split(structTag, "::")
-
-			// create a bcs decoder from the return value
 			bcsBytes, err := codec.AnySliceToBytes(returnedValue[0].([]any))
 			if err != nil {
 				return fmt.Errorf("failed to convert return value to bytes: %w", err)
 			}
-			bcsDecoder := bcs.NewDeserializer(bcsBytes)
-
-			// This is a special case for Sui strings as they are represented as a struct with tag "0x1::string::String"
-			// Since we use the tag to fetch the normalized module, it causes a failure since a module "string" does not exist.
-			// We parse the value separately here. The BCS decoder is not actually needed for this case but we are already initializing it for the complex structs
-			// so we can use it to read the string value.
-			if structTag == "0x1::string::String" {
-				strValue := bcsDecoder.ReadString()
-				results[i] = strValue
-				continue
-			}
-
-			// if the response type is not a struct (primitive type), skip the result (keep it as is)
-			structPartsLen := 3
-			if len(structParts) != structPartsLen {
-				primitive, err := codec.DecodeSuiPrimative(bcsDecoder, structTag)
-				if err != nil {
-					return fmt.Errorf("failed to decode primitive: %w", err)
-				}
-				results[i] = primitive
-			} else {
-				// otherwise, get the normalized struct and attempt turning the result into JSON
-				normalizedModule, err := c.GetNormalizedModule(ctx, packageId, structParts[1])
-				c.log.Debugw("normalizedModule", "normalizedModule", normalizedModule)
-				if err != nil {
-					return fmt.Errorf("failed to get normalized struct: %w", err)
-				}
-
-				jsonResult, err := codec.DecodeSuiStructToJSON(normalizedModule.Structs, structParts[2], bcsDecoder)
-				if err != nil {
-					return fmt.Errorf("failed to parse struct into JSON: %w", err)
-				}
-
-				results[i] = jsonResult
+			values[i] = MoveValue{
+				Type: returnedValue[1].(string),
+				Bcs:  bcsBytes,
 			}
 		}
 
-		c.log.Debugw("ReadFunction results", "functionTag", fmt.Sprintf("%s::%s::%s", packageId, module, function), "results", results)
-
 		return nil
 	})
 
-	return results, err
+	return values, err
+}
+
+func (c *PTBClient) ReadFunction(ctx context.Context, signerAddress string, packageId string, module string, function string, args []any, argTypes []string) ([]any, error) {
+	values, err := c.ReadFunctionBCS(ctx, signerAddress, packageId, module, function, args, argTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]any, len(values))
+
+	// every value, be it a primitive, a struct, an enum or a generic type, is decoded from the Move layouts of its type
+	for i, returnedValue := range values {
+		parsed, err := c.resolveMoveType(ctx, returnedValue.Type)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve return type %s: %w", returnedValue.Type, err)
+		}
+
+		// addresses are kept as raw bytes, as the chain reader has always returned them
+		results[i], err = c.moveTypes.DecodeAddressBytes(returnedValue.Bcs, parsed)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode return value %d: %w", i, err)
+		}
+	}
+
+	c.log.Debugw("ReadFunction results", "functionTag", fmt.Sprintf("%s::%s::%s", packageId, module, function), "results", results)
+
+	return results, nil
 }
 
 func (c *PTBClient) SignAndSendTransaction(ctx context.Context, txBytesRaw string, signerPublicKey []byte, executionRequestType TransactionRequestType) (SuiTransactionBlockResponse, error) {
@@ -450,7 +481,10 @@ func (c *PTBClient) SignAndSendTransaction(ctx context.Context, txBytesRaw strin
 		return SuiTransactionBlockResponse{}, fmt.Errorf("failed to sign tx: %w", err)
 	}
 
-	signaturesString := SerializeSuiSignature(signature, signerPublicKey)
+	signaturesString, err := SerializeSuiSignature(signature, signerPublicKey)
+	if err != nil {
+		return SuiTransactionBlockResponse{}, err
+	}
 
 	return c.SendTransaction(ctx, TransactionBlockRequest{
 		TxBytes:     txBytesRaw,
@@ -467,8 +501,9 @@ func (c *PTBClient) SignAndSendTransaction(ctx context.Context, txBytesRaw strin
 	})
 }
 
-func (c *PTBClient) QueryEvents(ctx context.Context, filter EventFilterByMoveEventModule, limit *uint, cursor *EventId, sortOptions *QuerySortOptions) (*models.PaginatedEventsResponse, error) {
-	var result *models.PaginatedEventsResponse
+// QueryEvents returns a page of the events of a Move event type, along with the encoding of their BCS.
+func (c *PTBClient) QueryEvents(ctx context.Context, filter EventFilterByMoveEventModule, limit *uint, cursor *EventId, sortOptions *QuerySortOptions) (*bind.EventsPage, error) {
+	var result *bind.EventsPage
 	err := c.WithRateLimit(ctx, func(ctx context.Context) error {
 		limitVal := uint64(maxPageSize)
 		if limit != nil {
@@ -496,7 +531,7 @@ func (c *PTBClient) QueryEvents(ctx context.Context, filter EventFilterByMoveEve
 			"cursor", cursor,
 		)
 
-		response, err := c.client.SuiXQueryEvents(ctx, queryReq)
+		response, err := bind.QuerySuiEvents(ctx, c.client, queryReq)
 		if err != nil {
 			return fmt.Errorf("failed to query events: %w", err)
 		}
@@ -525,8 +560,9 @@ func (c *PTBClient) GetTransactionStatus(ctx context.Context, digest string) (Tr
 		}
 
 		result = TransactionResult{
-			Status: response.Effects.Status.Status,
-			Error:  response.Effects.Status.Error,
+			Status:  response.Effects.Status.Status,
+			Error:   response.Effects.Status.Error,
+			GasUsed: netGasUsed(response.Effects.GasUsed),
 		}
 
 		return nil
@@ -692,16 +728,21 @@ func (c *PTBClient) GetBlockById(ctx context.Context, checkpointId string) (mode
 }
 
 func (c *PTBClient) GetSUIBalance(ctx context.Context, address string) (*big.Int, error) {
+	return c.GetCoinBalance(ctx, address, SuiCoinType)
+}
+
+// GetCoinBalance returns the total balance of the given coin type owned by an address, in the smallest unit of the coin.
+func (c *PTBClient) GetCoinBalance(ctx context.Context, address string, coinType string) (*big.Int, error) {
 	var result *big.Int
 	err := c.WithRateLimit(ctx, func(ctx context.Context) error {
 		balanceReq := models.SuiXGetBalanceRequest{
 			Owner:    address,
-			CoinType: "0x2::sui::SUI", // Default SUI coin type
+			CoinType: coinType,
 		}
 
 		response, err := c.client.SuiXGetBalance(ctx, balanceReq)
 		if err != nil {
-			return fmt.Errorf("failed to get SUI balance: %w", err)
+			return fmt.Errorf("failed to get %s balance: %w", coinType, err)
 		}
 
 		balance, ok := new(big.Int).SetString(response.TotalBalance, Base10)
@@ -0,0 +1,126 @@
+//go:build unit
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
+)
+
+const readFunctionModuleJSON = `{
+	"address": "0xabc",
+	"name": "pool",
+	"structs": {
+		"Config": {
+			"typeParameters": [],
+			"fields": [
+				{"name": "owner", "type": "Address"},
+				{"name": "fee_bps", "type": "U16"},
+				{"name": "cap", "type": {"Struct": {"address": "0x1", "module": "option", "name": "Option", "typeArguments": ["U128"]}}}
+			]
+		}
+	},
+	"enums": {
+		"Status": {
+			"typeParameters": [],
+			"variants": {"Active": [], "Paused": [{"name": "until", "type": "U64"}]},
+			"variantDeclarationOrder": ["Active", "Paused"]
+		}
+	}
+}`
+
+// newReadFunctionGateway serves the given return values to sui_devInspectTransactionBlock, and the pool module to
+// sui_getNormalizedMoveModule.
+func newReadFunctionGateway(t *testing.T, returnValues [][]any) *httptest.Server {
+	t.Helper()
+
+	devInspect, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"result":  map[string]any{"results": []any{map[string]any{"returnValues": returnValues}}},
+	})
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch request.Method {
+		case "sui_devInspectTransactionBlock":
+			_, _ = w.Write(devInspect)
+		case "sui_getNormalizedMoveModule":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":` + readFunctionModuleJSON + `}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// returnValue is a dev inspect return value: its BCS bytes as numbers, and its type.
+func returnValue(t *testing.T, registry *movebcs.Registry, moveType string, value any) []any {
+	t.Helper()
+
+	parsed, err := movebcs.ParseType(moveType)
+	require.NoError(t, err)
+	encoded, err := registry.Encode(parsed, value)
+	require.NoError(t, err)
+
+	numbers := make([]any, len(encoded))
+	for i, b := range encoded {
+		numbers[i] = b
+	}
+
+	return []any{numbers, moveType}
+}
+
+func TestPTBClientReadFunction(t *testing.T) {
+	t.Parallel()
+
+	registry := movebcs.NewRegistry()
+	require.NoError(t, registry.AddNormalizedModuleJSON([]byte(readFunctionModuleJSON)))
+	owner := make([]byte, 32)
+	owner[31] = 0x42
+
+	server := newReadFunctionGateway(t, [][]any{
+		returnValue(t, registry, "u64", uint64(7)),
+		returnValue(t, registry, "address", owner),
+		returnValue(t, registry, "vector<0x1::string::String>", []string{"a", "b"}),
+		returnValue(t, registry, "0xabc::pool::Config", map[string]any{"owner": owner, "fee_bps": 30, "cap": big.NewInt(1000)}),
+		returnValue(t, registry, "0x1::option::Option<0xabc::pool::Config>", nil),
+		returnValue(t, registry, "vector<0xabc::pool::Status>", []any{"Active", movebcs.Enum{Variant: "Paused", Fields: map[string]any{"until": 9}}}),
+	})
+	ptbClient, err := NewPTBClient(logger.Test(t), server.URL, nil, time.Second, nil, 1, WaitForEffectsCert)
+	require.NoError(t, err)
+
+	results, err := ptbClient.ReadFunction(context.Background(), "0x1", "0xabc", "pool", "get", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []any{
+		uint64(7),
+		owner,
+		[]any{"a", "b"},
+		map[string]any{"owner": owner, "fee_bps": uint16(30), "cap": big.NewInt(1000)},
+		nil,
+		[]any{
+			movebcs.Enum{Variant: "Active", Fields: map[string]any{}},
+			movebcs.Enum{Variant: "Paused", Fields: map[string]any{"until": uint64(9)}},
+		},
+	}, results)
+}
@@ -1,42 +1,87 @@
 package client
 
 import (
+	"crypto/ed25519"
 	"encoding/base64"
+	"fmt"
 )
 
 type SigFlag byte
 
 const (
-	SigFlagEd25519 SigFlag = 0x00
+	SigFlagEd25519   SigFlag = 0x00
+	SigFlagSecp256k1 SigFlag = 0x01
+	SigFlagSecp256r1 SigFlag = 0x02
 )
 
+// secpPublicKeyLength is the length of a compressed Secp256k1 or Secp256r1 public key.
+const secpPublicKeyLength = 33
+
+// ParsePublicKey resolves the signature scheme of a keystore public key.
+//
+// Keystore accounts are identified by their public key. Ed25519 accounts use the raw 32 bytes public key,
+// while Secp256k1 and Secp256r1 accounts use the Sui "flagged" form: the scheme flag followed by the
+// 33 bytes compressed public key. This allows selecting the signature scheme per account.
+//
+// Parameters:
+//
+//	pubKey - The keystore public key, raw (Ed25519) or flagged (Secp256k1, Secp256r1)
+//
+// Returns:
+//
+//	The signature scheme flag, the raw public key bytes and an error if the key is not recognized
+func ParsePublicKey(pubKey []byte) (SigFlag, []byte, error) {
+	switch len(pubKey) {
+	case ed25519.PublicKeySize:
+		return SigFlagEd25519, pubKey, nil
+	case 1 + secpPublicKeyLength:
+		flag := SigFlag(pubKey[0])
+		if flag != SigFlagSecp256k1 && flag != SigFlagSecp256r1 {
+			return 0, nil, fmt.Errorf("unsupported signature scheme flag %d", flag)
+		}
+
+		return flag, pubKey[1:], nil
+	default:
+		return 0, nil, fmt.Errorf("invalid public key length, expected %d or %d got %d",
+			ed25519.PublicKeySize, 1+secpPublicKeyLength, len(pubKey))
+	}
+}
+
 // SerializeSuiSignature formats and serializes a signature for use with Sui transactions.
 //
 // This function follows the Sui transaction signature format specification:
-// 1. A one-byte flag indicating the signature scheme (0x00 for Ed25519)
+// 1. A one-byte flag indicating the signature scheme (0x00 for Ed25519, 0x01 for Secp256k1, 0x02 for Secp256r1)
 // 2. The raw signature bytes
 // 3. The public key bytes
 // These components are concatenated and then base64 encoded to produce the final signature string
 // that can be submitted to the Sui network.
 //
+// The signature scheme is resolved from the public key with ParsePublicKey.
+//
 // Based on the implementation from block-vision's sui-go-sdk:
 // https://github.com/block-vision/sui-go-sdk/blob/main/models/signature.go#L140
 //
 // Parameters:
 //
-//	signature - The raw signature bytes from the ed25519 Sign operation
-//	pubKey    - The public key corresponding to the private key that produced the signature
+//	signature - The raw signature bytes produced by the keystore
+//	pubKey    - The keystore public key corresponding to the private key that produced the signature
 //
 // Returns:
 //
-//	A base64-encoded string containing the serialized signature ready for submission to Sui
-func SerializeSuiSignature(signature, pubKey []byte) string {
+//	A base64-encoded string containing the serialized signature ready for submission to Sui, and an error
+//	if the signature scheme of the public key is not recognized
+func SerializeSuiSignature(signature, pubKey []byte) (string, error) {
+	flag, rawPubKey, err := ParsePublicKey(pubKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize signature: %w", err)
+	}
+
 	signatureLen := len(signature)
-	pubKeyLen := len(pubKey)
+	pubKeyLen := len(rawPubKey)
 	serializedSignature := make([]byte, 1+signatureLen+pubKeyLen)
-	serializedSignature[0] = byte(SigFlagEd25519)
+	serializedSignature[0] = byte(flag)
 	copy(serializedSignature[1:], signature)
-	copy(serializedSignature[1+signatureLen:], pubKey)
+	copy(serializedSignature[1+signatureLen:], rawPubKey)
 
-	return base64.StdEncoding.EncodeToString(serializedSignature)
+	return base64.StdEncoding.EncodeToString(serializedSignature), nil
 }
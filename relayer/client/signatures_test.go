@@ -0,0 +1,37 @@
+//go:build unit
+
+package client
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSerializeSuiSignature(t *testing.T) {
+	t.Parallel()
+
+	signature := make([]byte, 64)
+
+	t.Run("Flags the signature with the scheme of the key", func(t *testing.T) {
+		t.Parallel()
+
+		publicKey := append([]byte{byte(SigFlagSecp256r1)}, make([]byte, 33)...)
+		serialized, err := SerializeSuiSignature(signature, publicKey)
+		require.NoError(t, err)
+
+		raw, err := base64.StdEncoding.DecodeString(serialized)
+		require.NoError(t, err)
+		assert.Equal(t, byte(SigFlagSecp256r1), raw[0])
+		assert.Len(t, raw, 1+64+33)
+	})
+
+	t.Run("Fails on keys of an unknown scheme", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := SerializeSuiSignature(signature, make([]byte, 20))
+		require.ErrorContains(t, err, "invalid public key length")
+	})
+}
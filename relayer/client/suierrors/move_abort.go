@@ -0,0 +1,98 @@
+package suierrors
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// ModuleId represents Move’s ModuleId { address, name }
+type ModuleId struct {
+	Address string
+	Name    string
+}
+
+// MoveLocation corresponds to MoveLocation { module, function, instruction, function_name }
+type MoveLocation struct {
+	Module       ModuleId
+	Function     uint64
+	Instruction  uint64
+	FunctionName *string // nil if None
+}
+
+// MoveAbort wraps a MoveLocation plus abort code and PTB command index
+type MoveAbort struct {
+	Location     MoveLocation
+	AbortCode    uint64
+	CommandIndex uint64
+}
+
+// regex to capture:
+//
+//	1: address (hex)
+//	2: module name
+//	3: function (decimal)
+//	4: instruction (decimal)
+//	5: either Some("X") or None
+//	6: inner X from Some("X") (empty if None)
+//	7: abort code
+//	8: command index
+var abortRe = regexp.MustCompile(
+	`^MoveAbort\(` +
+		`MoveLocation \{ module: ModuleId \{ address: ([0-9a-f]+), name: Identifier\("([^"]+)"\) \}, ` +
+		`function: (\d+), instruction: (\d+), function_name: (Some\("([^"]+)"\)|None) \}, ` +
+		`(\d+)\) in command (\d+)$`,
+)
+
+// ParseMoveAbort parses a transaction execution error of the form
+// `MoveAbort(MoveLocation { ... }, <code>) in command <index>` into a MoveAbort struct.
+func ParseMoveAbort(s string) (*MoveAbort, error) {
+	m := abortRe.FindStringSubmatch(s)
+	if m == nil {
+		return nil, fmt.Errorf("input does not match MoveAbort pattern")
+	}
+	// m[1]=address, m[2]=modName, m[3]=func, m[4]=instr,
+	// m[5]=full (Some("…")|None), m[6]=inner name or "",
+	// m[7]=abortCode, m[8]=cmdIndex
+
+	// parse integers
+	fn, err := strconv.ParseUint(m[3], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("bad function number: %w", err)
+	}
+	instr, err := strconv.ParseUint(m[4], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("bad instruction number: %w", err)
+	}
+	abortCode, err := strconv.ParseUint(m[7], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("bad abort code: %w", err)
+	}
+	cmdIdx, err := strconv.ParseUint(m[8], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("bad command index: %w", err)
+	}
+
+	// optional function name
+	var fname *string
+	if m[5] != "None" {
+		fname = new(string)
+		*fname = m[6]
+	}
+
+	loc := MoveLocation{
+		Module: ModuleId{
+			Address: m[1],
+			Name:    m[2],
+		},
+		Function:     fn,
+		Instruction:  instr,
+		FunctionName: fname,
+	}
+
+	return &MoveAbort{
+		Location:     loc,
+		AbortCode:    abortCode,
+		CommandIndex: cmdIdx,
+	}, nil
+}
@@ -71,15 +71,21 @@ func DecodeSuiJsonValue(data any, target any) error {
 	bigPtrT := reflect.TypeOf((*big.Int)(nil)) // *big.Int
 	bigValT := bigPtrT.Elem()                  // big.Int
 	if targetType == bigValT || targetType == bigPtrT {
-		// expect a JSON string
-		str, ok := data.(string)
-		if !ok {
+		// expect a JSON string, or a u128 or u256 decoded from BCS
+		var bi *big.Int
+		switch v := data.(type) {
+		case string:
+			var success bool
+			if bi, success = new(big.Int).SetString(v, 10); !success {
+				return fmt.Errorf("big.Int decode: invalid number %q", v)
+			}
+		case *big.Int:
+			bi = new(big.Int).Set(v)
+		case big.Int:
+			bi = new(big.Int).Set(&v)
+		default:
 			return fmt.Errorf("big.Int decode: expected string, got %T", data)
 		}
-		bi, success := new(big.Int).SetString(str, 10)
-		if !success {
-			return fmt.Errorf("big.Int decode: invalid number %q", str)
-		}
 		if targetType == bigValT {
 			// value form: big.Int
 			targetValue.Set(reflect.ValueOf(*bi))
@@ -106,242 +112,6 @@ func DecodeSuiJsonValue(data any, target any) error {
 	}
 }
 
-// DecodeSuiStructToJSON decodes a Sui struct into a JSON object
-// using the normalized struct and the result
-func DecodeSuiStructToJSON(normalizedStructs map[string]any, identifier string, bcsDecoder *aptosBCS.Deserializer) (map[string]any, error) {
-	jsonResult := make(map[string]any)
-
-	normalizedStruct, ok := normalizedStructs[identifier].(map[string]any)
-	if !ok {
-		return nil, fmt.Errorf("struct with identifier '%s' not found in normalized structs", identifier)
-	}
-
-	fields, ok := normalizedStruct["fields"].([]any)
-	if !ok {
-		return nil, fmt.Errorf("fields not found for struct '%s'", identifier)
-	}
-
-	for _, field := range fields {
-		fieldMap, ok := field.(map[string]any)
-		if !ok {
-			continue
-		}
-
-		fieldName, ok := fieldMap["name"].(string)
-		if !ok {
-			continue
-		}
-
-		fieldType := fieldMap["type"]
-
-		// Handle different field types based on the new format
-		switch v := fieldType.(type) {
-		case string:
-			// Primitive types like "U64", "Bool", "Address"
-			value, err := decodePrimitiveType(bcsDecoder, v)
-			if err != nil {
-				return nil, fmt.Errorf("failed to decode primitive field %s: %w", fieldName, err)
-			}
-			jsonResult[fieldName] = value
-
-		case map[string]any:
-			if vectorType, exists := v["Vector"]; exists {
-				// Vector type
-				decodedVector, err := decodeVectorField(bcsDecoder, vectorType, normalizedStructs)
-				if err != nil {
-					return nil, fmt.Errorf("failed to decode vector field %s: %w", fieldName, err)
-				}
-				jsonResult[fieldName] = decodedVector
-			} else if structType, exists := v["Struct"]; exists {
-				// Struct type
-				structMap, ok := structType.(map[string]any)
-				if !ok {
-					return nil, fmt.Errorf("invalid struct type for field %s", fieldName)
-				}
-				structName, ok := structMap["name"].(string)
-				if !ok {
-					return nil, fmt.Errorf("struct name not found for field %s", fieldName)
-				}
-
-				// Special case for String struct - it's a primitive type in Sui
-				if structName == "String" {
-					jsonResult[fieldName] = bcsDecoder.ReadString()
-				} else {
-					inner, err := DecodeSuiStructToJSON(normalizedStructs, structName, bcsDecoder)
-					if err != nil {
-						return nil, fmt.Errorf("failed to decode struct field %s: %w", fieldName, err)
-					}
-					jsonResult[fieldName] = inner
-				}
-			}
-		}
-	}
-
-	return jsonResult, nil
-}
-
-func decodeVectorField(bcsDecoder *aptosBCS.Deserializer, vectorType any, normalizedStructs map[string]any) (any, error) {
-	// Read the length of the vector first
-	vectorLength := bcsDecoder.Uleb128()
-
-	switch v := vectorType.(type) {
-	case string:
-		// Primitive vector type like "U8", "Address"
-		switch v {
-		//nolint:goconst
-		case "U8":
-			// This is vector<u8> - read as bytes
-			bytes := make([]byte, vectorLength)
-			for i := range vectorLength {
-				bytes[i] = bcsDecoder.U8()
-			}
-
-			return bytes, nil
-		//nolint:goconst
-		case "Address":
-			// This is vector<address>
-			addresses := make([]any, vectorLength)
-			for i := range vectorLength {
-				addressBytesLen := 32
-				addresses[i] = bcsDecoder.ReadFixedBytes(addressBytesLen)
-			}
-
-			return addresses, nil
-
-		default:
-			// Other primitive vectors
-			primitiveVector := make([]any, vectorLength)
-			for i := range vectorLength {
-				value, err := decodePrimitiveType(bcsDecoder, v)
-				if err != nil {
-					return nil, fmt.Errorf("failed to decode primitive vector element at index %d: %w", i, err)
-				}
-				primitiveVector[i] = value
-			}
-
-			return primitiveVector, nil
-		}
-
-	case map[string]any:
-		if innerVectorType, exists := v["Vector"]; exists {
-			// This is vector<vector<T>> - recursively decode each inner vector
-			outerVector := make([]any, vectorLength)
-			for i := range vectorLength {
-				innerResult, err := decodeVectorField(bcsDecoder, innerVectorType, normalizedStructs)
-				if err != nil {
-					return nil, fmt.Errorf("failed to decode inner vector at index %d: %w", i, err)
-				}
-				outerVector[i] = innerResult
-			}
-
-			return outerVector, nil
-		} else if structType, exists := v["Struct"]; exists {
-			// This is vector<SomeStruct> - decode each struct
-			structVector := make([]any, vectorLength)
-			structMap, ok := structType.(map[string]any)
-			if !ok {
-				return nil, fmt.Errorf("invalid struct type in vector")
-			}
-			structName, ok := structMap["name"].(string)
-			if !ok {
-				return nil, fmt.Errorf("struct name not found in vector element")
-			}
-
-			// this is a special case where strings are defined as a struct in Sui normalized module structs definition
-			if structName == "String" {
-				vecOfStrings := make([]any, vectorLength)
-				for i := range vectorLength {
-					vecOfStrings[i] = bcsDecoder.ReadString()
-				}
-
-				return vecOfStrings, nil
-			}
-
-			for i := range vectorLength {
-				structResult, err := DecodeSuiStructToJSON(normalizedStructs, structName, bcsDecoder)
-				if err != nil {
-					return nil, fmt.Errorf("failed to decode struct at index %d: %w", i, err)
-				}
-				structVector[i] = structResult
-			}
-
-			return structVector, nil
-		}
-	}
-
-	return nil, fmt.Errorf("unsupported vector type: %v", vectorType)
-}
-
-func DecodeSuiPrimative(bcsDecoder *aptosBCS.Deserializer, primativeType string) (any, error) {
-	switch primativeType {
-	//nolint:goconst
-	case "U8", "u8":
-		return bcsDecoder.U8(), nil
-	//nolint:goconst
-	case "U16", "u16":
-		return bcsDecoder.U16(), nil
-	//nolint:goconst
-	case "U32", "u32":
-		return bcsDecoder.U32(), nil
-	//nolint:goconst
-	case "U64", "u64":
-		return bcsDecoder.U64(), nil
-	//nolint:goconst
-	case "U128", "u128":
-		return bcsDecoder.U128(), nil
-	//nolint:goconst
-	case "U256", "u256":
-		return bcsDecoder.U256(), nil
-	//nolint:goconst
-	case "Bool", "bool":
-		return bcsDecoder.Bool(), nil
-	//nolint:goconst
-	case "Address", "address":
-		addressBytesLen := 32
-		return bcsDecoder.ReadFixedBytes(addressBytesLen), nil
-	}
-
-	// Handle vector types
-	if strings.HasPrefix(primativeType, "vector<") && strings.HasSuffix(primativeType, ">") {
-		innerType := strings.TrimSuffix(strings.TrimPrefix(primativeType, "vector<"), ">")
-		switch innerType {
-		case "Address", "address":
-			return decodeVectorField(bcsDecoder, "Address", nil)
-		case "U8", "u8":
-			return decodeVectorField(bcsDecoder, "U8", nil)
-		case "vector<U8>", "vector<u8>":
-			return decodeVectorField(bcsDecoder, map[string]any{"Vector": "U8"}, nil)
-		}
-	}
-
-	return nil, fmt.Errorf("unsupported BCS primitive type: %s", primativeType)
-}
-
-// Helper function to decode primitive types
-func decodePrimitiveType(bcsDecoder *aptosBCS.Deserializer, primitiveType string) (any, error) {
-	switch primitiveType {
-	case "U8":
-		return bcsDecoder.U8(), nil
-	case "U16":
-		return bcsDecoder.U16(), nil
-	case "U32":
-		return bcsDecoder.U32(), nil
-	case "U64":
-		return bcsDecoder.U64(), nil
-	case "U128":
-		return bcsDecoder.U128(), nil
-	case "U256":
-		return bcsDecoder.U256(), nil
-	case "Bool":
-		return bcsDecoder.Bool(), nil
-	case "Address":
-		addressBytesLen := 32
-		return bcsDecoder.ReadFixedBytes(addressBytesLen), nil
-	default:
-		return nil, fmt.Errorf("unsupported primitive type: %s", primitiveType)
-	}
-}
-
 // decodeString handles string type decoding
 func decodeString(data any, targetValue reflect.Value) error {
 	str, ok := data.(string)
@@ -12,9 +12,9 @@ import (
 
 	"github.com/block-vision/sui-go-sdk/models"
 
-	aptosBCS "github.com/aptos-labs/aptos-go-sdk/bcs"
 	"github.com/block-vision/sui-go-sdk/utils"
 
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
 	"github.com/smartcontractkit/chainlink-sui/shared"
 
 	"github.com/stretchr/testify/require"
@@ -152,6 +152,12 @@ func TestDecodeSuiJsonValue_NumericTypes(t *testing.T) {
 			target:   new(big.Int),
 			expected: func() any { b, _ := new(big.Int).SetString("98765432109876543210", 10); return *b }(),
 		},
+		{
+			name:     "decoded u128 to big.Int (value)",
+			data:     new(big.Int).Lsh(big.NewInt(1), 100),
+			target:   new(big.Int),
+			expected: *new(big.Int).Lsh(big.NewInt(1), 100),
+		},
 	}
 
 	for _, tt := range tests {
@@ -362,6 +368,32 @@ func TestDecodeSuiJsonValue_StructTypes(t *testing.T) {
 	require.Equal(t, uint32(42), nestedTarget.Inner.Count)
 }
 
+func TestDecodeSuiJsonValue_EnumTypes(t *testing.T) {
+	t.Parallel()
+
+	// enums returned by the chain reader, as converted by movebcs.ToJSON
+	type Paused struct {
+		Until  uint64
+		Reason string
+	}
+	type Status struct {
+		Variant string
+		Fields  Paused
+	}
+
+	value := movebcs.Enum{Variant: "Paused", Fields: map[string]any{"until": uint64(42), "reason": "maintenance"}}
+	var target Status
+	err := DecodeSuiJsonValue(movebcs.ToJSON(value), &target)
+	require.NoError(t, err)
+	require.Equal(t, Status{Variant: "Paused", Fields: Paused{Until: 42, Reason: "maintenance"}}, target)
+
+	// variants without fields
+	var active Status
+	err = DecodeSuiJsonValue(movebcs.ToJSON(movebcs.Enum{Variant: "Active", Fields: map[string]any{}}), &active)
+	require.NoError(t, err)
+	require.Equal(t, Status{Variant: "Active"}, active)
+}
+
 func TestHexStringHook(t *testing.T) {
 	t.Parallel()
 
@@ -1790,27 +1822,19 @@ func TestDecodeSuiJsonValue_SuiSpecificCases(t *testing.T) {
 		}
 		`
 
-		var schema map[string]any
-		err := json.Unmarshal([]byte(jsonModule), &schema)
-		require.NoError(t, err)
-
-		structs, ok := schema["structs"].(map[string]any)
-		require.True(t, ok)
+		registry := movebcs.NewRegistry()
+		require.NoError(t, registry.AddNormalizedModuleJSON([]byte(jsonModule)))
 
 		bcsBytes := []byte{
 			32, 0, 10, 163, 58, 124, 44, 0, 205, 25, 175, 172, 143, 227, 22, 8, 175, 42, 52, 252, 74, 32, 10, 107, 236, 80, 1, 177, 162, 131, 82, 115, 71, 1, 4, 1, 4, 32, 153, 199, 47, 13, 162, 190, 48, 139, 149, 84, 92, 112, 93, 249, 186, 231, 136, 123, 47, 47, 228, 6, 126, 60, 15, 225, 137, 169, 88, 36, 111, 223, 32, 185, 6, 58, 13, 126, 86, 237, 190, 192, 150, 150, 19, 74, 225, 21, 7, 83, 19, 164, 225, 70, 37, 68, 140, 138, 155, 195, 18, 14, 201, 54, 184, 32, 237, 81, 16, 104, 37, 16, 243, 198, 124, 89, 11, 86, 195, 24, 18, 132, 120, 108, 13, 25, 116, 159, 64, 190, 1, 184, 175, 103, 72, 18, 122, 255, 32, 213, 192, 56, 2, 175, 151, 186, 105, 250, 60, 206, 8, 54, 91, 208, 80, 45, 64, 142, 15, 45, 182, 101, 87, 125, 144, 114, 146, 189, 165, 130, 187, 4, 51, 226, 204, 208, 210, 225, 178, 251, 215, 161, 23, 19, 167, 250, 208, 102, 88, 245, 8, 211, 230, 10, 7, 91, 68, 202, 111, 169, 46, 217, 9, 137, 88, 2, 84, 235, 187, 66, 243, 57, 245, 194, 18, 92, 179, 94, 242, 121, 119, 226, 188, 125, 133, 223, 136, 196, 186, 122, 104, 225, 215, 140, 230, 170, 118, 124, 155, 157, 80, 221, 219, 194, 82, 0, 141, 107, 133, 15, 228, 127, 248, 169, 211, 92, 82, 137, 101, 86, 107, 86, 17, 193, 42, 182, 100, 61, 63, 88, 117, 124, 145, 87, 42, 74, 17, 60, 67, 61, 23, 200, 219, 8, 212, 84, 233, 97, 22, 211, 228, 125, 79, 118, 102, 0, 252, 175, 97, 116,
 		}
 
-		deserializer := aptosBCS.NewDeserializer(bcsBytes)
-
-		jsonMap, err := DecodeSuiStructToJSON(
-			structs,
-			"OCRConfig",
-			deserializer,
-		)
+		structType, err := movebcs.ParseType("0x66b827fe66f3bc50c9deef27624c7b705a1d0af4a8b0883280d729c728559b71::counter::OCRConfig")
+		require.NoError(t, err)
 
+		decoded, err := registry.DecodeAddressBytes(bcsBytes, structType)
 		require.NoError(t, err)
-		utils.PrettyPrint(jsonMap)
+		utils.PrettyPrint(decoded)
 	})
 
 	t.Run("JSON Struct Decoder (String case)", func(t *testing.T) {
@@ -2598,25 +2622,17 @@ func TestDecodeSuiJsonValue_SuiSpecificCases(t *testing.T) {
 		}
 		`
 
-		var schema map[string]any
-		err := json.Unmarshal([]byte(jsonModule), &schema)
-		require.NoError(t, err)
-
-		structs, ok := schema["structs"].(map[string]any)
-		require.True(t, ok)
+		registry := movebcs.NewRegistry()
+		require.NoError(t, registry.AddNormalizedModuleJSON([]byte(jsonModule)))
 
 		bcsBytes := []byte{1, 203, 131, 104, 67, 247, 212, 213, 63, 234, 0, 172, 253, 104, 80, 167, 198, 167, 122, 227, 165, 211, 158, 27, 218, 248, 148, 160, 212, 92, 144, 255, 107, 1, 5, 248, 236, 185, 248, 254, 11, 147, 254, 142, 242, 226, 6, 109, 14, 31, 63, 215, 241, 104, 164, 118, 6, 179, 25, 147, 35, 171, 213, 189, 127, 240, 1, 23, 108, 111, 99, 107, 95, 114, 101, 108, 101, 97, 115, 101, 95, 116, 111, 107, 101, 110, 95, 112, 111, 111, 108, 1, 88, 101, 57, 48, 98, 55, 52, 57, 101, 97, 53, 53, 50, 57, 100, 101, 49, 52, 51, 52, 49, 102, 55, 51, 54, 57, 98, 50, 53, 52, 98, 50, 56, 50, 54, 56, 101, 54, 99, 48, 50, 57, 97, 55, 50, 51, 57, 53, 51, 56, 99, 48, 54, 49, 98, 101, 97, 53, 56, 97, 54, 52, 50, 100, 50, 58, 58, 108, 105, 110, 107, 95, 116, 111, 107, 101, 110, 58, 58, 76, 73, 78, 75, 95, 84, 79, 75, 69, 78}
 
-		deserializer := aptosBCS.NewDeserializer(bcsBytes)
-
-		jsonMap, err := DecodeSuiStructToJSON(
-			structs,
-			"PoolInfos",
-			deserializer,
-		)
+		structType, err := movebcs.ParseType("0x93e6aa7d7efd881081c4523eb1225d7345da0c2e3188067a43e09ac58ade00f2::token_admin_registry::PoolInfos")
+		require.NoError(t, err)
 
+		decoded, err := registry.DecodeAddressBytes(bcsBytes, structType)
 		require.NoError(t, err)
-		utils.PrettyPrint(jsonMap)
+		utils.PrettyPrint(decoded)
 	})
 }
 
@@ -0,0 +1,318 @@
+package movebcs
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"unicode/utf8"
+)
+
+// maxDepth bounds the nesting of decoded and encoded values, matching the Move VM value depth limit.
+const maxDepth = 128
+
+// Enum is a Move enum value.
+type Enum struct {
+	Variant string
+	// Fields are the fields of the variant keyed by name. Positional fields are named pos0, pos1...
+	Fields map[string]any
+}
+
+// Decode decodes the BCS bytes of a value of type t into a generic Go value. It fails if data holds trailing bytes.
+func (r *Registry) Decode(data []byte, t Type) (any, error) {
+	return r.decodeAll(&reader{data: data}, t)
+}
+
+// DecodeAddressBytes is Decode, except that addresses, IDs and UIDs are decoded into their 32 raw bytes, the
+// representation of addresses in the values read by the chain reader.
+func (r *Registry) DecodeAddressBytes(data []byte, t Type) (any, error) {
+	return r.decodeAll(&reader{data: data, addressBytes: true}, t)
+}
+
+func (r *Registry) decodeAll(d *reader, t Type) (any, error) {
+	value, err := r.decode(d, t, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", t, err)
+	}
+	if d.remaining() != 0 {
+		return nil, fmt.Errorf("failed to decode %s: %d trailing bytes", t, d.remaining())
+	}
+
+	return value, nil
+}
+
+func (r *Registry) decode(d *reader, t Type, depth int) (any, error) {
+	if depth > maxDepth {
+		return nil, errors.New("value is nested too deeply")
+	}
+
+	switch t.Kind {
+	case KindBool:
+		b, err := d.read(1)
+		if err != nil {
+			return nil, err
+		}
+		switch b[0] {
+		case 0:
+			return false, nil
+		case 1:
+			return true, nil
+		default:
+			return nil, fmt.Errorf("invalid bool %d", b[0])
+		}
+	case KindU8:
+		b, err := d.read(1)
+		if err != nil {
+			return nil, err
+		}
+
+		return b[0], nil
+	case KindU16:
+		b, err := d.read(2)
+		if err != nil {
+			return nil, err
+		}
+
+		return binary.LittleEndian.Uint16(b), nil
+	case KindU32:
+		b, err := d.read(4)
+		if err != nil {
+			return nil, err
+		}
+
+		return binary.LittleEndian.Uint32(b), nil
+	case KindU64:
+		b, err := d.read(8)
+		if err != nil {
+			return nil, err
+		}
+
+		return binary.LittleEndian.Uint64(b), nil
+	case KindU128:
+		return d.readBigInt(16)
+	case KindU256:
+		return d.readBigInt(32)
+	case KindAddress, KindSigner:
+		return d.readAddress()
+	case KindVector:
+		return r.decodeVector(d, *t.Elem, depth)
+	case KindStruct:
+		return r.decodeStruct(d, t.Struct, depth)
+	case KindTypeParameter:
+		return nil, fmt.Errorf("type parameter T%d is not instantiated", t.Index)
+	default:
+		return nil, fmt.Errorf("unknown type kind %d", t.Kind)
+	}
+}
+
+func (r *Registry) decodeVector(d *reader, elem Type, depth int) (any, error) {
+	length, err := d.readLength()
+	if err != nil {
+		return nil, err
+	}
+
+	if elem.Kind == KindU8 {
+		b, err := d.read(length)
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]byte{}, b...), nil
+	}
+
+	values := make([]any, 0, length)
+	for range length {
+		value, err := r.decode(d, elem, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func (r *Registry) decodeStruct(d *reader, tag *StructTag, depth int) (any, error) {
+	switch {
+	case tag.Is("0x1", "string", "String"):
+		b, err := d.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		if !utf8.Valid(b) {
+			return nil, errors.New("invalid UTF-8 string")
+		}
+
+		return string(b), nil
+	case tag.Is("0x1", "ascii", "String"):
+		b, err := d.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range b {
+			if c > 0x7f {
+				return nil, errors.New("invalid ASCII string")
+			}
+		}
+
+		return string(b), nil
+	case tag.Is("0x2", "object", "ID"), tag.Is("0x2", "object", "UID"):
+		return d.readAddress()
+	case tag.Is("0x1", "option", "Option"):
+		if len(tag.TypeArgs) != 1 {
+			return nil, fmt.Errorf("%s expects 1 type argument", tag.Key())
+		}
+		length, err := d.readLength()
+		if err != nil {
+			return nil, err
+		}
+		switch length {
+		case 0:
+			return nil, nil
+		case 1:
+			return r.decode(d, tag.TypeArgs[0], depth+1)
+		default:
+			return nil, fmt.Errorf("invalid option length %d", length)
+		}
+	}
+
+	layout, err := r.lookup(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	if layout.IsEnum() {
+		index, err := d.readULEB128()
+		if err != nil {
+			return nil, err
+		}
+		if index >= uint64(len(layout.Variants)) {
+			return nil, fmt.Errorf("invalid variant %d of %s", index, tag.Key())
+		}
+		variant := layout.Variants[index]
+		fields, err := r.decodeFields(d, tag, layout, variant.Fields, depth)
+		if err != nil {
+			return nil, err
+		}
+
+		return Enum{Variant: variant.Name, Fields: fields}, nil
+	}
+
+	fields, err := r.decodeFields(d, tag, layout, layout.Fields, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	// VecMap and VecSet are exposed as their contents
+	if tag.Is("0x2", "vec_map", "VecMap") || tag.Is("0x2", "vec_set", "VecSet") {
+		return fields["contents"], nil
+	}
+
+	return fields, nil
+}
+
+func (r *Registry) decodeFields(d *reader, tag *StructTag, layout *Layout, declared []Field, depth int) (map[string]any, error) {
+	fields, err := instantiate(tag, layout, declared)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]any, len(fields))
+	for _, field := range fields {
+		value, err := r.decode(d, field.Type, depth+1)
+		if err != nil {
+			return nil, fmt.Errorf("field %s of %s: %w", field.Name, tag.Key(), err)
+		}
+		values[field.Name] = value
+	}
+
+	return values, nil
+}
+
+// reader reads BCS primitives, failing instead of panicking on malformed input.
+type reader struct {
+	data []byte
+	pos  int
+	// addressBytes decodes addresses into []byte instead of hex strings.
+	addressBytes bool
+}
+
+func (d *reader) remaining() int {
+	return len(d.data) - d.pos
+}
+
+func (d *reader) read(n int) ([]byte, error) {
+	if n < 0 || n > d.remaining() {
+		return nil, fmt.Errorf("unexpected end of input: need %d bytes, have %d", n, d.remaining())
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+
+	return b, nil
+}
+
+func (d *reader) readULEB128() (uint64, error) {
+	var value uint64
+	for shift := 0; shift < 64; shift += 7 {
+		b, err := d.read(1)
+		if err != nil {
+			return 0, err
+		}
+		value |= uint64(b[0]&0x7f) << shift
+		if b[0]&0x80 == 0 {
+			return value, nil
+		}
+	}
+
+	return 0, errors.New("invalid ULEB128 value")
+}
+
+// readLength reads the length of a vector. A length is never larger than the remaining input, as every encoded
+// element takes at least one byte.
+func (d *reader) readLength() (int, error) {
+	length, err := d.readULEB128()
+	if err != nil {
+		return 0, err
+	}
+	if length > uint64(d.remaining()) {
+		return 0, fmt.Errorf("length %d exceeds the remaining %d bytes", length, d.remaining())
+	}
+
+	return int(length), nil
+}
+
+func (d *reader) readBytes() ([]byte, error) {
+	length, err := d.readLength()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.read(length)
+}
+
+func (d *reader) readBigInt(size int) (*big.Int, error) {
+	b, err := d.read(size)
+	if err != nil {
+		return nil, err
+	}
+
+	bigEndian := make([]byte, size)
+	for i := range b {
+		bigEndian[size-1-i] = b[i]
+	}
+
+	return new(big.Int).SetBytes(bigEndian), nil
+}
+
+func (d *reader) readAddress() (any, error) {
+	b, err := d.read(addressLength)
+	if err != nil {
+		return nil, err
+	}
+	if d.addressBytes {
+		return append([]byte{}, b...), nil
+	}
+
+	return "0x" + hex.EncodeToString(b), nil
+}
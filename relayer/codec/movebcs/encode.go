@@ -0,0 +1,461 @@
+package movebcs
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const addressLength = 32
+
+var (
+	bigIntType = reflect.TypeOf(big.Int{})
+	enumType   = reflect.TypeOf(Enum{})
+)
+
+// Encode encodes a Go value as the BCS bytes of a value of type t. Besides the generic values produced by Decode, it
+// accepts:
+//   - any Go integer, integral float, *big.Int or decimal or 0x-prefixed hex string for integers
+//   - hex strings, [32]byte and 32-byte slices for addresses, IDs and UIDs
+//   - slices and arrays for vectors, and strings for vector<u8>: 0x-prefixed strings are hex-decoded, others are
+//     taken as raw bytes
+//   - nil or a nil pointer for Option::none, and any other value for Option::some
+//   - Go maps for VecMap, encoded in ascending order of their BCS-encoded keys
+//   - Go structs for Move structs, matching fields by their `sui:"name"` tag or else by name, ignoring case and
+//     underscores
+//   - Enum, a variant name, the {"variant", "fields"} map of ToJSON or a Go struct with one non-nil pointer field per
+//     variant for Move enums
+func (r *Registry) Encode(t Type, value any) ([]byte, error) {
+	var w bytes.Buffer
+	if err := r.encode(&w, t, reflect.ValueOf(value), 0); err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", t, err)
+	}
+
+	return w.Bytes(), nil
+}
+
+// indirect dereferences pointers and interfaces, returning an invalid value for nil.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+
+	return v
+}
+
+func (r *Registry) encode(w *bytes.Buffer, t Type, v reflect.Value, depth int) error {
+	if depth > maxDepth {
+		return errors.New("value is nested too deeply")
+	}
+
+	// Option is the only type accepting nil
+	if t.Kind == KindStruct && t.Struct.Is("0x1", "option", "Option") {
+		return r.encodeOption(w, t.Struct, v, depth)
+	}
+
+	v = indirect(v)
+	if !v.IsValid() {
+		return fmt.Errorf("missing value for %s", t)
+	}
+
+	switch t.Kind {
+	case KindBool:
+		if v.Kind() != reflect.Bool {
+			return fmt.Errorf("cannot encode %s as bool", v.Type())
+		}
+		if v.Bool() {
+			w.WriteByte(1)
+		} else {
+			w.WriteByte(0)
+		}
+
+		return nil
+	case KindU8:
+		return encodeUint(w, v, 1)
+	case KindU16:
+		return encodeUint(w, v, 2)
+	case KindU32:
+		return encodeUint(w, v, 4)
+	case KindU64:
+		return encodeUint(w, v, 8)
+	case KindU128:
+		return encodeUint(w, v, 16)
+	case KindU256:
+		return encodeUint(w, v, 32)
+	case KindAddress, KindSigner:
+		return encodeAddress(w, v)
+	case KindVector:
+		return r.encodeVector(w, *t.Elem, v, depth)
+	case KindStruct:
+		return r.encodeStruct(w, t.Struct, v, depth)
+	case KindTypeParameter:
+		return fmt.Errorf("type parameter T%d is not instantiated", t.Index)
+	default:
+		return fmt.Errorf("unknown type kind %d", t.Kind)
+	}
+}
+
+func (r *Registry) encodeVector(w *bytes.Buffer, elem Type, v reflect.Value, depth int) error {
+	if elem.Kind == KindU8 {
+		if b, ok := byteValue(v); ok {
+			writeULEB128(w, uint64(len(b)))
+			w.Write(b)
+
+			return nil
+		}
+	}
+
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return fmt.Errorf("cannot encode %s as vector<%s>", v.Type(), elem)
+	}
+
+	writeULEB128(w, uint64(v.Len()))
+	for i := range v.Len() {
+		if err := r.encode(w, elem, v.Index(i), depth+1); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Registry) encodeOption(w *bytes.Buffer, tag *StructTag, v reflect.Value, depth int) error {
+	if len(tag.TypeArgs) != 1 {
+		return fmt.Errorf("%s expects 1 type argument", tag.Key())
+	}
+
+	v = indirect(v)
+	if !v.IsValid() {
+		w.WriteByte(0)
+		return nil
+	}
+	w.WriteByte(1)
+
+	return r.encode(w, tag.TypeArgs[0], v, depth+1)
+}
+
+func (r *Registry) encodeStruct(w *bytes.Buffer, tag *StructTag, v reflect.Value, depth int) error {
+	switch {
+	case tag.Is("0x1", "string", "String"), tag.Is("0x1", "ascii", "String"):
+		var b []byte
+		switch {
+		case v.Kind() == reflect.String:
+			b = []byte(v.String())
+		case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+			b = v.Bytes()
+		default:
+			return fmt.Errorf("cannot encode %s as %s", v.Type(), tag)
+		}
+		if tag.Module == "ascii" {
+			for _, c := range b {
+				if c > 0x7f {
+					return errors.New("invalid ASCII string")
+				}
+			}
+		}
+		writeULEB128(w, uint64(len(b)))
+		w.Write(b)
+
+		return nil
+	case tag.Is("0x2", "object", "ID"), tag.Is("0x2", "object", "UID"):
+		return encodeAddress(w, v)
+	case tag.Is("0x2", "vec_map", "VecMap") && v.Kind() == reflect.Map:
+		return r.encodeVecMap(w, tag, v, depth)
+	case (tag.Is("0x2", "vec_map", "VecMap") || tag.Is("0x2", "vec_set", "VecSet")) &&
+		(v.Kind() == reflect.Slice || v.Kind() == reflect.Array):
+		layout, err := r.lookup(tag)
+		if err != nil {
+			return err
+		}
+		fields, err := instantiate(tag, layout, layout.Fields)
+		if err != nil {
+			return err
+		}
+
+		return r.encode(w, fields[0].Type, v, depth+1)
+	}
+
+	layout, err := r.lookup(tag)
+	if err != nil {
+		return err
+	}
+
+	if layout.IsEnum() {
+		return r.encodeEnum(w, tag, layout, v, depth)
+	}
+
+	return r.encodeFields(w, tag, layout, layout.Fields, v, depth)
+}
+
+func (r *Registry) encodeEnum(w *bytes.Buffer, tag *StructTag, layout *Layout, v reflect.Value, depth int) error {
+	var (
+		variantName string
+		fields      reflect.Value
+	)
+
+	switch {
+	case v.Type() == enumType:
+		variantName = v.Interface().(Enum).Variant
+		fields = reflect.ValueOf(v.Interface().(Enum).Fields)
+	case v.Kind() == reflect.String:
+		variantName = v.String()
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String:
+		// the canonical JSON form of ToJSON
+		variant := indirect(v.MapIndex(reflect.ValueOf("variant").Convert(v.Type().Key())))
+		if !variant.IsValid() || variant.Kind() != reflect.String {
+			return fmt.Errorf("cannot encode %s as %s: missing variant", v.Type(), tag)
+		}
+		variantName = variant.String()
+		fields = v.MapIndex(reflect.ValueOf("fields").Convert(v.Type().Key()))
+	case v.Kind() == reflect.Struct:
+		// a tagged struct holds one non-nil pointer field, named after the variant
+		for i := range v.NumField() {
+			field := v.Type().Field(i)
+			if !field.IsExported() || field.Type.Kind() != reflect.Pointer || v.Field(i).IsNil() {
+				continue
+			}
+			if variantName != "" {
+				return fmt.Errorf("cannot encode %s as %s: more than one variant is set", v.Type(), tag)
+			}
+			variantName = fieldName(field)
+			fields = v.Field(i)
+		}
+	default:
+		return fmt.Errorf("cannot encode %s as %s", v.Type(), tag)
+	}
+
+	for index, variant := range layout.Variants {
+		if normalizeName(variant.Name) != normalizeName(variantName) {
+			continue
+		}
+		writeULEB128(w, uint64(index))
+		if len(variant.Fields) == 0 {
+			return nil
+		}
+
+		return r.encodeFields(w, tag, layout, variant.Fields, fields, depth)
+	}
+
+	return fmt.Errorf("unknown variant %q of %s", variantName, tag)
+}
+
+func (r *Registry) encodeFields(w *bytes.Buffer, tag *StructTag, layout *Layout, declared []Field, v reflect.Value, depth int) error {
+	fields, err := instantiate(tag, layout, declared)
+	if err != nil {
+		return err
+	}
+
+	v = indirect(v)
+	if !v.IsValid() {
+		return fmt.Errorf("missing value for %s", tag)
+	}
+
+	for _, field := range fields {
+		fieldValue, err := lookupField(v, field.Name)
+		if err != nil {
+			return fmt.Errorf("field %s of %s: %w", field.Name, tag, err)
+		}
+		if err := r.encode(w, field.Type, fieldValue, depth+1); err != nil {
+			return fmt.Errorf("field %s of %s: %w", field.Name, tag, err)
+		}
+	}
+
+	return nil
+}
+
+// encodeVecMap encodes a Go map as a VecMap, sorting entries by their encoded keys so the encoding is deterministic.
+func (r *Registry) encodeVecMap(w *bytes.Buffer, tag *StructTag, v reflect.Value, depth int) error {
+	if len(tag.TypeArgs) != 2 {
+		return fmt.Errorf("%s expects 2 type arguments", tag.Key())
+	}
+
+	type entry struct {
+		key   []byte
+		value []byte
+	}
+	entries := make([]entry, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		var key, value bytes.Buffer
+		if err := r.encode(&key, tag.TypeArgs[0], iter.Key(), depth+2); err != nil {
+			return fmt.Errorf("key %v: %w", iter.Key(), err)
+		}
+		if err := r.encode(&value, tag.TypeArgs[1], iter.Value(), depth+2); err != nil {
+			return fmt.Errorf("value of key %v: %w", iter.Key(), err)
+		}
+		entries = append(entries, entry{key: key.Bytes(), value: value.Bytes()})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+
+	writeULEB128(w, uint64(len(entries)))
+	for _, e := range entries {
+		w.Write(e.key)
+		w.Write(e.value)
+	}
+
+	return nil
+}
+
+// lookupField returns the value of a Move field from a Go map or struct.
+func lookupField(v reflect.Value, name string) (reflect.Value, error) {
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return reflect.Value{}, fmt.Errorf("cannot read fields from %s", v.Type())
+		}
+		value := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+		if value.IsValid() {
+			return value, nil
+		}
+		// keys may have been renamed, e.g. from snake to camel case
+		iter := v.MapRange()
+		for iter.Next() {
+			if normalizeName(iter.Key().String()) == normalizeName(name) {
+				return iter.Value(), nil
+			}
+		}
+
+		return reflect.Value{}, errors.New("missing value")
+	case reflect.Struct:
+		index, ok := structFields(v.Type())[normalizeName(name)]
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("no matching field in %s", v.Type())
+		}
+
+		return v.Field(index), nil
+	default:
+		return reflect.Value{}, fmt.Errorf("cannot read fields from %s", v.Type())
+	}
+}
+
+func encodeUint(w *bytes.Buffer, v reflect.Value, size int) error {
+	n, err := toBigInt(v)
+	if err != nil {
+		return err
+	}
+	if n.Sign() < 0 || n.BitLen() > size*8 {
+		return fmt.Errorf("%s does not fit in u%d", n, size*8)
+	}
+
+	b := make([]byte, size)
+	n.FillBytes(b)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	w.Write(b)
+
+	return nil
+}
+
+func toBigInt(v reflect.Value) (*big.Int, error) {
+	v = indirect(v)
+	if !v.IsValid() {
+		return nil, errors.New("missing integer")
+	}
+	if v.Type() == bigIntType {
+		n := v.Interface().(big.Int)
+		return &n, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if f != math.Trunc(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("%v is not an integer", f)
+		}
+		n, _ := big.NewFloat(f).Int(nil)
+
+		return n, nil
+	case reflect.String:
+		s := strings.TrimSpace(v.String())
+		n, ok := new(big.Int).SetString(s, 0)
+		if !ok {
+			return nil, fmt.Errorf("%q is not an integer", s)
+		}
+
+		return n, nil
+	default:
+		return nil, fmt.Errorf("cannot encode %s as an integer", v.Type())
+	}
+}
+
+func encodeAddress(w *bytes.Buffer, v reflect.Value) error {
+	b, err := addressBytes(v)
+	if err != nil {
+		return err
+	}
+	w.Write(b)
+
+	return nil
+}
+
+func addressBytes(v reflect.Value) ([]byte, error) {
+	switch {
+	case v.Kind() == reflect.String:
+		s := v.String()
+		if len(strings.TrimPrefix(strings.ToLower(s), "0x")) > 2*addressLength {
+			return nil, fmt.Errorf("address %q is too long", s)
+		}
+		b, err := hex.DecodeString(strings.TrimPrefix(NormalizeAddress(s), "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", s, err)
+		}
+
+		return b, nil
+	case (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Type().Elem().Kind() == reflect.Uint8:
+		if v.Len() != addressLength {
+			return nil, fmt.Errorf("address must be %d bytes, got %d", addressLength, v.Len())
+		}
+		b := make([]byte, addressLength)
+		reflect.Copy(reflect.ValueOf(b), v)
+
+		return b, nil
+	default:
+		return nil, fmt.Errorf("cannot encode %s as an address", v.Type())
+	}
+}
+
+// byteValue returns the bytes of a []byte, [N]byte or string value.
+func byteValue(v reflect.Value) ([]byte, bool) {
+	switch {
+	case v.Kind() == reflect.String:
+		s := v.String()
+		if strings.HasPrefix(s, "0x") {
+			if b, err := hex.DecodeString(s[2:]); err == nil {
+				return b, true
+			}
+		}
+
+		return []byte(s), true
+	case (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Type().Elem().Kind() == reflect.Uint8:
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+
+		return b, true
+	default:
+		return nil, false
+	}
+}
+
+func writeULEB128(w *bytes.Buffer, value uint64) {
+	for value >= 0x80 {
+		w.WriteByte(byte(value) | 0x80)
+		value >>= 7
+	}
+	w.WriteByte(byte(value))
+}
@@ -0,0 +1,54 @@
+package movebcs
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// ToJSON converts a generic decoded value into its canonical JSON form, which follows the conventions of the
+// parsedJson rendering of Sui nodes but has exactly one representation per Move type:
+//
+//	u8, u16, u32            number
+//	u64, u128, u256         decimal string
+//	address, ID, UID        0x-prefixed, 64 hex chars string
+//	vector<u8>              array of numbers
+//	Option<T>               null or the value
+//	enums                   {"variant": name, "fields": {...}}
+//
+// Other values keep their JSON form. The result is safe to pass to json.Marshal.
+func ToJSON(value any) any {
+	switch typed := value.(type) {
+	case uint64:
+		return strconv.FormatUint(typed, 10)
+	case *big.Int:
+		return typed.String()
+	case []byte:
+		numbers := make([]any, len(typed))
+		for i, b := range typed {
+			numbers[i] = b
+		}
+
+		return numbers
+	case []any:
+		values := make([]any, len(typed))
+		for i, element := range typed {
+			values[i] = ToJSON(element)
+		}
+
+		return values
+	case map[string]any:
+		fields := make(map[string]any, len(typed))
+		for name, field := range typed {
+			fields[name] = ToJSON(field)
+		}
+
+		return fields
+	case Enum:
+		return map[string]any{
+			"variant": typed.Variant,
+			"fields":  ToJSON(typed.Fields),
+		}
+	default:
+		return value
+	}
+}
@@ -0,0 +1,300 @@
+package movebcs
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Field is a named field of a struct or enum variant.
+type Field struct {
+	Name string
+	Type Type
+}
+
+// Variant is a variant of a Move enum. Its BCS tag is its index in the declaration order.
+type Variant struct {
+	Name   string
+	Fields []Field
+}
+
+// Layout is the generic layout of a Move struct or enum, as declared in its module. Field types may reference the
+// type parameters of the declaration.
+type Layout struct {
+	TypeParameters int
+	// Fields are the fields of a struct, in declaration order
+	Fields []Field
+	// Variants are the variants of an enum, in declaration order
+	Variants []Variant
+}
+
+// IsEnum reports whether the layout is the layout of an enum.
+func (l *Layout) IsEnum() bool {
+	return l.Variants != nil
+}
+
+// Registry holds the layouts of the Move structs and enums that may be encoded or decoded. It is safe for concurrent
+// use. A new registry already knows the layouts of the std and framework types listed in the package documentation.
+type Registry struct {
+	mu      sync.RWMutex
+	layouts map[string]*Layout
+}
+
+var (
+	typeT0 = Type{Kind: KindTypeParameter, Index: 0}
+	typeT1 = Type{Kind: KindTypeParameter, Index: 1}
+)
+
+// builtinLayouts are the layouts of the std and framework types commonly found in contract interfaces.
+var builtinLayouts = map[string]*Layout{
+	Struct("0x1", "string", "String").Struct.Key(): {
+		Fields: []Field{{Name: "bytes", Type: Vector(Type{Kind: KindU8})}},
+	},
+	Struct("0x1", "ascii", "String").Struct.Key(): {
+		Fields: []Field{{Name: "bytes", Type: Vector(Type{Kind: KindU8})}},
+	},
+	Struct("0x1", "option", "Option").Struct.Key(): {
+		TypeParameters: 1,
+		Fields:         []Field{{Name: "vec", Type: Vector(typeT0)}},
+	},
+	Struct("0x1", "type_name", "TypeName").Struct.Key(): {
+		Fields: []Field{{Name: "name", Type: Struct("0x1", "ascii", "String")}},
+	},
+	Struct("0x2", "object", "ID").Struct.Key(): {
+		Fields: []Field{{Name: "bytes", Type: Type{Kind: KindAddress}}},
+	},
+	Struct("0x2", "object", "UID").Struct.Key(): {
+		Fields: []Field{{Name: "id", Type: Struct("0x2", "object", "ID")}},
+	},
+	Struct("0x2", "vec_map", "VecMap").Struct.Key(): {
+		TypeParameters: 2,
+		Fields:         []Field{{Name: "contents", Type: Vector(Struct("0x2", "vec_map", "Entry", typeT0, typeT1))}},
+	},
+	Struct("0x2", "vec_map", "Entry").Struct.Key(): {
+		TypeParameters: 2,
+		Fields:         []Field{{Name: "key", Type: typeT0}, {Name: "value", Type: typeT1}},
+	},
+	Struct("0x2", "vec_set", "VecSet").Struct.Key(): {
+		TypeParameters: 1,
+		Fields:         []Field{{Name: "contents", Type: Vector(typeT0)}},
+	},
+	Struct("0x2", "balance", "Balance").Struct.Key(): {
+		TypeParameters: 1,
+		Fields:         []Field{{Name: "value", Type: Type{Kind: KindU64}}},
+	},
+	Struct("0x2", "coin", "Coin").Struct.Key(): {
+		TypeParameters: 1,
+		Fields: []Field{
+			{Name: "id", Type: Struct("0x2", "object", "UID")},
+			{Name: "balance", Type: Struct("0x2", "balance", "Balance", typeT0)},
+		},
+	},
+	Struct("0x2", "url", "Url").Struct.Key(): {
+		Fields: []Field{{Name: "url", Type: Struct("0x1", "ascii", "String")}},
+	},
+}
+
+// NewRegistry returns a registry holding the builtin layouts.
+func NewRegistry() *Registry {
+	registry := &Registry{layouts: make(map[string]*Layout, len(builtinLayouts))}
+	for key, layout := range builtinLayouts {
+		registry.layouts[key] = layout
+	}
+
+	return registry
+}
+
+// Add registers the layout of the struct or enum address::module::name, replacing any previous layout.
+func (r *Registry) Add(address string, module string, name string, layout *Layout) {
+	key := Struct(address, module, name).Struct.Key()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.layouts[key] = layout
+}
+
+// Layout returns the generic layout of a struct or enum.
+func (r *Registry) Layout(tag *StructTag) (*Layout, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	layout, ok := r.layouts[tag.Key()]
+
+	return layout, ok
+}
+
+// Missing returns the structs and enums referenced by t, including in type arguments, whose layout is not
+// registered. Callers typically fetch the normalized modules of the missing types before decoding.
+func (r *Registry) Missing(t Type) []StructTag {
+	var missing []StructTag
+	seen := map[string]bool{}
+
+	var walk func(t Type)
+	walk = func(t Type) {
+		switch t.Kind {
+		case KindVector:
+			walk(*t.Elem)
+		case KindStruct:
+			for _, typeArg := range t.Struct.TypeArgs {
+				walk(typeArg)
+			}
+			if seen[t.Struct.Key()] {
+				return
+			}
+			seen[t.Struct.Key()] = true
+
+			layout, ok := r.Layout(t.Struct)
+			if !ok {
+				missing = append(missing, StructTag{Address: t.Struct.Address, Module: t.Struct.Module, Name: t.Struct.Name})
+				return
+			}
+			for _, field := range layout.Fields {
+				if fieldType, err := field.Type.substitute(t.Struct.TypeArgs); err == nil {
+					walk(fieldType)
+				}
+			}
+			for _, variant := range layout.Variants {
+				for _, field := range variant.Fields {
+					if fieldType, err := field.Type.substitute(t.Struct.TypeArgs); err == nil {
+						walk(fieldType)
+					}
+				}
+			}
+		default:
+		}
+	}
+	walk(t)
+
+	return missing
+}
+
+// instantiate substitutes the type arguments of tag into fields declared by layout.
+func instantiate(tag *StructTag, layout *Layout, fields []Field) ([]Field, error) {
+	if len(tag.TypeArgs) != layout.TypeParameters {
+		return nil, fmt.Errorf("%s expects %d type arguments, got %d", tag.Key(), layout.TypeParameters, len(tag.TypeArgs))
+	}
+
+	instantiated := make([]Field, len(fields))
+	for i, field := range fields {
+		fieldType, err := field.Type.substitute(tag.TypeArgs)
+		if err != nil {
+			return nil, fmt.Errorf("field %s of %s: %w", field.Name, tag.Key(), err)
+		}
+		instantiated[i] = Field{Name: field.Name, Type: fieldType}
+	}
+
+	return instantiated, nil
+}
+
+// lookup returns the layout of a struct type, failing if it is not registered.
+func (r *Registry) lookup(tag *StructTag) (*Layout, error) {
+	layout, ok := r.Layout(tag)
+	if !ok {
+		return nil, fmt.Errorf("no layout registered for %s", tag.Key())
+	}
+
+	return layout, nil
+}
+
+// AddNormalizedStructs registers the structs of a normalized Move module, as returned in the `structs` field of
+// `sui_getNormalizedMoveModule`.
+func (r *Registry) AddNormalizedStructs(address string, module string, structs map[string]any) error {
+	for name, rawStruct := range structs {
+		structFields, ok := rawStruct.(map[string]any)
+		if !ok {
+			return fmt.Errorf("unexpected normalized struct %s::%s: %v", module, name, rawStruct)
+		}
+
+		fields, err := parseNormalizedFields(structFields["fields"])
+		if err != nil {
+			return fmt.Errorf("struct %s::%s: %w", module, name, err)
+		}
+		typeParameters, _ := structFields["typeParameters"].([]any)
+
+		r.Add(address, module, name, &Layout{TypeParameters: len(typeParameters), Fields: fields})
+	}
+
+	return nil
+}
+
+// AddNormalizedEnums registers the enums of a normalized Move module, as returned in the `enums` field of
+// `sui_getNormalizedMoveModule`. Each enum must carry its `variantDeclarationOrder`, which determines the BCS tags
+// of its variants.
+func (r *Registry) AddNormalizedEnums(address string, module string, enums map[string]any) error {
+	for name, rawEnum := range enums {
+		enumFields, ok := rawEnum.(map[string]any)
+		if !ok {
+			return fmt.Errorf("unexpected normalized enum %s::%s: %v", module, name, rawEnum)
+		}
+
+		rawVariants, _ := enumFields["variants"].(map[string]any)
+		order, _ := enumFields["variantDeclarationOrder"].([]any)
+		if len(order) != len(rawVariants) {
+			return fmt.Errorf("enum %s::%s: missing variant declaration order", module, name)
+		}
+
+		variants := make([]Variant, 0, len(order))
+		for _, rawName := range order {
+			variantName, _ := rawName.(string)
+			rawFields, ok := rawVariants[variantName]
+			if !ok {
+				return fmt.Errorf("enum %s::%s: unknown variant %v", module, name, rawName)
+			}
+			fields, err := parseNormalizedFields(rawFields)
+			if err != nil {
+				return fmt.Errorf("enum %s::%s variant %s: %w", module, name, variantName, err)
+			}
+			variants = append(variants, Variant{Name: variantName, Fields: fields})
+		}
+		typeParameters, _ := enumFields["typeParameters"].([]any)
+
+		r.Add(address, module, name, &Layout{TypeParameters: len(typeParameters), Variants: variants})
+	}
+
+	return nil
+}
+
+// AddNormalizedModuleJSON registers the structs and enums of a normalized Move module given as the raw JSON result
+// of `sui_getNormalizedMoveModule`. Unlike the SDK model of the response, the raw JSON includes Move 2024 enums.
+func (r *Registry) AddNormalizedModuleJSON(data []byte) error {
+	var module struct {
+		Address string         `json:"address"`
+		Name    string         `json:"name"`
+		Structs map[string]any `json:"structs"`
+		Enums   map[string]any `json:"enums"`
+	}
+	if err := json.Unmarshal(data, &module); err != nil {
+		return fmt.Errorf("failed to parse normalized module: %w", err)
+	}
+	if module.Address == "" || module.Name == "" {
+		return fmt.Errorf("normalized module is missing its address or name")
+	}
+
+	if err := r.AddNormalizedStructs(module.Address, module.Name, module.Structs); err != nil {
+		return err
+	}
+
+	return r.AddNormalizedEnums(module.Address, module.Name, module.Enums)
+}
+
+func parseNormalizedFields(raw any) ([]Field, error) {
+	rawFields, ok := raw.([]any)
+	if !ok && raw != nil {
+		return nil, fmt.Errorf("unexpected fields %v", raw)
+	}
+
+	fields := make([]Field, 0, len(rawFields))
+	for _, rawField := range rawFields {
+		field, ok := rawField.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unexpected field %v", rawField)
+		}
+		name, _ := field["name"].(string)
+		fieldType, err := ParseNormalizedType(field["type"])
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", name, err)
+		}
+		fields = append(fields, Field{Name: name, Type: fieldType})
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,921 @@
+package movebcs
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	aptosBCS "github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/stretchr/testify/require"
+)
+
+const testModuleJSON = `{
+	"address": "0xabc",
+	"name": "pool",
+	"structs": {
+		"Pair": {
+			"typeParameters": [{"constraints": {"abilities": []}, "isPhantom": false}, {"constraints": {"abilities": []}, "isPhantom": false}],
+			"fields": [
+				{"name": "first", "type": {"TypeParameter": 0}},
+				{"name": "second", "type": {"TypeParameter": 1}},
+				{"name": "label", "type": {"Struct": {"address": "0x1", "module": "string", "name": "String", "typeArguments": []}}}
+			]
+		},
+		"Config": {
+			"typeParameters": [],
+			"fields": [
+				{"name": "owner", "type": "Address"},
+				{"name": "fee_bps", "type": "U16"},
+				{"name": "cap", "type": {"Struct": {"address": "0x1", "module": "option", "name": "Option", "typeArguments": ["U128"]}}},
+				{"name": "limits", "type": {"Struct": {"address": "0x2", "module": "vec_map", "name": "VecMap", "typeArguments": ["U64", "U256"]}}},
+				{"name": "action", "type": {"Struct": {"address": "0xabc", "module": "pool", "name": "Action", "typeArguments": ["U64"]}}}
+			]
+		}
+	},
+	"enums": {
+		"Action": {
+			"typeParameters": [{"constraints": {"abilities": []}, "isPhantom": false}],
+			"variants": {
+				"Batch": [{"name": "items", "type": {"Vector": {"TypeParameter": 0}}}],
+				"Noop": [],
+				"Transfer": [{"name": "to", "type": "Address"}, {"name": "amount", "type": {"TypeParameter": 0}}]
+			},
+			"variantDeclarationOrder": ["Noop", "Transfer", "Batch"]
+		}
+	}
+}`
+
+func newTestRegistry(t *testing.T) *Registry {
+	t.Helper()
+
+	registry := NewRegistry()
+	require.NoError(t, registry.AddNormalizedModuleJSON([]byte(testModuleJSON)))
+
+	return registry
+}
+
+func mustParseType(t *testing.T, s string) Type {
+	t.Helper()
+
+	parsed, err := ParseType(s)
+	require.NoError(t, err)
+
+	return parsed
+}
+
+func TestParseType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"u64", "u64"},
+		{"vector<vector<u8>>", "vector<vector<u8>>"},
+		{"&mut 0x2::coin::Coin<0x2::sui::SUI>", "0x2::coin::Coin<0x2::sui::SUI>"},
+		{"0x1::option::Option<u64>", "0x1::option::Option<u64>"},
+		{"0x0002::vec_map::VecMap<address,vector<u8>>", "0x2::vec_map::VecMap<address, vector<u8>>"},
+		{"vector<T0>", "vector<T0>"},
+	}
+
+	for _, test := range tests {
+		parsed, err := ParseType(test.input)
+		require.NoError(t, err, test.input)
+		require.Equal(t, test.expected, parsed.String())
+	}
+
+	for _, invalid := range []string{"", "u64>", "vector<u8", "0x1::option", "0x1::option::Option<u64", "foo"} {
+		_, err := ParseType(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestParseNormalizedType(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := ParseNormalizedType(map[string]any{
+		"MutableReference": map[string]any{
+			"Struct": map[string]any{
+				"address":       "0x2",
+				"module":        "vec_map",
+				"name":          "VecMap",
+				"typeArguments": []any{"Address", map[string]any{"Vector": "U8"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0x2::vec_map::VecMap<address, vector<u8>>", parsed.String())
+
+	parsed, err = ParseNormalizedType(map[string]any{"TypeParameter": float64(1)})
+	require.NoError(t, err)
+	require.Equal(t, Type{Kind: KindTypeParameter, Index: 1}, parsed)
+
+	_, err = ParseNormalizedType("U512")
+	require.Error(t, err)
+}
+
+func TestEncode_MatchesReferenceSerializer(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+	address := "0x" + fmt.Sprintf("%064x", 0xabcdef)
+	addressBytes := make([]byte, 32)
+	addressBytes[29], addressBytes[30], addressBytes[31] = 0xab, 0xcd, 0xef
+
+	tests := []struct {
+		moveType  string
+		value     any
+		reference func(s *aptosBCS.Serializer)
+	}{
+		{"bool", true, func(s *aptosBCS.Serializer) { s.Bool(true) }},
+		{"u8", 7, func(s *aptosBCS.Serializer) { s.U8(7) }},
+		{"u16", uint16(513), func(s *aptosBCS.Serializer) { s.U16(513) }},
+		{"u32", "70000", func(s *aptosBCS.Serializer) { s.U32(70000) }},
+		{"u64", float64(1 << 40), func(s *aptosBCS.Serializer) { s.U64(1 << 40) }},
+		{"u128", "0xffffffffffffffffff", func(s *aptosBCS.Serializer) {
+			n, _ := new(big.Int).SetString("ffffffffffffffffff", 16)
+			s.U128(*n)
+		}},
+		{"u256", big.NewInt(12345), func(s *aptosBCS.Serializer) { s.U256(*big.NewInt(12345)) }},
+		{"address", "0xabcdef", func(s *aptosBCS.Serializer) { s.FixedBytes(addressBytes) }},
+		{"vector<u8>", []byte{1, 2, 3}, func(s *aptosBCS.Serializer) { s.WriteBytes([]byte{1, 2, 3}) }},
+		{"vector<address>", []string{address}, func(s *aptosBCS.Serializer) {
+			s.Uleb128(1)
+			s.FixedBytes(addressBytes)
+		}},
+		{"0x1::string::String", "hello", func(s *aptosBCS.Serializer) { s.WriteString("hello") }},
+		{"0x1::option::Option<u64>", nil, func(s *aptosBCS.Serializer) { s.Uleb128(0) }},
+		{"0x1::option::Option<u64>", uint64(9), func(s *aptosBCS.Serializer) {
+			s.Uleb128(1)
+			s.U64(9)
+		}},
+		{"0x2::object::ID", address, func(s *aptosBCS.Serializer) { s.FixedBytes(addressBytes) }},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s/%v", test.moveType, test.value), func(t *testing.T) {
+			t.Parallel()
+
+			moveType := mustParseType(t, test.moveType)
+			encoded, err := registry.Encode(moveType, test.value)
+			require.NoError(t, err)
+
+			serializer := &aptosBCS.Serializer{}
+			test.reference(serializer)
+			require.NoError(t, serializer.Error())
+			require.Equal(t, serializer.ToBytes(), encoded)
+
+			decoded, err := registry.Decode(encoded, moveType)
+			require.NoError(t, err)
+			reencoded, err := registry.Encode(moveType, decoded)
+			require.NoError(t, err)
+			require.Equal(t, encoded, reencoded)
+		})
+	}
+}
+
+func TestEncode_Errors(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+
+	tests := []struct {
+		moveType string
+		value    any
+	}{
+		{"u8", 256},
+		{"u64", -1},
+		{"u64", 1.5},
+		{"u128", "not a number"},
+		{"bool", 1},
+		{"address", "0xzz"},
+		{"address", []byte{1, 2}},
+		{"0x1::ascii::String", "héllo"},
+		{"0x1::string::String", nil},
+		{"0xabc::pool::Unknown", map[string]any{}},
+	}
+
+	for _, test := range tests {
+		_, err := registry.Encode(mustParseType(t, test.moveType), test.value)
+		require.Error(t, err, "%s %v", test.moveType, test.value)
+	}
+}
+
+func TestDecode_Malformed(t *testing.T) {
+	t.Parallel()
+
+	registry := newTestRegistry(t)
+
+	tests := []struct {
+		moveType string
+		data     []byte
+	}{
+		{"u64", []byte{1, 2, 3}},
+		{"u8", []byte{1, 2}},
+		{"bool", []byte{2}},
+		{"vector<u64>", []byte{0xff, 0xff, 0xff, 0xff, 0x0f}},
+		{"0x1::option::Option<u8>", []byte{2, 1, 1}},
+		{"0x1::string::String", []byte{2, 0xff, 0xfe}},
+		{"0xabc::pool::Action<u64>", []byte{3}},
+		{"0xabc::pool::Action<u64>", []byte{1, 0}},
+		{"0xabc::pool::Pair<u8>", []byte{1, 1, 0}},
+	}
+
+	for _, test := range tests {
+		_, err := registry.Decode(test.data, mustParseType(t, test.moveType))
+		require.Error(t, err, "%s %x", test.moveType, test.data)
+	}
+}
+
+func TestEnums(t *testing.T) {
+	t.Parallel()
+
+	registry := newTestRegistry(t)
+	actionType := mustParseType(t, "0xabc::pool::Action<u64>")
+
+	encoded, err := registry.Encode(actionType, Enum{Variant: "Noop"})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, encoded)
+
+	encoded, err = registry.Encode(actionType, Enum{Variant: "Batch", Fields: map[string]any{"items": []uint64{1}}})
+	require.NoError(t, err)
+	require.Equal(t, []byte{2, 1, 1, 0, 0, 0, 0, 0, 0, 0}, encoded)
+
+	decoded, err := registry.Decode(encoded, actionType)
+	require.NoError(t, err)
+	require.Equal(t, Enum{Variant: "Batch", Fields: map[string]any{"items": []any{uint64(1)}}}, decoded)
+
+	// tagged structs hold one pointer field per variant
+	type transfer struct {
+		To     [32]byte
+		Amount uint64
+	}
+	type action struct {
+		Noop     *struct{}
+		Transfer *transfer
+		Batch    *struct{ Items []uint64 }
+	}
+
+	value := action{Transfer: &transfer{To: [32]byte{31: 0x42}, Amount: 7}}
+	encoded, err = registry.Encode(actionType, value)
+	require.NoError(t, err)
+	require.Equal(t, byte(1), encoded[0])
+
+	var decodedAction action
+	require.NoError(t, registry.DecodeInto(encoded, actionType, &decodedAction))
+	require.Equal(t, value, decodedAction)
+
+	var variant string
+	require.NoError(t, registry.DecodeInto([]byte{0}, actionType, &variant))
+	require.Equal(t, "Noop", variant)
+
+	_, err = registry.Encode(actionType, Enum{Variant: "Burn"})
+	require.ErrorContains(t, err, "unknown variant")
+	_, err = registry.Encode(actionType, action{Noop: &struct{}{}, Batch: &struct{ Items []uint64 }{}})
+	require.ErrorContains(t, err, "more than one variant")
+}
+
+func TestEnums_MissingDeclarationOrder(t *testing.T) {
+	t.Parallel()
+
+	err := NewRegistry().AddNormalizedEnums("0xabc", "pool", map[string]any{
+		"Action": map[string]any{
+			"variants": map[string]any{"A": []any{}, "B": []any{}},
+		},
+	})
+	require.ErrorContains(t, err, "missing variant declaration order")
+}
+
+func TestVecMap(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+	vecMapType := mustParseType(t, "0x2::vec_map::VecMap<0x1::string::String, u64>")
+
+	// Go maps are encoded in ascending order of their encoded keys
+	encoded, err := registry.Encode(vecMapType, map[string]uint64{"b": 2, "a": 1})
+	require.NoError(t, err)
+	require.Equal(t, []byte{2, 1, 'a', 1, 0, 0, 0, 0, 0, 0, 0, 1, 'b', 2, 0, 0, 0, 0, 0, 0, 0}, encoded)
+
+	decoded, err := registry.Decode(encoded, vecMapType)
+	require.NoError(t, err)
+	require.Equal(t, []any{
+		map[string]any{"key": "a", "value": uint64(1)},
+		map[string]any{"key": "b", "value": uint64(2)},
+	}, decoded)
+
+	var asMap map[string]uint64
+	require.NoError(t, registry.DecodeInto(encoded, vecMapType, &asMap))
+	require.Equal(t, map[string]uint64{"a": 1, "b": 2}, asMap)
+
+	type entry struct {
+		Key   string
+		Value uint64
+	}
+	var asSlice []entry
+	require.NoError(t, registry.DecodeInto(encoded, vecMapType, &asSlice))
+	require.Equal(t, []entry{{"a", 1}, {"b", 2}}, asSlice)
+
+	// slices keep their order
+	reencoded, err := registry.Encode(vecMapType, asSlice)
+	require.NoError(t, err)
+	require.Equal(t, encoded, reencoded)
+}
+
+func TestDecodeAddressBytes(t *testing.T) {
+	t.Parallel()
+
+	registry := newTestRegistry(t)
+	actionType := mustParseType(t, "0xabc::pool::Action<u64>")
+	owner := make([]byte, 32)
+	owner[31] = 0x42
+
+	encoded, err := registry.Encode(actionType, Enum{Variant: "Transfer", Fields: map[string]any{"to": "0x42", "amount": uint64(7)}})
+	require.NoError(t, err)
+
+	decoded, err := registry.DecodeAddressBytes(encoded, actionType)
+	require.NoError(t, err)
+	require.Equal(t, Enum{Variant: "Transfer", Fields: map[string]any{"to": owner, "amount": uint64(7)}}, decoded)
+
+	idType := mustParseType(t, "vector<0x2::object::ID>")
+	decoded, err = registry.DecodeAddressBytes(append([]byte{1}, owner...), idType)
+	require.NoError(t, err)
+	require.Equal(t, []any{owner}, decoded)
+
+	// Decode keeps hex strings
+	decoded, err = registry.Decode(append([]byte{1}, owner...), idType)
+	require.NoError(t, err)
+	require.Equal(t, []any{"0x" + fmt.Sprintf("%064x", 0x42)}, decoded)
+}
+
+func TestDecodeInto_Structs(t *testing.T) {
+	t.Parallel()
+
+	registry := newTestRegistry(t)
+	configType := mustParseType(t, "0xabc::pool::Config")
+
+	type config struct {
+		Owner  string
+		Fee    uint16 `sui:"fee_bps"`
+		Cap    *big.Int
+		Limits map[uint64]*big.Int
+		Action Enum
+	}
+
+	value := config{
+		Owner:  NormalizeAddress("0x7"),
+		Fee:    300,
+		Limits: map[uint64]*big.Int{10: big.NewInt(100)},
+		Action: Enum{Variant: "Transfer", Fields: map[string]any{"to": NormalizeAddress("0x8"), "amount": uint64(5)}},
+	}
+
+	encoded, err := registry.Encode(configType, value)
+	require.NoError(t, err)
+
+	var decoded config
+	require.NoError(t, registry.DecodeInto(encoded, configType, &decoded))
+	require.Equal(t, value, decoded)
+
+	value.Cap = big.NewInt(1000)
+	encoded, err = registry.Encode(configType, value)
+	require.NoError(t, err)
+	require.NoError(t, registry.DecodeInto(encoded, configType, &decoded))
+	require.Equal(t, 0, value.Cap.Cmp(decoded.Cap))
+
+	var generic map[string]any
+	require.NoError(t, registry.DecodeInto(encoded, configType, &generic))
+	require.Equal(t, uint16(300), generic["fee_bps"])
+	require.Equal(t, []any{map[string]any{"key": uint64(10), "value": big.NewInt(100)}}, generic["limits"])
+
+	var tooSmall struct {
+		FeeBps uint8
+	}
+	require.ErrorContains(t, registry.DecodeInto(encoded, configType, &tooSmall), "field fee_bps")
+	require.Error(t, registry.DecodeInto(encoded, configType, decoded))
+}
+
+func TestRegistry_Missing(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry()
+	missing := registry.Missing(mustParseType(t, "vector<0x1::option::Option<0xabc::pool::Pair<u8, 0xdef::m::S>>>"))
+	require.Len(t, missing, 2)
+	require.Equal(t, "0xdef::m::S", missing[0].String())
+	require.Equal(t, "0xabc::pool::Pair", missing[1].String())
+
+	registry = newTestRegistry(t)
+	require.Empty(t, registry.Missing(mustParseType(t, "0xabc::pool::Config")))
+}
+
+// TestRoundTrip checks that randomly generated values of randomly generated types survive an encode/decode cycle.
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	registry := newTestRegistry(t)
+
+	for seed := int64(1); seed <= 500; seed++ {
+		rnd := rand.New(rand.NewSource(seed))
+		moveType := randomType(rnd, 0)
+		value := randomValue(rnd, moveType)
+
+		encoded, err := registry.Encode(moveType, value)
+		require.NoError(t, err, "seed %d, type %s", seed, moveType)
+
+		decoded, err := registry.Decode(encoded, moveType)
+		require.NoError(t, err, "seed %d, type %s", seed, moveType)
+		requireValuesEqual(t, value, decoded, "seed %d, type %s", seed, moveType)
+
+		reencoded, err := registry.Encode(moveType, decoded)
+		require.NoError(t, err, "seed %d, type %s", seed, moveType)
+		require.Equal(t, encoded, reencoded, "seed %d, type %s", seed, moveType)
+
+		var generic any
+		require.NoError(t, registry.DecodeInto(encoded, moveType, &generic))
+		requireValuesEqual(t, decoded, generic, "seed %d, type %s", seed, moveType)
+
+		if len(encoded) > 0 {
+			_, err = registry.Decode(encoded[:len(encoded)-1], moveType)
+			require.Error(t, err, "seed %d, type %s", seed, moveType)
+		}
+		_, err = registry.Decode(append(encoded, 0), moveType)
+		require.Error(t, err, "seed %d, type %s", seed, moveType)
+	}
+}
+
+func randomType(rnd *rand.Rand, depth int) Type {
+	primitives := []Kind{KindBool, KindU8, KindU16, KindU32, KindU64, KindU128, KindU256, KindAddress}
+	if depth >= 3 {
+		return Type{Kind: primitives[rnd.Intn(len(primitives))]}
+	}
+
+	switch rnd.Intn(10) {
+	case 0, 1:
+		return Type{Kind: primitives[rnd.Intn(len(primitives))]}
+	case 2:
+		return Vector(randomType(rnd, depth+1))
+	case 3:
+		return Struct("0x1", "string", "String")
+	case 4:
+		return Struct("0x1", "option", "Option", randomType(rnd, depth+1))
+	case 5:
+		return Struct("0x2", "vec_map", "VecMap", randomType(rnd, depth+1), randomType(rnd, depth+1))
+	case 6:
+		return Struct("0x2", "object", "ID")
+	case 7:
+		return Struct("0xabc", "pool", "Pair", randomType(rnd, depth+1), randomType(rnd, depth+1))
+	case 8:
+		return Struct("0xabc", "pool", "Action", randomType(rnd, depth+1))
+	default:
+		return Struct("0xabc", "pool", "Config")
+	}
+}
+
+func randomValue(rnd *rand.Rand, t Type) any {
+	switch t.Kind {
+	case KindBool:
+		return rnd.Intn(2) == 1
+	case KindU8:
+		return uint8(rnd.Uint32())
+	case KindU16:
+		return uint16(rnd.Uint32())
+	case KindU32:
+		return rnd.Uint32()
+	case KindU64:
+		return rnd.Uint64()
+	case KindU128:
+		return new(big.Int).Rand(rnd, new(big.Int).Lsh(big.NewInt(1), 128))
+	case KindU256:
+		return new(big.Int).Rand(rnd, new(big.Int).Lsh(big.NewInt(1), 256))
+	case KindAddress:
+		return randomAddress(rnd)
+	case KindVector:
+		length := rnd.Intn(4)
+		if t.Elem.Kind == KindU8 {
+			b := make([]byte, length)
+			rnd.Read(b)
+
+			return b
+		}
+		values := make([]any, length)
+		for i := range values {
+			values[i] = randomValue(rnd, *t.Elem)
+		}
+
+		return values
+	}
+
+	tag := t.Struct
+	switch {
+	case tag.Is("0x1", "string", "String"):
+		return fmt.Sprintf("value-%d", rnd.Intn(1000))
+	case tag.Is("0x2", "object", "ID"):
+		return randomAddress(rnd)
+	case tag.Is("0x1", "option", "Option"):
+		if rnd.Intn(2) == 0 {
+			return nil
+		}
+
+		return randomValue(rnd, tag.TypeArgs[0])
+	case tag.Is("0x2", "vec_map", "VecMap"):
+		entries := make([]any, rnd.Intn(3))
+		for i := range entries {
+			entries[i] = map[string]any{
+				"key":   randomValue(rnd, tag.TypeArgs[0]),
+				"value": randomValue(rnd, tag.TypeArgs[1]),
+			}
+		}
+
+		return entries
+	case tag.Is("0xabc", "pool", "Pair"):
+		return map[string]any{
+			"first":  randomValue(rnd, tag.TypeArgs[0]),
+			"second": randomValue(rnd, tag.TypeArgs[1]),
+			"label":  randomValue(rnd, Struct("0x1", "string", "String")),
+		}
+	case tag.Is("0xabc", "pool", "Action"):
+		switch rnd.Intn(3) {
+		case 0:
+			return Enum{Variant: "Noop", Fields: map[string]any{}}
+		case 1:
+			return Enum{Variant: "Transfer", Fields: map[string]any{
+				"to":     randomAddress(rnd),
+				"amount": randomValue(rnd, tag.TypeArgs[0]),
+			}}
+		default:
+			return Enum{Variant: "Batch", Fields: map[string]any{
+				"items": randomValue(rnd, Vector(tag.TypeArgs[0])),
+			}}
+		}
+	default:
+		return map[string]any{
+			"owner":   randomAddress(rnd),
+			"fee_bps": uint16(rnd.Intn(10000)),
+			"cap":     randomValue(rnd, Struct("0x1", "option", "Option", Type{Kind: KindU128})),
+			"limits":  randomValue(rnd, Struct("0x2", "vec_map", "VecMap", Type{Kind: KindU64}, Type{Kind: KindU256})),
+			"action":  randomValue(rnd, Struct("0xabc", "pool", "Action", Type{Kind: KindU64})),
+		}
+	}
+}
+
+func randomAddress(rnd *rand.Rand) string {
+	b := make([]byte, 32)
+	rnd.Read(b)
+
+	return fmt.Sprintf("0x%x", b)
+}
+
+// requireValuesEqual compares generic values, comparing big integers by value.
+func requireValuesEqual(t *testing.T, expected any, actual any, msgAndArgs ...any) {
+	t.Helper()
+
+	switch expectedValue := expected.(type) {
+	case *big.Int:
+		actualValue, ok := actual.(*big.Int)
+		require.True(t, ok, msgAndArgs...)
+		require.Equal(t, 0, expectedValue.Cmp(actualValue), msgAndArgs...)
+	case []any:
+		actualValue, ok := actual.([]any)
+		require.True(t, ok, msgAndArgs...)
+		require.Len(t, actualValue, len(expectedValue), msgAndArgs...)
+		for i := range expectedValue {
+			requireValuesEqual(t, expectedValue[i], actualValue[i], msgAndArgs...)
+		}
+	case map[string]any:
+		actualValue, ok := actual.(map[string]any)
+		require.True(t, ok, msgAndArgs...)
+		require.Len(t, actualValue, len(expectedValue), msgAndArgs...)
+		for key := range expectedValue {
+			requireValuesEqual(t, expectedValue[key], actualValue[key], msgAndArgs...)
+		}
+	case Enum:
+		actualValue, ok := actual.(Enum)
+		require.True(t, ok, msgAndArgs...)
+		require.Equal(t, expectedValue.Variant, actualValue.Variant, msgAndArgs...)
+		requireValuesEqual(t, expectedValue.Fields, actualValue.Fields, msgAndArgs...)
+	default:
+		require.Equal(t, expected, actual, msgAndArgs...)
+	}
+}
+
+func TestToJSON(t *testing.T) {
+	t.Parallel()
+
+	registry := newTestRegistry(t)
+	configType := mustParseType(t, "0xabc::pool::Config")
+
+	encoded, err := registry.Encode(configType, map[string]any{
+		"owner":   "0x7",
+		"fee_bps": 300,
+		"cap":     "340282366920938463463374607431768211455",
+		"limits":  map[uint64]uint64{1: 2},
+		"action":  Enum{Variant: "Batch", Fields: map[string]any{"items": []uint64{18446744073709551615}}},
+	})
+	require.NoError(t, err)
+
+	decoded, err := registry.Decode(encoded, configType)
+	require.NoError(t, err)
+
+	asJSON, err := json.Marshal(ToJSON(decoded))
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"owner": "0x0000000000000000000000000000000000000000000000000000000000000007",
+		"fee_bps": 300,
+		"cap": "340282366920938463463374607431768211455",
+		"limits": [{"key": "1", "value": "2"}],
+		"action": {"variant": "Batch", "fields": {"items": ["18446744073709551615"]}}
+	}`, string(asJSON))
+
+	bytesJSON, err := json.Marshal(ToJSON([]byte{1, 255}))
+	require.NoError(t, err)
+	require.JSONEq(t, `[1, 255]`, string(bytesJSON))
+}
+
+type taggedTransfer struct {
+	To     string `sui:"to,type=address"`
+	Amount uint64 `sui:"amount,type=u64"`
+}
+
+type taggedAction struct {
+	Noop     *struct{}       `sui:"Noop"`
+	Transfer *taggedTransfer `sui:"Transfer"`
+}
+
+type taggedConfig struct {
+	Owner  string              `sui:"owner,type=address"`
+	Fee    uint16              `sui:"fee_bps,type=u16"`
+	Cap    *big.Int            `sui:"cap,type=0x1::option::Option<u128>"`
+	Limits map[uint64]*big.Int `sui:"limits,type=0x2::vec_map::VecMap<u64, u256>"`
+	Action taggedAction        `sui:"action"`
+}
+
+func TestParseFieldTag(t *testing.T) {
+	t.Parallel()
+
+	type tagged struct {
+		Plain    uint64
+		Named    uint64 `sui:"named_field"`
+		Typed    uint64 `sui:"typed,type=u64"`
+		Unnamed  uint64 `sui:",type=u64"`
+		Generic  any    `sui:"map,type=0x2::vec_map::VecMap<u64, vector<u8>>"`
+		Unknown  uint64 `sui:"unknown,omitempty"`
+		NoneType uint64 `sui:"none,type="`
+	}
+
+	goType := reflect.TypeOf(tagged{})
+	tests := []struct {
+		field    string
+		expected fieldTag
+		tagged   bool
+		err      bool
+	}{
+		{"Plain", fieldTag{name: "Plain"}, false, false},
+		{"Named", fieldTag{name: "named_field"}, true, false},
+		{"Typed", fieldTag{name: "typed", moveType: "u64"}, true, false},
+		{"Unnamed", fieldTag{name: "Unnamed", moveType: "u64"}, true, false},
+		{"Generic", fieldTag{name: "map", moveType: "0x2::vec_map::VecMap<u64, vector<u8>>"}, true, false},
+		{"Unknown", fieldTag{}, true, true},
+		{"NoneType", fieldTag{}, true, true},
+	}
+
+	for _, test := range tests {
+		field, ok := goType.FieldByName(test.field)
+		require.True(t, ok)
+
+		tag, tagged, err := parseFieldTag(field)
+		require.Equal(t, test.tagged, tagged, test.field)
+		if test.err {
+			require.Error(t, err, test.field)
+			continue
+		}
+		require.NoError(t, err, test.field)
+		require.Equal(t, test.expected, tag, test.field)
+	}
+
+	require.True(t, HasTags(reflect.TypeOf(&taggedConfig{})))
+	require.False(t, HasTags(reflect.TypeOf(struct{ A uint64 }{})))
+	require.False(t, HasTags(reflect.TypeOf(uint64(0))))
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	registry := newTestRegistry(t)
+	configType := mustParseType(t, "0xabc::pool::Config")
+
+	require.NoError(t, registry.Validate(reflect.TypeOf(taggedConfig{}), configType))
+	require.NoError(t, registry.Validate(reflect.TypeOf(&taggedConfig{}), configType))
+
+	type wrongType struct {
+		Fee uint64 `sui:"fee_bps,type=u64"`
+	}
+	require.ErrorContains(t, registry.Validate(reflect.TypeOf(wrongType{}), configType), "declares type u64, the Move field has type u16")
+
+	type unknownField struct {
+		Owner string `sui:"owner"`
+		Fee   uint16 `sui:"fees"`
+	}
+	require.ErrorContains(t, registry.Validate(reflect.TypeOf(unknownField{}), configType), "has no field fees")
+
+	type badAction struct {
+		Transfer *struct {
+			Amount uint64 `sui:"amount,type=u128"`
+		} `sui:"Transfer"`
+		Swap *struct{} `sui:"Swap"`
+	}
+	type nestedProblems struct {
+		Action badAction `sui:"action"`
+	}
+	err := registry.Validate(reflect.TypeOf(nestedProblems{}), configType)
+	require.ErrorContains(t, err, "declares type u128, the Move field has type u64")
+	require.ErrorContains(t, err, "has no variant Swap")
+
+	require.ErrorContains(t, registry.Validate(reflect.TypeOf(taggedConfig{}), mustParseType(t, "0xabc::pool::Missing")), "no layout registered")
+}
+
+func TestDecodeInto_DeclaredTypes(t *testing.T) {
+	t.Parallel()
+
+	registry := newTestRegistry(t)
+	configType := mustParseType(t, "0xabc::pool::Config")
+
+	value := taggedConfig{
+		Owner:  NormalizeAddress("0x7"),
+		Fee:    300,
+		Cap:    big.NewInt(1000),
+		Limits: map[uint64]*big.Int{10: big.NewInt(100)},
+		Action: taggedAction{Transfer: &taggedTransfer{To: NormalizeAddress("0x8"), Amount: 5}},
+	}
+
+	encoded, err := registry.Encode(configType, value)
+	require.NoError(t, err)
+
+	var decoded taggedConfig
+	require.NoError(t, registry.DecodeInto(encoded, configType, &decoded))
+	require.Equal(t, value, decoded)
+
+	var mismatched struct {
+		Fee uint64 `sui:"fee_bps,type=u64"`
+	}
+	require.ErrorContains(t, registry.DecodeInto(encoded, configType, &mismatched), "declares type u64")
+}
+
+func TestUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	registry := newTestRegistry(t)
+	configType := mustParseType(t, "0xabc::pool::Config")
+
+	expected := taggedConfig{
+		Owner:  NormalizeAddress("0x7"),
+		Fee:    300,
+		Cap:    big.NewInt(1000),
+		Limits: map[uint64]*big.Int{10: big.NewInt(100)},
+		Action: taggedAction{Transfer: &taggedTransfer{To: NormalizeAddress("0x8"), Amount: 5}},
+	}
+
+	// the canonical JSON of the indexer, with camel case keys
+	var canonical map[string]any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"owner": "0x7",
+		"feeBps": 300,
+		"cap": "1000",
+		"limits": [{"key": "10", "value": "100"}],
+		"action": {"variant": "Transfer", "fields": {"to": "0x8", "amount": "5"}}
+	}`), &canonical))
+
+	var decoded taggedConfig
+	require.NoError(t, registry.Unmarshal(canonical, configType, &decoded))
+	require.Equal(t, expected, decoded)
+
+	canonical["feeBps"] = "70000"
+	require.ErrorContains(t, registry.Unmarshal(canonical, configType, &decoded), "field fee_bps")
+}
+
+func TestUnmarshalFields(t *testing.T) {
+	t.Parallel()
+
+	registry := newTestRegistry(t)
+
+	type result struct {
+		Supply  *big.Int `sui:"total_supply,type=u128"`
+		Owner   []byte   `sui:"owner,type=address"`
+		Limit   *uint64  `sui:"limit,type=0x1::option::Option<u64>"`
+		Name    string   `sui:"name,type=0x1::string::String"`
+		Ignored string
+	}
+
+	var decoded result
+	require.NoError(t, registry.UnmarshalFields(map[string]any{
+		"totalSupply": "340282366920938463463374607431768211455",
+		"owner":       "0x2",
+		"name":        "pool",
+	}, &decoded))
+
+	maxU128, _ := new(big.Int).SetString("340282366920938463463374607431768211455", 10)
+	require.Equal(t, 0, maxU128.Cmp(decoded.Supply))
+	require.Equal(t, append(make([]byte, 31), 2), decoded.Owner)
+	require.Nil(t, decoded.Limit)
+	require.Equal(t, "pool", decoded.Name)
+
+	require.ErrorContains(t, registry.UnmarshalFields(map[string]any{"owner": "0x2", "name": "pool"}, &decoded), "field Supply")
+
+	var untyped struct {
+		Value uint64 `sui:"value"`
+	}
+	require.ErrorContains(t, registry.UnmarshalFields(map[string]any{"value": 1}, &untyped), "does not declare its Move type")
+	require.Error(t, registry.UnmarshalFields(map[string]any{}, decoded))
+}
+
+func TestMarshalFields(t *testing.T) {
+	t.Parallel()
+
+	registry := newTestRegistry(t)
+
+	type args struct {
+		Amount  *big.Int `sui:"amount,type=u128"`
+		Fee     uint16   `sui:"fee_bps,type=u16"`
+		Note    string   `sui:"note"`
+		Skipped string   `sui:"-"`
+		Plain   string
+	}
+
+	values, err := registry.MarshalFields(&args{Amount: big.NewInt(7), Fee: 30, Note: "x", Skipped: "y", Plain: "z"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"amount": big.NewInt(7), "fee_bps": uint16(30), "note": "x"}, values)
+
+	type overflowing struct {
+		Fee uint32 `sui:"fee_bps,type=u16"`
+	}
+	_, err = registry.MarshalFields(overflowing{Fee: 70000})
+	require.ErrorContains(t, err, "field Fee")
+
+	_, err = registry.MarshalFields(map[string]any{})
+	require.Error(t, err)
+}
+
+func TestValidateFields(t *testing.T) {
+	t.Parallel()
+
+	registry := newTestRegistry(t)
+	fields := []Field{
+		{Name: "owner", Type: Type{Kind: KindAddress}},
+		{Name: "amount", Type: Type{Kind: KindU128}},
+		{Name: "config", Type: mustParseType(t, "0xabc::pool::Config")},
+		{Name: "items", Type: Vector(Type{Kind: KindTypeParameter})},
+	}
+
+	type args struct {
+		Owner  string        `sui:"owner,type=address"`
+		Amount *big.Int      `sui:"amount,type=u128"`
+		Config *taggedConfig `sui:"config"`
+		Items  []uint64      `sui:"items,type=vector<u64>"`
+	}
+	require.NoError(t, registry.ValidateFields(reflect.TypeOf(args{}), fields))
+
+	type badArgs struct {
+		Amount uint64 `sui:"amount,type=u64"`
+		Fee    uint16 `sui:"fee"`
+		Config struct {
+			Fee uint8 `sui:"fee_bps,type=u8"`
+		} `sui:"config"`
+	}
+	err := registry.ValidateFields(reflect.TypeOf(badArgs{}), fields)
+	require.ErrorContains(t, err, "field Amount declares type u64, the Move field has type u128")
+	require.ErrorContains(t, err, "has no field fee")
+	require.ErrorContains(t, err, "field Fee declares type u8, the Move field has type u16")
+}
+
+func TestDecodeFieldsInto(t *testing.T) {
+	t.Parallel()
+
+	registry := newTestRegistry(t)
+	fields := []Field{
+		{Name: "count", Type: Type{Kind: KindU64}},
+		{Name: "label", Type: mustParseType(t, "0x1::string::String")},
+		{Name: "unused", Type: Type{Kind: KindBool}},
+	}
+
+	count, err := registry.Encode(fields[0].Type, 42)
+	require.NoError(t, err)
+	label, err := registry.Encode(fields[1].Type, "counter")
+	require.NoError(t, err)
+	unused, err := registry.Encode(fields[2].Type, true)
+	require.NoError(t, err)
+
+	var result struct {
+		Count uint64 `sui:"count,type=u64"`
+		Label string `sui:"label"`
+	}
+	require.NoError(t, registry.DecodeFieldsInto(fields, [][]byte{count, label, unused}, &result))
+	require.Equal(t, uint64(42), result.Count)
+	require.Equal(t, "counter", result.Label)
+
+	var mismatched struct {
+		Count uint32 `sui:"count,type=u32"`
+	}
+	require.ErrorContains(t, registry.DecodeFieldsInto(fields, [][]byte{count, label, unused}, &mismatched), "declares type u32")
+	require.ErrorContains(t, registry.DecodeFieldsInto(fields, [][]byte{count}, &result), "got 1 values for 3 fields")
+}
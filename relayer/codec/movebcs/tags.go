@@ -0,0 +1,391 @@
+package movebcs
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// TagName is the key of the struct tags mapping Go fields to Move fields:
+//
+//	type Pool struct {
+//		Owner  string   `sui:"owner"`
+//		Fee    uint16   `sui:"fee_bps,type=u16"`
+//		Supply *big.Int `sui:"total_supply,type=u128"`
+//	}
+//
+// The name is the Move field (or enum variant) name, matched ignoring case and underscores; it defaults to the Go
+// field name and "-" skips the field. The optional type option declares the Move type of the field. It is checked
+// against the Move layout when decoding and by Validate, and is required where no layout is available, e.g. to
+// decode the fields of a tuple with UnmarshalFields. Being the last option, the type may contain commas.
+const TagName = "sui"
+
+// fieldTag is a parsed `sui` struct tag.
+type fieldTag struct {
+	name string
+	// moveType is the declared Move type, "" when not declared
+	moveType string
+}
+
+// parseFieldTag parses the `sui` tag of a Go struct field, naming the field after its Go name when the tag has no
+// name. ok is false for fields without a tag.
+func parseFieldTag(field reflect.StructField) (tag fieldTag, ok bool, err error) {
+	raw, ok := field.Tag.Lookup(TagName)
+	if !ok {
+		return fieldTag{name: field.Name}, false, nil
+	}
+
+	name, options, _ := strings.Cut(raw, ",")
+	tag.name = name
+	if tag.name == "" {
+		tag.name = field.Name
+	}
+
+	if options != "" {
+		moveType, found := strings.CutPrefix(options, "type=")
+		if !found {
+			return tag, true, fmt.Errorf("unknown option %q in tag %q of field %s", options, raw, field.Name)
+		}
+		tag.moveType = strings.TrimSpace(moveType)
+		if tag.moveType == "" {
+			return tag, true, fmt.Errorf("empty type in tag %q of field %s", raw, field.Name)
+		}
+	}
+
+	return tag, true, nil
+}
+
+// declaredType returns the Move type declared by the `sui` tag of a Go struct field, if any.
+func declaredType(field reflect.StructField) (Type, bool, error) {
+	tag, _, err := parseFieldTag(field)
+	if err != nil {
+		return Type{}, false, err
+	}
+	if tag.moveType == "" {
+		return Type{}, false, nil
+	}
+
+	declared, err := ParseType(tag.moveType)
+	if err != nil {
+		return Type{}, false, fmt.Errorf("field %s: %w", field.Name, err)
+	}
+
+	return declared, true, nil
+}
+
+// checkDeclaredType fails when a Go struct field declares a Move type other than t.
+func checkDeclaredType(field reflect.StructField, t Type) error {
+	declared, ok, err := declaredType(field)
+	if err != nil || !ok {
+		return err
+	}
+	if !containsTypeParameter(t) && declared.String() != t.String() {
+		return fmt.Errorf("field %s declares type %s, the Move field has type %s", field.Name, declared, t)
+	}
+
+	return nil
+}
+
+// HasTags reports whether t, or the type it points to, is a Go struct with at least one `sui` tagged field.
+func HasTags(t reflect.Type) bool {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return false
+	}
+
+	for i := range t.NumField() {
+		if _, ok := t.Field(i).Tag.Lookup(TagName); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate checks the `sui` tags of the Go struct type goType against the layout of the Move struct or enum t: every
+// tagged field must name a field (or variant) of t, and declared types must match the Move field types. Nested Go
+// structs are validated against the layouts of the Move structs they hold. All problems are reported.
+func (r *Registry) Validate(goType reflect.Type, t Type) error {
+	return r.validate(goType, t, goType.String(), 0)
+}
+
+func (r *Registry) validate(goType reflect.Type, t Type, path string, depth int) error {
+	if depth > maxDepth {
+		return fmt.Errorf("%s: type is nested too deeply", path)
+	}
+
+	for goType.Kind() == reflect.Pointer {
+		goType = goType.Elem()
+	}
+
+	switch {
+	case t.Kind == KindVector:
+		if goType.Kind() != reflect.Slice && goType.Kind() != reflect.Array {
+			return nil
+		}
+
+		return r.validate(goType.Elem(), *t.Elem, path+"[]", depth+1)
+	case t.Kind != KindStruct:
+		return nil
+	case t.Struct.Is("0x1", "option", "Option"), t.Struct.Is("0x2", "vec_set", "VecSet"):
+		return r.validate(goType, optionOrSetElement(t), path, depth+1)
+	case t.Struct.Address == NormalizeAddress("0x1"), t.Struct.Address == NormalizeAddress("0x2"):
+		// framework types decode to plain Go values
+		return nil
+	case goType.Kind() != reflect.Struct || goType == bigIntType:
+		return nil
+	}
+
+	layout, err := r.lookup(t.Struct)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	if !layout.IsEnum() {
+		return r.validateFields(goType, t.Struct, layout, layout.Fields, path, depth)
+	}
+
+	var errs []error
+	for i := range goType.NumField() {
+		field := goType.Field(i)
+		tag, tagged, err := parseFieldTag(field)
+		if !tagged || tag.name == "-" {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+
+		variant := findVariant(layout, tag.name)
+		if variant == nil {
+			errs = append(errs, fmt.Errorf("%s.%s: %s has no variant %s", path, field.Name, t, tag.name))
+			continue
+		}
+		if err := r.validateFields(field.Type, t.Struct, layout, variant.Fields, path+"."+field.Name, depth+1); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *Registry) validateFields(goType reflect.Type, tag *StructTag, layout *Layout, declared []Field, path string, depth int) error {
+	fields, err := instantiate(tag, layout, declared)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return r.checkFields(goType, fields, tag.String(), path, depth)
+}
+
+// ValidateFields checks the `sui` tags of the Go struct type goType against named values of known types, such as the
+// values of a tuple or the params of a function: every tagged field must name a value, and declared types must match
+// the types of the values. Declared types are not checked against type parameters.
+func (r *Registry) ValidateFields(goType reflect.Type, fields []Field) error {
+	return r.checkFields(goType, fields, "the values", goType.String(), 0)
+}
+
+func (r *Registry) checkFields(goType reflect.Type, fields []Field, owner string, path string, depth int) error {
+	for goType.Kind() == reflect.Pointer {
+		goType = goType.Elem()
+	}
+	if goType.Kind() != reflect.Struct {
+		return nil
+	}
+
+	moveFields := make(map[string]Field, len(fields))
+	for _, field := range fields {
+		moveFields[normalizeName(field.Name)] = field
+	}
+
+	var errs []error
+	for i := range goType.NumField() {
+		field := goType.Field(i)
+		parsed, tagged, err := parseFieldTag(field)
+		if !tagged || parsed.name == "-" || !field.IsExported() {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+
+		moveField, ok := moveFields[normalizeName(parsed.name)]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s.%s: %s has no field %s", path, field.Name, owner, parsed.name))
+			continue
+		}
+		if err := checkDeclaredType(field, moveField.Type); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+		if err := r.validate(field.Type, moveField.Type, path+"."+field.Name, depth+1); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// DecodeFieldsInto decodes the BCS bytes of named values of known types, such as the values of a tuple, into the
+// `sui` tagged fields of the Go struct target points to. Values without a matching field are skipped, and declared
+// types must match the types of the values.
+func (r *Registry) DecodeFieldsInto(fields []Field, values [][]byte, target any) error {
+	pointer := reflect.ValueOf(target)
+	if pointer.Kind() != reflect.Pointer || pointer.IsNil() || pointer.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("decode target must be a non-nil pointer to a struct, got %T", target)
+	}
+	if len(fields) != len(values) {
+		return fmt.Errorf("got %d values for %d fields", len(values), len(fields))
+	}
+	dst := pointer.Elem()
+
+	indexes := structFields(dst.Type())
+	for i, field := range fields {
+		index, ok := indexes[normalizeName(field.Name)]
+		if !ok {
+			continue
+		}
+		if err := checkDeclaredType(dst.Type().Field(index), field.Type); err != nil {
+			return err
+		}
+		if err := r.DecodeInto(values[i], field.Type, dst.Field(index).Addr().Interface()); err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func containsTypeParameter(t Type) bool {
+	switch t.Kind {
+	case KindTypeParameter:
+		return true
+	case KindVector:
+		return containsTypeParameter(*t.Elem)
+	case KindStruct:
+		for _, typeArg := range t.Struct.TypeArgs {
+			if containsTypeParameter(typeArg) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func optionOrSetElement(t Type) Type {
+	if len(t.Struct.TypeArgs) != 1 {
+		return Type{Kind: KindTypeParameter}
+	}
+
+	return t.Struct.TypeArgs[0]
+}
+
+func findVariant(layout *Layout, name string) *Variant {
+	for i := range layout.Variants {
+		if normalizeName(layout.Variants[i].Name) == normalizeName(name) {
+			return &layout.Variants[i]
+		}
+	}
+
+	return nil
+}
+
+// Unmarshal converts a loosely typed value of type t into target, which must be a non-nil pointer. The value may be
+// in any form accepted by Encode, e.g. the canonical JSON of ToJSON, the parsed JSON of a Sui node (numbers as
+// decimal strings, struct field names in snake or camel case) or a generic value returned by Decode. The value is
+// converted exactly as if it had been decoded from its BCS bytes with DecodeInto.
+func (r *Registry) Unmarshal(value any, t Type, target any) error {
+	data, err := r.Encode(t, value)
+	if err != nil {
+		return err
+	}
+
+	return r.DecodeInto(data, t, target)
+}
+
+// UnmarshalFields converts named, loosely typed values, such as the fields of a tuple result, into the `sui` tagged
+// fields of the Go struct target points to. Tagged fields must declare their Move type; values are matched by name
+// ignoring case and underscores, and missing values are only allowed for Option fields.
+func (r *Registry) UnmarshalFields(values map[string]any, target any) error {
+	pointer := reflect.ValueOf(target)
+	if pointer.Kind() != reflect.Pointer || pointer.IsNil() || pointer.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("unmarshal target must be a non-nil pointer to a struct, got %T", target)
+	}
+	dst := pointer.Elem()
+
+	normalizedValues := make(map[string]any, len(values))
+	for name, value := range values {
+		normalizedValues[normalizeName(name)] = value
+	}
+
+	for i := range dst.NumField() {
+		field := dst.Type().Field(i)
+		tag, tagged, err := parseFieldTag(field)
+		if !tagged || tag.name == "-" || !field.IsExported() {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		fieldType, ok, err := declaredType(field)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("field %s of %s does not declare its Move type", field.Name, dst.Type())
+		}
+
+		value, found := values[tag.name]
+		if !found {
+			value = normalizedValues[normalizeName(tag.name)]
+		}
+		if err := r.Unmarshal(value, fieldType, dst.Field(i).Addr().Interface()); err != nil {
+			return fmt.Errorf("field %s of %s: %w", field.Name, dst.Type(), err)
+		}
+	}
+
+	return nil
+}
+
+// MarshalFields converts the `sui` tagged fields of a Go struct into a map keyed by Move name, checking that fields
+// declaring a Move type hold a value that can be encoded as that type. Types whose layouts are not registered are
+// left to be checked when the values are encoded.
+func (r *Registry) MarshalFields(source any) (map[string]any, error) {
+	v := indirect(reflect.ValueOf(source))
+	if !v.IsValid() || v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot marshal the fields of %T", source)
+	}
+
+	values := make(map[string]any, v.NumField())
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		tag, tagged, err := parseFieldTag(field)
+		if !tagged || tag.name == "-" || !field.IsExported() {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		fieldType, ok, err := declaredType(field)
+		if err != nil {
+			return nil, err
+		}
+		if ok && len(r.Missing(fieldType)) == 0 {
+			if _, err := r.Encode(fieldType, v.Field(i).Interface()); err != nil {
+				return nil, fmt.Errorf("field %s of %s: %w", field.Name, v.Type(), err)
+			}
+		}
+
+		values[tag.name] = v.Field(i).Interface()
+	}
+
+	return values, nil
+}
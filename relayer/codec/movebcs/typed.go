@@ -0,0 +1,412 @@
+package movebcs
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+var bigIntPointerType = reflect.TypeOf(&big.Int{})
+
+// DecodeInto decodes the BCS bytes of a value of type t into target, which must be a non-nil pointer. Targets may be
+// generic (any), or typed Go values following the conversions accepted by Encode: Move structs decode into Go
+// structs or string-keyed maps, Option<T> into pointers (nil for none), VecMap into Go maps or slices, and enums into
+// Enum, tagged structs or strings (for variants without fields). Types declared in `sui` tags must match the layout.
+func (r *Registry) DecodeInto(data []byte, t Type, target any) error {
+	pointer := reflect.ValueOf(target)
+	if pointer.Kind() != reflect.Pointer || pointer.IsNil() {
+		return fmt.Errorf("decode target must be a non-nil pointer, got %T", target)
+	}
+
+	value, err := r.Decode(data, t)
+	if err != nil {
+		return err
+	}
+
+	if err := r.assign(t, value, pointer.Elem(), 0); err != nil {
+		return fmt.Errorf("failed to decode %s into %T: %w", t, target, err)
+	}
+
+	return nil
+}
+
+// Assign converts a generic value of type t, as returned by Decode, into target, which must be a non-nil pointer.
+func (r *Registry) Assign(t Type, value any, target any) error {
+	pointer := reflect.ValueOf(target)
+	if pointer.Kind() != reflect.Pointer || pointer.IsNil() {
+		return fmt.Errorf("assign target must be a non-nil pointer, got %T", target)
+	}
+
+	return r.assign(t, value, pointer.Elem(), 0)
+}
+
+func (r *Registry) assign(t Type, src any, dst reflect.Value, depth int) error {
+	if depth > maxDepth {
+		return fmt.Errorf("value is nested too deeply")
+	}
+
+	if dst.Kind() == reflect.Interface && dst.NumMethod() == 0 {
+		if src == nil {
+			dst.Set(reflect.Zero(dst.Type()))
+		} else {
+			dst.Set(reflect.ValueOf(src))
+		}
+
+		return nil
+	}
+
+	if src == nil {
+		// Option::none
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+
+	if dst.Kind() == reflect.Pointer && dst.Type() != bigIntPointerType {
+		elem := reflect.New(dst.Type().Elem())
+		if err := r.assign(t, src, elem.Elem(), depth); err != nil {
+			return err
+		}
+		dst.Set(elem)
+
+		return nil
+	}
+
+	switch t.Kind {
+	case KindBool:
+		return assignValue(src, dst)
+	case KindU8, KindU16, KindU32, KindU64, KindU128, KindU256:
+		return assignInteger(src, dst)
+	case KindAddress, KindSigner:
+		return assignAddress(src, dst)
+	case KindVector:
+		return r.assignVector(*t.Elem, src, dst, depth)
+	case KindStruct:
+		return r.assignStruct(t.Struct, src, dst, depth)
+	default:
+		return fmt.Errorf("cannot assign %s", t)
+	}
+}
+
+func (r *Registry) assignVector(elem Type, src any, dst reflect.Value, depth int) error {
+	if b, ok := src.([]byte); ok {
+		switch {
+		case dst.Kind() == reflect.Slice && dst.Type().Elem().Kind() == reflect.Uint8:
+			dst.Set(reflect.ValueOf(append([]byte{}, b...)).Convert(dst.Type()))
+			return nil
+		case dst.Kind() == reflect.Array && dst.Type().Elem().Kind() == reflect.Uint8:
+			if dst.Len() != len(b) {
+				return fmt.Errorf("cannot assign %d bytes to %s", len(b), dst.Type())
+			}
+			reflect.Copy(dst, reflect.ValueOf(b))
+
+			return nil
+		case dst.Kind() == reflect.String:
+			dst.SetString(string(b))
+			return nil
+		}
+
+		values := make([]any, len(b))
+		for i := range b {
+			values[i] = b[i]
+		}
+		src = values
+	}
+
+	values, ok := src.([]any)
+	if !ok {
+		return fmt.Errorf("cannot assign %T to %s", src, dst.Type())
+	}
+
+	switch dst.Kind() {
+	case reflect.Slice:
+		dst.Set(reflect.MakeSlice(dst.Type(), len(values), len(values)))
+	case reflect.Array:
+		if dst.Len() != len(values) {
+			return fmt.Errorf("cannot assign %d elements to %s", len(values), dst.Type())
+		}
+	default:
+		return fmt.Errorf("cannot assign vector<%s> to %s", elem, dst.Type())
+	}
+
+	for i, value := range values {
+		if err := r.assign(elem, value, dst.Index(i), depth+1); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Registry) assignStruct(tag *StructTag, src any, dst reflect.Value, depth int) error {
+	switch {
+	case tag.Is("0x1", "string", "String"), tag.Is("0x1", "ascii", "String"):
+		s, ok := src.(string)
+		if !ok {
+			return fmt.Errorf("cannot assign %T as %s", src, tag)
+		}
+		if dst.Kind() == reflect.Slice && dst.Type().Elem().Kind() == reflect.Uint8 {
+			dst.SetBytes([]byte(s))
+			return nil
+		}
+
+		return assignValue(s, dst)
+	case tag.Is("0x2", "object", "ID"), tag.Is("0x2", "object", "UID"):
+		return assignAddress(src, dst)
+	case tag.Is("0x1", "option", "Option"):
+		return r.assign(tag.TypeArgs[0], src, dst, depth+1)
+	case tag.Is("0x2", "vec_set", "VecSet"):
+		return r.assign(Vector(tag.TypeArgs[0]), src, dst, depth+1)
+	case tag.Is("0x2", "vec_map", "VecMap"):
+		if dst.Kind() == reflect.Map {
+			return r.assignVecMap(tag, src, dst, depth)
+		}
+
+		return r.assign(Vector(Struct("0x2", "vec_map", "Entry", tag.TypeArgs...)), src, dst, depth+1)
+	}
+
+	layout, err := r.lookup(tag)
+	if err != nil {
+		return err
+	}
+
+	if layout.IsEnum() {
+		return r.assignEnum(tag, layout, src, dst, depth)
+	}
+
+	fields, ok := src.(map[string]any)
+	if !ok {
+		return fmt.Errorf("cannot assign %T as %s", src, tag)
+	}
+
+	return r.assignFields(tag, layout, layout.Fields, fields, dst, depth)
+}
+
+func (r *Registry) assignEnum(tag *StructTag, layout *Layout, src any, dst reflect.Value, depth int) error {
+	value, ok := src.(Enum)
+	if !ok {
+		return fmt.Errorf("cannot assign %T as %s", src, tag)
+	}
+
+	switch {
+	case dst.Type() == enumType:
+		dst.Set(reflect.ValueOf(value))
+		return nil
+	case dst.Kind() == reflect.String:
+		dst.SetString(value.Variant)
+		return nil
+	case dst.Kind() != reflect.Struct:
+		return fmt.Errorf("cannot assign %s to %s", tag, dst.Type())
+	}
+
+	var variant *Variant
+	for i := range layout.Variants {
+		if layout.Variants[i].Name == value.Variant {
+			variant = &layout.Variants[i]
+		}
+	}
+	if variant == nil {
+		return fmt.Errorf("unknown variant %q of %s", value.Variant, tag)
+	}
+
+	// a tagged struct holds one pointer field per variant, only the decoded one is set
+	index, ok := structFields(dst.Type())[normalizeName(value.Variant)]
+	if !ok {
+		return fmt.Errorf("no field of %s matches variant %s of %s", dst.Type(), value.Variant, tag)
+	}
+	dst.Set(reflect.Zero(dst.Type()))
+	field := dst.Field(index)
+	if field.Kind() == reflect.Pointer {
+		field.Set(reflect.New(field.Type().Elem()))
+		field = field.Elem()
+	}
+
+	return r.assignFields(tag, layout, variant.Fields, value.Fields, field, depth)
+}
+
+func (r *Registry) assignFields(tag *StructTag, layout *Layout, declared []Field, src map[string]any, dst reflect.Value, depth int) error {
+	fields, err := instantiate(tag, layout, declared)
+	if err != nil {
+		return err
+	}
+
+	switch dst.Kind() {
+	case reflect.Map:
+		if dst.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("cannot assign %s to %s", tag, dst.Type())
+		}
+		dst.Set(reflect.MakeMapWithSize(dst.Type(), len(fields)))
+		for _, field := range fields {
+			value := reflect.New(dst.Type().Elem()).Elem()
+			if err := r.assign(field.Type, src[field.Name], value, depth+1); err != nil {
+				return fmt.Errorf("field %s of %s: %w", field.Name, tag, err)
+			}
+			dst.SetMapIndex(reflect.ValueOf(field.Name).Convert(dst.Type().Key()), value)
+		}
+
+		return nil
+	case reflect.Struct:
+		indexes := structFields(dst.Type())
+		for _, field := range fields {
+			// Move fields without a Go counterpart are skipped
+			index, ok := indexes[normalizeName(field.Name)]
+			if !ok {
+				continue
+			}
+			if err := checkDeclaredType(dst.Type().Field(index), field.Type); err != nil {
+				return fmt.Errorf("field %s of %s: %w", field.Name, tag, err)
+			}
+			if err := r.assign(field.Type, src[field.Name], dst.Field(index), depth+1); err != nil {
+				return fmt.Errorf("field %s of %s: %w", field.Name, tag, err)
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("cannot assign %s to %s", tag, dst.Type())
+	}
+}
+
+func (r *Registry) assignVecMap(tag *StructTag, src any, dst reflect.Value, depth int) error {
+	entries, ok := src.([]any)
+	if !ok {
+		return fmt.Errorf("cannot assign %T as %s", src, tag)
+	}
+
+	dst.Set(reflect.MakeMapWithSize(dst.Type(), len(entries)))
+	for i, rawEntry := range entries {
+		entry, ok := rawEntry.(map[string]any)
+		if !ok {
+			return fmt.Errorf("entry %d: unexpected %T", i, rawEntry)
+		}
+		key := reflect.New(dst.Type().Key()).Elem()
+		if err := r.assign(tag.TypeArgs[0], entry["key"], key, depth+2); err != nil {
+			return fmt.Errorf("key of entry %d: %w", i, err)
+		}
+		value := reflect.New(dst.Type().Elem()).Elem()
+		if err := r.assign(tag.TypeArgs[1], entry["value"], value, depth+2); err != nil {
+			return fmt.Errorf("value of entry %d: %w", i, err)
+		}
+		dst.SetMapIndex(key, value)
+	}
+
+	return nil
+}
+
+// assignValue assigns src to dst when src is convertible to the type of dst.
+func assignValue(src any, dst reflect.Value) error {
+	value := reflect.ValueOf(src)
+	if !value.Type().ConvertibleTo(dst.Type()) || value.Kind() != dst.Kind() {
+		return fmt.Errorf("cannot assign %T to %s", src, dst.Type())
+	}
+	dst.Set(value.Convert(dst.Type()))
+
+	return nil
+}
+
+func assignInteger(src any, dst reflect.Value) error {
+	n, err := toBigInt(reflect.ValueOf(src))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case dst.Type() == bigIntPointerType:
+		dst.Set(reflect.ValueOf(new(big.Int).Set(n)))
+		return nil
+	case dst.Type() == bigIntType:
+		dst.Set(reflect.ValueOf(*new(big.Int).Set(n)))
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if !n.IsUint64() || dst.OverflowUint(n.Uint64()) {
+			return fmt.Errorf("%s overflows %s", n, dst.Type())
+		}
+		dst.SetUint(n.Uint64())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !n.IsInt64() || dst.OverflowInt(n.Int64()) {
+			return fmt.Errorf("%s overflows %s", n, dst.Type())
+		}
+		dst.SetInt(n.Int64())
+	case reflect.Float32, reflect.Float64:
+		f, _ := new(big.Float).SetInt(n).Float64()
+		dst.SetFloat(f)
+	case reflect.String:
+		dst.SetString(n.String())
+	default:
+		return fmt.Errorf("cannot assign an integer to %s", dst.Type())
+	}
+
+	return nil
+}
+
+func assignAddress(src any, dst reflect.Value) error {
+	address, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("cannot assign %T as an address", src)
+	}
+
+	switch {
+	case dst.Kind() == reflect.String:
+		dst.SetString(address)
+		return nil
+	case (dst.Kind() == reflect.Slice || dst.Kind() == reflect.Array) && dst.Type().Elem().Kind() == reflect.Uint8:
+		b, err := addressBytes(reflect.ValueOf(address))
+		if err != nil {
+			return err
+		}
+		if dst.Kind() == reflect.Slice {
+			dst.SetBytes(b)
+			return nil
+		}
+		if dst.Len() != len(b) {
+			return fmt.Errorf("cannot assign an address to %s", dst.Type())
+		}
+		reflect.Copy(dst, reflect.ValueOf(b))
+
+		return nil
+	default:
+		return fmt.Errorf("cannot assign an address to %s", dst.Type())
+	}
+}
+
+var structFieldsCache sync.Map
+
+// structFields maps the normalized Move field names of a Go struct type to the field indexes. Fields are named by
+// their `sui:"name"` tag, or else by their Go name; `sui:"-"` skips a field.
+func structFields(t reflect.Type) map[string]int {
+	if cached, ok := structFieldsCache.Load(t); ok {
+		return cached.(map[string]int)
+	}
+
+	indexes := make(map[string]int, t.NumField())
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := fieldName(field)
+		if name == "-" {
+			continue
+		}
+		indexes[normalizeName(name)] = i
+	}
+	structFieldsCache.Store(t, indexes)
+
+	return indexes
+}
+
+// fieldName returns the Move name of a Go struct field.
+func fieldName(field reflect.StructField) string {
+	tag, _, _ := parseFieldTag(field)
+
+	return tag.name
+}
+
+// normalizeName folds Move snake_case and Go CamelCase names to a common form.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
@@ -0,0 +1,391 @@
+// Package movebcs encodes and decodes BCS values of arbitrary Move types, driven by the normalized layouts of the
+// Move structs and enums involved. It supports primitives, vectors, Option, String, ID, VecMap, user structs, Move
+// 2024 enums and generic instantiations, and converts between BCS and generic Go values (maps, slices and scalars)
+// or annotated Go structs.
+//
+// Decoded values use the following Go types:
+//
+//	bool                    bool
+//	u8, u16, u32, u64       uint8, uint16, uint32, uint64
+//	u128, u256              *big.Int
+//	address, ID, UID        string, 0x-prefixed 64 hex chars
+//	vector<u8>              []byte
+//	vector<T>               []any
+//	String (std or ascii)   string
+//	Option<T>               nil or the decoded T
+//	VecMap<K, V>            []any of map[string]any{"key": K, "value": V}
+//	enums                   Enum
+//	other structs           map[string]any keyed by field name
+package movebcs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Kind is the kind of a Move type.
+type Kind int
+
+const (
+	KindBool Kind = iota
+	KindU8
+	KindU16
+	KindU32
+	KindU64
+	KindU128
+	KindU256
+	KindAddress
+	KindSigner
+	KindVector
+	KindStruct
+	KindTypeParameter
+)
+
+var primitiveKinds = map[string]Kind{
+	"bool":    KindBool,
+	"u8":      KindU8,
+	"u16":     KindU16,
+	"u32":     KindU32,
+	"u64":     KindU64,
+	"u128":    KindU128,
+	"u256":    KindU256,
+	"address": KindAddress,
+	"signer":  KindSigner,
+}
+
+// Type is a Move type, possibly generic.
+type Type struct {
+	Kind Kind
+	// Elem is the element type of a vector
+	Elem *Type
+	// Struct is the struct or enum of a struct type
+	Struct *StructTag
+	// Index is the index of a type parameter
+	Index int
+}
+
+// StructTag identifies a struct or enum type and its type arguments.
+type StructTag struct {
+	// Address is the normalized (0x-prefixed, 64 hex chars) address of the defining package
+	Address  string
+	Module   string
+	Name     string
+	TypeArgs []Type
+}
+
+// Vector returns the type vector<elem>.
+func Vector(elem Type) Type {
+	return Type{Kind: KindVector, Elem: &elem}
+}
+
+// Struct returns the struct type address::module::name<typeArgs>.
+func Struct(address string, module string, name string, typeArgs ...Type) Type {
+	return Type{Kind: KindStruct, Struct: &StructTag{
+		Address:  NormalizeAddress(address),
+		Module:   module,
+		Name:     name,
+		TypeArgs: typeArgs,
+	}}
+}
+
+func (t Type) String() string {
+	switch t.Kind {
+	case KindVector:
+		return "vector<" + t.Elem.String() + ">"
+	case KindStruct:
+		return t.Struct.String()
+	case KindTypeParameter:
+		return fmt.Sprintf("T%d", t.Index)
+	default:
+		for name, kind := range primitiveKinds {
+			if kind == t.Kind {
+				return name
+			}
+		}
+
+		return "unknown"
+	}
+}
+
+// Is reports whether the type is the struct address::module::name, whatever its type arguments.
+func (t Type) Is(address string, module string, name string) bool {
+	return t.Kind == KindStruct && t.Struct.Is(address, module, name)
+}
+
+// Is reports whether the tag is the struct address::module::name, whatever its type arguments.
+func (s *StructTag) Is(address string, module string, name string) bool {
+	return s.Address == NormalizeAddress(address) && s.Module == module && s.Name == name
+}
+
+// Key identifies the struct regardless of its type arguments.
+func (s *StructTag) Key() string {
+	return s.Address + "::" + s.Module + "::" + s.Name
+}
+
+func (s *StructTag) String() string {
+	tag := ShortAddress(s.Address) + "::" + s.Module + "::" + s.Name
+	if len(s.TypeArgs) == 0 {
+		return tag
+	}
+
+	typeArgs := make([]string, len(s.TypeArgs))
+	for i, typeArg := range s.TypeArgs {
+		typeArgs[i] = typeArg.String()
+	}
+
+	return tag + "<" + strings.Join(typeArgs, ", ") + ">"
+}
+
+// substitute replaces the type parameters of t with the given type arguments.
+func (t Type) substitute(typeArgs []Type) (Type, error) {
+	switch t.Kind {
+	case KindTypeParameter:
+		if t.Index >= len(typeArgs) {
+			return Type{}, fmt.Errorf("type parameter T%d is not instantiated", t.Index)
+		}
+
+		return typeArgs[t.Index], nil
+	case KindVector:
+		elem, err := t.Elem.substitute(typeArgs)
+		if err != nil {
+			return Type{}, err
+		}
+
+		return Vector(elem), nil
+	case KindStruct:
+		if len(t.Struct.TypeArgs) == 0 {
+			return t, nil
+		}
+		substituted := make([]Type, len(t.Struct.TypeArgs))
+		for i, typeArg := range t.Struct.TypeArgs {
+			var err error
+			if substituted[i], err = typeArg.substitute(typeArgs); err != nil {
+				return Type{}, err
+			}
+		}
+
+		return Type{Kind: KindStruct, Struct: &StructTag{
+			Address:  t.Struct.Address,
+			Module:   t.Struct.Module,
+			Name:     t.Struct.Name,
+			TypeArgs: substituted,
+		}}, nil
+	default:
+		return t, nil
+	}
+}
+
+// ParseType parses a Move type string such as `u64`, `vector<address>` or
+// `0x1::option::Option<0x2::vec_map::VecMap<address, u64>>`. References (`&T`, `&mut T`) are parsed as T, and
+// `T0`, `T1`... as type parameters.
+func ParseType(s string) (Type, error) {
+	parser := &typeParser{input: s}
+	t, err := parser.parseType()
+	if err != nil {
+		return Type{}, fmt.Errorf("invalid type %q: %w", s, err)
+	}
+	parser.skipSpaces()
+	if parser.pos != len(parser.input) {
+		return Type{}, fmt.Errorf("invalid type %q: unexpected %q", s, parser.input[parser.pos:])
+	}
+
+	return t, nil
+}
+
+type typeParser struct {
+	input string
+	pos   int
+}
+
+func (p *typeParser) skipSpaces() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *typeParser) consume(token string) bool {
+	p.skipSpaces()
+	if strings.HasPrefix(p.input[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+
+	return false
+}
+
+// identifier reads an identifier or a hex address.
+func (p *typeParser) identifier() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') {
+			p.pos++
+			continue
+		}
+
+		break
+	}
+
+	return p.input[start:p.pos]
+}
+
+func (p *typeParser) parseType() (Type, error) {
+	if p.consume("&") {
+		p.consume("mut ")
+	}
+
+	name := p.identifier()
+	if name == "" {
+		return Type{}, fmt.Errorf("expected a type at offset %d", p.pos)
+	}
+
+	if kind, ok := primitiveKinds[name]; ok {
+		return Type{Kind: kind}, nil
+	}
+
+	if name == "vector" {
+		if !p.consume("<") {
+			return Type{}, fmt.Errorf("expected '<' after vector")
+		}
+		elem, err := p.parseType()
+		if err != nil {
+			return Type{}, err
+		}
+		if !p.consume(">") {
+			return Type{}, fmt.Errorf("expected '>' to close vector")
+		}
+
+		return Vector(elem), nil
+	}
+
+	if !p.consume("::") {
+		if index, ok := parseTypeParameter(name); ok {
+			return Type{Kind: KindTypeParameter, Index: index}, nil
+		}
+
+		return Type{}, fmt.Errorf("unknown type %q", name)
+	}
+
+	module := p.identifier()
+	if module == "" || !p.consume("::") {
+		return Type{}, fmt.Errorf("expected address::module::name")
+	}
+	structName := p.identifier()
+	if structName == "" {
+		return Type{}, fmt.Errorf("expected a struct name")
+	}
+
+	var typeArgs []Type
+	if p.consume("<") {
+		for {
+			typeArg, err := p.parseType()
+			if err != nil {
+				return Type{}, err
+			}
+			typeArgs = append(typeArgs, typeArg)
+			if p.consume(",") {
+				continue
+			}
+			if p.consume(">") {
+				break
+			}
+
+			return Type{}, fmt.Errorf("expected ',' or '>' in type arguments")
+		}
+	}
+
+	return Struct(name, module, structName, typeArgs...), nil
+}
+
+func parseTypeParameter(name string) (int, bool) {
+	if !strings.HasPrefix(name, "T") {
+		return 0, false
+	}
+	index, err := strconv.Atoi(name[1:])
+
+	return index, err == nil
+}
+
+// ParseNormalizedType parses a type of a normalized Move module (`sui_getNormalizedMoveModule`), e.g. `"U64"`,
+// `{"Vector": "U8"}` or `{"Struct": {"address": "0x1", "module": "option", "name": "Option", "typeArguments": [...]}}`.
+// References are parsed as the referenced type.
+func ParseNormalizedType(raw any) (Type, error) {
+	if primitive, ok := raw.(string); ok {
+		kind, ok := primitiveKinds[strings.ToLower(primitive)]
+		if !ok {
+			return Type{}, fmt.Errorf("unknown normalized type %q", primitive)
+		}
+
+		return Type{Kind: kind}, nil
+	}
+
+	fields, ok := raw.(map[string]any)
+	if !ok || len(fields) != 1 {
+		return Type{}, fmt.Errorf("unexpected normalized type %v", raw)
+	}
+
+	for kind, value := range fields {
+		switch kind {
+		case "Reference", "MutableReference":
+			return ParseNormalizedType(value)
+		case "Vector":
+			elem, err := ParseNormalizedType(value)
+			if err != nil {
+				return Type{}, err
+			}
+
+			return Vector(elem), nil
+		case "TypeParameter":
+			index, ok := value.(float64)
+			if !ok {
+				return Type{}, fmt.Errorf("unexpected type parameter %v", value)
+			}
+
+			return Type{Kind: KindTypeParameter, Index: int(index)}, nil
+		case "Struct":
+			structFields, ok := value.(map[string]any)
+			if !ok {
+				return Type{}, fmt.Errorf("unexpected struct type %v", value)
+			}
+			address, _ := structFields["address"].(string)
+			module, _ := structFields["module"].(string)
+			name, _ := structFields["name"].(string)
+			if address == "" || module == "" || name == "" {
+				return Type{}, fmt.Errorf("incomplete struct type %v", value)
+			}
+
+			rawTypeArgs, _ := structFields["typeArguments"].([]any)
+			typeArgs := make([]Type, 0, len(rawTypeArgs))
+			for _, rawTypeArg := range rawTypeArgs {
+				typeArg, err := ParseNormalizedType(rawTypeArg)
+				if err != nil {
+					return Type{}, err
+				}
+				typeArgs = append(typeArgs, typeArg)
+			}
+
+			return Struct(address, module, name, typeArgs...), nil
+		}
+	}
+
+	return Type{}, fmt.Errorf("unexpected normalized type %v", raw)
+}
+
+// NormalizeAddress returns the 0x-prefixed, 64 hex chars, lower case form of an address.
+func NormalizeAddress(address string) string {
+	trimmed := strings.TrimLeft(strings.TrimPrefix(strings.ToLower(address), "0x"), "0")
+
+	return "0x" + strings.Repeat("0", max(0, 64-len(trimmed))) + trimmed
+}
+
+// ShortAddress returns the 0x-prefixed form of an address without leading zeros, e.g. 0x2.
+func ShortAddress(address string) string {
+	trimmed := strings.TrimLeft(strings.TrimPrefix(strings.ToLower(address), "0x"), "0")
+	if trimmed == "" {
+		trimmed = "0"
+	}
+
+	return "0x" + trimmed
+}
@@ -30,6 +30,20 @@ type SuiFunctionParam struct {
 	DefaultValue any
 	// Result from a previous PTB Command (optional). It is used for expressive construction of PTB commands
 	PTBDependency *PTBCommandDependency
+	// Coin (optional) makes the parameter a `Coin<T>` or `Balance<T>` taken from the signer's coins. The amount
+	// is read from the argument named after the parameter, falling back to Coin.Amount.
+	Coin *CoinParam
+}
+
+// CoinParam describes a `Coin<T>` or `Balance<T>` parameter built from the coins owned by the signer. The coins
+// are merged and split inside the PTB, SUI is split from the gas coin.
+type CoinParam struct {
+	// CoinType is the type T of the coin, e.g. "0x2::sui::SUI"
+	CoinType string
+	// Amount used when no argument is provided for the parameter
+	Amount uint64
+	// IntoBalance converts the coin into a `Balance<T>` with `0x2::coin::into_balance`
+	IntoBalance bool
 }
 
 type SuiPTBCommandType string
@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"math/rand"
 	"net/url"
 	"strings"
@@ -210,6 +211,36 @@ func (t *TransactionManagerConfig) setDefaults() {
 
 type BalanceMonitorConfig struct {
 	BalancePollPeriod *string
+
+	// WarnBalance and CriticalBalance are the default SUI balance thresholds of every account
+	WarnBalance     *float64
+	CriticalBalance *float64
+	// AccountThresholds overrides the default thresholds, keyed by account address
+	AccountThresholds map[string]BalanceThresholdsConfig
+	// Coins are additional coin types whose balance is monitored
+	Coins []MonitoredCoinConfig
+	// TopUp enables automatic funding of accounts from a funding account
+	TopUp *BalanceTopUpConfig
+}
+
+type BalanceThresholdsConfig struct {
+	WarnBalance     *float64
+	CriticalBalance *float64
+}
+
+type MonitoredCoinConfig struct {
+	CoinType string
+	Symbol   string
+	Decimals uint8
+}
+
+type BalanceTopUpConfig struct {
+	// FundingPublicKey is the hex encoded public key of the keystore account paying for the top-ups
+	FundingPublicKey string
+	// FloorBalance, TargetBalance and DailySpendCap are expressed in SUI
+	FloorBalance  float64
+	TargetBalance float64
+	DailySpendCap float64
 }
 
 func (b *BalanceMonitorConfig) setDefaults() {
@@ -246,6 +277,19 @@ func (b *BalanceMonitorConfig) setDefaults() {
 //
 // [Sui.BalanceMonitor]
 // BalancePollPeriod = '10s'
+// WarnBalance = 1.0
+// CriticalBalance = 0.1
+//
+// [[Sui.BalanceMonitor.Coins]]
+// CoinType = '0x...::link::LINK'
+// Symbol = 'LINK'
+// Decimals = 9
+//
+// [Sui.BalanceMonitor.TopUp]
+// FundingPublicKey = '...'
+// FloorBalance = 0.5
+// TargetBalance = 2.0
+// DailySpendCap = 10.0
 
 type TOMLConfig struct {
 	// ChainID is a unique identifier for the Sui chain
@@ -332,6 +376,64 @@ func setFromBalanceMonitor(c, f *BalanceMonitorConfig) {
 	if f.BalancePollPeriod != nil {
 		c.BalancePollPeriod = f.BalancePollPeriod
 	}
+	if f.WarnBalance != nil {
+		c.WarnBalance = f.WarnBalance
+	}
+	if f.CriticalBalance != nil {
+		c.CriticalBalance = f.CriticalBalance
+	}
+	// the maps and slices are copied rather than updated in place, as they may be shared with another config
+	if len(f.AccountThresholds) > 0 {
+		accountThresholds := make(map[string]BalanceThresholdsConfig, len(c.AccountThresholds)+len(f.AccountThresholds))
+		maps.Copy(accountThresholds, c.AccountThresholds)
+		c.AccountThresholds = accountThresholds
+	}
+	for account, thresholds := range f.AccountThresholds {
+		merged := c.AccountThresholds[account]
+		if thresholds.WarnBalance != nil {
+			merged.WarnBalance = thresholds.WarnBalance
+		}
+		if thresholds.CriticalBalance != nil {
+			merged.CriticalBalance = thresholds.CriticalBalance
+		}
+		c.AccountThresholds[account] = merged
+	}
+	// coins are identified by their type, an override replaces the coin of the same type
+	if len(f.Coins) > 0 {
+		c.Coins = slices.Clone(c.Coins)
+	}
+	for _, coin := range f.Coins {
+		i := slices.IndexFunc(c.Coins, func(existing MonitoredCoinConfig) bool {
+			return existing.CoinType == coin.CoinType
+		})
+		if i < 0 {
+			c.Coins = append(c.Coins, coin)
+		} else {
+			c.Coins[i] = coin
+		}
+	}
+	if f.TopUp != nil {
+		if c.TopUp == nil {
+			c.TopUp = &BalanceTopUpConfig{}
+		}
+		setFromBalanceTopUp(c.TopUp, f.TopUp)
+	}
+}
+
+// setFromBalanceTopUp merges the top-up fields set in f, the zero value of a field meaning it is unset
+func setFromBalanceTopUp(c, f *BalanceTopUpConfig) {
+	if f.FundingPublicKey != "" {
+		c.FundingPublicKey = f.FundingPublicKey
+	}
+	if f.FloorBalance != 0 {
+		c.FloorBalance = f.FloorBalance
+	}
+	if f.TargetBalance != 0 {
+		c.TargetBalance = f.TargetBalance
+	}
+	if f.DailySpendCap != 0 {
+		c.DailySpendCap = f.DailySpendCap
+	}
 }
 
 func (c *TOMLConfig) ValidateConfig() error {
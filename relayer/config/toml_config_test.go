@@ -0,0 +1,107 @@
+//go:build unit
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/config"
+)
+
+const baseConfig = `
+ChainID = '4'
+
+[[Nodes]]
+Name = 'primary'
+URL = 'https://fullnode.devnet.sui.io:443'
+
+[BalanceMonitor]
+WarnBalance = 1.0
+CriticalBalance = 0.1
+
+[BalanceMonitor.AccountThresholds.0xa]
+WarnBalance = 5.0
+CriticalBalance = 0.5
+
+[[BalanceMonitor.Coins]]
+CoinType = '0x1::link::LINK'
+Symbol = 'LINK'
+Decimals = 9
+
+[BalanceMonitor.TopUp]
+FundingPublicKey = 'aa'
+FloorBalance = 0.5
+TargetBalance = 2.0
+DailySpendCap = 10.0
+`
+
+const overrideConfig = `
+[BalanceMonitor]
+BalancePollPeriod = '30s'
+CriticalBalance = 0.2
+
+[BalanceMonitor.AccountThresholds.0xa]
+WarnBalance = 6.0
+
+[BalanceMonitor.AccountThresholds.0xb]
+CriticalBalance = 0.3
+
+[[BalanceMonitor.Coins]]
+CoinType = '0x1::link::LINK'
+Symbol = 'LINK'
+Decimals = 8
+
+[[BalanceMonitor.Coins]]
+CoinType = '0x2::usdc::USDC'
+Symbol = 'USDC'
+Decimals = 6
+
+[BalanceMonitor.TopUp]
+TargetBalance = 3.0
+`
+
+func TestTOMLConfigSetFromBalanceMonitor(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := config.NewDecodedTOMLConfig(baseConfig)
+	require.NoError(t, err)
+	var override config.TOMLConfig
+	require.NoError(t, toml.Unmarshal([]byte(overrideConfig), &override))
+
+	cfg.SetFrom(&override)
+
+	ptr := func(v float64) *float64 { return &v }
+	monitor := cfg.BalanceMonitor
+	assert.Equal(t, "30s", *monitor.BalancePollPeriod)
+	assert.Equal(t, ptr(1.0), monitor.WarnBalance)
+	assert.Equal(t, ptr(0.2), monitor.CriticalBalance)
+	assert.Equal(t, map[string]config.BalanceThresholdsConfig{
+		"0xa": {WarnBalance: ptr(6.0), CriticalBalance: ptr(0.5)},
+		"0xb": {CriticalBalance: ptr(0.3)},
+	}, monitor.AccountThresholds)
+	assert.Equal(t, []config.MonitoredCoinConfig{
+		{CoinType: "0x1::link::LINK", Symbol: "LINK", Decimals: 8},
+		{CoinType: "0x2::usdc::USDC", Symbol: "USDC", Decimals: 6},
+	}, monitor.Coins)
+	assert.Equal(t, &config.BalanceTopUpConfig{
+		FundingPublicKey: "aa",
+		FloorBalance:     0.5,
+		TargetBalance:    3.0,
+		DailySpendCap:    10.0,
+	}, monitor.TopUp)
+
+	// the merged config survives a round trip through TOML
+	encoded, err := cfg.TOMLString()
+	require.NoError(t, err)
+	decoded, err := config.NewDecodedTOMLConfig(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, cfg.BalanceMonitor, decoded.BalanceMonitor)
+
+	// setting a config from itself changes nothing
+	decoded.SetFrom(decoded)
+	assert.Equal(t, cfg.BalanceMonitor, decoded.BalanceMonitor)
+}
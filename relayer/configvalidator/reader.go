@@ -0,0 +1,143 @@
+package configvalidator
+
+import (
+	"context"
+	"fmt"
+
+	crConfig "github.com/smartcontractkit/chainlink-sui/relayer/chainreader/config"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
+)
+
+// ValidateChainReaderConfig checks the functions and events of every module of the config bound to a package,
+// boundPackageIds mapping the module keys of the config to package IDs as in ContractReader.Bind: the functions
+// exist and are callable, their param counts and types match, tuple results have a field per returned value, event
+// selectors resolve to an event struct, and the `sui` tags of the configured ResultType and DataType match the
+// returned values and event structs. Modules without a bound package are skipped.
+func (v *Validator) ValidateChainReaderConfig(ctx context.Context, config crConfig.ChainReaderConfig, boundPackageIds map[string]string) *Report {
+	report := &Report{}
+
+	for _, moduleKey := range sortedKeys(config.Modules) {
+		packageId, bound := boundPackageIds[moduleKey]
+		if !bound {
+			continue
+		}
+
+		module := config.Modules[moduleKey]
+		if module == nil {
+			report.addf("reader."+moduleKey, "module config is empty")
+			continue
+		}
+
+		moduleName := moduleKey
+		if module.Name != "" {
+			moduleName = module.Name
+		}
+
+		for _, functionKey := range sortedKeys(module.Functions) {
+			v.validateReaderFunction(ctx, report, fmt.Sprintf("reader.%s.functions.%s", moduleKey, functionKey),
+				packageId, moduleName, functionKey, module.Functions[functionKey])
+		}
+
+		for _, eventKey := range sortedKeys(module.Events) {
+			v.validateReaderEvent(ctx, report, fmt.Sprintf("reader.%s.events.%s", moduleKey, eventKey),
+				packageId, module.Events[eventKey])
+		}
+	}
+
+	return report
+}
+
+func (v *Validator) validateReaderFunction(
+	ctx context.Context,
+	report *Report,
+	path string,
+	packageId string,
+	moduleName string,
+	functionKey string,
+	function *crConfig.ChainReaderFunction,
+) {
+	if function == nil {
+		report.addf(path, "function config is empty")
+		return
+	}
+
+	functionName := functionKey
+	if function.Name != "" {
+		functionName = function.Name
+	}
+
+	called, ok := v.function(ctx, report, path, packageId, moduleName, functionName)
+	if !ok {
+		return
+	}
+
+	if len(function.Params) != len(called.parameters) {
+		report.addf(path, "%s::%s takes %d params, %d configured", moduleName, functionName, len(called.parameters), len(function.Params))
+	} else {
+		for i, param := range function.Params {
+			if problem := checkParamType(param, called.parameters[i]); problem != "" {
+				report.addf(fmt.Sprintf("%s.params[%d](%s)", path, i, param.Name), "%s", problem)
+			}
+		}
+	}
+
+	if len(function.ResultTupleToStruct) > 0 && len(function.ResultTupleToStruct) != len(called.returns) {
+		report.addf(path, "ResultTupleToStruct has %d fields, %s::%s returns %d values",
+			len(function.ResultTupleToStruct), moduleName, functionName, len(called.returns))
+
+		return
+	}
+
+	if function.ResultType == nil {
+		return
+	}
+
+	switch {
+	case len(function.ResultTupleToStruct) > 0:
+		fields := make([]movebcs.Field, len(called.returns))
+		for i, returnType := range called.returns {
+			fields[i] = movebcs.Field{Name: function.ResultTupleToStruct[i], Type: returnType.Type}
+		}
+		v.validateFieldTags(ctx, report, path+".ResultType", function.ResultType, fields)
+	case len(called.returns) == 1:
+		v.validateStructTags(ctx, report, path+".ResultType", function.ResultType, called.returns[0].Type)
+	default:
+		report.addf(path+".ResultType", "%s::%s returns %d values, use ResultTupleToStruct to decode them into a struct",
+			moduleName, functionName, len(called.returns))
+	}
+}
+
+func (v *Validator) validateReaderEvent(
+	ctx context.Context,
+	report *Report,
+	path string,
+	packageId string,
+	event *crConfig.ChainReaderEvent,
+) {
+	if event == nil {
+		report.addf(path, "event config is empty")
+		return
+	}
+
+	// the chain reader always queries the events of the bound package, using the configured module and event
+	eventModule, eventName := event.Module, event.Event
+	if eventModule == "" || eventName == "" {
+		report.addf(path, "event selector requires a module and an event")
+		return
+	}
+
+	module, err := v.module(ctx, packageId, eventModule)
+	if err != nil {
+		report.addErrf(path, err, "failed to get module %s::%s: %v", packageId, eventModule)
+		return
+	}
+
+	if _, ok := module.Structs[eventName]; !ok {
+		report.addf(path, "event struct %s not found in module %s::%s", eventName, packageId, eventModule)
+		return
+	}
+
+	if event.DataType != nil {
+		v.validateStructTags(ctx, report, path+".DataType", event.DataType, movebcs.Struct(packageId, eventModule, eventName))
+	}
+}
@@ -0,0 +1,98 @@
+package configvalidator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
+)
+
+// ModuleJSONSource is implemented by module sources that also provide the raw JSON of normalized modules, which
+// unlike the SDK model holds the enums of Move 2024 modules. client.PTBClient implements it. The `sui` tags of
+// types holding enums can only be validated against such a source.
+type ModuleJSONSource interface {
+	GetNormalizedModuleJSON(ctx context.Context, packageId string, moduleName string) ([]byte, error)
+}
+
+// validateStructTags validates the `sui` tags of the Go struct type of sample against the layout of a Move type.
+func (v *Validator) validateStructTags(ctx context.Context, report *Report, path string, sample any, t movebcs.Type) {
+	goType := reflect.TypeOf(sample)
+	if !movebcs.HasTags(goType) {
+		return
+	}
+
+	if err := v.resolveType(ctx, t); err != nil {
+		report.addErrf(path, err, "failed to resolve %s: %v", t)
+		return
+	}
+
+	addTagProblems(report, path, v.types.Validate(goType, t))
+}
+
+// validateFieldTags validates the `sui` tags of the Go struct type of sample against named values, e.g. the values
+// of a tuple or the params of a function.
+func (v *Validator) validateFieldTags(ctx context.Context, report *Report, path string, sample any, fields []movebcs.Field) {
+	goType := reflect.TypeOf(sample)
+	if !movebcs.HasTags(goType) {
+		return
+	}
+
+	for _, field := range fields {
+		if err := v.resolveType(ctx, field.Type); err != nil {
+			report.addErrf(path, err, "failed to resolve %s: %v", field.Type)
+			return
+		}
+	}
+
+	addTagProblems(report, path, v.types.ValidateFields(goType, fields))
+}
+
+func addTagProblems(report *Report, path string, err error) {
+	if err == nil {
+		return
+	}
+
+	for _, problem := range strings.Split(err.Error(), "\n") {
+		report.Problems = append(report.Problems, Problem{Path: path, Message: problem, Tags: true})
+	}
+}
+
+// resolveType registers the layouts of all the structs and enums a Move type references.
+func (v *Validator) resolveType(ctx context.Context, t movebcs.Type) error {
+	// registering a layout may reveal the types of its fields, so repeat until every type is known
+	for missing := v.types.Missing(t); len(missing) > 0; missing = v.types.Missing(t) {
+		for _, tag := range missing {
+			key := moduleKey(tag.Address, tag.Module)
+			if v.registered[key] {
+				return fmt.Errorf("type %s not found in its module", tag.String())
+			}
+			v.registered[key] = true
+
+			if err := v.registerModule(ctx, tag.Address, tag.Module); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) registerModule(ctx context.Context, packageId string, moduleName string) error {
+	if source, ok := v.modules.(ModuleJSONSource); ok {
+		normalizedModule, err := source.GetNormalizedModuleJSON(ctx, packageId, moduleName)
+		if err != nil {
+			return &moduleUnavailableError{err: err}
+		}
+
+		return v.types.AddNormalizedModuleJSON(normalizedModule)
+	}
+
+	module, err := v.module(ctx, packageId, moduleName)
+	if err != nil {
+		return err
+	}
+
+	return v.types.AddNormalizedStructs(packageId, moduleName, module.Structs)
+}
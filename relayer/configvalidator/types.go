@@ -0,0 +1,220 @@
+package configvalidator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
+)
+
+// moveType is a parsed normalized Move type, with the reference it is passed by.
+type moveType struct {
+	movebcs.Type
+	// reference is "", "Reference" or "MutableReference"
+	reference string
+}
+
+func (t moveType) String() string {
+	prefix := ""
+	switch t.reference {
+	case "Reference":
+		prefix = "&"
+	case "MutableReference":
+		prefix = "&mut "
+	}
+
+	return prefix + typeString(t.Type)
+}
+
+// typeString formats a type with its structs named by their module only, e.g. vector<counter::Counter>.
+func typeString(t movebcs.Type) string {
+	switch t.Kind {
+	case movebcs.KindVector:
+		return "vector<" + typeString(*t.Elem) + ">"
+	case movebcs.KindStruct:
+		return t.Struct.Module + "::" + t.Struct.Name
+	default:
+		return t.String()
+	}
+}
+
+func (t moveType) isPrimitive() bool {
+	return t.Kind < movebcs.KindVector
+}
+
+func (t moveType) elem() moveType {
+	return moveType{Type: *t.Elem}
+}
+
+func (t moveType) isTxContext() bool {
+	return t.Is("0x2", "tx_context", "TxContext")
+}
+
+func (t moveType) isString() bool {
+	return t.Is("0x1", "string", "String") || t.Is("0x1", "ascii", "String")
+}
+
+// isPure reports whether values of the type are passed as pure (BCS encoded) inputs rather than objects.
+func (t moveType) isPure() bool {
+	switch t.Kind {
+	case movebcs.KindVector:
+		return t.elem().isPure()
+	case movebcs.KindStruct:
+		return t.isString() || t.Is("0x2", "object", "ID") || t.Is("0x1", "option", "Option")
+	case movebcs.KindTypeParameter:
+		return false
+	default:
+		return true
+	}
+}
+
+// parseMoveType parses a type of a normalized module with movebcs.ParseNormalizedType, keeping the reference it is
+// passed by.
+func parseMoveType(raw any) (moveType, error) {
+	var reference string
+	if fields, ok := raw.(map[string]any); ok && len(fields) == 1 {
+		for kind := range fields {
+			if kind == "Reference" || kind == "MutableReference" {
+				reference = kind
+			}
+		}
+	}
+
+	parsed, err := movebcs.ParseNormalizedType(raw)
+	if err != nil {
+		return moveType{}, err
+	}
+
+	return moveType{Type: parsed, reference: reference}, nil
+}
+
+// normalizedFunction is a parsed exposed function of a normalized module.
+type normalizedFunction struct {
+	visibility     string
+	isEntry        bool
+	typeParameters int
+	// parameters excludes the trailing TxContext, which is provided by the runtime
+	parameters []moveType
+	returns    []moveType
+}
+
+func (f *normalizedFunction) callable() bool {
+	return f.visibility == "Public" || f.isEntry
+}
+
+func parseFunction(raw any) (*normalizedFunction, error) {
+	fields, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected normalized function %v", raw)
+	}
+
+	function := &normalizedFunction{}
+	function.visibility, _ = fields["visibility"].(string)
+	function.isEntry, _ = fields["isEntry"].(bool)
+	if typeParameters, ok := fields["typeParameters"].([]any); ok {
+		function.typeParameters = len(typeParameters)
+	}
+
+	parameters, _ := fields["parameters"].([]any)
+	for _, parameter := range parameters {
+		parsed, err := parseMoveType(parameter)
+		if err != nil {
+			return nil, err
+		}
+		if parsed.isTxContext() {
+			continue
+		}
+		function.parameters = append(function.parameters, parsed)
+	}
+
+	returns, _ := fields["return"].([]any)
+	for _, returned := range returns {
+		parsed, err := parseMoveType(returned)
+		if err != nil {
+			return nil, err
+		}
+		function.returns = append(function.returns, parsed)
+	}
+
+	return function, nil
+}
+
+// checkParamType reports why a configured param type cannot be used for a Move parameter, or "" if it can.
+func checkParamType(param codec.SuiFunctionParam, parameter moveType) string {
+	// generic parameters can hold any value
+	if parameter.Kind == movebcs.KindTypeParameter {
+		return ""
+	}
+
+	if param.Coin != nil {
+		if parameter.Is("0x2", "coin", "Coin") || parameter.Is("0x2", "balance", "Balance") {
+			return ""
+		}
+
+		return fmt.Sprintf("coin param used for parameter of type %s", parameter)
+	}
+
+	if param.IsMutable != nil && !*param.IsMutable && parameter.reference == "MutableReference" {
+		return fmt.Sprintf("param is not mutable but the parameter is %s", parameter)
+	}
+
+	if !compatibleType(param.Type, parameter) {
+		return fmt.Sprintf("type %q does not match parameter type %s", param.Type, parameter)
+	}
+
+	return ""
+}
+
+// compatibleType reports whether a config param type can encode a value of the Move type.
+func compatibleType(configType string, parameter moveType) bool {
+	if parameter.Kind == movebcs.KindTypeParameter {
+		return true
+	}
+
+	switch strings.ToLower(configType) {
+	case "object_id", "objectid":
+		return !parameter.isPure()
+	case "string":
+		return parameter.isString()
+	case "address":
+		return parameter.Kind == movebcs.KindAddress || parameter.Is("0x2", "object", "ID")
+	case "u8", "u16", "u32", "u64", "u128", "u256", "bool":
+		return parameter.reference == "" && parameter.isPrimitive() && parameter.Type.String() == strings.ToLower(configType)
+	}
+
+	if strings.HasPrefix(configType, "vector<") && strings.HasSuffix(configType, ">") {
+		elementType := configType[len("vector<") : len(configType)-1]
+		switch {
+		case parameter.Kind == movebcs.KindVector:
+			return compatibleType(elementType, parameter.elem())
+		case parameter.Is("0x1", "option", "Option") && len(parameter.Struct.TypeArgs) == 1:
+			// an option is encoded like a vector of at most one element
+			return compatibleType(elementType, moveType{Type: parameter.Struct.TypeArgs[0]})
+		case elementType == "u8":
+			// strings are encoded like byte vectors, and address strings are encoded as addresses by the
+			// pure argument encoder whatever the configured type
+			return parameter.isString() || parameter.Kind == movebcs.KindAddress || parameter.Is("0x2", "object", "ID")
+		}
+	}
+
+	return false
+}
+
+// compatibleResult reports whether the result of a command can be passed to a parameter, ignoring references.
+func compatibleResult(result moveType, parameter moveType) bool {
+	if result.Kind == movebcs.KindTypeParameter || parameter.Kind == movebcs.KindTypeParameter {
+		return true
+	}
+
+	switch {
+	case result.isPrimitive() || parameter.isPrimitive():
+		return result.Kind == parameter.Kind
+	case result.Kind == movebcs.KindVector || parameter.Kind == movebcs.KindVector:
+		return result.Kind == parameter.Kind && compatibleResult(result.elem(), parameter.elem())
+	case result.Kind == movebcs.KindStruct && parameter.Kind == movebcs.KindStruct:
+		return parameter.Is(result.Struct.Address, result.Struct.Module, result.Struct.Name)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,243 @@
+// Package configvalidator statically validates chain writer and chain reader configs against the normalized Move
+// modules they call, so that a typo in a function or param name, a wrong PTB dependency or an incorrect param type
+// is reported when the config is loaded instead of at the first submission.
+package configvalidator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/block-vision/sui-go-sdk/models"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
+)
+
+// ModuleSource provides the normalized modules configs are validated against. client.SuiPTBClient implements it
+// to validate against the chain, StaticModules to validate offline.
+type ModuleSource interface {
+	GetNormalizedModule(ctx context.Context, packageId string, moduleId string) (models.GetNormalizedMoveModuleResponse, error)
+}
+
+// StaticModules serves normalized modules from memory, keyed by package ID and module name. It is used to validate
+// configs offline against saved `sui_getNormalizedMoveModule` or `sui_getNormalizedMoveModulesByPackage` responses.
+type StaticModules map[string]models.GetNormalizedMoveModuleResponse
+
+// Add registers a normalized module under the given package ID.
+func (m StaticModules) Add(packageId string, module models.GetNormalizedMoveModuleResponse) {
+	m[moduleKey(packageId, module.Name)] = module
+}
+
+func (m StaticModules) GetNormalizedModule(_ context.Context, packageId string, moduleId string) (models.GetNormalizedMoveModuleResponse, error) {
+	module, ok := m[moduleKey(packageId, moduleId)]
+	if !ok {
+		return models.GetNormalizedMoveModuleResponse{}, fmt.Errorf("module %s::%s not found", packageId, moduleId)
+	}
+
+	return module, nil
+}
+
+// LoadStaticModules reads saved normalized modules from JSON files. A file holds either a single normalized module
+// or the normalized modules of a package keyed by module name. Modules are registered under their `address`, use
+// StaticModules.Add to register them under another package ID (e.g. an upgraded package).
+func LoadStaticModules(paths ...string) (StaticModules, error) {
+	modules := StaticModules{}
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read normalized modules from %s: %w", path, err)
+		}
+
+		var module models.GetNormalizedMoveModuleResponse
+		if err := json.Unmarshal(content, &module); err == nil && module.Name != "" {
+			modules.Add(module.Address, module)
+			continue
+		}
+
+		var packageModules map[string]models.GetNormalizedMoveModuleResponse
+		if err := json.Unmarshal(content, &packageModules); err != nil {
+			return nil, fmt.Errorf("failed to parse normalized modules from %s: %w", path, err)
+		}
+		for _, module := range packageModules {
+			modules.Add(module.Address, module)
+		}
+	}
+
+	return modules, nil
+}
+
+// Problem is a single config issue, located by a path in the config, e.g.
+// `writer.OffRamp.commit.commands[0].params[2]`.
+type Problem struct {
+	Path    string
+	Message string
+	// ModuleUnavailable is set when the problem is caused by a module that could not be fetched, e.g. because its
+	// package is not deployed yet
+	ModuleUnavailable bool
+	// Tags is set when the `sui` tags of a Go type do not match the Move layout it is decoded from
+	Tags bool
+}
+
+func (p Problem) String() string {
+	return p.Path + ": " + p.Message
+}
+
+// Report collects all the problems found in a config.
+type Report struct {
+	Problems []Problem
+}
+
+func (r *Report) addf(path string, format string, args ...any) {
+	r.Problems = append(r.Problems, Problem{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// addErrf adds a problem caused by err, formatted as the last argument of format.
+func (r *Report) addErrf(path string, err error, format string, args ...any) {
+	var unavailable *moduleUnavailableError
+	r.Problems = append(r.Problems, Problem{
+		Path:              path,
+		Message:           fmt.Sprintf(format, append(args, err)...),
+		ModuleUnavailable: errors.As(err, &unavailable),
+	})
+}
+
+// Err returns an error listing every problem, or nil if the config is valid.
+func (r *Report) Err() error {
+	return problemsErr(r.Problems)
+}
+
+// ErrIgnoringUnavailableModules returns an error listing every problem not caused by a module that could not be
+// fetched, or nil if there are none. It is used to fail on invalid configs while packages may still be deployed
+// later.
+func (r *Report) ErrIgnoringUnavailableModules() error {
+	problems := make([]Problem, 0, len(r.Problems))
+	for _, problem := range r.Problems {
+		if !problem.ModuleUnavailable {
+			problems = append(problems, problem)
+		}
+	}
+
+	return problemsErr(problems)
+}
+
+// TagsErr returns an error listing every mismatch between `sui` tags and Move layouts, or nil if there are none.
+// Unlike the other problems, a mismatch is a bug of the Go types that no deployment fixes.
+func (r *Report) TagsErr() error {
+	problems := make([]Problem, 0, len(r.Problems))
+	for _, problem := range r.Problems {
+		if problem.Tags {
+			problems = append(problems, problem)
+		}
+	}
+
+	return problemsErr(problems)
+}
+
+func problemsErr(problems []Problem) error {
+	if len(problems) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(problems))
+	for _, problem := range problems {
+		lines = append(lines, problem.String())
+	}
+
+	return errors.New("invalid config:\n" + strings.Join(lines, "\n"))
+}
+
+// moduleUnavailableError wraps the error of a module that could not be fetched.
+type moduleUnavailableError struct {
+	err error
+}
+
+func (e *moduleUnavailableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *moduleUnavailableError) Unwrap() error {
+	return e.err
+}
+
+// Validator validates configs against the normalized modules of a ModuleSource. Modules are fetched once per
+// validator.
+type Validator struct {
+	modules ModuleSource
+	cache   map[string]models.GetNormalizedMoveModuleResponse
+	// types holds the layouts `sui` struct tags are validated against, registered holds the modules added to it
+	types      *movebcs.Registry
+	registered map[string]bool
+}
+
+func NewValidator(modules ModuleSource) *Validator {
+	return &Validator{
+		modules:    modules,
+		cache:      make(map[string]models.GetNormalizedMoveModuleResponse),
+		types:      movebcs.NewRegistry(),
+		registered: make(map[string]bool),
+	}
+}
+
+func (v *Validator) module(ctx context.Context, packageId string, moduleName string) (models.GetNormalizedMoveModuleResponse, error) {
+	key := moduleKey(packageId, moduleName)
+	if module, ok := v.cache[key]; ok {
+		return module, nil
+	}
+
+	module, err := v.modules.GetNormalizedModule(ctx, packageId, moduleName)
+	if err != nil {
+		return models.GetNormalizedMoveModuleResponse{}, &moduleUnavailableError{err: err}
+	}
+	v.cache[key] = module
+
+	return module, nil
+}
+
+// function looks up a function of a module and checks that it can be called from a PTB, reporting problems at path.
+func (v *Validator) function(
+	ctx context.Context,
+	report *Report,
+	path string,
+	packageId string,
+	moduleName string,
+	functionName string,
+) (*normalizedFunction, bool) {
+	module, err := v.module(ctx, packageId, moduleName)
+	if err != nil {
+		report.addErrf(path, err, "failed to get module %s::%s: %v", packageId, moduleName)
+		return nil, false
+	}
+
+	raw, ok := module.ExposedFunctions[functionName]
+	if !ok {
+		report.addf(path, "function %s not found in module %s::%s", functionName, packageId, moduleName)
+		return nil, false
+	}
+
+	function, err := parseFunction(raw)
+	if err != nil {
+		report.addf(path, "failed to parse function %s::%s: %v", moduleName, functionName, err)
+		return nil, false
+	}
+
+	if !function.callable() {
+		report.addf(path, "function %s::%s is not callable from a transaction (visibility %s)", moduleName, functionName, function.visibility)
+		return nil, false
+	}
+
+	return function, true
+}
+
+// moduleKey identifies a module, normalizing the package ID so that short and zero-padded IDs match.
+func moduleKey(packageId string, moduleName string) string {
+	return normalizeAddress(packageId) + "::" + moduleName
+}
+
+func normalizeAddress(address string) string {
+	trimmed := strings.TrimLeft(strings.TrimPrefix(strings.ToLower(address), "0x"), "0")
+
+	return "0x" + strings.Repeat("0", max(0, 64-len(trimmed))) + trimmed
+}
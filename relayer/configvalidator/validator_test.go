@@ -0,0 +1,443 @@
+//go:build unit
+
+package configvalidator
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	crConfig "github.com/smartcontractkit/chainlink-sui/relayer/chainreader/config"
+	cwConfig "github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/config"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec"
+)
+
+const testPackageId = "0x0000000000000000000000000000000000000000000000000000000000000abc"
+
+func structTypeJSON(address string, module string, name string, typeArguments ...any) map[string]any {
+	if typeArguments == nil {
+		typeArguments = []any{}
+	}
+
+	return map[string]any{"Struct": map[string]any{
+		"address":       address,
+		"module":        module,
+		"name":          name,
+		"typeArguments": typeArguments,
+	}}
+}
+
+func functionJSON(visibility string, isEntry bool, typeParameters int, parameters []any, returns []any) map[string]any {
+	abilities := make([]any, typeParameters)
+	for i := range abilities {
+		abilities[i] = map[string]any{"abilities": []any{}}
+	}
+
+	return map[string]any{
+		"visibility":     visibility,
+		"isEntry":        isEntry,
+		"typeParameters": abilities,
+		"parameters":     parameters,
+		"return":         returns,
+	}
+}
+
+func testModules() StaticModules {
+	txContext := map[string]any{"MutableReference": structTypeJSON("0x2", "tx_context", "TxContext")}
+	counter := structTypeJSON(testPackageId, "counter", "Counter")
+
+	modules := StaticModules{}
+	modules.Add("0xabc", models.GetNormalizedMoveModuleResponse{
+		Address: testPackageId,
+		Name:    "counter",
+		Structs: map[string]any{
+			"Counter": map[string]any{},
+			"CounterIncremented": map[string]any{
+				"typeParameters": []any{},
+				"fields": []any{
+					map[string]any{"name": "counter_id", "type": structTypeJSON("0x2", "object", "ID")},
+					map[string]any{"name": "new_value", "type": "U64"},
+				},
+			},
+		},
+		ExposedFunctions: map[string]any{
+			"increment": functionJSON("Public", false, 0,
+				[]any{map[string]any{"MutableReference": counter}, txContext}, []any{}),
+			"increment_by": functionJSON("Public", false, 0,
+				[]any{map[string]any{"MutableReference": counter}, "U64", txContext}, []any{}),
+			"get_count": functionJSON("Public", false, 0,
+				[]any{map[string]any{"Reference": counter}}, []any{"U64"}),
+			"get_counts": functionJSON("Public", false, 0,
+				[]any{map[string]any{"Reference": counter}}, []any{"U64", "U64"}),
+			"get_label": functionJSON("Public", false, 0,
+				[]any{}, []any{structTypeJSON("0x1", "string", "String")}),
+			"deposit": functionJSON("Public", false, 1,
+				[]any{map[string]any{"MutableReference": counter}, structTypeJSON("0x2", "coin", "Coin", map[string]any{"TypeParameter": float64(0)})},
+				[]any{}),
+			"internal_reset": functionJSON("Private", false, 0,
+				[]any{map[string]any{"MutableReference": counter}}, []any{}),
+		},
+	})
+
+	return modules
+}
+
+func strPtr(s string) *string { return &s }
+
+func moveCall(function string, params ...codec.SuiFunctionParam) cwConfig.ChainWriterPTBCommand {
+	return cwConfig.ChainWriterPTBCommand{
+		Type:      codec.SuiPTBCommandMoveCall,
+		PackageId: strPtr("0xabc"),
+		ModuleId:  strPtr("counter"),
+		Function:  strPtr(function),
+		Params:    params,
+	}
+}
+
+func writerConfig(function *cwConfig.ChainWriterFunction) cwConfig.ChainWriterConfig {
+	return cwConfig.ChainWriterConfig{
+		Modules: map[string]*cwConfig.ChainWriterModule{
+			"counter": {
+				Name:      "counter",
+				ModuleID:  "0xabc",
+				Functions: map[string]*cwConfig.ChainWriterFunction{"fn": function},
+			},
+		},
+	}
+}
+
+func problemPaths(report *Report) []string {
+	paths := make([]string, 0, len(report.Problems))
+	for _, problem := range report.Problems {
+		paths = append(paths, problem.Path)
+	}
+
+	return paths
+}
+
+func TestValidateChainWriterConfig(t *testing.T) {
+	t.Parallel()
+
+	counterParam := codec.SuiFunctionParam{Name: "counter", Type: "object_id"}
+
+	tests := []struct {
+		name     string
+		function *cwConfig.ChainWriterFunction
+		problems []string
+	}{
+		{
+			name: "valid config",
+			function: &cwConfig.ChainWriterFunction{
+				PrerequisiteObjects: []cwConfig.PrerequisiteObject{{Name: "counter", Tag: "counter::Counter"}},
+				PTBCommands: []cwConfig.ChainWriterPTBCommand{
+					moveCall("get_count", counterParam),
+					moveCall("increment_by", counterParam, codec.SuiFunctionParam{
+						Name:          "amount",
+						Type:          "ptb_dependency",
+						PTBDependency: &codec.PTBCommandDependency{CommandIndex: 0},
+					}),
+					moveCall("deposit", counterParam, codec.SuiFunctionParam{
+						Name:        "coin",
+						GenericType: strPtr("0x2::sui::SUI"),
+						Coin:        &codec.CoinParam{CoinType: "0x2::sui::SUI"},
+					}),
+				},
+			},
+		},
+		{
+			name: "missing and private functions",
+			function: &cwConfig.ChainWriterFunction{
+				PTBCommands: []cwConfig.ChainWriterPTBCommand{
+					moveCall("incremnt", counterParam),
+					moveCall("internal_reset", counterParam),
+				},
+			},
+			problems: []string{"writer.counter.fn.commands[0]", "writer.counter.fn.commands[1]"},
+		},
+		{
+			name: "wrong param count and types",
+			function: &cwConfig.ChainWriterFunction{
+				PTBCommands: []cwConfig.ChainWriterPTBCommand{
+					moveCall("increment"),
+					moveCall("increment_by", counterParam, codec.SuiFunctionParam{Name: "amount", Type: "u8"}),
+					moveCall("increment_by", codec.SuiFunctionParam{Name: "counter", Type: "u64"}, codec.SuiFunctionParam{Name: "amount", Type: "u64"}),
+					moveCall("increment", codec.SuiFunctionParam{Name: "counter", Type: "object_id", IsMutable: new(bool)}),
+				},
+			},
+			problems: []string{
+				"writer.counter.fn.commands[0]",
+				"writer.counter.fn.commands[1].params[1](amount)",
+				"writer.counter.fn.commands[2].params[0](counter)",
+				"writer.counter.fn.commands[3].params[0](counter)",
+			},
+		},
+		{
+			name: "missing generic type",
+			function: &cwConfig.ChainWriterFunction{
+				PTBCommands: []cwConfig.ChainWriterPTBCommand{
+					moveCall("deposit", counterParam, codec.SuiFunctionParam{Name: "coin", Type: "object_id"}),
+				},
+			},
+			problems: []string{"writer.counter.fn.commands[0]"},
+		},
+		{
+			name: "bad dependencies",
+			function: &cwConfig.ChainWriterFunction{
+				PTBCommands: []cwConfig.ChainWriterPTBCommand{
+					moveCall("increment_by", counterParam, codec.SuiFunctionParam{
+						Name:          "amount",
+						Type:          "ptb_dependency",
+						PTBDependency: &codec.PTBCommandDependency{CommandIndex: 0},
+					}),
+					moveCall("get_counts", counterParam),
+					moveCall("increment_by", counterParam, codec.SuiFunctionParam{
+						Name:          "amount",
+						Type:          "ptb_dependency",
+						PTBDependency: &codec.PTBCommandDependency{CommandIndex: 1},
+					}),
+					moveCall("increment_by", counterParam, codec.SuiFunctionParam{
+						Name:          "amount",
+						Type:          "ptb_dependency",
+						PTBDependency: &codec.PTBCommandDependency{CommandIndex: 1, ResultIndex: new(uint16)},
+					}),
+					moveCall("get_label"),
+					moveCall("increment_by", counterParam, codec.SuiFunctionParam{
+						Name:          "amount",
+						Type:          "ptb_dependency",
+						PTBDependency: &codec.PTBCommandDependency{CommandIndex: 4},
+					}),
+				},
+			},
+			problems: []string{
+				"writer.counter.fn.commands[0].params[1](amount)",
+				"writer.counter.fn.commands[2].params[1](amount)",
+				"writer.counter.fn.commands[5].params[1](amount)",
+			},
+		},
+		{
+			name: "prerequisite object name typo",
+			function: &cwConfig.ChainWriterFunction{
+				PrerequisiteObjects: []cwConfig.PrerequisiteObject{{Name: "countr", Tag: "counter::Counter"}},
+				PTBCommands:         []cwConfig.ChainWriterPTBCommand{moveCall("increment", counterParam)},
+			},
+			problems: []string{"writer.counter.fn.prerequisiteObjects[0]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			report := NewValidator(testModules()).ValidateChainWriterConfig(context.Background(), writerConfig(tt.function))
+			assert.ElementsMatch(t, tt.problems, problemPaths(report), "problems: %v", report.Problems)
+			if len(tt.problems) == 0 {
+				require.NoError(t, report.Err())
+			} else {
+				require.Error(t, report.Err())
+			}
+		})
+	}
+}
+
+func TestReportErrIgnoringUnavailableModules(t *testing.T) {
+	t.Parallel()
+
+	counterParam := codec.SuiFunctionParam{Name: "counter", Type: "object_id"}
+	undeployed := moveCall("increment", counterParam)
+	undeployed.PackageId = strPtr("0xdef")
+
+	report := NewValidator(testModules()).ValidateChainWriterConfig(context.Background(), writerConfig(&cwConfig.ChainWriterFunction{
+		PTBCommands: []cwConfig.ChainWriterPTBCommand{undeployed},
+	}))
+	require.Len(t, report.Problems, 1)
+	assert.True(t, report.Problems[0].ModuleUnavailable)
+	require.Error(t, report.Err())
+	require.NoError(t, report.ErrIgnoringUnavailableModules(), "modules of packages not deployed yet are ignored")
+
+	report = NewValidator(testModules()).ValidateChainWriterConfig(context.Background(), writerConfig(&cwConfig.ChainWriterFunction{
+		PTBCommands: []cwConfig.ChainWriterPTBCommand{undeployed, moveCall("incremnt", counterParam)},
+	}))
+	require.Len(t, report.Problems, 2)
+	err := report.ErrIgnoringUnavailableModules()
+	require.ErrorContains(t, err, "writer.counter.fn.commands[1]")
+	assert.NotContains(t, err.Error(), "writer.counter.fn.commands[0]")
+}
+
+func TestValidateChainReaderConfig(t *testing.T) {
+	t.Parallel()
+
+	config := crConfig.ChainReaderConfig{
+		Modules: map[string]*crConfig.ChainReaderModule{
+			"Counter": {
+				Name: "counter",
+				Functions: map[string]*crConfig.ChainReaderFunction{
+					"get_count": {
+						Params: []codec.SuiFunctionParam{{Name: "counter", Type: "object_id"}},
+					},
+					"get_counts": {
+						Params:              []codec.SuiFunctionParam{{Name: "counter", Type: "object_id"}},
+						ResultTupleToStruct: []string{"count"},
+					},
+					"count": {
+						Name:   "get_count",
+						Params: []codec.SuiFunctionParam{{Name: "counter", Type: "address"}},
+					},
+					"get_total": {},
+				},
+				Events: map[string]*crConfig.ChainReaderEvent{
+					"CounterIncremented": {
+						EventSelector: client.EventSelector{Module: "counter", Event: "CounterIncremented"},
+					},
+					"CounterReset": {
+						EventSelector: client.EventSelector{Module: "counter", Event: "CounterReset"},
+					},
+				},
+			},
+			"Unbound": {
+				Functions: map[string]*crConfig.ChainReaderFunction{"missing": {}},
+			},
+		},
+	}
+
+	report := NewValidator(testModules()).ValidateChainReaderConfig(context.Background(), config, map[string]string{"Counter": "0xabc"})
+	assert.ElementsMatch(t, []string{
+		"reader.Counter.functions.count.params[0](counter)",
+		"reader.Counter.functions.get_counts",
+		"reader.Counter.functions.get_total",
+		"reader.Counter.events.CounterReset",
+	}, problemPaths(report), "problems: %v", report.Problems)
+}
+
+func TestValidateStructTags(t *testing.T) {
+	t.Parallel()
+
+	type counterIncremented struct {
+		CounterId string `sui:"counter_id,type=0x2::object::ID"`
+		NewValue  uint64 `sui:"new_value,type=u64"`
+	}
+	type badCounterIncremented struct {
+		NewValue uint32 `sui:"new_value,type=u32"`
+		OldValue uint64 `sui:"old_value"`
+	}
+	type counts struct {
+		First  uint64 `sui:"first,type=u64"`
+		Second uint64 `sui:"second"`
+	}
+	type badCounts struct {
+		First uint8 `sui:"first,type=u8"`
+	}
+
+	readerConfig := crConfig.ChainReaderConfig{
+		Modules: map[string]*crConfig.ChainReaderModule{
+			"Counter": {
+				Name: "counter",
+				Functions: map[string]*crConfig.ChainReaderFunction{
+					"get_counts": {
+						Params:              []codec.SuiFunctionParam{{Name: "counter", Type: "object_id"}},
+						ResultTupleToStruct: []string{"first", "second"},
+						ResultType:          counts{},
+					},
+					"bad_counts": {
+						Name:                "get_counts",
+						Params:              []codec.SuiFunctionParam{{Name: "counter", Type: "object_id"}},
+						ResultTupleToStruct: []string{"first", "second"},
+						ResultType:          &badCounts{},
+					},
+				},
+				Events: map[string]*crConfig.ChainReaderEvent{
+					"CounterIncremented": {
+						EventSelector: client.EventSelector{Module: "counter", Event: "CounterIncremented"},
+						DataType:      counterIncremented{},
+					},
+					"BadIncrement": {
+						EventSelector: client.EventSelector{Module: "counter", Event: "CounterIncremented"},
+						DataType:      badCounterIncremented{},
+					},
+				},
+			},
+		},
+	}
+
+	report := NewValidator(testModules()).ValidateChainReaderConfig(context.Background(), readerConfig, map[string]string{"Counter": "0xabc"})
+	assert.ElementsMatch(t, []string{
+		"reader.Counter.functions.bad_counts.ResultType",
+		"reader.Counter.events.BadIncrement.DataType",
+		"reader.Counter.events.BadIncrement.DataType",
+	}, problemPaths(report), "problems: %v", report.Problems)
+	require.ErrorContains(t, report.Err(), "field First declares type u8, the Move field has type u64")
+	require.ErrorContains(t, report.Err(), "has no field old_value")
+	require.ErrorContains(t, report.TagsErr(), "has no field old_value")
+
+	type incrementArgs struct {
+		Counter string `sui:"counter,type=address"`
+		By      uint64 `sui:"by,type=u64"`
+	}
+	type badIncrementArgs struct {
+		By    uint32 `sui:"by,type=u32"`
+		Extra string `sui:"extra"`
+	}
+
+	function := &cwConfig.ChainWriterFunction{
+		PTBCommands: []cwConfig.ChainWriterPTBCommand{
+			moveCall("increment_by", codec.SuiFunctionParam{Name: "counter", Type: "object_id"}, codec.SuiFunctionParam{Name: "by", Type: "u64"}),
+		},
+		ArgsType: incrementArgs{},
+	}
+	report = NewValidator(testModules()).ValidateChainWriterConfig(context.Background(), writerConfig(function))
+	require.Empty(t, report.Problems)
+
+	function.ArgsType = badIncrementArgs{}
+	report = NewValidator(testModules()).ValidateChainWriterConfig(context.Background(), writerConfig(function))
+	assert.Equal(t, []string{"writer.counter.fn.ArgsType", "writer.counter.fn.ArgsType"}, problemPaths(report), "problems: %v", report.Problems)
+
+	// only tag mismatches are reported by TagsErr
+	config := crConfig.ChainReaderConfig{Modules: map[string]*crConfig.ChainReaderModule{
+		"Counter": {Name: "counter", Functions: map[string]*crConfig.ChainReaderFunction{"missing": {}}},
+	}}
+	report = NewValidator(testModules()).ValidateChainReaderConfig(context.Background(), config, map[string]string{"Counter": "0xabc"})
+	require.Error(t, report.Err())
+	require.NoError(t, report.TagsErr())
+}
+
+func TestLoadStaticModules(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	module := testModules()[moduleKey("0xabc", "counter")]
+
+	moduleFile := filepath.Join(dir, "counter.json")
+	content, err := json.Marshal(module)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(moduleFile, content, 0o600))
+
+	packageFile := filepath.Join(dir, "package.json")
+	other := models.GetNormalizedMoveModuleResponse{Address: "0xdef", Name: "other"}
+	content, err = json.Marshal(map[string]models.GetNormalizedMoveModuleResponse{"counter": module, "other": other})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(packageFile, content, 0o600))
+
+	modules, err := LoadStaticModules(moduleFile)
+	require.NoError(t, err)
+	loaded, err := modules.GetNormalizedModule(context.Background(), "0xabc", "counter")
+	require.NoError(t, err)
+	assert.Contains(t, loaded.ExposedFunctions, "increment")
+
+	modules, err = LoadStaticModules(packageFile)
+	require.NoError(t, err)
+	_, err = modules.GetNormalizedModule(context.Background(), testPackageId, "counter")
+	require.NoError(t, err)
+	_, err = modules.GetNormalizedModule(context.Background(), "0x0def", "other")
+	require.NoError(t, err)
+	_, err = modules.GetNormalizedModule(context.Background(), "0xabc", "other")
+	require.Error(t, err)
+
+	_, err = LoadStaticModules(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
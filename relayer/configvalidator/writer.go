@@ -0,0 +1,179 @@
+package configvalidator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	cwConfig "github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/config"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
+)
+
+// ValidateChainWriterConfig checks every PTB command of the config against the module it calls: the function
+// exists and is callable, the param count, types, mutability and generics match its parameters, PTB dependencies
+// point to earlier commands returning a compatible value, and the `sui` tags of the configured ArgsType name params
+// of the commands and match their types. Functions without PTB commands (e.g. the CCIP execute and send functions,
+// which build their PTBs themselves) have nothing to validate.
+func (v *Validator) ValidateChainWriterConfig(ctx context.Context, config cwConfig.ChainWriterConfig) *Report {
+	report := &Report{}
+
+	for _, moduleKey := range sortedKeys(config.Modules) {
+		module := config.Modules[moduleKey]
+		if module == nil {
+			report.addf("writer."+moduleKey, "module config is empty")
+			continue
+		}
+
+		for _, functionKey := range sortedKeys(module.Functions) {
+			function := module.Functions[functionKey]
+			path := fmt.Sprintf("writer.%s.%s", moduleKey, functionKey)
+			if function == nil {
+				report.addf(path, "function config is empty")
+				continue
+			}
+
+			v.validateWriterFunction(ctx, report, path, function)
+		}
+	}
+
+	return report
+}
+
+func (v *Validator) validateWriterFunction(ctx context.Context, report *Report, path string, function *cwConfig.ChainWriterFunction) {
+	paramNames := map[string]bool{}
+	// the params of the commands, as the named values the `sui` tags of ArgsType are validated against
+	var argFields []movebcs.Field
+	// the called function of each command, nil if it could not be resolved
+	commandFunctions := make([]*normalizedFunction, len(function.PTBCommands))
+
+	for i, cmd := range function.PTBCommands {
+		cmdPath := fmt.Sprintf("%s.commands[%d]", path, i)
+		for _, param := range cmd.Params {
+			paramNames[param.Name] = true
+		}
+
+		if cmd.Type != codec.SuiPTBCommandMoveCall {
+			report.addf(cmdPath, "unsupported command type %q", cmd.Type)
+			continue
+		}
+		if cmd.PackageId == nil || cmd.ModuleId == nil || cmd.Function == nil {
+			report.addf(cmdPath, "move call requires a package ID, module ID and function")
+			continue
+		}
+
+		called, ok := v.function(ctx, report, cmdPath, *cmd.PackageId, *cmd.ModuleId, *cmd.Function)
+		if !ok {
+			continue
+		}
+		commandFunctions[i] = called
+
+		validateGenerics(report, cmdPath, cmd.Params, called)
+
+		if len(cmd.Params) != len(called.parameters) {
+			report.addf(cmdPath, "%s::%s takes %d params, %d configured", *cmd.ModuleId, *cmd.Function, len(called.parameters), len(cmd.Params))
+			continue
+		}
+
+		for j, param := range cmd.Params {
+			paramPath := fmt.Sprintf("%s.params[%d](%s)", cmdPath, j, param.Name)
+			parameter := called.parameters[j]
+
+			if param.PTBDependency != nil {
+				validateDependency(report, paramPath, i, *param.PTBDependency, commandFunctions, parameter)
+				continue
+			}
+			if param.Type == "ptb_dependency" {
+				report.addf(paramPath, "ptb_dependency param has no PTBDependency")
+				continue
+			}
+
+			if problem := checkParamType(param, parameter); problem != "" {
+				report.addf(paramPath, "%s", problem)
+			}
+
+			// objects are passed by ID
+			argType := movebcs.Type{Kind: movebcs.KindAddress}
+			if parameter.isPure() {
+				argType = parameter.Type
+			}
+			argFields = append(argFields, movebcs.Field{Name: param.Name, Type: argType})
+		}
+	}
+
+	if function.ArgsType != nil {
+		v.validateFieldTags(ctx, report, path+".ArgsType", function.ArgsType, argFields)
+	}
+
+	for i, prereq := range function.PrerequisiteObjects {
+		if prereq.SetKeys || paramNames[prereq.Name] {
+			continue
+		}
+		report.addf(fmt.Sprintf("%s.prerequisiteObjects[%d]", path, i), "name %q does not match any param of the PTB commands", prereq.Name)
+	}
+}
+
+// validateGenerics checks that the distinct generic types of the params, from which the type arguments of the
+// call are resolved, match the type parameters of the function.
+func validateGenerics(report *Report, path string, params []codec.SuiFunctionParam, called *normalizedFunction) {
+	genericTypes := map[string]bool{}
+	for _, param := range params {
+		if param.GenericType != nil {
+			genericTypes[*param.GenericType] = true
+		}
+	}
+
+	if len(genericTypes) != called.typeParameters {
+		report.addf(path, "function takes %d type arguments, params define %d generic types", called.typeParameters, len(genericTypes))
+	}
+}
+
+func validateDependency(
+	report *Report,
+	path string,
+	commandIndex int,
+	dependency codec.PTBCommandDependency,
+	commandFunctions []*normalizedFunction,
+	parameter moveType,
+) {
+	if int(dependency.CommandIndex) >= commandIndex {
+		report.addf(path, "depends on command %d, which is not an earlier command", dependency.CommandIndex)
+		return
+	}
+
+	dependee := commandFunctions[dependency.CommandIndex]
+	if dependee == nil {
+		// the dependee is reported on its own
+		return
+	}
+
+	var result moveType
+	if dependency.ResultIndex == nil {
+		if len(dependee.returns) != 1 {
+			report.addf(path, "depends on the result of command %d, which returns %d values", dependency.CommandIndex, len(dependee.returns))
+			return
+		}
+		result = dependee.returns[0]
+	} else {
+		if int(*dependency.ResultIndex) >= len(dependee.returns) {
+			report.addf(path, "depends on result %d of command %d, which returns %d values",
+				*dependency.ResultIndex, dependency.CommandIndex, len(dependee.returns))
+			return
+		}
+		result = dependee.returns[*dependency.ResultIndex]
+	}
+
+	if !compatibleResult(result, parameter) {
+		report.addf(path, "result %s of command %d does not match parameter type %s", result, dependency.CommandIndex, parameter)
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -2,10 +2,14 @@ package monitor
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"math"
+	"math/big"
 
 	"github.com/smartcontractkit/chainlink-sui/relayer/client"
 	"github.com/smartcontractkit/chainlink-sui/relayer/config"
+	"github.com/smartcontractkit/chainlink-sui/relayer/txm"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -13,7 +17,7 @@ import (
 )
 
 const SuiDecimals = 9
-const SuiDecimalsDenominator = 10 ^ SuiDecimals
+const SuiDecimalsDenominator = 1_000_000_000 // 10^SuiDecimals
 
 // BalanceMonitorOpts contains the options for creating a new Sui account balance monitor.
 type BalanceMonitorOpts struct {
@@ -23,10 +27,24 @@ type BalanceMonitorOpts struct {
 	Logger    logger.Logger
 	Keystore  core.Keystore
 	NewClient func() (client.SuiPTBClient, error)
+
+	// TopUp enables automatic funding of accounts falling under a floor balance (optional)
+	TopUp *TopUpConfig
+	// TxManager submits the top-up transactions, required when TopUp is set
+	TxManager txm.TxManager
 }
 
 // NewBalanceMonitor returns a balance monitoring services.Service which reports balance of all Keystore accounts.
 func NewBalanceMonitor(opts BalanceMonitorOpts) (services.Service, error) {
+	var onBalanceUpdate func(ctx context.Context, pubKey string, acc string, balance float64)
+	if opts.TopUp != nil {
+		topUpper, err := newTopUpper(*opts.TopUp, opts.TxManager, logger.Named(opts.Logger, "BalanceTopUp"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create top-up: %w", err)
+		}
+		onBalanceUpdate = topUpper.onBalanceUpdate
+	}
+
 	return NewGenericBalanceMonitor(GenericBalanceMonitorOpts{
 		ChainInfo:           opts.ChainInfo,
 		ChainNativeCurrency: "SUI",
@@ -43,17 +61,28 @@ func NewBalanceMonitor(opts BalanceMonitorOpts) (services.Service, error) {
 		},
 		KeyToAccountMapper: func(ctx context.Context, pubKey string) (string, error) {
 			// We need to convert the Sui public key to an account address
-			return client.GetAddressFromPublicKey([]byte(pubKey))
+			return client.GetAddressFromPublicKey(keystorePublicKey(pubKey))
 		},
+		OnBalanceUpdate: onBalanceUpdate,
 	})
 }
 
+// keystorePublicKey returns the public key bytes of a keystore account, which is identified by its hex encoded public key.
+func keystorePublicKey(account string) []byte {
+	pubKey, err := hex.DecodeString(account)
+	if err != nil {
+		return []byte(account)
+	}
+
+	return pubKey
+}
+
 // Sui balance reader client implementation
 type balanceClient struct {
 	client client.SuiPTBClient
 }
 
-// GetAccountBalance returns the account balance in APT.
+// GetAccountBalance returns the account balance in SUI.
 func (c balanceClient) GetAccountBalance(ctx context.Context, address string) (float64, error) {
 	// Get the account balance
 	balance, err := c.client.GetSUIBalance(ctx, address)
@@ -64,6 +93,21 @@ func (c balanceClient) GetAccountBalance(ctx context.Context, address string) (f
 	return mistToSui(balance.Uint64()), nil
 }
 
+// GetCoinBalance returns the account balance of the given coin type, in whole coins.
+func (c balanceClient) GetCoinBalance(ctx context.Context, address string, coin CoinConfig) (float64, error) {
+	balance, err := c.client.GetCoinBalance(ctx, address, coin.CoinType)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get %s balance: %w", coin.CoinType, err)
+	}
+
+	value, _ := new(big.Float).Quo(
+		new(big.Float).SetInt(balance),
+		new(big.Float).SetFloat64(math.Pow10(int(coin.Decimals))),
+	).Float64()
+
+	return value, nil
+}
+
 // Convert MIST to SUI as 1/10^9 SUI
 // Source: https://docs.sui.io/references/framework/sui/sui
 func mistToSui(mist uint64) float64 {
@@ -0,0 +1,131 @@
+//go:build unit
+
+package monitor
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/txm"
+)
+
+// fakeTxManager records enqueued PTBs and reports every transaction with the same status.
+type fakeTxManager struct {
+	txm.TxManager
+	enqueued []string
+	status   commontypes.TransactionStatus
+}
+
+func (f *fakeTxManager) EnqueuePTB(_ context.Context, transactionID string, _ *commontypes.TxMeta, _ []byte, _ *transaction.Transaction) (*txm.SuiTx, error) {
+	f.enqueued = append(f.enqueued, transactionID)
+	return &txm.SuiTx{TransactionID: transactionID}, nil
+}
+
+func (f *fakeTxManager) GetTransactionStatus(_ context.Context, _ string) (commontypes.TransactionStatus, error) {
+	return f.status, nil
+}
+
+func TestBalanceThresholds(t *testing.T) {
+	t.Parallel()
+
+	m := &genericBalanceMonitor{
+		cfg: GenericBalanceConfig{
+			Thresholds:        BalanceThresholds{Warn: 1, Critical: 0.1},
+			AccountThresholds: map[string]BalanceThresholds{"0xspecial": {Critical: 5}},
+		},
+		lggr:     logger.Test(t),
+		currency: "SUI",
+		levels:   map[string]balanceLevel{},
+	}
+
+	m.checkThresholds("0xa", 0.5)
+	assert.Equal(t, balanceWarn, m.levels["0xa"])
+	require.NoError(t, m.lowBalanceError(), "warnings should not affect health")
+
+	m.checkThresholds("0xa", 0.05)
+	assert.Equal(t, balanceCritical, m.levels["0xa"])
+	require.ErrorContains(t, m.lowBalanceError(), "0xa")
+
+	m.checkThresholds("0xspecial", 2)
+	assert.Equal(t, balanceCritical, m.levels["0xspecial"], "account thresholds should override the defaults")
+
+	m.checkThresholds("0xa", 3)
+	m.checkThresholds("0xspecial", 6)
+	assert.NoError(t, m.lowBalanceError(), "health should recover once balances are back above the thresholds")
+}
+
+func TestTopUp(t *testing.T) {
+	t.Parallel()
+
+	fundingPubKey := make([]byte, ed25519.PublicKeySize)
+	fundingAddress, err := client.GetAddressFromPublicKey(fundingPubKey)
+	require.NoError(t, err)
+
+	newTopUp := func(t *testing.T) (*topUpper, *fakeTxManager) {
+		t.Helper()
+		txManager := &fakeTxManager{status: commontypes.Pending}
+		topUp, err := newTopUpper(TopUpConfig{
+			FundingPublicKey: hex.EncodeToString(fundingPubKey),
+			FloorBalance:     1,
+			TargetBalance:    3,
+			DailySpendCap:    5,
+		}, txManager, logger.Test(t))
+		require.NoError(t, err)
+
+		return topUp, txManager
+	}
+
+	t.Run("tops up accounts under the floor once", func(t *testing.T) {
+		t.Parallel()
+		topUp, txManager := newTopUp(t)
+
+		topUp.onBalanceUpdate(t.Context(), "", "0xa", 2)
+		assert.Empty(t, txManager.enqueued, "accounts above the floor should not be funded")
+
+		topUp.onBalanceUpdate(t.Context(), "", fundingAddress, 0)
+		assert.Empty(t, txManager.enqueued, "the funding account should not fund itself")
+
+		topUp.onBalanceUpdate(t.Context(), "", "0xa", 0.5)
+		require.Len(t, txManager.enqueued, 1)
+		assert.Equal(t, suiToMist(2.5), topUp.spentMist)
+
+		topUp.onBalanceUpdate(t.Context(), "", "0xa", 0.5)
+		assert.Len(t, txManager.enqueued, 1, "no new top-up while one is in flight")
+
+		txManager.status = commontypes.Finalized
+		topUp.onBalanceUpdate(t.Context(), "", "0xa", 0.5)
+		assert.Len(t, txManager.enqueued, 2, "a new top-up is allowed once the previous one completed")
+	})
+
+	t.Run("enforces the daily spend cap", func(t *testing.T) {
+		t.Parallel()
+		topUp, txManager := newTopUp(t)
+		now := time.Now()
+		topUp.now = func() time.Time { return now }
+
+		topUp.onBalanceUpdate(t.Context(), "", "0xa", 0)
+		topUp.onBalanceUpdate(t.Context(), "", "0xb", 0)
+		assert.Len(t, txManager.enqueued, 1, "second top-up should exceed the cap")
+
+		now = now.Add(topUpSpendWindow)
+		topUp.onBalanceUpdate(t.Context(), "", "0xb", 0)
+		assert.Len(t, txManager.enqueued, 2, "cap should reset after a day")
+	})
+
+	t.Run("rejects invalid configs", func(t *testing.T) {
+		t.Parallel()
+		_, err := newTopUpper(TopUpConfig{FundingPublicKey: hex.EncodeToString(fundingPubKey), FloorBalance: 2, TargetBalance: 1, DailySpendCap: 1}, &fakeTxManager{}, logger.Test(t))
+		require.Error(t, err)
+	})
+}
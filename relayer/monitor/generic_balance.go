@@ -2,7 +2,10 @@ package monitor
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	config2 "github.com/smartcontractkit/chainlink-sui/relayer/config"
@@ -17,13 +20,47 @@ import (
 // Config defines the balance monitor configuration.
 type GenericBalanceConfig struct {
 	BalancePollPeriod config.Duration
+
+	// Thresholds applied to the native balance of every account, unless overridden in AccountThresholds
+	Thresholds BalanceThresholds
+	// AccountThresholds overrides Thresholds for specific account addresses
+	AccountThresholds map[string]BalanceThresholds
+	// Coins are additional coin types whose balance is reported for every account
+	Coins []CoinConfig
+}
+
+// BalanceThresholds defines the native balance levels under which an account is reported.
+// A zero value disables the corresponding level.
+type BalanceThresholds struct {
+	// Warn logs an alert when the balance falls below it
+	Warn float64
+	// Critical logs an alert and marks the monitor unhealthy when the balance falls below it
+	Critical float64
+}
+
+// CoinConfig identifies a coin type tracked by the balance monitor.
+type CoinConfig struct {
+	CoinType string
+	Symbol   string
+	Decimals uint8
 }
 
 // GenericBalanceClient defines the interface for getting account balances.
 type GenericBalanceClient interface {
 	GetAccountBalance(ctx context.Context, addr string) (float64, error)
+	// GetCoinBalance returns the balance of the given coin type, in whole coins.
+	GetCoinBalance(ctx context.Context, addr string, coin CoinConfig) (float64, error)
 }
 
+// balanceLevel is the severity of an account balance with respect to its thresholds.
+type balanceLevel int
+
+const (
+	balanceOK balanceLevel = iota
+	balanceWarn
+	balanceCritical
+)
+
 // GenericBalanceMonitorOpts contains the options for creating a new balance monitor.
 type GenericBalanceMonitorOpts struct {
 	ChainInfo           config2.ChainInfo
@@ -36,6 +73,9 @@ type GenericBalanceMonitorOpts struct {
 
 	// Maps a public key to an account address (optional, can return key as is)
 	KeyToAccountMapper func(context.Context, string) (string, error)
+
+	// OnBalanceUpdate is called with the native balance of every account after it is recorded (optional)
+	OnBalanceUpdate func(ctx context.Context, pubKey string, acc string, balance float64)
 }
 
 // NewGenericBalanceMonitor returns a balance monitoring services.Service which reports the balance of all Keystore accounts.
@@ -46,19 +86,31 @@ func NewGenericBalanceMonitor(opts GenericBalanceMonitorOpts) (services.Service,
 		return nil, fmt.Errorf("failed to create gauge: %w", err)
 	}
 
+	coinGauge, err := NewGaugeCoinBalance()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create coin gauge: %w", err)
+	}
+
 	lggr := logger.Named(opts.Logger, "BalanceMonitor")
 
 	return &genericBalanceMonitor{
-		cfg:  opts.Config,
-		lggr: lggr,
-		ks:   opts.Keystore,
+		cfg:      opts.Config,
+		lggr:     lggr,
+		ks:       opts.Keystore,
+		currency: opts.ChainNativeCurrency,
 
 		newReader:          opts.NewGenericBalanceClient,
 		keyToAccountMapper: opts.KeyToAccountMapper,
+		onBalanceUpdate:    opts.OnBalanceUpdate,
 		updateFn: func(ctx context.Context, acc string, balance float64) {
 			lggr.Infow("Account balance updated", "unit", opts.ChainNativeCurrency, "account", acc, "balance", balance)
 			gauge.Record(ctx, balance, acc, opts.ChainInfo)
 		},
+		updateCoinFn: func(ctx context.Context, acc string, coin CoinConfig, balance float64) {
+			lggr.Infow("Account coin balance updated", "unit", coin.Symbol, "coinType", coin.CoinType, "account", acc, "balance", balance)
+			coinGauge.Record(ctx, balance, acc, coin, opts.ChainInfo)
+		},
+		levels: make(map[string]balanceLevel),
 
 		stop: make(chan struct{}),
 		done: make(chan struct{}),
@@ -68,16 +120,25 @@ func NewGenericBalanceMonitor(opts GenericBalanceMonitorOpts) (services.Service,
 // TODO: Use common pkg once the generic balance monitor is included there.
 type genericBalanceMonitor struct {
 	services.StateMachine
-	cfg  GenericBalanceConfig
-	lggr logger.Logger
-	ks   core.Keystore
+	cfg      GenericBalanceConfig
+	lggr     logger.Logger
+	ks       core.Keystore
+	currency string
 
 	// Returns a new GenericBalanceClient
 	newReader func() (GenericBalanceClient, error)
 	// Maps a public key to an account address (optional, can return key as is)
 	keyToAccountMapper func(context.Context, string) (string, error)
+	// Called with the native balance of every account (optional)
+	onBalanceUpdate func(ctx context.Context, pubKey string, acc string, balance float64)
 	// Updates the balance metric
 	updateFn func(ctx context.Context, acc string, balance float64) // overridable for testing
+	// Updates the coin balance metric
+	updateCoinFn func(ctx context.Context, acc string, coin CoinConfig, balance float64) // overridable for testing
+
+	// Threshold level of every account, used to alert on changes and to report health
+	levelsMu sync.RWMutex
+	levels   map[string]balanceLevel
 
 	// Cached instance, intermitently reset to nil.
 	reader GenericBalanceClient
@@ -108,7 +169,66 @@ func (m *genericBalanceMonitor) Close() error {
 }
 
 func (m *genericBalanceMonitor) HealthReport() map[string]error {
-	return map[string]error{m.Name(): m.Healthy()}
+	return map[string]error{m.Name(): errors.Join(m.Healthy(), m.lowBalanceError())}
+}
+
+// lowBalanceError returns an error listing the accounts whose balance is under their critical threshold.
+func (m *genericBalanceMonitor) lowBalanceError() error {
+	m.levelsMu.RLock()
+	defer m.levelsMu.RUnlock()
+
+	var accounts []string
+	for acc, level := range m.levels {
+		if level == balanceCritical {
+			accounts = append(accounts, acc)
+		}
+	}
+	if len(accounts) == 0 {
+		return nil
+	}
+	sort.Strings(accounts)
+
+	return fmt.Errorf("%s balance below critical threshold for accounts %v", m.currency, accounts)
+}
+
+// thresholds returns the thresholds applying to an account.
+func (m *genericBalanceMonitor) thresholds(acc string) BalanceThresholds {
+	if thresholds, ok := m.cfg.AccountThresholds[acc]; ok {
+		return thresholds
+	}
+
+	return m.cfg.Thresholds
+}
+
+// checkThresholds compares a native balance with the account thresholds and alerts when the account
+// crosses a threshold, in either direction.
+func (m *genericBalanceMonitor) checkThresholds(acc string, balance float64) {
+	thresholds := m.thresholds(acc)
+	level := balanceOK
+	switch {
+	case thresholds.Critical > 0 && balance < thresholds.Critical:
+		level = balanceCritical
+	case thresholds.Warn > 0 && balance < thresholds.Warn:
+		level = balanceWarn
+	}
+
+	m.levelsMu.Lock()
+	previous := m.levels[acc]
+	m.levels[acc] = level
+	m.levelsMu.Unlock()
+
+	if level == previous {
+		return
+	}
+
+	switch level {
+	case balanceCritical:
+		m.lggr.Errorw("Account balance below critical threshold", "unit", m.currency, "account", acc, "balance", balance, "threshold", thresholds.Critical)
+	case balanceWarn:
+		m.lggr.Warnw("Account balance below warning threshold", "unit", m.currency, "account", acc, "balance", balance, "threshold", thresholds.Warn)
+	case balanceOK:
+		m.lggr.Infow("Account balance back above thresholds", "unit", m.currency, "account", acc, "balance", balance)
+	}
 }
 
 // monitor fn continuously updates balances, until stop signal is received.
@@ -185,6 +305,19 @@ func (m *genericBalanceMonitor) updateBalances(ctx context.Context) {
 		}
 		hasBalance = true
 		m.updateFn(ctx, accAddr, balance)
+		m.checkThresholds(accAddr, balance)
+		if m.onBalanceUpdate != nil {
+			m.onBalanceUpdate(ctx, pk, accAddr, balance)
+		}
+
+		for _, coin := range m.cfg.Coins {
+			coinBalance, err := reader.GetCoinBalance(ctx, accAddr, coin)
+			if err != nil {
+				m.lggr.Errorw("Failed to get coin balance", "account", accAddr, "coinType", coin.CoinType, "err", err)
+				continue
+			}
+			m.updateCoinFn(ctx, accAddr, coin, coinBalance)
+		}
 	}
 
 	// Try a new client next time. // TODO: This is for multinode
@@ -49,3 +49,41 @@ func (g *GaugeAccBalance) GetAttributes(account string, chainInfo config.ChainIn
 		attribute.String("network_name_full", utils.ValOrUnknown(chainInfo.NetworkNameFull)),
 	)
 }
+
+// Define a new gauge metric for the balance of non native coins
+type GaugeCoinBalance struct {
+	// account_coin_balance
+	gauge metric.Float64Gauge
+}
+
+func NewGaugeCoinBalance() (*GaugeCoinBalance, error) {
+	name := "account_coin_balance"
+	description := "Coin balance for configured WT account"
+	gauge, err := beholder.GetMeter().Float64Gauge(name, metric.WithDescription(description))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new gauge %s: %+w", name, err)
+	}
+
+	return &GaugeCoinBalance{gauge}, nil
+}
+
+func (g *GaugeCoinBalance) Record(ctx context.Context, balance float64, account string, coin CoinConfig, chainInfo config.ChainInfo) {
+	oAttrs := metric.WithAttributeSet(g.GetAttributes(account, coin, chainInfo))
+	g.gauge.Record(ctx, balance, oAttrs)
+}
+
+func (g *GaugeCoinBalance) GetAttributes(account string, coin CoinConfig, chainInfo config.ChainInfo) attribute.Set {
+	return attribute.NewSet(
+		attribute.String("account", account),
+		attribute.String("coin_type", coin.CoinType),
+		attribute.String("coin_symbol", utils.ValOrUnknown(coin.Symbol)),
+
+		// Execution Context - Source
+		attribute.String("source_id", utils.ValOrUnknown(account)), // reusing account as source_id
+		// Execution Context - Chain
+		attribute.String("chain_family_name", utils.ValOrUnknown(chainInfo.ChainFamilyName)),
+		attribute.String("chain_id", utils.ValOrUnknown(chainInfo.ChainID)),
+		attribute.String("network_name", utils.ValOrUnknown(chainInfo.NetworkName)),
+		attribute.String("network_name_full", utils.ValOrUnknown(chainInfo.NetworkNameFull)),
+	)
+}
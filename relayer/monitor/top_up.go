@@ -0,0 +1,153 @@
+package monitor
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/block-vision/sui-go-sdk/transaction"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/txm"
+)
+
+// topUpSpendWindow is the period over which the top-up spend cap applies.
+const topUpSpendWindow = 24 * time.Hour
+
+// TopUpConfig configures the automatic funding of keystore accounts. Amounts are expressed in SUI.
+type TopUpConfig struct {
+	// FundingPublicKey is the hex encoded public key of the keystore account paying for the top-ups
+	FundingPublicKey string
+	// FloorBalance is the balance under which an account is topped up
+	FloorBalance float64
+	// TargetBalance is the balance an account is topped up to
+	TargetBalance float64
+	// DailySpendCap is the maximum amount transferred by top-ups over a day
+	DailySpendCap float64
+}
+
+func (c TopUpConfig) validate() error {
+	if c.FundingPublicKey == "" {
+		return errors.New("funding public key is required")
+	}
+	if c.FloorBalance <= 0 {
+		return errors.New("floor balance must be positive")
+	}
+	if c.TargetBalance <= c.FloorBalance {
+		return fmt.Errorf("target balance %v must be greater than the floor balance %v", c.TargetBalance, c.FloorBalance)
+	}
+	if c.DailySpendCap <= 0 {
+		return errors.New("daily spend cap must be positive")
+	}
+
+	return nil
+}
+
+// topUpper transfers SUI from the funding account to accounts whose balance falls under the floor.
+// Transfers are submitted through the TXM, at most one in flight per account, and the total amount
+// transferred within a day is capped.
+type topUpper struct {
+	cfg            TopUpConfig
+	lggr           logger.Logger
+	txManager      txm.TxManager
+	fundingPubKey  []byte
+	fundingAddress string
+
+	mu          sync.Mutex
+	now         func() time.Time // overridable for testing
+	windowStart time.Time
+	spentMist   uint64
+	inFlight    map[string]string // account address -> top-up transaction ID
+}
+
+func newTopUpper(cfg TopUpConfig, txManager txm.TxManager, lggr logger.Logger) (*topUpper, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid top-up config: %w", err)
+	}
+	if txManager == nil {
+		return nil, errors.New("a transaction manager is required to submit top-ups")
+	}
+
+	fundingPubKey, err := hex.DecodeString(cfg.FundingPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode funding public key: %w", err)
+	}
+	fundingAddress, err := client.GetAddressFromPublicKey(fundingPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive funding address: %w", err)
+	}
+
+	return &topUpper{
+		cfg:            cfg,
+		lggr:           lggr,
+		txManager:      txManager,
+		fundingPubKey:  fundingPubKey,
+		fundingAddress: fundingAddress,
+		now:            time.Now,
+		inFlight:       make(map[string]string),
+	}, nil
+}
+
+// onBalanceUpdate tops up the account if its balance is under the floor, no top-up is in flight for it and
+// the daily spend cap allows it.
+func (t *topUpper) onBalanceUpdate(ctx context.Context, _ string, acc string, balance float64) {
+	if acc == t.fundingAddress || balance >= t.cfg.FloorBalance {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if txID, ok := t.inFlight[acc]; ok {
+		status, err := t.txManager.GetTransactionStatus(ctx, txID)
+		if err == nil && (status == commontypes.Pending || status == commontypes.Unconfirmed) {
+			t.lggr.Debugw("Top-up already in flight", "account", acc, "transactionID", txID)
+			return
+		}
+		delete(t.inFlight, acc)
+	}
+
+	now := t.now()
+	if now.Sub(t.windowStart) >= topUpSpendWindow {
+		t.windowStart = now
+		t.spentMist = 0
+	}
+
+	amount := suiToMist(t.cfg.TargetBalance - balance)
+	if t.spentMist+amount > suiToMist(t.cfg.DailySpendCap) {
+		t.lggr.Errorw("Top-up daily spend cap reached, account not funded",
+			"account", acc, "balance", balance, "amount", mistToSui(amount), "spent", mistToSui(t.spentMist), "cap", t.cfg.DailySpendCap)
+
+		return
+	}
+
+	ptb := transaction.NewTransaction()
+	coin := ptb.SplitCoins(ptb.Gas(), []transaction.Argument{ptb.Pure(amount)})
+	ptb.TransferObjects([]transaction.Argument{coin}, ptb.Pure(acc))
+
+	txID := fmt.Sprintf("top-up-%s-%d", acc, now.UnixNano())
+	_, err := t.txManager.EnqueuePTB(ctx, txID, &commontypes.TxMeta{}, t.fundingPubKey, ptb)
+	if err != nil {
+		t.lggr.Errorw("Failed to enqueue top-up", "account", acc, "amount", mistToSui(amount), "error", err)
+		return
+	}
+
+	t.spentMist += amount
+	t.inFlight[acc] = txID
+	t.lggr.Infow("Top-up enqueued", "account", acc, "balance", balance, "amount", mistToSui(amount), "transactionID", txID)
+}
+
+// Convert SUI to MIST, rounding down
+func suiToMist(sui float64) uint64 {
+	if sui <= 0 {
+		return 0
+	}
+
+	return uint64(sui * SuiDecimalsDenominator)
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/smartcontractkit/chainlink-sui/relayer/chainreader/database"
 	"github.com/smartcontractkit/chainlink-sui/relayer/chainreader/indexer"
 
 	"github.com/smartcontractkit/chainlink-sui/relayer/config"
@@ -25,7 +26,9 @@ import (
 	chainreader "github.com/smartcontractkit/chainlink-sui/relayer/chainreader/reader"
 	"github.com/smartcontractkit/chainlink-sui/relayer/chainwriter"
 	cwConfig "github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/config"
+	"github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/ptb/offramp"
 	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/configvalidator"
 	"github.com/smartcontractkit/chainlink-sui/relayer/txm"
 )
 
@@ -79,6 +82,13 @@ func NewRelayer(cfg *config.TOMLConfig, lggr logger.Logger, keystore core.Keysto
 	maxConcurrentRequests := int64(*cfg.TransactionManager.MaxConcurrentRequests)
 	requestType := *cfg.TransactionManager.RequestType
 
+	chainInfo := config.ChainInfo{
+		ChainFamilyName: "sui",
+		ChainID:         *cfg.ChainID,
+		NetworkName:     *cfg.NetworkName,
+		NetworkNameFull: *cfg.NetworkNameFull,
+	}
+
 	txmConfig := txm.Config{
 		BroadcastChanSize:     uint(*cfg.TransactionManager.BroadcastChanSize),
 		RequestType:           requestType,
@@ -87,6 +97,7 @@ func NewRelayer(cfg *config.TOMLConfig, lggr logger.Logger, keystore core.Keysto
 		MaxTxRetryAttempts:    *cfg.TransactionManager.MaxTxRetryAttempts,
 		TransactionTimeout:    *cfg.TransactionManager.TransactionTimeout,
 		MaxConcurrentRequests: *cfg.TransactionManager.MaxConcurrentRequests,
+		ChainInfo:             chainInfo,
 	}
 
 	// Use config values instead of constants
@@ -149,20 +160,15 @@ func NewRelayer(cfg *config.TOMLConfig, lggr logger.Logger, keystore core.Keysto
 		return nil, fmt.Errorf("error in NewRelayer (monitor) - invalid balance poll period: %w", err)
 	}
 	balanceMonitorService, err := monitor.NewBalanceMonitor(monitor.BalanceMonitorOpts{
-		ChainInfo: config.ChainInfo{
-			ChainFamilyName: "sui",
-			ChainID:         *cfg.ChainID,
-			NetworkName:     *cfg.NetworkName,
-			NetworkNameFull: *cfg.NetworkNameFull,
-		},
-		Config: monitor.GenericBalanceConfig{
-			BalancePollPeriod: balancePollPeriod,
-		},
-		Logger:   loggerInstance,
-		Keystore: keystore,
+		ChainInfo: chainInfo,
+		Config:    newBalanceMonitorConfig(cfg.BalanceMonitor, balancePollPeriod),
+		Logger:    loggerInstance,
+		Keystore:  keystore,
 		NewClient: func() (client.SuiPTBClient, error) {
 			return suiClient, nil
 		},
+		TopUp:     newBalanceTopUpConfig(cfg.BalanceMonitor.TopUp),
+		TxManager: txManager,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error in NewRelayer (monitor) - failed to create new balance monitor: %w", err)
@@ -249,20 +255,34 @@ func (r *SuiRelayer) Transact(ctx context.Context, from, to string, amount *big.
 }
 
 // Relayer interface
-func (r *SuiRelayer) NewContractWriter(_ context.Context, configBytes []byte) (types.ContractWriter, error) {
+func (r *SuiRelayer) NewContractWriter(ctx context.Context, configBytes []byte) (types.ContractWriter, error) {
 	chainConfig := cwConfig.ChainWriterConfig{}
 	err := json.Unmarshal(configBytes, &chainConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error in NewContractWriter: %w", err)
 	}
 
-	// TODO: validate chainConfig
+	// the packages may not be deployed yet, so an invalid config is only reported unless the validation is strict,
+	// which still ignores modules that can't be fetched
+	report := configvalidator.NewValidator(r.client).ValidateChainWriterConfig(ctx, chainConfig)
+	if err = report.Err(); err != nil {
+		r.lggr.Errorw("chain writer config does not match the on-chain modules", "err", err)
+	}
+	if chainConfig.StrictValidation {
+		if err = report.ErrIgnoringUnavailableModules(); err != nil {
+			return nil, fmt.Errorf("error in NewContractWriter: %w", err)
+		}
+	}
 
-	chainWriter, err := chainwriter.NewSuiChainWriter(r.lggr, r.txm, chainConfig, false)
+	chainWriter, err := chainwriter.NewSuiChainWriter(r.lggr, r.txm, chainConfig, chainConfig.Simulate)
 	if err != nil {
 		return nil, fmt.Errorf("error in NewContractWriter: %w", err)
 	}
 
+	// invalidate the cached offramp configuration on the CCIP config events indexed by the chain reader
+	configVersions := offramp.NewEventsConfigVersionSource(database.NewDBStore(r.db, r.lggr))
+	chainWriter.SetOffRampCache(offramp.NewExecutionCache(offramp.DefaultExecutionCacheTTL, configVersions))
+
 	return chainWriter, nil
 }
 
@@ -273,7 +293,7 @@ func (r *SuiRelayer) NewContractReader(ctx context.Context, contractReaderConfig
 		return nil, fmt.Errorf("error in NewContractReader: %w", err)
 	}
 
-	// TODO: validate chainConfig
+	// the config is validated against the modules of each package when it is bound
 	chainReader, err := chainreader.NewChainReader(ctx, r.lggr, r.client, chainConfig, r.db, r.indexer)
 	if err != nil {
 		return nil, fmt.Errorf("error in NewContractReader: %w", err)
@@ -352,3 +372,49 @@ func (r *SuiRelayer) NewOCR3CapabilityProvider(ctx context.Context, rargs types.
 func (r *SuiRelayer) EVM() (types.EVMService, error) {
 	return nil, errors.New("evm service not supported in Sui relayer")
 }
+
+// newBalanceMonitorConfig converts the TOML balance monitor configuration to the monitor configuration.
+func newBalanceMonitorConfig(cfg *config.BalanceMonitorConfig, pollPeriod commonConfig.Duration) monitor.GenericBalanceConfig {
+	monitorConfig := monitor.GenericBalanceConfig{
+		BalancePollPeriod: pollPeriod,
+		Thresholds:        newBalanceThresholds(cfg.WarnBalance, cfg.CriticalBalance),
+		AccountThresholds: make(map[string]monitor.BalanceThresholds, len(cfg.AccountThresholds)),
+	}
+	for account, thresholds := range cfg.AccountThresholds {
+		monitorConfig.AccountThresholds[account] = newBalanceThresholds(thresholds.WarnBalance, thresholds.CriticalBalance)
+	}
+	for _, coin := range cfg.Coins {
+		monitorConfig.Coins = append(monitorConfig.Coins, monitor.CoinConfig{
+			CoinType: coin.CoinType,
+			Symbol:   coin.Symbol,
+			Decimals: coin.Decimals,
+		})
+	}
+
+	return monitorConfig
+}
+
+func newBalanceThresholds(warn *float64, critical *float64) monitor.BalanceThresholds {
+	var thresholds monitor.BalanceThresholds
+	if warn != nil {
+		thresholds.Warn = *warn
+	}
+	if critical != nil {
+		thresholds.Critical = *critical
+	}
+
+	return thresholds
+}
+
+func newBalanceTopUpConfig(cfg *config.BalanceTopUpConfig) *monitor.TopUpConfig {
+	if cfg == nil {
+		return nil
+	}
+
+	return &monitor.TopUpConfig{
+		FundingPublicKey: cfg.FundingPublicKey,
+		FloorBalance:     cfg.FloorBalance,
+		TargetBalance:    cfg.TargetBalance,
+		DailySpendCap:    cfg.DailySpendCap,
+	}
+}
@@ -0,0 +1,253 @@
+package signer
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// MaxMultiSigSigners is the maximum number of public keys a Sui MultiSig account can hold.
+	MaxMultiSigSigners = 10
+	// compressedSignatureLength is the length of a signature without its flag and public key.
+	compressedSignatureLength = 64
+	// compressedSecpPublicKeyLength is the length of a compressed Secp256k1 or Secp256r1 public key.
+	compressedSecpPublicKeyLength = 33
+)
+
+// MultiSigMember is a public key taking part in a Sui MultiSig account, together with its weight.
+type MultiSigMember struct {
+	Flag      SigFlag
+	PublicKey []byte
+	Weight    uint8
+}
+
+// MultiSigPublicKey describes a Sui MultiSig account: a set of weighted public keys and the
+// total weight required for a signature to be valid.
+type MultiSigPublicKey struct {
+	Members   []MultiSigMember
+	Threshold uint16
+}
+
+// NewMultiSigPublicKey validates the MultiSig members and threshold.
+// The order of the members is significant, as it determines both the address and the signature bitmap.
+func NewMultiSigPublicKey(members []MultiSigMember, threshold uint16) (*MultiSigPublicKey, error) {
+	if len(members) == 0 || len(members) > MaxMultiSigSigners {
+		return nil, fmt.Errorf("multisig must have between 1 and %d members, got %d", MaxMultiSigSigners, len(members))
+	}
+	if threshold == 0 {
+		return nil, errors.New("multisig threshold must be greater than zero")
+	}
+
+	var totalWeight uint16
+	seen := map[string]struct{}{}
+	for i, member := range members {
+		if member.Weight == 0 {
+			return nil, fmt.Errorf("multisig member %d has zero weight", i)
+		}
+		if err := validatePublicKey(member.Flag, member.PublicKey); err != nil {
+			return nil, fmt.Errorf("multisig member %d: %w", i, err)
+		}
+		key := string(append([]byte{byte(member.Flag)}, member.PublicKey...))
+		if _, duplicated := seen[key]; duplicated {
+			return nil, fmt.Errorf("multisig member %d is duplicated", i)
+		}
+		seen[key] = struct{}{}
+		totalWeight += uint16(member.Weight)
+	}
+
+	if totalWeight < threshold {
+		return nil, fmt.Errorf("multisig threshold %d is unreachable with total weight %d", threshold, totalWeight)
+	}
+
+	return &MultiSigPublicKey{
+		Members:   members,
+		Threshold: threshold,
+	}, nil
+}
+
+// Address returns the Sui address of the MultiSig account, i.e. the blake2b-256 hash of
+// `0x03 || threshold (u16 LE) || flag_1 || pk_1 || weight_1 || ... || flag_n || pk_n || weight_n`.
+func (m *MultiSigPublicKey) Address() string {
+	ser := &bcs.Serializer{}
+	ser.U8(uint8(SigFlagMultiSig))
+	ser.U16(m.Threshold)
+	for _, member := range m.Members {
+		ser.U8(uint8(member.Flag))
+		ser.FixedBytes(member.PublicKey)
+		ser.U8(member.Weight)
+	}
+
+	digest := blake2b.Sum256(ser.ToBytes())
+
+	return "0x" + hex.EncodeToString(digest[:])
+}
+
+// CombineSignatures combines serialized single key signatures (`flag || signature || pubKey`, base64 encoded)
+// produced by members of the MultiSig account into a serialized Sui MultiSig signature.
+// Signatures may be provided in any order, but the accumulated weight must reach the threshold.
+func (m *MultiSigPublicKey) CombineSignatures(partialSignatures []string) (string, error) {
+	type indexedSignature struct {
+		index     int
+		flag      SigFlag
+		signature []byte
+	}
+
+	signatures := make([]indexedSignature, 0, len(partialSignatures))
+	var bitmap uint16
+	var weight uint16
+	for _, partial := range partialSignatures {
+		flag, signature, pubKey, err := parseSerializedSignature(partial)
+		if err != nil {
+			return "", err
+		}
+
+		index := m.memberIndex(flag, pubKey)
+		if index < 0 {
+			return "", fmt.Errorf("signature public key %x is not a member of the multisig", pubKey)
+		}
+		if bitmap&(1<<index) != 0 {
+			return "", fmt.Errorf("duplicated signature for multisig member %d", index)
+		}
+
+		bitmap |= 1 << index
+		weight += uint16(m.Members[index].Weight)
+		signatures = append(signatures, indexedSignature{index: index, flag: flag, signature: signature})
+	}
+
+	if weight < m.Threshold {
+		return "", fmt.Errorf("insufficient multisig weight: got %d, threshold %d", weight, m.Threshold)
+	}
+
+	// signatures must follow the order of the bitmap
+	sort.Slice(signatures, func(i, j int) bool {
+		return signatures[i].index < signatures[j].index
+	})
+
+	ser := &bcs.Serializer{}
+	ser.U8(uint8(SigFlagMultiSig))
+
+	// sigs: vector<CompressedSignature>
+	ser.Uleb128(uint32(len(signatures)))
+	for _, sig := range signatures {
+		ser.Uleb128(uint32(sig.flag))
+		ser.FixedBytes(sig.signature)
+	}
+
+	// bitmap: u16
+	ser.U16(bitmap)
+
+	// multisig_pk: MultiSigPublicKey { pk_map: vector<(PublicKey, u8)>, threshold: u16 }
+	ser.Uleb128(uint32(len(m.Members)))
+	for _, member := range m.Members {
+		ser.Uleb128(uint32(member.Flag))
+		ser.FixedBytes(member.PublicKey)
+		ser.U8(member.Weight)
+	}
+	ser.U16(m.Threshold)
+
+	if err := ser.Error(); err != nil {
+		return "", fmt.Errorf("failed to serialize multisig signature: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(ser.ToBytes()), nil
+}
+
+func (m *MultiSigPublicKey) memberIndex(flag SigFlag, pubKey []byte) int {
+	for i, member := range m.Members {
+		if member.Flag == flag && string(member.PublicKey) == string(pubKey) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+var _ SuiSigner = (*MultiSigSigner)(nil)
+
+// MultiSigSigner signs Sui transactions on behalf of a MultiSig account using the member signers
+// available locally. The signers must reach the MultiSig threshold for Sign to succeed. When some
+// members sign elsewhere (e.g. offline), use MultiSigPublicKey.CombineSignatures instead.
+type MultiSigSigner struct {
+	multiSigPublicKey *MultiSigPublicKey
+	signers           []SuiSigner
+}
+
+func NewMultiSigSigner(multiSigPublicKey *MultiSigPublicKey, signers ...SuiSigner) SuiSigner {
+	return &MultiSigSigner{
+		multiSigPublicKey: multiSigPublicKey,
+		signers:           signers,
+	}
+}
+
+// Sign collects a partial signature from every member signer and combines them into a
+// serialized Sui MultiSig signature.
+func (s *MultiSigSigner) Sign(message []byte) ([]string, error) {
+	partialSignatures := make([]string, 0, len(s.signers))
+	for i, memberSigner := range s.signers {
+		signatures, err := memberSigner.Sign(message)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign with multisig member signer %d: %w", i, err)
+		}
+		partialSignatures = append(partialSignatures, signatures...)
+	}
+
+	multiSigSignature, err := s.multiSigPublicKey.CombineSignatures(partialSignatures)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{multiSigSignature}, nil
+}
+
+// GetAddress returns the Sui address of the MultiSig account
+func (s *MultiSigSigner) GetAddress() (string, error) {
+	return s.multiSigPublicKey.Address(), nil
+}
+
+// parseSerializedSignature splits a serialized single key signature into its flag, signature and public key.
+func parseSerializedSignature(serialized string) (SigFlag, []byte, []byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(serialized)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("failed to decode signature: %w", err)
+	}
+	if len(raw) < 1+compressedSignatureLength {
+		return 0, nil, nil, fmt.Errorf("invalid signature length %d", len(raw))
+	}
+
+	flag := SigFlag(raw[0])
+	signature := raw[1 : 1+compressedSignatureLength]
+	pubKey := raw[1+compressedSignatureLength:]
+	if err := validatePublicKey(flag, pubKey); err != nil {
+		return 0, nil, nil, err
+	}
+
+	return flag, signature, pubKey, nil
+}
+
+// validatePublicKey checks that a public key has the expected length for its signature scheme.
+func validatePublicKey(flag SigFlag, pubKey []byte) error {
+	var expectedLength int
+	switch flag {
+	case SigFlagEd25519:
+		expectedLength = ed25519.PublicKeySize
+	case SigFlagSecp256k1, SigFlagSecp256r1:
+		expectedLength = compressedSecpPublicKeyLength
+	case SigFlagMultiSig:
+		return errors.New("nested multisig public keys are not supported")
+	default:
+		return fmt.Errorf("unsupported signature scheme flag %d", flag)
+	}
+
+	if len(pubKey) != expectedLength {
+		return fmt.Errorf("invalid public key length for scheme %d, expected %d got %d", flag, expectedLength, len(pubKey))
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/blake2b"
+)
+
+// secp256k1SignatureLength is the length of a compact (r || s) ECDSA signature.
+const secp256k1SignatureLength = 64
+
+var _ SuiSigner = (*Secp256k1Signer)(nil)
+
+// Secp256k1Signer signs Sui transactions with a Secp256k1 private key.
+type Secp256k1Signer struct {
+	privateKey *ecdsa.PrivateKey
+}
+
+func NewSecp256k1Signer(privateKey *ecdsa.PrivateKey) SuiSigner {
+	return &Secp256k1Signer{
+		privateKey: privateKey,
+	}
+}
+
+// Sign implements the SuiSigner interface for Secp256k1Signer.
+//
+// Sui hashes the intent message with blake2b-256 and signs the digest with ECDSA over SHA-256.
+// The signature is serialized in its compact, low-s normalized (r || s) form together with the
+// 33 bytes compressed public key.
+//
+// Parameters:
+//
+//	message - The raw message bytes to sign
+//
+// Returns:
+//
+//	The serialized Sui signature and any error encountered during signing
+func (s *Secp256k1Signer) Sign(message []byte) ([]string, error) {
+	digest := blake2b.Sum256(messageWithIntent(message))
+	hash := sha256.Sum256(digest[:])
+
+	// the returned signature is [r || s || v] and already low-s normalized
+	sigBytes, err := crypto.Sign(hash[:], s.privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign message with secp256k1 key: %w", err)
+	}
+
+	serializedSignature := SerializeSuiSignatureWithFlag(SigFlagSecp256k1, sigBytes[:secp256k1SignatureLength], s.PublicKey())
+
+	return []string{serializedSignature}, nil
+}
+
+// GetAddress returns the Sui address derived from the signer's public key
+func (s *Secp256k1Signer) GetAddress() (string, error) {
+	return AddressFromPublicKey(SigFlagSecp256k1, s.PublicKey()), nil
+}
+
+// PublicKey returns the 33 bytes compressed public key of the signer.
+func (s *Secp256k1Signer) PublicKey() []byte {
+	return crypto.CompressPubkey(&s.privateKey.PublicKey)
+}
@@ -0,0 +1,80 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// secp256r1ScalarLength is the length of each of the r and s components of a P-256 signature.
+const secp256r1ScalarLength = 32
+
+var _ SuiSigner = (*Secp256r1Signer)(nil)
+
+// Secp256r1Signer signs Sui transactions with a Secp256r1 (NIST P-256) private key,
+// the scheme supported by most HSMs.
+type Secp256r1Signer struct {
+	privateKey *ecdsa.PrivateKey
+}
+
+func NewSecp256r1Signer(privateKey *ecdsa.PrivateKey) (SuiSigner, error) {
+	if privateKey.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("secp256r1 signer requires a P-256 private key")
+	}
+
+	return &Secp256r1Signer{
+		privateKey: privateKey,
+	}, nil
+}
+
+// Sign implements the SuiSigner interface for Secp256r1Signer.
+//
+// Sui hashes the intent message with blake2b-256 and signs the digest with ECDSA over SHA-256.
+// Sui rejects malleable signatures, so s is normalized to the lower half of the curve order before
+// the compact (r || s) signature is serialized with the 33 bytes compressed public key.
+//
+// Parameters:
+//
+//	message - The raw message bytes to sign
+//
+// Returns:
+//
+//	The serialized Sui signature and any error encountered during signing
+func (s *Secp256r1Signer) Sign(message []byte) ([]string, error) {
+	digest := blake2b.Sum256(messageWithIntent(message))
+	hash := sha256.Sum256(digest[:])
+
+	r, sig, err := ecdsa.Sign(rand.Reader, s.privateKey, hash[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign message with secp256r1 key: %w", err)
+	}
+
+	curveOrder := s.privateKey.Curve.Params().N
+	halfOrder := new(big.Int).Rsh(curveOrder, 1)
+	if sig.Cmp(halfOrder) > 0 {
+		sig = new(big.Int).Sub(curveOrder, sig)
+	}
+
+	sigBytes := make([]byte, 2*secp256r1ScalarLength)
+	r.FillBytes(sigBytes[:secp256r1ScalarLength])
+	sig.FillBytes(sigBytes[secp256r1ScalarLength:])
+
+	serializedSignature := SerializeSuiSignatureWithFlag(SigFlagSecp256r1, sigBytes, s.PublicKey())
+
+	return []string{serializedSignature}, nil
+}
+
+// GetAddress returns the Sui address derived from the signer's public key
+func (s *Secp256r1Signer) GetAddress() (string, error) {
+	return AddressFromPublicKey(SigFlagSecp256r1, s.PublicKey()), nil
+}
+
+// PublicKey returns the 33 bytes compressed public key of the signer.
+func (s *Secp256r1Signer) PublicKey() []byte {
+	return elliptic.MarshalCompressed(s.privateKey.Curve, s.privateKey.X, s.privateKey.Y)
+}
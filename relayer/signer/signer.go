@@ -14,7 +14,10 @@ import (
 type SigFlag byte
 
 const (
-	SigFlagEd25519 SigFlag = 0x00
+	SigFlagEd25519   SigFlag = 0x00
+	SigFlagSecp256k1 SigFlag = 0x01
+	SigFlagSecp256r1 SigFlag = 0x02
+	SigFlagMultiSig  SigFlag = 0x03
 )
 
 // SuiSigner defines an interface for signing messages in the Sui blockchain format.
@@ -48,16 +51,34 @@ type SuiSigner interface {
 //
 //	A base64-encoded string containing the serialized signature ready for submission to Sui
 func SerializeSuiSignature(signature, pubKey []byte) string {
+	return SerializeSuiSignatureWithFlag(SigFlagEd25519, signature, pubKey)
+}
+
+// SerializeSuiSignatureWithFlag serializes a signature produced by any single key scheme
+// (Ed25519, Secp256k1 or Secp256r1) as `flag || signature || pubKey`, base64 encoded.
+func SerializeSuiSignatureWithFlag(flag SigFlag, signature, pubKey []byte) string {
 	signatureLen := len(signature)
 	pubKeyLen := len(pubKey)
 	serializedSignature := make([]byte, 1+signatureLen+pubKeyLen)
-	serializedSignature[0] = byte(SigFlagEd25519)
+	serializedSignature[0] = byte(flag)
 	copy(serializedSignature[1:], signature)
 	copy(serializedSignature[1+signatureLen:], pubKey)
 
 	return base64.StdEncoding.EncodeToString(serializedSignature)
 }
 
+// AddressFromPublicKey derives the Sui address of a single key account, i.e. the blake2b-256 hash
+// of the scheme flag followed by the public key bytes.
+func AddressFromPublicKey(flag SigFlag, pubKey []byte) string {
+	flaggedPubKey := make([]byte, 1+len(pubKey))
+	flaggedPubKey[0] = byte(flag)
+	copy(flaggedPubKey[1:], pubKey)
+
+	digest := blake2b.Sum256(flaggedPubKey)
+
+	return "0x" + hex.EncodeToString(digest[:])
+}
+
 var _ SuiSigner = (*PrivateKeySigner)(nil)
 
 type PrivateKeySigner struct {
@@ -106,21 +127,7 @@ func (s *PrivateKeySigner) Sign(message []byte) ([]string, error) {
 func (s *PrivateKeySigner) GetAddress() (string, error) {
 	pubKey := s.privateKey.Public().(ed25519.PublicKey)
 
-	// Prepend the Ed25519 flag byte to the public key
-	flaggedPubKey := make([]byte, 1+len(pubKey))
-	flaggedPubKey[0] = byte(SigFlagEd25519)
-	copy(flaggedPubKey[1:], pubKey)
-
-	// Hash the flagged public key
-	digest := blake2b.Sum256(flaggedPubKey)
-
-	// Take the first 32 bytes of the hash as the address
-	addressBytes := digest[:32]
-
-	// Convert to hex string with "0x" prefix
-	address := "0x" + hex.EncodeToString(addressBytes)
-
-	return address, nil
+	return AddressFromPublicKey(SigFlagEd25519, pubKey), nil
 }
 
 // TODO: add docstring and reference to block-vision implementation
@@ -0,0 +1,335 @@
+//go:build unit
+
+package signer_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/signer"
+)
+
+var message = []byte("transaction bytes")
+
+// intentDigest returns the SHA-256 of the blake2b digest of the intent message, as signed by ECDSA schemes.
+func intentDigest() []byte {
+	digest := blake2b.Sum256(append(append([]byte{}, signer.IntentBytes...), message...))
+	hash := sha256.Sum256(digest[:])
+
+	return hash[:]
+}
+
+func decodeSignature(t *testing.T, serialized string) (byte, []byte, []byte) {
+	t.Helper()
+	raw, err := base64.StdEncoding.DecodeString(serialized)
+	require.NoError(t, err)
+
+	return raw[0], raw[1:65], raw[65:]
+}
+
+func TestSecp256k1Signer(t *testing.T) {
+	t.Parallel()
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	suiSigner := signer.NewSecp256k1Signer(privateKey)
+	signatures, err := suiSigner.Sign(message)
+	require.NoError(t, err)
+	require.Len(t, signatures, 1)
+
+	flag, signature, pubKey := decodeSignature(t, signatures[0])
+	assert.Equal(t, byte(signer.SigFlagSecp256k1), flag)
+	assert.Len(t, pubKey, 33)
+	assert.True(t, crypto.VerifySignature(pubKey, intentDigest(), signature), "signature should verify over sha256(blake2b(intent || message))")
+
+	address, err := suiSigner.GetAddress()
+	require.NoError(t, err)
+	expectedAddress, err := client.GetAddressFromPublicKey(append([]byte{byte(signer.SigFlagSecp256k1)}, pubKey...))
+	require.NoError(t, err)
+	assert.Equal(t, expectedAddress, address, "signer and keystore address derivation should match")
+}
+
+func TestSecp256r1Signer(t *testing.T) {
+	t.Parallel()
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	suiSigner, err := signer.NewSecp256r1Signer(privateKey)
+	require.NoError(t, err)
+
+	halfOrder := new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+	for range 10 {
+		signatures, err := suiSigner.Sign(message)
+		require.NoError(t, err)
+
+		flag, signature, pubKey := decodeSignature(t, signatures[0])
+		assert.Equal(t, byte(signer.SigFlagSecp256r1), flag)
+		assert.Len(t, pubKey, 33)
+
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		assert.LessOrEqual(t, s.Cmp(halfOrder), 0, "signature should be low-s normalized")
+		assert.True(t, ecdsa.Verify(&privateKey.PublicKey, intentDigest(), r, s))
+	}
+
+	_, err = signer.NewSecp256r1Signer(&ecdsa.PrivateKey{PublicKey: ecdsa.PublicKey{Curve: elliptic.P384()}})
+	require.Error(t, err, "only P-256 keys are supported")
+}
+
+func TestMultiSigSigner(t *testing.T) {
+	t.Parallel()
+
+	edPub, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	k1Priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	r1Priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	k1Signer := signer.NewSecp256k1Signer(k1Priv)
+	r1Signer, err := signer.NewSecp256r1Signer(r1Priv)
+	require.NoError(t, err)
+
+	multiSigPublicKey, err := signer.NewMultiSigPublicKey([]signer.MultiSigMember{
+		{Flag: signer.SigFlagEd25519, PublicKey: edPub, Weight: 1},
+		{Flag: signer.SigFlagSecp256k1, PublicKey: k1Signer.(*signer.Secp256k1Signer).PublicKey(), Weight: 1},
+		{Flag: signer.SigFlagSecp256r1, PublicKey: r1Signer.(*signer.Secp256r1Signer).PublicKey(), Weight: 1},
+	}, 2)
+	require.NoError(t, err)
+
+	t.Run("combines signatures in bitmap order", func(t *testing.T) {
+		t.Parallel()
+		// provide the signers out of order, the combined signature must follow member order
+		multiSigSigner := signer.NewMultiSigSigner(multiSigPublicKey, r1Signer, signer.NewPrivateKeySigner(edPriv))
+		signatures, err := multiSigSigner.Sign(message)
+		require.NoError(t, err)
+		require.Len(t, signatures, 1)
+
+		raw, err := base64.StdEncoding.DecodeString(signatures[0])
+		require.NoError(t, err)
+		assert.Equal(t, byte(signer.SigFlagMultiSig), raw[0])
+		assert.Equal(t, byte(2), raw[1], "two compressed signatures expected")
+		assert.Equal(t, byte(signer.SigFlagEd25519), raw[2], "first signature should belong to member 0")
+		assert.Equal(t, byte(signer.SigFlagSecp256r1), raw[2+1+64], "second signature should belong to member 2")
+
+		bitmapOffset := 2 + 2*(1+64)
+		assert.Equal(t, uint16(0b101), binary.LittleEndian.Uint16(raw[bitmapOffset:]))
+
+		address, err := multiSigSigner.GetAddress()
+		require.NoError(t, err)
+		assert.Equal(t, multiSigPublicKey.Address(), address)
+	})
+
+	t.Run("rejects insufficient weight", func(t *testing.T) {
+		t.Parallel()
+		_, err := signer.NewMultiSigSigner(multiSigPublicKey, k1Signer).Sign(message)
+		require.ErrorContains(t, err, "insufficient multisig weight")
+	})
+
+	t.Run("rejects non member signatures", func(t *testing.T) {
+		t.Parallel()
+		_, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		_, err = signer.NewMultiSigSigner(multiSigPublicKey, k1Signer, signer.NewPrivateKeySigner(otherPriv)).Sign(message)
+		require.ErrorContains(t, err, "is not a member of the multisig")
+	})
+
+	t.Run("rejects unreachable threshold", func(t *testing.T) {
+		t.Parallel()
+		_, err := signer.NewMultiSigPublicKey(multiSigPublicKey.Members, 4)
+		require.Error(t, err)
+	})
+}
+
+// Known answer vectors: the key pairs are the valid Secp256k1 and Secp256r1 key pairs of the Sui TypeScript SDK
+// keypair tests. Secp256k1 signatures are deterministic (RFC 6979), the expected signature was produced with the
+// independent RFC 6979 implementation of github.com/decred/dcrd/dcrec/secp256k1.
+var (
+	knownSecp256k1SecretKey = []byte{
+		59, 148, 11, 85, 134, 130, 61, 253, 2, 174, 59, 70, 27, 180, 51, 107, 94, 203, 174, 253, 102, 39,
+		170, 146, 46, 252, 4, 143, 236, 12, 136, 28,
+	}
+	knownSecp256k1PublicKey = []byte{
+		2, 29, 21, 35, 7, 198, 183, 43, 14, 208, 65, 139, 14, 112, 205, 128, 231, 245, 41, 91, 141, 134,
+		245, 114, 45, 63, 82, 19, 251, 210, 57, 79, 54,
+	}
+	knownSecp256r1SecretKey = []byte{
+		66, 37, 141, 205, 161, 76, 241, 17, 198, 2, 184, 151, 27, 140, 200, 67, 233, 30, 70, 202, 144, 81,
+		81, 192, 39, 68, 166, 176, 23, 230, 147, 22,
+	}
+	knownSecp256r1PublicKey = []byte{
+		2, 39, 50, 43, 58, 137, 26, 10, 40, 13, 107, 193, 251, 44, 187, 35, 210, 143, 84, 144, 111, 214,
+		64, 127, 95, 116, 31, 109, 239, 87, 98, 96, 154,
+	}
+	// the signature of message by knownSecp256k1SecretKey
+	knownSecp256k1Signature = "84662a4331060b55908f56f602ca3a1c22c74cd994ef916f3daf12b8b3330998" +
+		"4cd1b41641a8fe6c000125ee2098bc7922b650b3b7c2b90453ce6d5723c92b9c"
+)
+
+func knownSecp256k1Signer(t *testing.T) *signer.Secp256k1Signer {
+	t.Helper()
+	privateKey, err := crypto.ToECDSA(knownSecp256k1SecretKey)
+	require.NoError(t, err)
+
+	return signer.NewSecp256k1Signer(privateKey).(*signer.Secp256k1Signer)
+}
+
+func TestSecp256k1SignerKnownAnswer(t *testing.T) {
+	t.Parallel()
+	suiSigner := knownSecp256k1Signer(t)
+	assert.Equal(t, knownSecp256k1PublicKey, suiSigner.PublicKey())
+
+	signatures, err := suiSigner.Sign(message)
+	require.NoError(t, err)
+	flag, signature, pubKey := decodeSignature(t, signatures[0])
+	assert.Equal(t, byte(signer.SigFlagSecp256k1), flag)
+	assert.Equal(t, knownSecp256k1Signature, hex.EncodeToString(signature))
+	assert.Equal(t, knownSecp256k1PublicKey, pubKey)
+}
+
+func TestSecp256r1SignerKnownAnswer(t *testing.T) {
+	t.Parallel()
+	curve := elliptic.P256()
+	x, y := curve.ScalarBaseMult(knownSecp256r1SecretKey)
+	privateKey := &ecdsa.PrivateKey{PublicKey: ecdsa.PublicKey{Curve: curve, X: x, Y: y}, D: new(big.Int).SetBytes(knownSecp256r1SecretKey)}
+
+	suiSigner, err := signer.NewSecp256r1Signer(privateKey)
+	require.NoError(t, err)
+	assert.Equal(t, knownSecp256r1PublicKey, suiSigner.(*signer.Secp256r1Signer).PublicKey())
+
+	// Secp256r1 signatures are randomized, only their public key is known
+	signatures, err := suiSigner.Sign(message)
+	require.NoError(t, err)
+	_, _, pubKey := decodeSignature(t, signatures[0])
+	assert.Equal(t, knownSecp256r1PublicKey, pubKey)
+}
+
+// TestMultiSigKnownEncoding checks the MultiSig address and signature against their layout in Sui, spelled out
+// byte by byte: the address hashes `0x03 || threshold || (flag || pk || weight)*`, and the signature is the BCS
+// of MultiSig { sigs: Vec<CompressedSignature>, bitmap: u16, multisig_pk: { pk_map: Vec<(PublicKey, u8)>, threshold: u16 } }
+// prefixed with its flag, enums being encoded by their variant index, which is the scheme flag.
+func TestMultiSigKnownEncoding(t *testing.T) {
+	t.Parallel()
+	edPriv := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	edPub := edPriv.Public().(ed25519.PublicKey)
+	// the well known public key of the all-zero Ed25519 seed
+	require.Equal(t, "3b6a27bcceb6a42d62a3a8d02a6f0d73653215771de243a63ac048a18b59da29", hex.EncodeToString(edPub))
+
+	multiSigPublicKey, err := signer.NewMultiSigPublicKey([]signer.MultiSigMember{
+		{Flag: signer.SigFlagEd25519, PublicKey: edPub, Weight: 1},
+		{Flag: signer.SigFlagSecp256k1, PublicKey: knownSecp256k1PublicKey, Weight: 2},
+	}, 3)
+	require.NoError(t, err)
+
+	members := "00" + hex.EncodeToString(edPub) + "01" + // Ed25519, weight 1
+		"01" + hex.EncodeToString(knownSecp256k1PublicKey) + "02" // Secp256k1, weight 2
+	addressPreimage, err := hex.DecodeString("03" + "0300" + members) // MultiSig flag, threshold 3
+	require.NoError(t, err)
+	addressDigest := blake2b.Sum256(addressPreimage)
+	assert.Equal(t, "0x"+hex.EncodeToString(addressDigest[:]), multiSigPublicKey.Address())
+
+	signatures, err := signer.NewMultiSigSigner(multiSigPublicKey, knownSecp256k1Signer(t), signer.NewPrivateKeySigner(edPriv)).Sign(message)
+	require.NoError(t, err)
+
+	digest := blake2b.Sum256(append(append([]byte{}, signer.IntentBytes...), message...))
+	edSignature := ed25519.Sign(edPriv, digest[:])
+	expected := "03" + // MultiSig flag
+		"02" + // two signatures
+		"00" + hex.EncodeToString(edSignature) + // Ed25519 signature of member 0
+		"01" + knownSecp256k1Signature + // Secp256k1 signature of member 1
+		"0300" + // bitmap of members 0 and 1
+		"02" + members + // pk_map
+		"0300" // threshold
+	raw, err := base64.StdEncoding.DecodeString(signatures[0])
+	require.NoError(t, err)
+	assert.Equal(t, expected, hex.EncodeToString(raw))
+}
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	_, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	k1Priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	r1Priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	r1Signer, err := signer.NewSecp256r1Signer(r1Priv)
+	require.NoError(t, err)
+
+	edSigner := signer.NewPrivateKeySigner(edPriv)
+	k1Signer := signer.NewSecp256k1Signer(k1Priv)
+	multiSigPublicKey, err := signer.NewMultiSigPublicKey([]signer.MultiSigMember{
+		{Flag: signer.SigFlagEd25519, PublicKey: edPriv.Public().(ed25519.PublicKey), Weight: 1},
+		{Flag: signer.SigFlagSecp256k1, PublicKey: k1Signer.(*signer.Secp256k1Signer).PublicKey(), Weight: 1},
+		{Flag: signer.SigFlagSecp256r1, PublicKey: r1Signer.(*signer.Secp256r1Signer).PublicKey(), Weight: 1},
+	}, 2)
+	require.NoError(t, err)
+
+	for name, suiSigner := range map[string]signer.SuiSigner{
+		"ed25519":   edSigner,
+		"secp256k1": k1Signer,
+		"secp256r1": r1Signer,
+		"multisig":  signer.NewMultiSigSigner(multiSigPublicKey, r1Signer, edSigner),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			signatures, err := suiSigner.Sign(message)
+			require.NoError(t, err)
+			expected, err := suiSigner.GetAddress()
+			require.NoError(t, err)
+
+			address, err := signer.VerifySignature(message, signatures[0])
+			require.NoError(t, err)
+			assert.Equal(t, expected, address)
+
+			_, err = signer.VerifySignature([]byte("other transaction bytes"), signatures[0])
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("known secp256k1 signature", func(t *testing.T) {
+		t.Parallel()
+		signature, err := hex.DecodeString(knownSecp256k1Signature)
+		require.NoError(t, err)
+
+		address, err := signer.VerifySignature(message, signer.SerializeSuiSignatureWithFlag(signer.SigFlagSecp256k1, signature, knownSecp256k1PublicKey))
+		require.NoError(t, err)
+		assert.Equal(t, signer.AddressFromPublicKey(signer.SigFlagSecp256k1, knownSecp256k1PublicKey), address)
+	})
+
+	t.Run("rejects multisig below threshold", func(t *testing.T) {
+		t.Parallel()
+		signatures, err := signer.NewMultiSigSigner(multiSigPublicKey, k1Signer, edSigner).Sign(message)
+		require.NoError(t, err)
+		raw, err := base64.StdEncoding.DecodeString(signatures[0])
+		require.NoError(t, err)
+
+		// drop the secp256k1 signature of member 1, leaving the weight of member 0 only
+		tampered := append([]byte{byte(signer.SigFlagMultiSig), 1}, raw[2:2+1+64]...)
+		tampered = binary.LittleEndian.AppendUint16(tampered, 0b01)
+		tampered = append(tampered, raw[2+2*(1+64)+2:]...)
+		_, err = signer.VerifySignature(message, base64.StdEncoding.EncodeToString(tampered))
+		require.ErrorContains(t, err, "insufficient multisig weight")
+
+		// claim member 2 signed with the signature of member 1
+		tampered = append([]byte{}, raw...)
+		binary.LittleEndian.PutUint16(tampered[2+2*(1+64):], 0b101)
+		_, err = signer.VerifySignature(message, base64.StdEncoding.EncodeToString(tampered))
+		require.ErrorContains(t, err, "does not match multisig member 2")
+	})
+}
@@ -0,0 +1,158 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/aptos-labs/aptos-go-sdk/bcs"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/blake2b"
+)
+
+// VerifySignature verifies a serialized Sui signature (base64 encoded) of message and returns the address of the
+// signing account. Single key signatures (`flag || signature || pubKey`) must be valid for their public key, MultiSig
+// signatures must carry valid signatures of members reaching the threshold of their MultiSig public key.
+func VerifySignature(message []byte, serialized string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(serialized)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode signature: %w", err)
+	}
+	if len(raw) == 0 {
+		return "", errors.New("empty signature")
+	}
+
+	digest := blake2b.Sum256(messageWithIntent(message))
+	if SigFlag(raw[0]) == SigFlagMultiSig {
+		return verifyMultiSig(digest[:], raw[1:])
+	}
+
+	flag, signature, pubKey, err := parseSerializedSignature(serialized)
+	if err != nil {
+		return "", err
+	}
+	if err := verifySingleKey(digest[:], flag, signature, pubKey); err != nil {
+		return "", err
+	}
+
+	return AddressFromPublicKey(flag, pubKey), nil
+}
+
+// verifySingleKey verifies a compact signature of the intent message digest. Secp256k1 and Secp256r1 signatures
+// sign the SHA-256 of the digest, and must be low-s normalized as Sui rejects malleable signatures.
+func verifySingleKey(digest []byte, flag SigFlag, signature []byte, pubKey []byte) error {
+	valid := false
+	switch flag {
+	case SigFlagEd25519:
+		valid = ed25519.Verify(pubKey, digest, signature)
+	case SigFlagSecp256k1:
+		hash := sha256.Sum256(digest)
+		valid = crypto.VerifySignature(pubKey, hash[:], signature)
+	case SigFlagSecp256r1:
+		curve := elliptic.P256()
+		x, y := elliptic.UnmarshalCompressed(curve, pubKey)
+		if x == nil {
+			return errors.New("invalid secp256r1 public key")
+		}
+		r := new(big.Int).SetBytes(signature[:secp256r1ScalarLength])
+		s := new(big.Int).SetBytes(signature[secp256r1ScalarLength:])
+		hash := sha256.Sum256(digest)
+		halfOrder := new(big.Int).Rsh(curve.Params().N, 1)
+		valid = s.Cmp(halfOrder) <= 0 && ecdsa.Verify(&ecdsa.PublicKey{Curve: curve, X: x, Y: y}, hash[:], r, s)
+	default:
+		return fmt.Errorf("unsupported signature scheme flag %d", flag)
+	}
+
+	if !valid {
+		return fmt.Errorf("invalid signature for public key %x", pubKey)
+	}
+
+	return nil
+}
+
+// verifyMultiSig verifies the BCS of a MultiSig signature (without its flag), as serialized by CombineSignatures.
+func verifyMultiSig(digest []byte, raw []byte) (string, error) {
+	type compressedSignature struct {
+		flag      SigFlag
+		signature []byte
+	}
+
+	des := bcs.NewDeserializer(raw)
+	signatureCount := des.Uleb128()
+	if des.Error() == nil && signatureCount > MaxMultiSigSigners {
+		return "", fmt.Errorf("multisig has %d signatures, at most %d are supported", signatureCount, MaxMultiSigSigners)
+	}
+	signatures := make([]compressedSignature, signatureCount)
+	for i := range signatures {
+		signatures[i].flag = SigFlag(des.Uleb128())
+		signatures[i].signature = des.ReadFixedBytes(compressedSignatureLength)
+	}
+	bitmap := des.U16()
+
+	members := make([]MultiSigMember, des.Uleb128())
+	if des.Error() == nil && len(members) > MaxMultiSigSigners {
+		return "", fmt.Errorf("multisig has %d members, at most %d are supported", len(members), MaxMultiSigSigners)
+	}
+	for i := range members {
+		members[i].Flag = SigFlag(des.Uleb128())
+		if des.Error() != nil {
+			break
+		}
+		switch members[i].Flag {
+		case SigFlagEd25519:
+			members[i].PublicKey = des.ReadFixedBytes(ed25519.PublicKeySize)
+		case SigFlagSecp256k1, SigFlagSecp256r1:
+			members[i].PublicKey = des.ReadFixedBytes(compressedSecpPublicKeyLength)
+		default:
+			return "", fmt.Errorf("multisig member %d has unsupported signature scheme flag %d", i, members[i].Flag)
+		}
+		members[i].Weight = des.U8()
+	}
+	threshold := des.U16()
+	if err := des.Error(); err != nil {
+		return "", fmt.Errorf("failed to decode multisig signature: %w", err)
+	}
+	if des.Remaining() > 0 {
+		return "", fmt.Errorf("%d unexpected bytes after multisig signature", des.Remaining())
+	}
+
+	multiSigPublicKey, err := NewMultiSigPublicKey(members, threshold)
+	if err != nil {
+		return "", err
+	}
+
+	// the signatures follow the order of the members set in the bitmap
+	var weight uint16
+	next := 0
+	for index := range members {
+		if bitmap&(1<<index) == 0 {
+			continue
+		}
+		if next == len(signatures) {
+			return "", fmt.Errorf("missing signature for multisig member %d", index)
+		}
+		member := members[index]
+		signature := signatures[next]
+		next++
+		if signature.flag != member.Flag {
+			return "", fmt.Errorf("signature scheme %d does not match multisig member %d", signature.flag, index)
+		}
+		if err := verifySingleKey(digest, member.Flag, signature.signature, member.PublicKey); err != nil {
+			return "", fmt.Errorf("multisig member %d: %w", index, err)
+		}
+		weight += uint16(member.Weight)
+	}
+	if next != len(signatures) || bitmap>>len(members) != 0 {
+		return "", fmt.Errorf("multisig bitmap %b does not match its %d signatures", bitmap, len(signatures))
+	}
+	if weight < threshold {
+		return "", fmt.Errorf("insufficient multisig weight: got %d, threshold %d", weight, threshold)
+	}
+
+	return multiSigPublicKey.Address(), nil
+}
@@ -2,6 +2,7 @@ package testutils
 
 import (
 	"context"
+	"errors"
 	"math/big"
 
 	"github.com/block-vision/sui-go-sdk/models"
@@ -9,7 +10,9 @@ import (
 	"github.com/block-vision/sui-go-sdk/sui"
 	"github.com/block-vision/sui-go-sdk/transaction"
 
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
 	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/codec/movebcs"
 )
 
 // FakeSuiPTBClient implements the SuiPTBClient interface for testing
@@ -18,6 +21,14 @@ type FakeSuiPTBClient struct {
 	Status client.TransactionResult
 	// CoinsData controls the simulated response for GetCoinsByAddress
 	CoinsData []models.CoinData
+	// DryRun controls the simulated response for DryRunTransaction
+	DryRun client.DryRunResult
+	// MoveTypes holds the layouts used by DecodeMoveValue, which fails when unset
+	MoveTypes *movebcs.Registry
+	// Balances controls the simulated response for GetSUIBalance by address, unknown addresses having no balance
+	Balances map[string]*big.Int
+	// Events controls the simulated response for QueryEvents, returned as a single page
+	Events []bind.SuiEvent
 }
 
 var _ client.SuiPTBClient = (*FakeSuiPTBClient)(nil)
@@ -50,8 +61,8 @@ func (c *FakeSuiPTBClient) SignAndSendTransaction(ctx context.Context, txBytesRa
 	return client.SuiTransactionBlockResponse{}, nil
 }
 
-func (c *FakeSuiPTBClient) QueryEvents(ctx context.Context, filter client.EventFilterByMoveEventModule, limit *uint, cursor *client.EventId, sortOptions *client.QuerySortOptions) (*models.PaginatedEventsResponse, error) {
-	return &models.PaginatedEventsResponse{}, nil
+func (c *FakeSuiPTBClient) QueryEvents(ctx context.Context, filter client.EventFilterByMoveEventModule, limit *uint, cursor *client.EventId, sortOptions *client.QuerySortOptions) (*bind.EventsPage, error) {
+	return &bind.EventsPage{Data: c.Events}, nil
 }
 
 func (c *FakeSuiPTBClient) GetTransactionStatus(ctx context.Context, digest string) (client.TransactionResult, error) {
@@ -89,6 +100,10 @@ func (c *FakeSuiPTBClient) EstimateGas(ctx context.Context, txBytes string) (uin
 	return 0, nil
 }
 
+func (c *FakeSuiPTBClient) DryRunTransaction(ctx context.Context, txBytes string) (client.DryRunResult, error) {
+	return c.DryRun, nil
+}
+
 func (c *FakeSuiPTBClient) BlockByDigest(ctx context.Context, txDigest string) (*client.SuiTransactionBlockResponse, error) {
 	return &client.SuiTransactionBlockResponse{}, nil
 }
@@ -98,6 +113,14 @@ func (c *FakeSuiPTBClient) FinishPTBAndSend(ctx context.Context, txnSigner *sign
 }
 
 func (c *FakeSuiPTBClient) GetSUIBalance(ctx context.Context, address string) (*big.Int, error) {
+	if balance, ok := c.Balances[address]; ok {
+		return balance, nil
+	}
+
+	return big.NewInt(0), nil
+}
+
+func (c *FakeSuiPTBClient) GetCoinBalance(ctx context.Context, address string, coinType string) (*big.Int, error) {
 	return big.NewInt(0), nil
 }
 
@@ -105,6 +128,18 @@ func (c *FakeSuiPTBClient) GetNormalizedModule(ctx context.Context, packageId st
 	return models.GetNormalizedMoveModuleResponse{}, nil
 }
 
+func (c *FakeSuiPTBClient) DecodeMoveValue(ctx context.Context, moveType string, bcsBytes []byte) (any, error) {
+	if c.MoveTypes == nil {
+		return nil, errors.New("no move types registered")
+	}
+	parsed, err := movebcs.ParseType(moveType)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.MoveTypes.Decode(bcsBytes, parsed)
+}
+
 func (c *FakeSuiPTBClient) GetClient() sui.ISuiAPI {
 	return nil
 }
@@ -117,8 +152,9 @@ func (c *FakeSuiPTBClient) QueryTransactions(ctx context.Context, fromAddress st
 	return models.SuiXQueryTransactionBlocksResponse{}, nil
 }
 
+// HashTxBytes hashes the transaction bytes like the PTB client does, so that signatures can be verified
 func (c *FakeSuiPTBClient) HashTxBytes(txBytes []byte) []byte {
-	return []byte{}
+	return new(client.PTBClient).HashTxBytes(txBytes)
 }
 
 func (c *FakeSuiPTBClient) SuiXGetReferenceGasPrice(ctx context.Context) (string, error) {
@@ -165,8 +201,8 @@ func (c *StatefulFakeSuiPTBClient) SignAndSendTransaction(ctx context.Context, t
 	return client.SuiTransactionBlockResponse{}, nil
 }
 
-func (c *StatefulFakeSuiPTBClient) QueryEvents(ctx context.Context, filter client.EventFilterByMoveEventModule, limit *uint, cursor *client.EventId, sortOptions *client.QuerySortOptions) (*models.PaginatedEventsResponse, error) {
-	return &models.PaginatedEventsResponse{}, nil
+func (c *StatefulFakeSuiPTBClient) QueryEvents(ctx context.Context, filter client.EventFilterByMoveEventModule, limit *uint, cursor *client.EventId, sortOptions *client.QuerySortOptions) (*bind.EventsPage, error) {
+	return &bind.EventsPage{}, nil
 }
 
 func (c *StatefulFakeSuiPTBClient) GetTransactionStatus(ctx context.Context, digest string) (client.TransactionResult, error) {
@@ -216,6 +252,10 @@ func (c *StatefulFakeSuiPTBClient) EstimateGas(ctx context.Context, txBytes stri
 	return 0, nil
 }
 
+func (c *StatefulFakeSuiPTBClient) DryRunTransaction(ctx context.Context, txBytes string) (client.DryRunResult, error) {
+	return client.DryRunResult{TransactionResult: client.TransactionResult{Status: "success"}}, nil
+}
+
 func (c *StatefulFakeSuiPTBClient) BlockByDigest(ctx context.Context, txDigest string) (*client.SuiTransactionBlockResponse, error) {
 	return &client.SuiTransactionBlockResponse{}, nil
 }
@@ -228,10 +268,18 @@ func (c *StatefulFakeSuiPTBClient) GetSUIBalance(ctx context.Context, address st
 	return big.NewInt(0), nil
 }
 
+func (c *StatefulFakeSuiPTBClient) GetCoinBalance(ctx context.Context, address string, coinType string) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
 func (c *StatefulFakeSuiPTBClient) GetNormalizedModule(ctx context.Context, packageId string, module string) (models.GetNormalizedMoveModuleResponse, error) {
 	return models.GetNormalizedMoveModuleResponse{}, nil
 }
 
+func (c *StatefulFakeSuiPTBClient) DecodeMoveValue(ctx context.Context, moveType string, bcsBytes []byte) (any, error) {
+	return nil, errors.New("no move types registered")
+}
+
 func (c *StatefulFakeSuiPTBClient) GetClient() sui.ISuiAPI {
 	return nil
 }
@@ -0,0 +1,95 @@
+package testutils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+)
+
+// secpScalarLength is the length of each of the r and s components of a compact ECDSA signature.
+const secpScalarLength = 32
+
+// NewTestSecpKeystore creates a new test keystore of Secp256k1 and Secp256r1 keys
+func NewTestSecpKeystore(t *testing.T) *TestSecpKeystore {
+	t.Helper()
+	return &TestSecpKeystore{t: t, Keys: map[string]*ecdsa.PrivateKey{}}
+}
+
+// TestSecpKeystore is a simple keystore of Secp256k1 and Secp256r1 keys for testing. Its accounts are identified by
+// the flagged public key, and it signs the digest it is given the way the TXM expects such keystores to: with ECDSA
+// over SHA-256, returning the 64 bytes, low-s normalized r || s signature.
+type TestSecpKeystore struct {
+	t    *testing.T
+	Keys map[string]*ecdsa.PrivateKey
+}
+
+var _ loop.Keystore = &TestSecpKeystore{}
+
+// AddKey adds a Secp256k1 or Secp256r1 private key to the keystore and returns its flagged public key
+func (tk *TestSecpKeystore) AddKey(key *ecdsa.PrivateKey) []byte {
+	var publicKey []byte
+	switch key.Curve {
+	case crypto.S256():
+		publicKey = append([]byte{byte(client.SigFlagSecp256k1)}, crypto.CompressPubkey(&key.PublicKey)...)
+	case elliptic.P256():
+		publicKey = append([]byte{byte(client.SigFlagSecp256r1)}, elliptic.MarshalCompressed(key.Curve, key.X, key.Y)...)
+	default:
+		tk.t.Fatalf("Unsupported curve: %s", key.Curve.Params().Name)
+	}
+
+	tk.Keys[fmt.Sprintf("%064x", publicKey)] = key
+
+	return publicKey
+}
+
+func (tk *TestSecpKeystore) Decrypt(ctx context.Context, account string, encrypted []byte) (decrypted []byte, err error) {
+	return nil, fmt.Errorf("method Decrypt not implemented")
+}
+
+func (tk *TestSecpKeystore) Sign(ctx context.Context, id string, hash []byte) ([]byte, error) {
+	privateKey, ok := tk.Keys[id]
+	if !ok {
+		tk.t.Fatalf("No such key: %s", id)
+	}
+
+	// used to check if the account exists.
+	if hash == nil {
+		return nil, nil
+	}
+
+	digest := sha256.Sum256(hash)
+	r, s, err := ecdsa.Sign(rand.Reader, privateKey, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// Sui rejects malleable signatures
+	curveOrder := privateKey.Curve.Params().N
+	if s.Cmp(new(big.Int).Rsh(curveOrder, 1)) > 0 {
+		s = new(big.Int).Sub(curveOrder, s)
+	}
+
+	signature := make([]byte, 2*secpScalarLength)
+	r.FillBytes(signature[:secpScalarLength])
+	s.FillBytes(signature[secpScalarLength:])
+
+	return signature, nil
+}
+
+func (tk *TestSecpKeystore) Accounts(ctx context.Context) ([]string, error) {
+	accounts := make([]string, 0, len(tk.Keys))
+	for id := range tk.Keys {
+		accounts = append(accounts, id)
+	}
+	return accounts, nil
+}
@@ -57,58 +57,89 @@ func (txm *SuiTxm) broadcastLoop() {
 }
 
 func broadcastTransactions(loopCtx context.Context, txm *SuiTxm, transactions []SuiTx) {
-	for _, tx := range transactions {
-		// Process the transaction for broadcasting
-		payload := client.TransactionBlockRequest{
-			TxBytes:    tx.Payload,
-			Signatures: tx.Signatures,
-			Options: client.TransactionBlockOptions{
-				ShowInput:          true,
-				ShowRawInput:       true,
-				ShowEffects:        true,
-				ShowObjectChanges:  true,
-				ShowBalanceChanges: true,
-				ShowEvents:         true,
-			},
-			RequestType: tx.RequestType,
-		}
+	// the pending transactions of a bundle are broadcast in bundle order the first time one of them is seen
+	handledBundles := map[string]struct{}{}
 
-		txm.lggr.Infow("Broadcasting transaction", "txID", tx.TransactionID, "payload", tx)
+	for _, tx := range transactions {
+		if tx.BundleID != "" && tx.State == StatePending {
+			if _, handled := handledBundles[tx.BundleID]; handled {
+				continue
+			}
+			handledBundles[tx.BundleID] = struct{}{}
 
-		resp, err := txm.suiGateway.SendTransaction(loopCtx, payload)
-		txm.lggr.Infow("Transaction broadcasted", "txID", tx.TransactionID, "resp", resp)
+			broadcastBundle(loopCtx, txm, tx.BundleID)
 
-		// We increment the attempts here regardless of the error
-		// This is because we want to keep track of how many times we tried to broadcast the transaction
-		// Even in the case the transaction is malformed (e.g wrong function name)
-		attemptErr := txm.transactionRepository.IncrementAttempts(tx.TransactionID)
-		if attemptErr != nil {
-			txm.lggr.Errorw("Failed to increment transaction attempts", "txID", tx.TransactionID, "error", attemptErr)
 			continue
 		}
-		if err != nil {
-			// In the case there is an error submitting
-			txm.lggr.Errorw("Failed to broadcast transaction", "txID", tx.TransactionID, "function inputs", tx.Functions, "error", err)
-			// Update the transaction state to Failed if the digest is empty
-			// An empty digest indicates a total failure of the transaction
-			if resp.TxDigest == "" {
-				txm.lggr.Errorw("Transaction failed without a digest", "txID", tx.TransactionID, "function inputs", tx.Functions)
-				err = txm.transactionRepository.ChangeState(tx.TransactionID, StateFailed)
-				if err != nil {
-					txm.lggr.Errorw("Failed to change transaction state to Failed", "txID", tx.TransactionID, "error", err)
-				}
-			}
 
-			continue
-		}
-		txm.lggr.Infow("Transaction broadcasted", "response", resp, "txID", tx.TransactionID)
+		broadcastTransaction(loopCtx, txm, tx)
+	}
+}
 
-		err = txm.transactionRepository.UpdateTransactionDigest(tx.TransactionID, resp.TxDigest)
-		if err != nil {
-			txm.lggr.Errorw("Failed to update transaction digest", "txID", tx.TransactionID, "error", err)
-			continue
+// broadcastTransaction submits a single transaction to the Sui blockchain and updates its state
+// according to the submission result.
+func broadcastTransaction(loopCtx context.Context, txm *SuiTxm, tx SuiTx) {
+	// Process the transaction for broadcasting
+	payload := transactionBlockRequest(tx)
+
+	txm.lggr.Infow("Broadcasting transaction", "txID", tx.TransactionID, "payload", tx)
+
+	resp, err := txm.suiGateway.SendTransaction(loopCtx, payload)
+	txm.lggr.Infow("Transaction broadcasted", "txID", tx.TransactionID, "resp", resp)
+	txm.objectResolver.UpdateFromEffects(resp.Effects)
+
+	// We increment the attempts here regardless of the error
+	// This is because we want to keep track of how many times we tried to broadcast the transaction
+	// Even in the case the transaction is malformed (e.g wrong function name)
+	attemptErr := txm.transactionRepository.IncrementAttempts(tx.TransactionID)
+	if attemptErr != nil {
+		txm.lggr.Errorw("Failed to increment transaction attempts", "txID", tx.TransactionID, "error", attemptErr)
+		return
+	}
+	if err != nil {
+		// In the case there is an error submitting
+		txm.lggr.Errorw("Failed to broadcast transaction", "txID", tx.TransactionID, "function inputs", tx.Functions, "error", err)
+		// Update the transaction state to Failed if the digest is empty
+		// An empty digest indicates a total failure of the transaction
+		if resp.TxDigest == "" {
+			txm.lggr.Errorw("Transaction failed without a digest", "txID", tx.TransactionID, "function inputs", tx.Functions)
+			err = txm.transactionRepository.ChangeState(tx.TransactionID, StateFailed)
+			if err != nil {
+				txm.lggr.Errorw("Failed to change transaction state to Failed", "txID", tx.TransactionID, "error", err)
+			}
 		}
-		_ = txm.transactionRepository.ChangeState(tx.TransactionID, StateSubmitted)
+
+		return
+	}
+	txm.lggr.Infow("Transaction broadcasted", "response", resp, "txID", tx.TransactionID)
+
+	markSubmitted(txm, tx.TransactionID, resp.TxDigest)
+}
+
+// markSubmitted records the digest of a broadcast transaction and moves it to the submitted state.
+func markSubmitted(txm *SuiTxm, transactionID string, digest string) {
+	err := txm.transactionRepository.UpdateTransactionDigest(transactionID, digest)
+	if err != nil {
+		txm.lggr.Errorw("Failed to update transaction digest", "txID", transactionID, "error", err)
+		return
+	}
+	_ = txm.transactionRepository.ChangeState(transactionID, StateSubmitted)
+}
+
+// transactionBlockRequest builds the execution request for a signed transaction.
+func transactionBlockRequest(tx SuiTx) client.TransactionBlockRequest {
+	return client.TransactionBlockRequest{
+		TxBytes:    tx.Payload,
+		Signatures: tx.Signatures,
+		Options: client.TransactionBlockOptions{
+			ShowInput:          true,
+			ShowRawInput:       true,
+			ShowEffects:        true,
+			ShowObjectChanges:  true,
+			ShowBalanceChanges: true,
+			ShowEvents:         true,
+		},
+		RequestType: tx.RequestType,
 	}
 }
 
@@ -0,0 +1,133 @@
+// bundle.go provides ordered submission of groups of transactions for the transaction manager.
+// The transactions of a bundle are stored together, submitted one after the other in bundle order, and their
+// status is aggregated per bundle. They are not submitted as a Sui soft bundle: full nodes don't accept soft
+// bundles, so the transactions of a bundle are neither sequenced in the same consensus round nor atomic.
+package txm
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/block-vision/sui-go-sdk/transaction"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+// EnqueueBundle generates one transaction per Programmable Transaction Block (PTB), adds them to the
+// transaction store as a group identified by bundleID, and queues the group for broadcasting.
+// Every transaction of the bundle is signed by the same account and uses its own gas coins, so that submitting
+// one of them doesn't invalidate the gas payment of the next ones.
+// It's part of the TxManager interface implementation.
+//
+// Parameters:
+//   - ctx: Context for the operation.
+//   - bundleID: Unique identifier for the bundle. Transaction IDs are derived from it.
+//   - txMetadata: Transaction metadata applied to every transaction of the bundle.
+//   - signerPublicKey: The public key of the account signing and sending the transactions.
+//   - ptbs: The ProgrammableTransaction blocks, in the order they should be executed.
+//
+// Returns:
+//   - []*SuiTx: The generated and stored transactions, in bundle order.
+//   - error: An error if any transaction cannot be generated or stored. No transaction is enqueued in that case.
+func (txm *SuiTxm) EnqueueBundle(ctx context.Context, bundleID string, txMetadata *commontypes.TxMeta, signerPublicKey []byte, ptbs []*transaction.Transaction) ([]*SuiTx, error) {
+	txm.lggr.Infow("Enqueuing bundle", "bundleID", bundleID, "size", len(ptbs))
+
+	if len(ptbs) == 0 {
+		return nil, errors.New("bundle must contain at least one PTB")
+	}
+
+	usedCoins := map[string]struct{}{}
+	txns := make([]*SuiTx, 0, len(ptbs))
+
+	for i, ptb := range ptbs {
+		txn, err := generatePTBTransactionWithGasEstimation(
+			ctx, signerPublicKey, txm.lggr, txm.keystoreService, txm.suiGateway,
+			txm.configuration.RequestType, fmt.Sprintf("%s-%d", bundleID, i), txMetadata,
+			ptb, txm.gasManager, usedCoins,
+		)
+		if err != nil {
+			txm.lggr.Errorw("Failed to generate bundle txn", "bundleID", bundleID, "index", i, "error", err)
+			return nil, err
+		}
+		txn.BundleID = bundleID
+
+		// reserve the gas coins of this transaction so the next ones do not conflict with it
+		if ptb.Data.V1.GasData.Payment != nil {
+			for _, coin := range *ptb.Data.V1.GasData.Payment {
+				usedCoins["0x"+hex.EncodeToString(coin.ObjectId[:])] = struct{}{}
+			}
+		}
+
+		txns = append(txns, txn)
+	}
+
+	// every transaction is generated and signed before any is stored, and they are stored at once, so that the
+	// broadcaster never sees part of a bundle
+	bundle := make([]SuiTx, 0, len(txns))
+	for _, txn := range txns {
+		bundle = append(bundle, *txn)
+	}
+	err := txm.transactionRepository.AddTransactions(bundle)
+	if err != nil {
+		txm.lggr.Errorw("Failed to add bundle txns to repository", "bundleID", bundleID, "error", err)
+		return nil, err
+	}
+
+	// Only the first transaction is queued, the broadcaster picks up the rest of the bundle from the store
+	txm.broadcastChannel <- txns[0].TransactionID
+	txm.metrics.RecordQueueDepth(ctx, len(txm.broadcastChannel))
+	txm.lggr.Infow("Bundle enqueued", "bundleID", bundleID)
+
+	return txns, nil
+}
+
+// GetBundleStatus aggregates the status of every transaction of a bundle.
+// A bundle is fatal if any of its transactions failed permanently, failed if any is being retried,
+// pending while any has not been submitted yet, and finalized once all of them are finalized.
+func (txm *SuiTxm) GetBundleStatus(ctx context.Context, bundleID string) (commontypes.TransactionStatus, error) {
+	txns, err := txm.transactionRepository.GetTransactionsByBundle(bundleID)
+	if err != nil {
+		txm.lggr.Errorw("Failed to get bundle transactions", "bundleID", bundleID, "error", err)
+		return commontypes.Unknown, err
+	}
+
+	counts := map[TransactionState]int{}
+	for _, tx := range txns {
+		counts[tx.State]++
+	}
+
+	switch {
+	case counts[StateFailed] > 0:
+		return commontypes.Fatal, nil
+	case counts[StateRetriable] > 0:
+		return commontypes.Failed, nil
+	case counts[StatePending] > 0:
+		return commontypes.Pending, nil
+	case counts[StateSubmitted] > 0:
+		return commontypes.Unconfirmed, nil
+	case counts[StateFinalized] == len(txns):
+		return commontypes.Finalized, nil
+	default:
+		return commontypes.Unknown, errors.New("unknown bundle state")
+	}
+}
+
+// broadcastBundle submits the pending transactions of a bundle one after the other, in bundle order. Each of them
+// is tracked and retried as a standalone transaction once submitted.
+func broadcastBundle(loopCtx context.Context, txm *SuiTxm, bundleID string) {
+	members, err := txm.transactionRepository.GetTransactionsByBundle(bundleID)
+	if err != nil {
+		txm.lggr.Errorw("Failed to get bundle transactions", "bundleID", bundleID, "error", err)
+		return
+	}
+
+	txm.lggr.Infow("Broadcasting bundle", "bundleID", bundleID, "size", len(members))
+	for _, tx := range members {
+		// transactions already submitted, e.g. before a restart, are left to the confirmer
+		if tx.State != StatePending {
+			continue
+		}
+		broadcastTransaction(loopCtx, txm, tx)
+	}
+}
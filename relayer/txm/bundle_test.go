@@ -0,0 +1,160 @@
+//go:build unit
+
+package txm_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/testutils"
+	"github.com/smartcontractkit/chainlink-sui/relayer/txm"
+)
+
+// sendRecordingClient records the order in which transactions are sent, and fails without digest the sending of
+// the transaction bytes in failing.
+type sendRecordingClient struct {
+	*testutils.FakeSuiPTBClient
+	mu      sync.Mutex
+	sent    []string
+	failing string
+}
+
+func (c *sendRecordingClient) SendTransaction(_ context.Context, payload client.TransactionBlockRequest) (client.SuiTransactionBlockResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sent = append(c.sent, payload.TxBytes)
+	if payload.TxBytes == c.failing {
+		return client.SuiTransactionBlockResponse{}, errors.New("rejected")
+	}
+
+	return client.SuiTransactionBlockResponse{TxDigest: fmt.Sprintf("digest-%d", len(c.sent))}, nil
+}
+
+func (c *sendRecordingClient) sentPayloads() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]string(nil), c.sent...)
+}
+
+func newSendRecordingClient(coins int) *sendRecordingClient {
+	coinsData := make([]models.CoinData, coins)
+	for i := range coinsData {
+		coinsData[i] = bundleTestCoin(fmt.Sprintf("0x%d234567890abcdef1234567890abcdef12345678", i+1))
+	}
+
+	return &sendRecordingClient{FakeSuiPTBClient: &testutils.FakeSuiPTBClient{CoinsData: coinsData}}
+}
+
+func payloads(txns []*txm.SuiTx) []string {
+	result := make([]string, len(txns))
+	for i, tx := range txns {
+		result[i] = tx.Payload
+	}
+
+	return result
+}
+
+func TestBroadcastBundleInOrder(t *testing.T) {
+	t.Parallel()
+
+	fakeClient := newSendRecordingClient(3)
+	txmInstance, store, publicKey := newBundleTestTxm(t, fakeClient)
+
+	txns, err := txmInstance.EnqueueBundle(context.Background(), "bundle", &commontypes.TxMeta{GasLimit: big.NewInt(10000000)}, publicKey, newBundleTestPTBs(3))
+	require.NoError(t, err)
+	require.Len(t, txns, 3)
+
+	status, err := txmInstance.GetBundleStatus(context.Background(), "bundle")
+	require.NoError(t, err)
+	assert.Equal(t, commontypes.Pending, status)
+
+	require.NoError(t, txmInstance.Start(context.Background()))
+	t.Cleanup(func() { _ = txmInstance.Close() })
+
+	require.Eventually(t, func() bool {
+		return len(fakeClient.sentPayloads()) == len(txns)
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, payloads(txns), fakeClient.sentPayloads())
+
+	require.Eventually(t, func() bool {
+		status, err := txmInstance.GetBundleStatus(context.Background(), "bundle")
+		require.NoError(t, err)
+
+		return status == commontypes.Unconfirmed
+	}, 5*time.Second, 10*time.Millisecond)
+	bundle, err := store.GetTransactionsByBundle("bundle")
+	require.NoError(t, err)
+	for i, tx := range bundle {
+		assert.Equal(t, txm.StateSubmitted, tx.State)
+		assert.Equal(t, 1, tx.Attempt)
+		assert.Equal(t, fmt.Sprintf("digest-%d", i+1), tx.Digest)
+	}
+}
+
+func TestBroadcastBundleSkipsSubmittedTransactions(t *testing.T) {
+	t.Parallel()
+
+	fakeClient := newSendRecordingClient(3)
+	txmInstance, store, publicKey := newBundleTestTxm(t, fakeClient)
+
+	txns, err := txmInstance.EnqueueBundle(context.Background(), "bundle", &commontypes.TxMeta{GasLimit: big.NewInt(10000000)}, publicKey, newBundleTestPTBs(3))
+	require.NoError(t, err)
+	// the second transaction was already submitted, e.g. by an interrupted broadcast
+	require.NoError(t, store.UpdateTransactionDigest(txns[1].TransactionID, "digest-0"))
+	require.NoError(t, store.ChangeState(txns[1].TransactionID, txm.StateSubmitted))
+
+	require.NoError(t, txmInstance.Start(context.Background()))
+	t.Cleanup(func() { _ = txmInstance.Close() })
+
+	require.Eventually(t, func() bool {
+		return len(fakeClient.sentPayloads()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{txns[0].Payload, txns[2].Payload}, fakeClient.sentPayloads())
+
+	submitted, err := store.GetTransaction(txns[1].TransactionID)
+	require.NoError(t, err)
+	assert.Equal(t, "digest-0", submitted.Digest)
+	assert.Equal(t, 0, submitted.Attempt)
+}
+
+func TestBroadcastBundleFailedTransaction(t *testing.T) {
+	t.Parallel()
+
+	fakeClient := newSendRecordingClient(3)
+	txmInstance, store, publicKey := newBundleTestTxm(t, fakeClient)
+
+	txns, err := txmInstance.EnqueueBundle(context.Background(), "bundle", &commontypes.TxMeta{GasLimit: big.NewInt(10000000)}, publicKey, newBundleTestPTBs(3))
+	require.NoError(t, err)
+	fakeClient.failing = txns[1].Payload
+
+	require.NoError(t, txmInstance.Start(context.Background()))
+	t.Cleanup(func() { _ = txmInstance.Close() })
+
+	// the transactions after a failed one are still submitted, in order
+	require.Eventually(t, func() bool {
+		return len(fakeClient.sentPayloads()) == len(txns)
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, payloads(txns), fakeClient.sentPayloads())
+
+	require.Eventually(t, func() bool {
+		failed, err := store.GetTransaction(txns[1].TransactionID)
+		require.NoError(t, err)
+
+		return failed.State == txm.StateFailed
+	}, 5*time.Second, 10*time.Millisecond)
+	status, err := txmInstance.GetBundleStatus(context.Background(), "bundle")
+	require.NoError(t, err)
+	assert.Equal(t, commontypes.Fatal, status)
+}
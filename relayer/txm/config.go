@@ -1,5 +1,7 @@
 package txm
 
+import "github.com/smartcontractkit/chainlink-sui/relayer/config"
+
 const (
 	// DefaultBroadcastChanSize is the default size of the broadcast channel.
 	DefaultBroadcastChanSize = 100
@@ -23,6 +25,8 @@ type Config struct {
 	MaxTxRetryAttempts    uint64
 	TransactionTimeout    string
 	MaxConcurrentRequests uint64
+	// ChainInfo labels the metrics emitted by the TXM
+	ChainInfo config.ChainInfo
 }
 
 var DefaultConfigSet = Config{
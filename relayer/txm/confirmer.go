@@ -84,6 +84,9 @@ func (txm *SuiTxm) confirmerLoop() {
 }
 
 func checkConfirmations(loopCtx context.Context, txm *SuiTxm) {
+	txm.metrics.RecordQueueDepth(loopCtx, len(txm.broadcastChannel))
+	txm.metrics.RecordStoreStates(loopCtx, txm.transactionRepository)
+
 	inFlightTransactions, err := txm.transactionRepository.GetInflightTransactions()
 	if err != nil {
 		txm.lggr.Errorw("Error getting in-flight transactions", "error", err)
@@ -102,7 +105,7 @@ func checkConfirmations(loopCtx context.Context, txm *SuiTxm) {
 
 			switch resp.Status {
 			case success:
-				err := handleSuccess(txm, tx)
+				err := handleSuccess(loopCtx, txm, tx, &resp)
 				if err != nil {
 					txm.lggr.Errorw("Error handling successful transaction", "transactionID", tx.TransactionID, "error", err)
 					continue
@@ -124,13 +127,14 @@ func checkConfirmations(loopCtx context.Context, txm *SuiTxm) {
 	}
 }
 
-func handleSuccess(txm *SuiTxm, tx SuiTx) error {
+func handleSuccess(ctx context.Context, txm *SuiTxm, tx SuiTx, result *client.TransactionResult) error {
 	err := txm.transactionRepository.ChangeState(tx.TransactionID, StateFinalized)
 	if err != nil {
 		txm.lggr.Errorw("Failed to update transaction state", "transactionID", tx.TransactionID, "error", err)
 		return err
 	}
 	txm.lggr.Infow("Transaction finalized", "transactionID", tx.TransactionID)
+	txm.metrics.RecordFinalization(ctx, tx, result.GasUsed)
 
 	return nil
 }
@@ -147,6 +151,7 @@ func handleTransactionError(ctx context.Context, txm *SuiTxm, tx SuiTx, result *
 
 	if isRetryable {
 		txm.lggr.Infow("Transaction is retriable", "transactionID", tx.TransactionID, "strategy", strategy)
+		txm.metrics.IncRetries(ctx, txError.Category, strategy)
 		switch strategy {
 		case ExponentialBackoff:
 			// TODO: for another PR implement exponential backoff
@@ -164,6 +169,7 @@ func handleTransactionError(ctx context.Context, txm *SuiTxm, tx SuiTx, result *
 				if err != nil {
 					txm.lggr.Errorw("Failed to update transaction error", "transactionID", tx.TransactionID, "error", err)
 				}
+				txm.metrics.IncFailures(ctx, txError.Category)
 
 				return nil
 			}
@@ -173,6 +179,7 @@ func handleTransactionError(ctx context.Context, txm *SuiTxm, tx SuiTx, result *
 				txm.lggr.Errorw("Failed to update transaction gas", "transactionID", tx.TransactionID, "error", err)
 				return err
 			}
+			txm.metrics.IncGasBumps(ctx)
 			err = txm.transactionRepository.IncrementAttempts(tx.TransactionID)
 			if err != nil {
 				txm.lggr.Errorw("Failed to increment transaction attempts", "transactionID", tx.TransactionID, "error", err)
@@ -195,6 +202,7 @@ func handleTransactionError(ctx context.Context, txm *SuiTxm, tx SuiTx, result *
 				txm.lggr.Errorw("Failed to update transaction state", "transactionID", tx.TransactionID, "error", err)
 				return err
 			}
+			txm.metrics.IncFailures(ctx, txError.Category)
 		}
 	} else {
 		txm.lggr.Infow("Transaction is not retriable", "transactionID", tx.TransactionID, "result", result)
@@ -209,6 +217,7 @@ func handleTransactionError(ctx context.Context, txm *SuiTxm, tx SuiTx, result *
 			return err
 		}
 		txm.lggr.Infow("Transaction failed", "transactionID", tx.TransactionID)
+		txm.metrics.IncFailures(ctx, txError.Category)
 	}
 
 	return nil
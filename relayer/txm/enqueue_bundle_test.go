@@ -0,0 +1,86 @@
+//go:build unit
+
+package txm_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/testutils"
+	"github.com/smartcontractkit/chainlink-sui/relayer/txm"
+)
+
+func bundleTestCoin(id string) models.CoinData {
+	return models.CoinData{
+		CoinType:     "0x2::sui::SUI",
+		Balance:      "100000000",
+		CoinObjectId: id,
+		Version:      "1",
+		Digest:       "9WzSXdwbky8tNbH7juvyaui4QzMUYEjdCEKMrMgLhXHT",
+	}
+}
+
+func newBundleTestTxm(t *testing.T, fakeClient client.SuiPTBClient) (*txm.SuiTxm, txm.TxmStore, []byte) {
+	t.Helper()
+
+	lggr := logger.Test(t)
+	store := txm.NewTxmStoreImpl(lggr)
+	gasManager := txm.NewSuiGasManager(lggr, fakeClient, *big.NewInt(200000000), 0)
+	keystoreInstance := testutils.NewTestKeystore(t)
+	txmInstance, err := txm.NewSuiTxm(lggr, fakeClient, keystoreInstance, txm.DefaultConfigSet, store, txm.NewDefaultRetryManager(3), gasManager)
+	require.NoError(t, err)
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keystoreInstance.AddKey(privateKey)
+
+	return txmInstance, store, publicKey
+}
+
+func newBundleTestPTBs(n int) []*transaction.Transaction {
+	ptbs := make([]*transaction.Transaction, n)
+	for i := range ptbs {
+		ptbs[i] = transaction.NewTransaction()
+		ptbs[i].SetGasPrice(1000)
+	}
+
+	return ptbs
+}
+
+func TestEnqueueBundleStoresNothingOnError(t *testing.T) {
+	t.Parallel()
+
+	// a single gas coin, which the second transaction can't use as the first one does
+	fakeClient := &testutils.FakeSuiPTBClient{CoinsData: []models.CoinData{bundleTestCoin("0x1234567890abcdef1234567890abcdef12345678")}}
+	txmInstance, store, publicKey := newBundleTestTxm(t, fakeClient)
+
+	_, err := txmInstance.EnqueueBundle(context.Background(), "bundle", &commontypes.TxMeta{GasLimit: big.NewInt(10000000)}, publicKey, newBundleTestPTBs(2))
+	require.Error(t, err)
+
+	_, err = store.GetTransactionsByBundle("bundle")
+	require.Error(t, err)
+	pending, err := store.GetTransactionsByState(txm.StatePending)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	// the second transaction can't be stored, neither is the first one
+	fakeClient.CoinsData = append(fakeClient.CoinsData, bundleTestCoin("0x2234567890abcdef1234567890abcdef12345678"))
+	require.NoError(t, store.AddTransaction(txm.SuiTx{TransactionID: "bundle-1"}))
+	_, err = txmInstance.EnqueueBundle(context.Background(), "bundle", &commontypes.TxMeta{GasLimit: big.NewInt(10000000)}, publicKey, newBundleTestPTBs(2))
+	require.ErrorContains(t, err, "already exists")
+	_, err = store.GetTransaction("bundle-0")
+	require.Error(t, err)
+	_, err = store.GetTransactionsByBundle("bundle")
+	require.Error(t, err)
+}
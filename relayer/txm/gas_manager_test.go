@@ -15,6 +15,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/smartcontractkit/chainlink-sui/relayer/chainwriter/ptb/offramp"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
 	"github.com/smartcontractkit/chainlink-sui/relayer/client/mocks"
 	"github.com/smartcontractkit/chainlink-sui/relayer/txm"
 )
@@ -93,6 +94,17 @@ func TestSuiGasManager_EstimateGasBudget(t *testing.T) {
 			expectedGas:   0,
 			expectedError: errors.New("failed to estimate gas budget: network error"),
 		},
+		{
+			name: "transaction fails in dry run",
+			setupMock: func(mockClient *mocks.MockSuiPTBClient) {
+				mockClient.EXPECT().
+					EstimateGas(gomock.Any(), gomock.Any()).
+					Return(uint64(0), &client.DryRunFailedError{Reason: "InsufficientGas"}).
+					Times(1)
+			},
+			expectedGas:   0,
+			expectedError: errors.New("failed to estimate gas budget: transaction failed in dry run: InsufficientGas"),
+		},
 	}
 
 	for _, tt := range tests {
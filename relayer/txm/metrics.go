@@ -0,0 +1,150 @@
+package txm
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/beholder"
+
+	"github.com/smartcontractkit/chainlink-aptos/relayer/monitoring/metric/utils"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/client/suierrors"
+	"github.com/smartcontractkit/chainlink-sui/relayer/config"
+)
+
+var allStates = []TransactionState{StatePending, StateSubmitted, StateFinalized, StateRetriable, StateFailed}
+
+// txmMetrics holds the OTel instruments describing the transaction lifecycle of the TXM
+type txmMetrics struct {
+	chainAttributes []attribute.KeyValue
+
+	// txm_broadcast_queue_depth
+	queueDepth metric.Int64Gauge
+	// txm_transactions
+	transactions metric.Int64Gauge
+	// txm_finalization_duration
+	finalizationDuration metric.Float64Histogram
+	// txm_gas_budget
+	gasBudget metric.Int64Histogram
+	// txm_gas_used
+	gasUsed metric.Int64Histogram
+	// txm_gas_bumps
+	gasBumps metric.Int64Counter
+	// txm_retries
+	retries metric.Int64Counter
+	// txm_failures
+	failures metric.Int64Counter
+}
+
+func newTxmMetrics(chainInfo config.ChainInfo) (*txmMetrics, error) {
+	meter := beholder.GetMeter()
+	m := &txmMetrics{
+		chainAttributes: []attribute.KeyValue{
+			// Execution Context - Chain
+			attribute.String("chain_family_name", utils.ValOrUnknown(chainInfo.ChainFamilyName)),
+			attribute.String("chain_id", utils.ValOrUnknown(chainInfo.ChainID)),
+			attribute.String("network_name", utils.ValOrUnknown(chainInfo.NetworkName)),
+			attribute.String("network_name_full", utils.ValOrUnknown(chainInfo.NetworkNameFull)),
+		},
+	}
+
+	var err error
+	name := "txm_broadcast_queue_depth"
+	m.queueDepth, err = meter.Int64Gauge(name, metric.WithDescription("Number of transaction IDs waiting in the TXM broadcast channel"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new gauge %s: %+w", name, err)
+	}
+
+	name = "txm_transactions"
+	m.transactions, err = meter.Int64Gauge(name, metric.WithDescription("Number of transactions in the TXM store by state"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new gauge %s: %+w", name, err)
+	}
+
+	name = "txm_finalization_duration"
+	m.finalizationDuration, err = meter.Float64Histogram(name, metric.WithUnit("s"), metric.WithDescription("Time from enqueue to finalization of a transaction"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new histogram %s: %+w", name, err)
+	}
+
+	name = "txm_gas_budget"
+	m.gasBudget, err = meter.Int64Histogram(name, metric.WithUnit("MIST"), metric.WithDescription("Gas budget of finalized transactions"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new histogram %s: %+w", name, err)
+	}
+
+	name = "txm_gas_used"
+	m.gasUsed, err = meter.Int64Histogram(name, metric.WithUnit("MIST"), metric.WithDescription("Gas used by finalized transactions"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new histogram %s: %+w", name, err)
+	}
+
+	name = "txm_gas_bumps"
+	m.gasBumps, err = meter.Int64Counter(name, metric.WithDescription("Number of gas bumps applied to transactions"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new counter %s: %+w", name, err)
+	}
+
+	name = "txm_retries"
+	m.retries, err = meter.Int64Counter(name, metric.WithDescription("Number of transaction retries by error category and strategy"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new counter %s: %+w", name, err)
+	}
+
+	name = "txm_failures"
+	m.failures, err = meter.Int64Counter(name, metric.WithDescription("Number of permanently failed transactions by error category"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new counter %s: %+w", name, err)
+	}
+
+	return m, nil
+}
+
+// attributes returns the chain attributes together with the given metric specific attributes
+func (m *txmMetrics) attributes(extra ...attribute.KeyValue) metric.MeasurementOption {
+	return metric.WithAttributeSet(attribute.NewSet(append(extra, m.chainAttributes...)...))
+}
+
+func (m *txmMetrics) RecordQueueDepth(ctx context.Context, depth int) {
+	m.queueDepth.Record(ctx, int64(depth), m.attributes())
+}
+
+// RecordStoreStates records the number of transactions in each state of the store
+func (m *txmMetrics) RecordStoreStates(ctx context.Context, store TxmStore) {
+	for _, state := range allStates {
+		txs, err := store.GetTransactionsByState(state)
+		if err != nil {
+			continue
+		}
+		m.transactions.Record(ctx, int64(len(txs)), m.attributes(attribute.String("state", state.String())))
+	}
+}
+
+// RecordFinalization records the enqueue to finalization time and the gas figures of a finalized transaction
+func (m *txmMetrics) RecordFinalization(ctx context.Context, tx SuiTx, gasUsed uint64) {
+	if now := GetCurrentUnixTimestamp(); now >= tx.Timestamp {
+		m.finalizationDuration.Record(ctx, float64(now-tx.Timestamp), m.attributes())
+	}
+	//nolint:gosec
+	m.gasBudget.Record(ctx, int64(tx.GasBudget), m.attributes())
+	//nolint:gosec
+	m.gasUsed.Record(ctx, int64(gasUsed), m.attributes())
+}
+
+func (m *txmMetrics) IncGasBumps(ctx context.Context) {
+	m.gasBumps.Add(ctx, 1, m.attributes())
+}
+
+func (m *txmMetrics) IncRetries(ctx context.Context, category suierrors.ErrorCategory, strategy RetryStrategy) {
+	m.retries.Add(ctx, 1, m.attributes(
+		attribute.String("error_category", category.String()),
+		attribute.String("strategy", strategy.String()),
+	))
+}
+
+func (m *txmMetrics) IncFailures(ctx context.Context, category suierrors.ErrorCategory) {
+	m.failures.Add(ctx, 1, m.attributes(attribute.String("error_category", category.String())))
+}
@@ -0,0 +1,142 @@
+//go:build unit
+
+package txm_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/smartcontractkit/chainlink-common/pkg/beholder"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client/suierrors"
+	"github.com/smartcontractkit/chainlink-sui/relayer/testutils"
+	"github.com/smartcontractkit/chainlink-sui/relayer/txm"
+)
+
+const metricsTestChainID = "txm-metrics-test"
+
+// collectTxmMetrics returns the data points of the TXM metrics recorded for the test chain, by metric name and
+// metric specific attribute ("state", or "error_category" and "strategy")
+func collectTxmMetrics(t *testing.T, reader sdkmetric.Reader) map[string]map[string]int64 {
+	t.Helper()
+
+	var resourceMetrics metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &resourceMetrics))
+
+	key := func(attributes attribute.Set) (string, bool) {
+		if chainID, _ := attributes.Value("chain_id"); chainID.AsString() != metricsTestChainID {
+			return "", false
+		}
+		var key string
+		for _, name := range []attribute.Key{"state", "error_category", "strategy"} {
+			if value, ok := attributes.Value(name); ok {
+				key += value.AsString() + "/"
+			}
+		}
+
+		return key, true
+	}
+
+	collected := map[string]map[string]int64{}
+	for _, scopeMetrics := range resourceMetrics.ScopeMetrics {
+		for _, m := range scopeMetrics.Metrics {
+			points := map[string]int64{}
+			switch data := m.Data.(type) {
+			case metricdata.Gauge[int64]:
+				for _, point := range data.DataPoints {
+					if k, ok := key(point.Attributes); ok {
+						points[k] = point.Value
+					}
+				}
+			case metricdata.Sum[int64]:
+				for _, point := range data.DataPoints {
+					if k, ok := key(point.Attributes); ok {
+						points[k] = point.Value
+					}
+				}
+			case metricdata.Histogram[int64]:
+				for _, point := range data.DataPoints {
+					if k, ok := key(point.Attributes); ok {
+						points[k] = int64(point.Count)
+					}
+				}
+			}
+			collected[m.Name] = points
+		}
+	}
+
+	return collected
+}
+
+// TestTxmMetrics replaces the global beholder client, so it must not run in parallel with the other tests.
+//
+//nolint:paralleltest
+func TestTxmMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	meterProvider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	beholderClient := beholder.NewNoopClient()
+	beholderClient.MeterProvider = meterProvider
+	beholderClient.Meter = meterProvider.Meter("txm-test")
+	previousClient := beholder.GetClient()
+	beholder.SetClient(beholderClient)
+	t.Cleanup(func() { beholder.SetClient(previousClient) })
+
+	lggr := logger.Test(t)
+	store := txm.NewTxmStoreImpl(lggr)
+	// the transaction fails with a gas error, retried with a gas bump until the retry limit is reached
+	fakeClient := &testutils.FakeSuiPTBClient{
+		Status:    client.TransactionResult{Status: "failure", Error: "ErrGasBudgetTooHigh"},
+		CoinsData: []models.CoinData{bundleTestCoin("0x1234567890abcdef1234567890abcdef12345678")},
+	}
+	gasManager := txm.NewSuiGasManager(lggr, fakeClient, *big.NewInt(12000000), 0)
+	keystoreInstance := testutils.NewTestKeystore(t)
+	conf := txm.DefaultConfigSet
+	conf.ConfirmPollSecs = 1
+	conf.ChainInfo.ChainID = metricsTestChainID
+	txmInstance, err := txm.NewSuiTxm(lggr, fakeClient, keystoreInstance, conf, store, txm.NewDefaultRetryManager(3), gasManager)
+	require.NoError(t, err)
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keystoreInstance.AddKey(privateKey)
+
+	ptb := transaction.NewTransaction()
+	ptb.SetGasPrice(1000)
+	_, err = txmInstance.EnqueuePTB(context.Background(), "tx", &commontypes.TxMeta{GasLimit: big.NewInt(10000000)}, publicKey, ptb)
+	require.NoError(t, err)
+
+	// the transaction is queued until the broadcaster starts
+	collected := collectTxmMetrics(t, reader)
+	assert.Equal(t, map[string]int64{"": 1}, collected["txm_broadcast_queue_depth"])
+
+	require.NoError(t, txmInstance.Start(context.Background()))
+	t.Cleanup(func() { _ = txmInstance.Close() })
+
+	// the store states are recorded at the start of each confirm cycle, the one following the failure
+	require.Eventually(t, func() bool {
+		return collectTxmMetrics(t, reader)["txm_transactions"]["failed/"] == 1
+	}, 10*time.Second, 50*time.Millisecond)
+
+	collected = collectTxmMetrics(t, reader)
+	assert.Equal(t, map[string]int64{"": 0}, collected["txm_broadcast_queue_depth"])
+	assert.Equal(t, map[string]int64{"pending/": 0, "submitted/": 0, "finalized/": 0, "retriable/": 0, "failed/": 1}, collected["txm_transactions"])
+	gasErrors := suierrors.GasErrors.String() + "/"
+	assert.Equal(t, map[string]int64{gasErrors + txm.GasBump.String() + "/": 1}, collected["txm_retries"])
+	assert.Equal(t, map[string]int64{"": 1}, collected["txm_gas_bumps"])
+	assert.Equal(t, map[string]int64{gasErrors: 1}, collected["txm_failures"])
+	assert.Empty(t, collected["txm_finalization_duration"])
+}
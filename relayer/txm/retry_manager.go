@@ -16,6 +16,19 @@ const (
 	GasBump
 )
 
+func (s RetryStrategy) String() string {
+	switch s {
+	case NoRetry:
+		return "no_retry"
+	case ExponentialBackoff:
+		return "exponential_backoff"
+	case GasBump:
+		return "gas_bump"
+	default:
+		return "unknown"
+	}
+}
+
 // RetryStrategyFunc defines a function signature for evaluating if a transaction error
 // is retryable and determining which retry strategy to use.
 //
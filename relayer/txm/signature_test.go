@@ -0,0 +1,71 @@
+//go:build unit
+
+package txm_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/signer"
+	"github.com/smartcontractkit/chainlink-sui/relayer/testutils"
+	"github.com/smartcontractkit/chainlink-sui/relayer/txm"
+)
+
+func TestEnqueuePTBSecpSignature(t *testing.T) {
+	t.Parallel()
+
+	secp256k1Key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	secp256r1Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	for name, privateKey := range map[string]*ecdsa.PrivateKey{"Secp256k1": secp256k1Key, "Secp256r1": secp256r1Key} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			lggr := logger.Test(t)
+			fakeClient := &testutils.FakeSuiPTBClient{CoinsData: []models.CoinData{bundleTestCoin("0x1234567890abcdef1234567890abcdef12345678")}}
+			gasManager := txm.NewSuiGasManager(lggr, fakeClient, *big.NewInt(200000000), 0)
+			keystoreInstance := testutils.NewTestSecpKeystore(t)
+			publicKey := keystoreInstance.AddKey(privateKey)
+
+			txmInstance, err := txm.NewSuiTxm(lggr, fakeClient, keystoreInstance, txm.DefaultConfigSet, txm.NewTxmStoreImpl(lggr), txm.NewDefaultRetryManager(3), gasManager)
+			require.NoError(t, err)
+
+			tx, err := txmInstance.EnqueuePTB(context.Background(), "tx-secp", &commontypes.TxMeta{GasLimit: big.NewInt(10000000)}, publicKey, newBundleTestPTBs(1)[0])
+			require.NoError(t, err)
+			require.Len(t, tx.Signatures, 1)
+
+			// the keystore signature is serialized with the scheme of the key and signs the transaction of the sender
+			txBytes, err := base64.StdEncoding.DecodeString(tx.Payload)
+			require.NoError(t, err)
+			address, err := signer.VerifySignature(txBytes, tx.Signatures[0])
+			require.NoError(t, err)
+			assert.Equal(t, tx.Sender, address)
+		})
+	}
+}
+
+func TestEnqueuePTBInvalidPublicKey(t *testing.T) {
+	t.Parallel()
+
+	txmInstance, _, _ := newBundleTestTxm(t, &testutils.FakeSuiPTBClient{})
+
+	// a flagged key of an unknown scheme is rejected instead of being signed and serialized as an Ed25519 key
+	publicKey := make([]byte, 34)
+	publicKey[0] = 0x05
+	_, err := txmInstance.EnqueuePTB(context.Background(), "tx-invalid", &commontypes.TxMeta{GasLimit: big.NewInt(10000000)}, publicKey, newBundleTestPTBs(1)[0])
+	require.ErrorContains(t, err, "unsupported signature scheme flag")
+}
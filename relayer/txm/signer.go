@@ -0,0 +1,219 @@
+// signer.go provides the selection of the account submitting a transaction among several candidate signers, which
+// lets transactions of the same kind be spread over several accounts instead of queuing behind the gas coins and
+// shared object ordering of a single one.
+package txm
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+)
+
+const (
+	// signerReservationTTL bounds how long a selected signer counts as busy before its transaction is stored, so
+	// that selections whose transaction is never enqueued, e.g. because its PTB could not be built, are forgotten
+	signerReservationTTL = time.Minute
+	// signerBalanceTTL is how long the balance of a candidate is reused by later selections
+	signerBalanceTTL = 10 * time.Second
+)
+
+// signerSelections holds the state shared by concurrent SelectSigner calls.
+type signerSelections struct {
+	mu sync.Mutex
+	// reservations holds, by hex public key, the selections whose transaction is not stored yet
+	reservations map[string][]*signerReservation
+	balances     map[string]cachedBalance
+}
+
+// signerReservation is a selection of a signer, counted as one of its transactions until it is released or expires.
+type signerReservation struct {
+	expiresAt time.Time
+}
+
+type cachedBalance struct {
+	balance   *big.Int
+	expiresAt time.Time
+}
+
+func newSignerSelections() *signerSelections {
+	return &signerSelections{reservations: map[string][]*signerReservation{}, balances: map[string]cachedBalance{}}
+}
+
+// reserved returns the number of unexpired selections of a signer, forgetting the expired ones.
+func (s *signerSelections) reserved(key string, now time.Time) int {
+	unexpired := s.reservations[key][:0]
+	for _, reservation := range s.reservations[key] {
+		if reservation.expiresAt.After(now) {
+			unexpired = append(unexpired, reservation)
+		}
+	}
+	if len(unexpired) == 0 {
+		delete(s.reservations, key)
+	} else {
+		s.reservations[key] = unexpired
+	}
+
+	return len(unexpired)
+}
+
+// release forgets a selection of a signer once its transaction is stored, and counted as active, or could not be
+// enqueued. Releasing a selection more than once, or after it expired, has no effect.
+func (s *signerSelections) release(key string, reservation *signerReservation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reservations := s.reservations[key]
+	for i, r := range reservations {
+		if r == reservation {
+			reservations = append(reservations[:i], reservations[i+1:]...)
+			break
+		}
+	}
+	if len(reservations) == 0 {
+		delete(s.reservations, key)
+	} else {
+		s.reservations[key] = reservations
+	}
+}
+
+// SelectSigner picks the public key a transaction is submitted with among candidates. When no candidate is given,
+// every account of the keystore (the transmitter keys of the node) is a candidate.
+// It's part of the TxManager interface implementation.
+//
+// The candidate with the fewest pending, in-flight and selected but not yet enqueued transactions is selected, ties
+// being broken by the highest SUI balance and then by the order of the candidates. Candidates whose balance cannot
+// be read are only selected when no balance can be read. Selections are serialized, and each one counts as a
+// transaction of the selected signer until it is released or a minute has passed, so that concurrent submissions
+// are spread over the candidates. Balances are cached for a few seconds.
+//
+// Parameters:
+//   - ctx: Context for the operation.
+//   - candidates: The public keys of the accounts that may sign the transaction.
+//
+// Returns:
+//   - []byte: The public key of the selected signer.
+//   - func(): Releases the selection, to be called by the caller once the transaction is enqueued or when it won't
+//     be. It only releases this selection, and does nothing when there was a single candidate.
+//   - error: An error if there are no candidates or the transactions of the store cannot be read.
+func (txm *SuiTxm) SelectSigner(ctx context.Context, candidates [][]byte) ([]byte, func(), error) {
+	if len(candidates) == 0 {
+		accounts, err := txm.keystoreService.Accounts(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list keystore accounts: %w", err)
+		}
+		for _, account := range accounts {
+			publicKey, err := hex.DecodeString(account)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to decode keystore account %s: %w", account, err)
+			}
+			candidates = append(candidates, publicKey)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil, errors.New("no signer candidates and no keystore accounts")
+	}
+	if len(candidates) == 1 {
+		return candidates[0], func() {}, nil
+	}
+
+	selections := txm.signerSelections
+	selections.mu.Lock()
+	defer selections.mu.Unlock()
+
+	counts, err := txm.countActiveTransactions(candidates)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	for i, candidate := range candidates {
+		counts[i] += selections.reserved(hex.EncodeToString(candidate), now)
+	}
+
+	// only the least busy candidates are worth a balance read
+	least := counts[0]
+	for _, count := range counts[1:] {
+		least = min(least, count)
+	}
+
+	var selected []byte
+	var selectedBalance *big.Int
+	for i, candidate := range candidates {
+		if counts[i] != least {
+			continue
+		}
+		if selected == nil {
+			selected = candidate
+		}
+
+		balance, err := txm.signerBalance(ctx, candidate, now)
+		if err != nil {
+			txm.lggr.Warnw("Failed to read signer balance", "publicKey", hex.EncodeToString(candidate), "error", err)
+			continue
+		}
+		if selectedBalance == nil || balance.Cmp(selectedBalance) > 0 {
+			selected, selectedBalance = candidate, balance
+		}
+	}
+
+	key := hex.EncodeToString(selected)
+	reservation := &signerReservation{expiresAt: now.Add(signerReservationTTL)}
+	selections.reservations[key] = append(selections.reservations[key], reservation)
+
+	txm.lggr.Debugw("Selected signer", "publicKey", key, "activeTransactions", least,
+		"balance", selectedBalance, "candidates", len(candidates))
+
+	return selected, func() { selections.release(key, reservation) }, nil
+}
+
+// signerBalance returns the SUI balance of a candidate signer, read at most once every signerBalanceTTL. It must be
+// called with the lock of the signer selections held.
+func (txm *SuiTxm) signerBalance(ctx context.Context, publicKey []byte, now time.Time) (*big.Int, error) {
+	key := hex.EncodeToString(publicKey)
+	if cached, ok := txm.signerSelections.balances[key]; ok && now.Before(cached.expiresAt) {
+		return cached.balance, nil
+	}
+
+	address, err := client.GetAddressFromPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := txm.suiGateway.GetSUIBalance(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	txm.signerSelections.balances[key] = cachedBalance{balance: balance, expiresAt: now.Add(signerBalanceTTL)}
+
+	return balance, nil
+}
+
+// countActiveTransactions returns the number of pending and in-flight transactions of each candidate signer.
+func (txm *SuiTxm) countActiveTransactions(candidates [][]byte) ([]int, error) {
+	pending, err := txm.transactionRepository.GetTransactionsByState(StatePending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending transactions: %w", err)
+	}
+	inflight, err := txm.transactionRepository.GetInflightTransactions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get in-flight transactions: %w", err)
+	}
+
+	counts := make([]int, len(candidates))
+	for _, tx := range append(pending, inflight...) {
+		for i, candidate := range candidates {
+			if bytes.Equal(tx.PublicKey, candidate) {
+				counts[i]++
+				break
+			}
+		}
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,188 @@
+//go:build unit
+
+package txm_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/testutils"
+	"github.com/smartcontractkit/chainlink-sui/relayer/txm"
+)
+
+func TestSelectSigner(t *testing.T) {
+	t.Parallel()
+
+	newKey := func(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey, string) {
+		t.Helper()
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		address, err := client.GetAddressFromPublicKey(publicKey)
+		require.NoError(t, err)
+
+		return publicKey, privateKey, address
+	}
+
+	setup := func(t *testing.T, fakeClient *testutils.FakeSuiPTBClient) (*txm.SuiTxm, txm.TxmStore, *testutils.TestKeystore) {
+		t.Helper()
+		lggr := logger.Test(t)
+		store := txm.NewTxmStoreImpl(lggr)
+		gasManager := txm.NewSuiGasManager(lggr, fakeClient, *big.NewInt(200000000), 0)
+		keystoreInstance := testutils.NewTestKeystore(t)
+
+		txmInstance, err := txm.NewSuiTxm(lggr, fakeClient, keystoreInstance, txm.DefaultConfigSet, store, txm.NewDefaultRetryManager(3), gasManager)
+		require.NoError(t, err)
+
+		return txmInstance, store, keystoreInstance
+	}
+
+	t.Run("Prefers the signer with the fewest active transactions", func(t *testing.T) {
+		t.Parallel()
+
+		busy, _, busyAddress := newKey(t)
+		idle, _, _ := newKey(t)
+		fakeClient := &testutils.FakeSuiPTBClient{Balances: map[string]*big.Int{busyAddress: big.NewInt(1000)}}
+		txmInstance, store, _ := setup(t, fakeClient)
+
+		require.NoError(t, store.AddTransaction(txm.SuiTx{TransactionID: "tx-pending", PublicKey: busy}))
+		require.NoError(t, store.AddTransaction(txm.SuiTx{TransactionID: "tx-submitted", PublicKey: busy}))
+		require.NoError(t, store.ChangeState("tx-submitted", txm.StateSubmitted))
+
+		selected, _, err := txmInstance.SelectSigner(context.Background(), [][]byte{busy, idle})
+		require.NoError(t, err)
+		assert.Equal(t, []byte(idle), selected)
+	})
+
+	t.Run("Breaks ties by balance", func(t *testing.T) {
+		t.Parallel()
+
+		poor, _, poorAddress := newKey(t)
+		rich, _, richAddress := newKey(t)
+		fakeClient := &testutils.FakeSuiPTBClient{Balances: map[string]*big.Int{
+			poorAddress: big.NewInt(10),
+			richAddress: big.NewInt(1000),
+		}}
+		txmInstance, store, _ := setup(t, fakeClient)
+
+		// finalized transactions are not active
+		require.NoError(t, store.AddTransaction(txm.SuiTx{TransactionID: "tx-done", PublicKey: poor}))
+		require.NoError(t, store.ChangeState("tx-done", txm.StateSubmitted))
+		require.NoError(t, store.ChangeState("tx-done", txm.StateFinalized))
+
+		selected, _, err := txmInstance.SelectSigner(context.Background(), [][]byte{poor, rich})
+		require.NoError(t, err)
+		assert.Equal(t, []byte(rich), selected)
+	})
+
+	t.Run("Uses the keystore accounts without candidates", func(t *testing.T) {
+		t.Parallel()
+
+		transmitter, privateKey, _ := newKey(t)
+		txmInstance, _, keystoreInstance := setup(t, &testutils.FakeSuiPTBClient{})
+		keystoreInstance.AddKey(privateKey)
+
+		selected, _, err := txmInstance.SelectSigner(context.Background(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(transmitter), selected)
+	})
+
+	t.Run("Fails without candidates nor keystore accounts", func(t *testing.T) {
+		t.Parallel()
+
+		txmInstance, _, _ := setup(t, &testutils.FakeSuiPTBClient{})
+
+		_, _, err := txmInstance.SelectSigner(context.Background(), nil)
+		require.ErrorContains(t, err, "no signer candidates")
+	})
+
+	t.Run("Releases only its own selection", func(t *testing.T) {
+		t.Parallel()
+
+		first, _, _ := newKey(t)
+		second, _, _ := newKey(t)
+		txmInstance, _, _ := setup(t, &testutils.FakeSuiPTBClient{})
+		candidates := [][]byte{first, second}
+
+		selected, release, err := txmInstance.SelectSigner(context.Background(), candidates)
+		require.NoError(t, err)
+		require.Equal(t, []byte(first), selected)
+
+		// a selection without reservation, e.g. of a single candidate, releases nothing
+		_, releaseSingle, err := txmInstance.SelectSigner(context.Background(), [][]byte{first})
+		require.NoError(t, err)
+		releaseSingle()
+
+		selected, releaseSecond, err := txmInstance.SelectSigner(context.Background(), candidates)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(second), selected, "the first selection is still reserved")
+
+		selected, _, err = txmInstance.SelectSigner(context.Background(), candidates)
+		require.NoError(t, err)
+		require.Equal(t, []byte(first), selected)
+
+		// releasing twice only releases the first selection, the first signer keeps the third one
+		release()
+		release()
+		selected, _, err = txmInstance.SelectSigner(context.Background(), candidates)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(first), selected)
+		selected, _, err = txmInstance.SelectSigner(context.Background(), candidates)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(second), selected)
+		releaseSecond()
+	})
+
+	t.Run("Spreads concurrent selections over the candidates", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := make([][]byte, 4)
+		for i := range candidates {
+			candidates[i], _, _ = newKey(t)
+		}
+		fakeClient := &countingBalanceClient{FakeSuiPTBClient: &testutils.FakeSuiPTBClient{}}
+		lggr := logger.Test(t)
+		txmInstance, err := txm.NewSuiTxm(lggr, fakeClient, testutils.NewTestKeystore(t), txm.DefaultConfigSet,
+			txm.NewTxmStoreImpl(lggr), txm.NewDefaultRetryManager(3), txm.NewSuiGasManager(lggr, fakeClient, *big.NewInt(200000000), 0))
+		require.NoError(t, err)
+
+		selected := make([][]byte, len(candidates))
+		var wg sync.WaitGroup
+		for i := range candidates {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				var selectErr error
+				selected[i], _, selectErr = txmInstance.SelectSigner(context.Background(), candidates)
+				assert.NoError(t, selectErr)
+			}()
+		}
+		wg.Wait()
+
+		// none of the transactions is stored yet, but each selection counts as one of its signer
+		assert.ElementsMatch(t, candidates, selected)
+		// the balances are read once and then cached
+		assert.Equal(t, int64(len(candidates)), fakeClient.reads.Load())
+	})
+}
+
+// countingBalanceClient counts the balance reads of the signer selection.
+type countingBalanceClient struct {
+	*testutils.FakeSuiPTBClient
+	reads atomic.Int64
+}
+
+func (c *countingBalanceClient) GetSUIBalance(ctx context.Context, address string) (*big.Int, error) {
+	c.reads.Add(1)
+
+	return c.FakeSuiPTBClient.GetSUIBalance(ctx, address)
+}
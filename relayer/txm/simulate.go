@@ -0,0 +1,91 @@
+// simulate.go provides the dry-run mode of the transaction manager. Simulated transactions are generated and signed
+// exactly like enqueued ones, but they are dry-run instead of broadcast and stored directly in a final state.
+package txm
+
+import (
+	"context"
+	"fmt"
+
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/block-vision/sui-go-sdk/transaction"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/client/suierrors"
+)
+
+// SimulatePTB generates a transaction from a pre-constructed Programmable Transaction Block (PTB) as EnqueuePTB
+// does, dry-runs it instead of broadcasting it, and stores it with the simulated effects and gas usage as its result.
+// The stored transaction is finalized if the dry-run succeeded and failed otherwise, so that GetTransactionStatus
+// reports the outcome the transaction would have had on-chain. Nothing is submitted and no gas is spent.
+// It's part of the TxManager interface implementation.
+//
+// Parameters:
+//   - ctx: Context for the operation.
+//   - transactionID: Unique identifier for the transaction.
+//   - txMetadata: Transaction metadata, potentially including gas limits.
+//   - signerPublicKey: The public key of the account the transaction is simulated for.
+//   - ptb: The ProgrammableTransaction block containing the sequence of commands.
+//
+// Returns:
+//   - *SuiTx: The simulated and stored transaction object, its Simulation holding the dry-run result.
+//   - error: An error if the transaction cannot be generated, dry-run or stored. A transaction aborting during the
+//     dry-run is not an error.
+func (txm *SuiTxm) SimulatePTB(ctx context.Context, transactionID string, txMetadata *commontypes.TxMeta, signerPublicKey []byte, ptb *transaction.Transaction) (*SuiTx, error) {
+	txm.lggr.Infow("Simulating PTB", "transactionID", transactionID, "ptb", ptb)
+
+	txn, err := generatePTBTransactionWithGasEstimation(
+		ctx, signerPublicKey, txm.lggr, txm.keystoreService, txm.suiGateway,
+		txm.configuration.RequestType, transactionID, txMetadata,
+		ptb, txm.gasManager, nil,
+	)
+	if err != nil {
+		txm.lggr.Errorw("Failed to generate PTB txn", "error", err)
+		return nil, err
+	}
+
+	result, err := txm.suiGateway.DryRunTransaction(ctx, txn.Payload)
+	if err != nil {
+		txm.lggr.Errorw("Failed to dry run PTB txn", "transactionID", transactionID, "error", err)
+		return nil, err
+	}
+
+	txn.Simulation = &result
+	txn.Digest = result.Effects.TransactionDigest
+	if result.Status == success {
+		txn.State = StateFinalized
+	} else {
+		txn.State = StateFailed
+		txn.TxError = suierrors.ParseSuiErrorMessage(result.Error)
+	}
+
+	err = txm.transactionRepository.AddTransaction(*txn)
+	if err != nil {
+		txm.lggr.Errorw("Failed to add simulated txn to repository", "error", err)
+		return nil, err
+	}
+
+	txm.lggr.Infow("PTB Transaction simulated",
+		"transactionID", transactionID,
+		"status", result.Status,
+		"error", result.Error,
+		"gasUsed", result.GasUsed,
+		"gasBudget", txn.GasBudget,
+	)
+
+	return txn, nil
+}
+
+// GetSimulationResult returns the dry-run result of a transaction simulated with SimulatePTB.
+func (txm *SuiTxm) GetSimulationResult(ctx context.Context, transactionID string) (*client.DryRunResult, error) {
+	tx, err := txm.transactionRepository.GetTransaction(transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx.Simulation == nil {
+		return nil, fmt.Errorf("transaction %s was not simulated", transactionID)
+	}
+
+	return tx.Simulation, nil
+}
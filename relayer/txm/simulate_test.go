@@ -0,0 +1,121 @@
+//go:build unit
+
+package txm_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/block-vision/sui-go-sdk/models"
+	"github.com/block-vision/sui-go-sdk/transaction"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-sui/relayer/client"
+	"github.com/smartcontractkit/chainlink-sui/relayer/testutils"
+	"github.com/smartcontractkit/chainlink-sui/relayer/txm"
+)
+
+func TestSimulatePTB(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		name           string
+		dryRun         client.DryRunResult
+		expectedState  txm.TransactionState
+		expectedStatus commontypes.TransactionStatus
+	}{
+		{
+			name: "Successful dry-run finalizes the transaction",
+			dryRun: client.DryRunResult{
+				TransactionResult: client.TransactionResult{Status: "success", GasUsed: 1500000},
+				Effects:           models.SuiEffects{TransactionDigest: "simulated-digest"},
+			},
+			expectedState:  txm.StateFinalized,
+			expectedStatus: commontypes.Finalized,
+		},
+		{
+			name: "Aborted dry-run fails the transaction",
+			dryRun: client.DryRunResult{
+				TransactionResult: client.TransactionResult{
+					Status:  "failure",
+					Error:   "MoveAbort(MoveLocation { module: ModuleId { address: 0x1, name: Identifier(\"offramp\") } }, 7) in command 0",
+					GasUsed: 900000,
+				},
+				Effects: models.SuiEffects{TransactionDigest: "simulated-digest"},
+			},
+			expectedState:  txm.StateFailed,
+			expectedStatus: commontypes.Fatal,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			t.Parallel()
+
+			lggr := logger.Test(t)
+			store := txm.NewTxmStoreImpl(lggr)
+			fakeClient := &testutils.FakeSuiPTBClient{DryRun: scenario.dryRun}
+			gasManager := txm.NewSuiGasManager(lggr, fakeClient, *big.NewInt(200000000), 0)
+			keystoreInstance := testutils.NewTestKeystore(t)
+
+			txmInstance, err := txm.NewSuiTxm(lggr, fakeClient, keystoreInstance, txm.DefaultConfigSet, store, txm.NewDefaultRetryManager(3), gasManager)
+			require.NoError(t, err)
+
+			publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+			require.NoError(t, err)
+			keystoreInstance.AddKey(privateKey)
+
+			ptb := transaction.NewTransaction()
+			ptb.SetGasPrice(1000)
+
+			ctx := context.Background()
+			tx, err := txmInstance.SimulatePTB(ctx, "tx-simulated", &commontypes.TxMeta{GasLimit: big.NewInt(10000000)}, publicKey, ptb)
+			require.NoError(t, err)
+			assert.Equal(t, scenario.expectedState, tx.State)
+			assert.Equal(t, "simulated-digest", tx.Digest)
+
+			status, err := txmInstance.GetTransactionStatus(ctx, "tx-simulated")
+			require.NoError(t, err)
+			assert.Equal(t, scenario.expectedStatus, status)
+
+			// simulated transactions are never broadcast nor confirmed
+			inflight, err := store.GetInflightTransactions()
+			require.NoError(t, err)
+			assert.Empty(t, inflight)
+			pending, err := store.GetTransactionsByState(txm.StatePending)
+			require.NoError(t, err)
+			assert.Empty(t, pending)
+
+			result, err := txmInstance.GetSimulationResult(ctx, "tx-simulated")
+			require.NoError(t, err)
+			assert.Equal(t, scenario.dryRun.GasUsed, result.GasUsed)
+			assert.Equal(t, scenario.dryRun.Error, result.Error)
+		})
+	}
+}
+
+func TestGetSimulationResult_NotSimulated(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.Test(t)
+	store := txm.NewTxmStoreImpl(lggr)
+	fakeClient := &testutils.FakeSuiPTBClient{}
+	gasManager := txm.NewSuiGasManager(lggr, fakeClient, *big.NewInt(200000000), 0)
+
+	txmInstance, err := txm.NewSuiTxm(lggr, fakeClient, testutils.NewTestKeystore(t), txm.DefaultConfigSet, store, txm.NewDefaultRetryManager(3), gasManager)
+	require.NoError(t, err)
+
+	require.NoError(t, store.AddTransaction(txm.SuiTx{TransactionID: "tx-broadcast"}))
+
+	_, err = txmInstance.GetSimulationResult(context.Background(), "tx-broadcast")
+	require.ErrorContains(t, err, "was not simulated")
+
+	_, err = txmInstance.GetSimulationResult(context.Background(), "tx-unknown")
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"slices"
 	"sync"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -20,6 +21,10 @@ type TxmStore interface {
 	// Returns an error if a transaction with the same ID already exists.
 	AddTransaction(tx SuiTx) error
 
+	// AddTransactions adds transactions to the store at once: either all of them are added, or none if any of
+	// them already exists.
+	AddTransactions(txs []SuiTx) error
+
 	// IncrementAttempts increments the attempt count of a transaction.
 	// Returns an error if the transaction is not found.
 	IncrementAttempts(transactionID string) error
@@ -58,6 +63,10 @@ type TxmStore interface {
 	GetTransactionsByState(state TransactionState) ([]SuiTx, error)
 
 	GetInflightTransactions() ([]SuiTx, error)
+
+	// GetTransactionsByBundle retrieves all transactions belonging to a soft bundle, in the order they were added.
+	// Returns an error if no transaction is registered under the given bundle ID.
+	GetTransactionsByBundle(bundleID string) ([]SuiTx, error)
 }
 
 // InMemoryStore implements the TxmStore interface using in-memory data structures.
@@ -74,6 +83,7 @@ type InMemoryStore struct {
 	mu           sync.RWMutex                             // Mutex to control concurrent access to the data structures
 	transactions map[string]*SuiTx                        // Main map to store pointers to transactions by ID
 	stateBuckets map[TransactionState]map[string]struct{} // Auxiliary maps to store transaction IDs by state for efficient lookups
+	bundles      map[string][]string                      // Ordered transaction IDs grouped by soft bundle ID
 }
 
 var _ TxmStore = (*InMemoryStore)(nil)
@@ -91,32 +101,55 @@ func NewTxmStoreImpl(lggr logger.Logger) *InMemoryStore {
 			StateRetriable: make(map[string]struct{}),
 			StateFailed:    make(map[string]struct{}),
 		},
+		bundles: make(map[string][]string),
 	}
 }
 
 // AddTransaction adds a new transaction to the store.
-// It sets the initial state to StatePending and updates both the transactions map
+// It sets the initial state to StatePending, except for simulated transactions which keep their final state,
+// and updates both the transactions map
 // and the state buckets accordingly.
 // Returns an error if a transaction with the same ID already exists.
 func (s *InMemoryStore) AddTransaction(tx SuiTx) error {
-	id := tx.TransactionID
+	return s.AddTransactions([]SuiTx{tx})
+}
 
+// AddTransactions adds the transactions like AddTransaction, holding the lock for all of them.
+// Returns an error without adding any transaction if one of them already exists or their IDs are not unique.
+func (s *InMemoryStore) AddTransactions(txs []SuiTx) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	// Check if the transaction already exists
-	_, exists := s.transactions[id]
-	if exists {
-		return fmt.Errorf("transaction already exists")
+	// Check that no transaction already exists before adding any
+	ids := make(map[string]struct{}, len(txs))
+	for _, tx := range txs {
+		_, exists := s.transactions[tx.TransactionID]
+		_, duplicate := ids[tx.TransactionID]
+		if exists || duplicate {
+			return fmt.Errorf("transaction already exists")
+		}
+		ids[tx.TransactionID] = struct{}{}
 	}
 
-	tx.State = StatePending
+	for _, tx := range txs {
+		id := tx.TransactionID
+
+		// Simulated transactions are never broadcast, they are added in their final state
+		if tx.Simulation == nil {
+			tx.State = StatePending
+		}
+
+		// Add to the main transactions map
+		s.transactions[id] = &tx
 
-	// Add to the main transactions map
-	s.transactions[id] = &tx
+		// Add the transaction ID to the appropriate state bucket
+		s.stateBuckets[tx.State][id] = struct{}{}
 
-	// Add the transaction ID to the appropriate state bucket
-	s.stateBuckets[StatePending][id] = struct{}{}
+		// Track the transaction as part of its bundle, if any
+		if tx.BundleID != "" {
+			s.bundles[tx.BundleID] = append(s.bundles[tx.BundleID], id)
+		}
+	}
 
 	return nil
 }
@@ -247,6 +280,16 @@ func (s *InMemoryStore) DeleteTransaction(transactionID string) error {
 	// Remove from the state bucket
 	delete(s.stateBuckets[state], transactionID)
 
+	// Remove from the bundle, if any
+	if tx.BundleID != "" {
+		s.bundles[tx.BundleID] = slices.DeleteFunc(s.bundles[tx.BundleID], func(id string) bool {
+			return id == transactionID
+		})
+		if len(s.bundles[tx.BundleID]) == 0 {
+			delete(s.bundles, tx.BundleID)
+		}
+	}
+
 	return nil
 }
 
@@ -287,6 +330,24 @@ func (s *InMemoryStore) GetInflightTransactions() ([]SuiTx, error) {
 	return txs, nil
 }
 
+// GetTransactionsByBundle implements TxmStore.
+func (s *InMemoryStore) GetTransactionsByBundle(bundleID string) ([]SuiTx, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids, exists := s.bundles[bundleID]
+	if !exists {
+		return nil, fmt.Errorf("bundle not found")
+	}
+
+	transactions := make([]SuiTx, 0, len(ids))
+	for _, id := range ids {
+		transactions = append(transactions, *s.transactions[id])
+	}
+
+	return transactions, nil
+}
+
 // UpdateTransactionGas implements TxmStore.
 func (s *InMemoryStore) UpdateTransactionGas(
 	ctx context.Context,
@@ -3,6 +3,7 @@
 package txm
 
 import (
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -158,6 +159,62 @@ func TestGetTransactionsByState(t *testing.T) {
 	assert.Contains(t, submittedTxs, tx3, "expected tx3 to be in the Submitted state")
 }
 
+func TestGetTransactionsByBundle(t *testing.T) {
+	t.Parallel()
+	lggr := logger.Test(t)
+	store := NewTxmStoreImpl(lggr)
+
+	for _, id := range []string{"bundle-0", "bundle-1", "bundle-2"} {
+		tx := GetTransaction()
+		tx.TransactionID = id
+		tx.BundleID = "bundle"
+		require.NoError(t, store.AddTransaction(tx), "expected no error when adding a bundle transaction")
+	}
+	require.NoError(t, store.AddTransaction(GetTransaction()), "expected no error when adding a standalone transaction")
+
+	bundleTxs, err := store.GetTransactionsByBundle("bundle")
+	require.NoError(t, err, "expected no error when retrieving bundle transactions")
+	require.Len(t, bundleTxs, 3, "expected only the bundle transactions to be returned")
+	for i, tx := range bundleTxs {
+		assert.Equal(t, fmt.Sprintf("bundle-%d", i), tx.TransactionID, "bundle transactions should keep their insertion order")
+	}
+
+	require.NoError(t, store.DeleteTransaction("bundle-1"), "expected no error when deleting a bundle transaction")
+	bundleTxs, err = store.GetTransactionsByBundle("bundle")
+	require.NoError(t, err, "expected no error when retrieving bundle transactions")
+	assert.Len(t, bundleTxs, 2, "deleted transaction should be removed from the bundle")
+
+	_, err = store.GetTransactionsByBundle("unknown")
+	require.Error(t, err, "expected an error when retrieving an unknown bundle")
+}
+
+func TestAddTransactions(t *testing.T) {
+	t.Parallel()
+	lggr := logger.Test(t)
+	store := NewTxmStoreImpl(lggr)
+
+	txs := make([]SuiTx, 3)
+	for i := range txs {
+		txs[i] = GetTransaction()
+		txs[i].TransactionID = fmt.Sprintf("bundle-%d", i)
+		txs[i].BundleID = "bundle"
+	}
+	require.NoError(t, store.AddTransaction(txs[2]), "expected no error when adding a transaction")
+
+	err := store.AddTransactions(txs)
+	require.Error(t, err, "expected an error when one of the transactions already exists")
+	_, err = store.GetTransaction("bundle-0")
+	require.Error(t, err, "expected no transaction to be added when one of them already exists")
+
+	err = store.AddTransactions([]SuiTx{txs[0], txs[0]})
+	require.Error(t, err, "expected an error when adding the same transaction twice")
+
+	require.NoError(t, store.AddTransactions(txs[:2]), "expected no error when adding new transactions")
+	bundleTxs, err := store.GetTransactionsByBundle("bundle")
+	require.NoError(t, err, "expected no error when retrieving bundle transactions")
+	assert.Len(t, bundleTxs, 3, "expected every added transaction to be part of the bundle")
+}
+
 func TestGetTransactionsByInvalidState(t *testing.T) {
 	t.Parallel()
 	lggr := logger.Test(t)
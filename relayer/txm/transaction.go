@@ -3,6 +3,7 @@ package txm
 import (
 	"context"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
@@ -48,8 +49,26 @@ const (
 	StateFailed
 )
 
+func (s TransactionState) String() string {
+	switch s {
+	case StatePending:
+		return "pending"
+	case StateSubmitted:
+		return "submitted"
+	case StateFinalized:
+		return "finalized"
+	case StateRetriable:
+		return "retriable"
+	case StateFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
 type SuiTx struct {
 	TransactionID string
+	BundleID      string // ID of the soft bundle the transaction belongs to, empty for standalone transactions
 	Sender        string
 	PublicKey     []byte
 	Metadata      *commontypes.TxMeta
@@ -65,6 +84,7 @@ type SuiTx struct {
 	TxError       *suierrors.SuiError
 	GasBudget     uint64
 	Ptb           *transaction.Transaction
+	Simulation    *client.DryRunResult // dry-run result of a simulated transaction, nil for broadcast transactions
 }
 
 // UpdateBSCPayload regenerates the BCS payload and signatures for the SuiTx.
@@ -90,7 +110,7 @@ func (tx *SuiTx) UpdateBSCPayload(
 		return fmt.Errorf("failed to get address from public key: %w", err)
 	}
 
-	txBytes, _, err := preparePTBTransaction(ctx, signerAddress, suiClient, tx.Ptb, tx.GasBudget, lggr)
+	txBytes, _, err := preparePTBTransaction(ctx, signerAddress, suiClient, tx.Ptb, tx.GasBudget, nil, lggr)
 	if err != nil {
 		return fmt.Errorf("failed to prepare PTB transaction: %w", err)
 	}
@@ -114,8 +134,11 @@ func (tx *SuiTx) UpdateBSCPayload(
 	}
 
 	// Serialize signatures for new bcs payload
-	signatureStrings := []string{client.SerializeSuiSignature(signature, tx.PublicKey)}
-	tx.Signatures = signatureStrings
+	serializedSignature, err := client.SerializeSuiSignature(signature, tx.PublicKey)
+	if err != nil {
+		return err
+	}
+	tx.Signatures = []string{serializedSignature}
 
 	return nil
 }
@@ -166,6 +189,29 @@ func GeneratePTBTransactionWithGasEstimation(
 	ptb *transaction.Transaction,
 	simulateTx bool,
 	gasManager GasManager,
+) (*SuiTx, error) {
+	return generatePTBTransactionWithGasEstimation(
+		ctx, pubKey, lggr, keystoreService, suiClient,
+		requestType, transactionID, txMetadata,
+		ptb, gasManager, nil,
+	)
+}
+
+// generatePTBTransactionWithGasEstimation implements GeneratePTBTransactionWithGasEstimation.
+// Gas coins listed in excludedCoins (keyed by normalized object ID) are never selected for payment,
+// which allows building several transactions from the same sender that do not conflict on gas coins.
+func generatePTBTransactionWithGasEstimation(
+	ctx context.Context,
+	pubKey []byte,
+	lggr logger.Logger,
+	keystoreService loop.Keystore,
+	suiClient client.SuiPTBClient,
+	requestType string,
+	transactionID string,
+	txMetadata *commontypes.TxMeta,
+	ptb *transaction.Transaction,
+	gasManager GasManager,
+	excludedCoins map[string]struct{},
 ) (*SuiTx, error) {
 	signerAddress, err := client.GetAddressFromPublicKey(pubKey)
 	if err != nil {
@@ -188,7 +234,7 @@ func GeneratePTBTransactionWithGasEstimation(
 		"preliminaryGasBudget", preliminaryGasBudget)
 
 	preliminaryTx, err := buildPreliminaryTransaction(
-		ctx, signerAddress, suiClient, ptb, preliminaryGasBudget, lggr,
+		ctx, signerAddress, suiClient, ptb, preliminaryGasBudget, excludedCoins, lggr,
 	)
 	if err != nil {
 		lggr.Errorf("failed to build preliminary transaction: %v", err)
@@ -197,11 +243,18 @@ func GeneratePTBTransactionWithGasEstimation(
 
 	// Step 3: Estimate gas using the gas manager
 	estimatedGas, err := gasManager.EstimateGasBudget(ctx, preliminaryTx)
-	if err != nil {
+	var dryRunErr *client.DryRunFailedError
+	switch {
+	case errors.As(err, &dryRunErr):
+		// the transaction is still submitted, e.g. a failed CCIP execution is recorded on chain
+		lggr.Warnw("Transaction fails in gas estimation dry run, falling back to metadata/default",
+			"error", dryRunErr.Reason, "abort", dryRunErr.Abort, "fallbackBudget", preliminaryGasBudget)
+		finalGasBudget = preliminaryGasBudget
+	case err != nil:
 		lggr.Warnw("Gas estimation failed, falling back to metadata/default",
 			"error", err, "fallbackBudget", preliminaryGasBudget)
 		finalGasBudget = preliminaryGasBudget
-	} else {
+	default:
 		// If the estimate is bigger than the provided buddet, we need to abort
 		if estimatedGas > preliminaryGasBudget {
 			return nil, fmt.Errorf("estimated gas is greater than preliminary gas budget: %d > %d", estimatedGas, preliminaryGasBudget)
@@ -222,7 +275,7 @@ func GeneratePTBTransactionWithGasEstimation(
 		requestType, transactionID, &commontypes.TxMeta{
 			GasLimit: big.NewInt(int64(finalGasBudget)),
 		},
-		ptb,
+		ptb, excludedCoins,
 	)
 }
 
@@ -247,6 +300,7 @@ func GeneratePTBTransactionWithGasEstimation(
 //   - txMetadata: Transaction metadata including gas configuration (GasLimit).
 //   - signerAddress: Address of the account that will sign and submit the transaction.
 //   - ptb: The ProgrammableTransaction block containing the commands to be executed.
+//   - excludedCoins: Gas coins (keyed by normalized object ID) that must not be used for payment.
 //
 // Returns:
 //   - *SuiTx: A complete transaction object ready for submission.
@@ -261,6 +315,7 @@ func generatePTBTransaction(
 	transactionID string,
 	txMetadata *commontypes.TxMeta,
 	ptb *transaction.Transaction,
+	excludedCoins map[string]struct{},
 ) (*SuiTx, error) {
 	signerAddress, err := client.GetAddressFromPublicKey(pubKey)
 	if err != nil {
@@ -277,7 +332,7 @@ func generatePTBTransaction(
 	}
 
 	// Use common preparation logic
-	txBytes, _, err := preparePTBTransaction(ctx, signerAddress, suiClient, ptb, gasBudget, lggr)
+	txBytes, _, err := preparePTBTransaction(ctx, signerAddress, suiClient, ptb, gasBudget, excludedCoins, lggr)
 	if err != nil {
 		lggr.Errorf("failed to prepare PTB transaction: %v", err)
 		return nil, err
@@ -300,7 +355,12 @@ func generatePTBTransaction(
 	}
 
 	// Serialize signature (same as working code)
-	signatureStrings := []string{client.SerializeSuiSignature(signature, pubKey)}
+	serializedSignature, err := client.SerializeSuiSignature(signature, pubKey)
+	if err != nil {
+		lggr.Errorf("Error serializing signature: %v", err)
+		return nil, err
+	}
+	signatureStrings := []string{serializedSignature}
 
 	// Extract functions from PTB commands
 	functions := []*SuiFunction{}
@@ -404,6 +464,7 @@ func SelectCoinsForGasBudget(gasBudget uint64, availableCoins []models.CoinData)
 
 // preparePTBTransaction handles the common logic for setting up a PTB transaction.
 // This includes fetching coins, selecting gas coins, setting PTB parameters, and converting to BCS bytes.
+// Coins listed in excludedCoins are not considered for gas payment.
 // It returns the transaction bytes and payment coins for further processing.
 func preparePTBTransaction(
 	ctx context.Context,
@@ -411,6 +472,7 @@ func preparePTBTransaction(
 	suiClient client.SuiPTBClient,
 	ptb *transaction.Transaction,
 	gasBudget uint64,
+	excludedCoins map[string]struct{},
 	lggr logger.Logger,
 ) (txBytes string, paymentCoins []transaction.SuiObjectRef, err error) {
 	// Get available coins for gas
@@ -419,6 +481,11 @@ func preparePTBTransaction(
 		return "", nil, fmt.Errorf("failed to get coins by address: %w", err)
 	}
 
+	coinData, err = filterExcludedCoins(coinData, excludedCoins)
+	if err != nil {
+		return "", nil, err
+	}
+
 	// Select coins for gas budget
 	gasBudgetCoins, err := SelectCoinsForGasBudget(gasBudget, coinData)
 	if err != nil {
@@ -467,6 +534,37 @@ func preparePTBTransaction(
 	return txBytes, paymentCoins, nil
 }
 
+// filterExcludedCoins removes the coins listed in excludedCoins from the available coins.
+func filterExcludedCoins(coins []models.CoinData, excludedCoins map[string]struct{}) ([]models.CoinData, error) {
+	if len(excludedCoins) == 0 {
+		return coins, nil
+	}
+
+	filtered := make([]models.CoinData, 0, len(coins))
+	for _, coin := range coins {
+		coinKey, err := normalizeObjectID(coin.CoinObjectId)
+		if err != nil {
+			return nil, err
+		}
+		if _, excluded := excludedCoins[coinKey]; excluded {
+			continue
+		}
+		filtered = append(filtered, coin)
+	}
+
+	return filtered, nil
+}
+
+// normalizeObjectID converts an object ID into its canonical, zero-padded hex representation.
+func normalizeObjectID(objectID string) (string, error) {
+	objectIDBytes, err := transaction.ConvertSuiAddressStringToBytes(models.SuiAddress(objectID))
+	if err != nil {
+		return "", fmt.Errorf("failed to convert object ID %s: %w", objectID, err)
+	}
+
+	return "0x" + hex.EncodeToString(objectIDBytes[:]), nil
+}
+
 // buildPreliminaryTransaction creates a minimal SuiTx for gas estimation purposes.
 // This transaction is not signed and is only used to get the transaction bytes for estimation.
 func buildPreliminaryTransaction(
@@ -475,10 +573,11 @@ func buildPreliminaryTransaction(
 	suiClient client.SuiPTBClient,
 	ptb *transaction.Transaction,
 	gasBudget uint64,
+	excludedCoins map[string]struct{},
 	lggr logger.Logger,
 ) (*SuiTx, error) {
 	// Use common preparation logic
-	txBytes, _, err := preparePTBTransaction(ctx, signerAddress, suiClient, ptb, gasBudget, lggr)
+	txBytes, _, err := preparePTBTransaction(ctx, signerAddress, suiClient, ptb, gasBudget, excludedCoins, lggr)
 	if err != nil {
 		return nil, err
 	}
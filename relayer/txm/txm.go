@@ -3,6 +3,7 @@ package txm
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/block-vision/sui-go-sdk/transaction"
 
+	"github.com/smartcontractkit/chainlink-sui/bindings/bind"
 	"github.com/smartcontractkit/chainlink-sui/relayer/client"
 )
 
@@ -21,9 +23,15 @@ const numberGoroutines = 2
 type TxManager interface {
 	services.Service
 	EnqueuePTB(ctx context.Context, transactionID string, txMetadata *commontypes.TxMeta, signerPublicKey []byte, ptb *transaction.Transaction) (*SuiTx, error)
+	SimulatePTB(ctx context.Context, transactionID string, txMetadata *commontypes.TxMeta, signerPublicKey []byte, ptb *transaction.Transaction) (*SuiTx, error)
+	GetSimulationResult(ctx context.Context, transactionID string) (*client.DryRunResult, error)
+	EnqueueBundle(ctx context.Context, bundleID string, txMetadata *commontypes.TxMeta, signerPublicKey []byte, ptbs []*transaction.Transaction) ([]*SuiTx, error)
+	SelectSigner(ctx context.Context, candidates [][]byte) ([]byte, func(), error)
 	GetTransactionStatus(ctx context.Context, transactionID string) (commontypes.TransactionStatus, error)
+	GetBundleStatus(ctx context.Context, bundleID string) (commontypes.TransactionStatus, error)
 	GetClient() client.SuiPTBClient
 	GetGasManager() GasManager
+	GetObjectResolver() *bind.ObjectResolver
 }
 
 type SuiTxm struct {
@@ -38,6 +46,9 @@ type SuiTxm struct {
 	done                  sync.WaitGroup
 	broadcastChannel      chan string
 	stopChannel           chan struct{}
+	metrics               *txmMetrics
+	objectResolver        *bind.ObjectResolver
+	signerSelections      *signerSelections
 }
 
 func NewSuiTxm(
@@ -49,6 +60,11 @@ func NewSuiTxm(
 	lggr.Infof("SuiTxm configuration: %+v", conf)
 	lggr.Infof("Gas manager Max Gas Budget: %+v", gasManager.MaxGasBudget())
 
+	metrics, err := newTxmMetrics(conf.ChainInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create TXM metrics: %w", err)
+	}
+
 	return &SuiTxm{
 		lggr:                  logger.Named(lggr, "SuiTxm"),
 		suiGateway:            gateway,
@@ -59,6 +75,9 @@ func NewSuiTxm(
 		configuration:         conf,
 		broadcastChannel:      make(chan string, conf.BroadcastChanSize),
 		stopChannel:           make(chan struct{}),
+		metrics:               metrics,
+		objectResolver:        bind.NewObjectResolver(gateway.GetClient()),
+		signerSelections:      newSignerSelections(),
 	}, nil
 }
 
@@ -102,6 +121,7 @@ func (txm *SuiTxm) EnqueuePTB(ctx context.Context, transactionID string, txMetad
 	}
 
 	txm.broadcastChannel <- transactionID
+	txm.metrics.RecordQueueDepth(ctx, len(txm.broadcastChannel))
 	txm.lggr.Infow("PTB Transaction added to broadcast channel", "transactionID", transactionID)
 	txm.lggr.Infow("PTB Transaction enqueued", "transactionID", transactionID)
 
@@ -182,4 +202,10 @@ func (txm *SuiTxm) GetGasManager() GasManager {
 	return txm.gasManager
 }
 
+// GetObjectResolver returns the object resolver kept current with the effects of the transactions broadcast by
+// the TXM, to resolve the objects of the PTBs it is given.
+func (txm *SuiTxm) GetObjectResolver() *bind.ObjectResolver {
+	return txm.objectResolver
+}
+
 var _ TxManager = (*SuiTxm)(nil)
@@ -4,23 +4,14 @@ echo "Generating bindings for Move Sui contracts..."
 # Build the bindings (add the path to contracts you want to generate bindings for)
 
 # Test Package
-go run bindgen/main.go --moveConfig ./contracts/test/ --input ./contracts/test/sources/counter.move --output ./bindings/generated/test/counter
+go run bindgen/main.go --moveConfig ./contracts/test/ --input ./contracts/test/sources/counter.move --output ./bindings/generated/test/counter --mocks
 go run bindgen/main.go --moveConfig ./contracts/test/ --input ./contracts/test/sources/complex.move --output ./bindings/generated/test/complex
 go run bindgen/main.go --moveConfig ./contracts/test/ --input ./contracts/test/sources/generics.move --output ./bindings/generated/test/generics
+go run bindgen/main.go --moveConfig ./contracts/test/ --input ./contracts/test/sources/enums.move --output ./bindings/generated/test/enums
 
-# CCIP
-go run bindgen/main.go --moveConfig ./contracts/ccip/ccip --input ./contracts/ccip/ccip/sources/fee_quoter.move --output ./bindings/generated/ccip/ccip/fee_quoter
-go run bindgen/main.go --moveConfig ./contracts/ccip/ccip --input ./contracts/ccip/ccip/sources/state_object.move --output ./bindings/generated/ccip/ccip/state_object
-go run bindgen/main.go --moveConfig ./contracts/ccip/ccip --input ./contracts/ccip/ccip/sources/nonce_manager.move --output ./bindings/generated/ccip/ccip/nonce_manager
-go run bindgen/main.go --moveConfig ./contracts/ccip/ccip --input ./contracts/ccip/ccip/sources/receiver_registry.move --output ./bindings/generated/ccip/ccip/receiver_registry
-go run bindgen/main.go --moveConfig ./contracts/ccip/ccip --input ./contracts/ccip/ccip/sources/rmn_remote.move --output ./bindings/generated/ccip/ccip/rmn_remote
-go run bindgen/main.go --moveConfig ./contracts/ccip/ccip --input ./contracts/ccip/ccip/sources/token_admin_registry.move --output ./bindings/generated/ccip/ccip/token_admin_registry
-
-# CCIP - Onramp
-go run bindgen/main.go --moveConfig ./contracts/ccip/ccip_onramp --input ./contracts/ccip/ccip_onramp/sources/onramp.move --output ./bindings/generated/ccip/ccip_onramp/onramp
-
-# CCIP - Offramp
-go run bindgen/main.go --moveConfig ./contracts/ccip/ccip_offramp --input ./contracts/ccip/ccip_offramp/sources/offramp.move --output ./bindings/generated/ccip/ccip_offramp/offramp
+# CCIP - Onramp and Offramp, with every module of their CCIP and MCMS dependencies, referencing each other's structs
+go run bindgen/main.go --moveConfig ./contracts/ccip/ccip_onramp --output ./bindings/generated/ccip/ccip_onramp --dependencies ChainlinkCCIP=./bindings/generated/ccip/ccip,ChainlinkManyChainMultisig=./bindings/generated/mcms
+go run bindgen/main.go --moveConfig ./contracts/ccip/ccip_offramp --output ./bindings/generated/ccip/ccip_offramp --dependencies ChainlinkCCIP=./bindings/generated/ccip/ccip,ChainlinkManyChainMultisig=./bindings/generated/mcms
 
 # CCIP - LINK
 go run bindgen/main.go --moveConfig ./contracts/ccip/mock_link_token --input ./contracts/ccip/mock_link_token/sources/mock_link_token.move --output ./bindings/generated/ccip/mock_link_token/mock_link_token
@@ -49,12 +40,6 @@ go run bindgen/main.go --moveConfig ./contracts/ccip/ccip_router --input ./contr
 # LINK TOKEN
 go run bindgen/main.go --moveConfig ./contracts/link --input ./contracts/link/sources/link.move --output ./bindings/generated/link/link
 
-# MCMS
-go run bindgen/main.go --moveConfig ./contracts/mcms/mcms --input ./contracts/mcms/mcms/sources/mcms.move --output ./bindings/generated/mcms/mcms
-go run bindgen/main.go --moveConfig ./contracts/mcms/mcms --input ./contracts/mcms/mcms/sources/mcms_account.move --output ./bindings/generated/mcms/mcms_account
-go run bindgen/main.go --moveConfig ./contracts/mcms/mcms --input ./contracts/mcms/mcms/sources/mcms_deployer.move --output ./bindings/generated/mcms/mcms_deployer
-go run bindgen/main.go --moveConfig ./contracts/mcms/mcms --input ./contracts/mcms/mcms/sources/mcms_registry.move --output ./bindings/generated/mcms/mcms_registry
-
 # CCIP - Dummy Receiver
 go run bindgen/main.go --moveConfig ./contracts/ccip/ccip_dummy_receiver --input ./contracts/ccip/ccip_dummy_receiver/sources/ccip_dummy_receiver.move --output ./bindings/generated/ccip/ccip_dummy_receiver/ccip_dummy_receiver
 